@@ -6,14 +6,28 @@ import (
 	// #include "AccessibilityHandler_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// AccessibilityHandlerProxy defines methods required for using AccessibilityHandler.
+// AccessibilityHandlerProxy is implemented by the proxies used with AccessibilityHandler. Embed
+// DefaultAccessibilityHandler to satisfy it, then implement any of the optional
+// AccessibilityHandler*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type AccessibilityHandlerProxy interface {
+	isAccessibilityHandlerProxy()
+}
+
+// DefaultAccessibilityHandler may be embedded to satisfy AccessibilityHandlerProxy.
+type DefaultAccessibilityHandler struct{}
+
+func (DefaultAccessibilityHandler) isAccessibilityHandlerProxy() {}
+
+// AccessibilityHandlerOnAccessibilityTreeChangeProxy may be implemented by a AccessibilityHandlerProxy to handle OnAccessibilityTreeChange.
+type AccessibilityHandlerOnAccessibilityTreeChangeProxy interface {
 	OnAccessibilityTreeChange(self *AccessibilityHandler, value *Value)
+}
+
+// AccessibilityHandlerOnAccessibilityLocationChangeProxy may be implemented by a AccessibilityHandlerProxy to handle OnAccessibilityLocationChange.
+type AccessibilityHandlerOnAccessibilityLocationChangeProxy interface {
 	OnAccessibilityLocationChange(self *AccessibilityHandler, value *Value)
 }
 
@@ -28,7 +42,9 @@ type AccessibilityHandler C.cef_accessibility_handler_t
 func NewAccessibilityHandler(proxy AccessibilityHandlerProxy) *AccessibilityHandler {
 	result := (*AccessibilityHandler)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_accessibility_handler_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_accessibility_handler_proxy(result.toNative())
+		_, on_accessibility_tree_change := proxy.(AccessibilityHandlerOnAccessibilityTreeChangeProxy)
+		_, on_accessibility_location_change := proxy.(AccessibilityHandlerOnAccessibilityLocationChangeProxy)
+		C.gocef_set_accessibility_handler_proxy(result.toNative(), cefBool(on_accessibility_tree_change), cefBool(on_accessibility_location_change))
 	}
 	return result
 }
@@ -37,14 +53,15 @@ func (d *AccessibilityHandler) toNative() *C.cef_accessibility_handler_t {
 	return (*C.cef_accessibility_handler_t)(d)
 }
 
-func lookupAccessibilityHandlerProxy(obj *BaseRefCounted) AccessibilityHandlerProxy {
+func lookupAccessibilityHandlerProxy(obj *BaseRefCounted, callback string) AccessibilityHandlerProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("AccessibilityHandler", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(AccessibilityHandlerProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type AccessibilityHandlerProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("AccessibilityHandler", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -59,13 +76,19 @@ func (d *AccessibilityHandler) Base() *BaseRefCounted {
 // Called after renderer process sends accessibility tree changes to the
 // browser process.
 func (d *AccessibilityHandler) OnAccessibilityTreeChange(value *Value) {
-	lookupAccessibilityHandlerProxy(d.Base()).OnAccessibilityTreeChange(d, value)
+	if proxy__, ok__ := lookupAccessibilityHandlerProxy(d.Base(), "OnAccessibilityTreeChange").(AccessibilityHandlerOnAccessibilityTreeChangeProxy); ok__ {
+		proxy__.OnAccessibilityTreeChange(d, value)
+	}
 }
 
 //export gocef_accessibility_handler_on_accessibility_tree_change
 func gocef_accessibility_handler_on_accessibility_tree_change(self *C.cef_accessibility_handler_t, value *C.cef_value_t) {
+	defer recoverProxyPanic("AccessibilityHandler", "OnAccessibilityTreeChange")
 	me__ := (*AccessibilityHandler)(self)
-	proxy__ := lookupAccessibilityHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupAccessibilityHandlerProxy(me__.Base(), "OnAccessibilityTreeChange").(AccessibilityHandlerOnAccessibilityTreeChangeProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnAccessibilityTreeChange(me__, (*Value)(value))
 }
 
@@ -73,12 +96,18 @@ func gocef_accessibility_handler_on_accessibility_tree_change(self *C.cef_access
 // Called after renderer process sends accessibility location changes to the
 // browser process.
 func (d *AccessibilityHandler) OnAccessibilityLocationChange(value *Value) {
-	lookupAccessibilityHandlerProxy(d.Base()).OnAccessibilityLocationChange(d, value)
+	if proxy__, ok__ := lookupAccessibilityHandlerProxy(d.Base(), "OnAccessibilityLocationChange").(AccessibilityHandlerOnAccessibilityLocationChangeProxy); ok__ {
+		proxy__.OnAccessibilityLocationChange(d, value)
+	}
 }
 
 //export gocef_accessibility_handler_on_accessibility_location_change
 func gocef_accessibility_handler_on_accessibility_location_change(self *C.cef_accessibility_handler_t, value *C.cef_value_t) {
+	defer recoverProxyPanic("AccessibilityHandler", "OnAccessibilityLocationChange")
 	me__ := (*AccessibilityHandler)(self)
-	proxy__ := lookupAccessibilityHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupAccessibilityHandlerProxy(me__.Base(), "OnAccessibilityLocationChange").(AccessibilityHandlerOnAccessibilityLocationChangeProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnAccessibilityLocationChange(me__, (*Value)(value))
 }
@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_accessibility_handler_proxy(cef_accessibility_handler_t *self);
+void gocef_set_accessibility_handler_proxy(cef_accessibility_handler_t *self, int on_accessibility_tree_change, int on_accessibility_location_change);
 
 #endif // GOCEF_AccessibilityHandler_H_
@@ -3,12 +3,22 @@
 #include "App_gen.h"
 #include "_cgo_export.h"
 
-void gocef_set_app_proxy(cef_app_t *self) {
+void gocef_set_app_proxy(cef_app_t *self, int on_before_command_line_processing, int on_register_custom_schemes, int get_resource_bundle_handler, int get_browser_process_handler, int get_render_process_handler) {
 	// Casts to (void *) added to avoid warnings since Go callbacks can't have
 	// some modifiers, such as 'const' applied to their parameter signatures.
-	self->on_before_command_line_processing = (void *)&gocef_app_on_before_command_line_processing;
-	self->on_register_custom_schemes = (void *)&gocef_app_on_register_custom_schemes;
-	self->get_resource_bundle_handler = (void *)&gocef_app_get_resource_bundle_handler;
-	self->get_browser_process_handler = (void *)&gocef_app_get_browser_process_handler;
-	self->get_render_process_handler = (void *)&gocef_app_get_render_process_handler;
+	if (on_before_command_line_processing) {
+		self->on_before_command_line_processing = (void *)&gocef_app_on_before_command_line_processing;
+	}
+	if (on_register_custom_schemes) {
+		self->on_register_custom_schemes = (void *)&gocef_app_on_register_custom_schemes;
+	}
+	if (get_resource_bundle_handler) {
+		self->get_resource_bundle_handler = (void *)&gocef_app_get_resource_bundle_handler;
+	}
+	if (get_browser_process_handler) {
+		self->get_browser_process_handler = (void *)&gocef_app_get_browser_process_handler;
+	}
+	if (get_render_process_handler) {
+		self->get_render_process_handler = (void *)&gocef_app_get_render_process_handler;
+	}
 }
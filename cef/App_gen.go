@@ -6,17 +6,43 @@ import (
 	// #include "App_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// AppProxy defines methods required for using App.
+// AppProxy is implemented by the proxies used with App. Embed
+// DefaultApp to satisfy it, then implement any of the optional
+// App*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type AppProxy interface {
+	isAppProxy()
+}
+
+// DefaultApp may be embedded to satisfy AppProxy.
+type DefaultApp struct{}
+
+func (DefaultApp) isAppProxy() {}
+
+// AppOnBeforeCommandLineProcessingProxy may be implemented by a AppProxy to handle OnBeforeCommandLineProcessing.
+type AppOnBeforeCommandLineProcessingProxy interface {
 	OnBeforeCommandLineProcessing(self *App, process_type string, command_line *CommandLine)
+}
+
+// AppOnRegisterCustomSchemesProxy may be implemented by a AppProxy to handle OnRegisterCustomSchemes.
+type AppOnRegisterCustomSchemesProxy interface {
 	OnRegisterCustomSchemes(self *App, registrar *SchemeRegistrar)
+}
+
+// AppGetResourceBundleHandlerProxy may be implemented by a AppProxy to handle GetResourceBundleHandler.
+type AppGetResourceBundleHandlerProxy interface {
 	GetResourceBundleHandler(self *App) *ResourceBundleHandler
+}
+
+// AppGetBrowserProcessHandlerProxy may be implemented by a AppProxy to handle GetBrowserProcessHandler.
+type AppGetBrowserProcessHandlerProxy interface {
 	GetBrowserProcessHandler(self *App) *BrowserProcessHandler
+}
+
+// AppGetRenderProcessHandlerProxy may be implemented by a AppProxy to handle GetRenderProcessHandler.
+type AppGetRenderProcessHandlerProxy interface {
 	GetRenderProcessHandler(self *App) *RenderProcessHandler
 }
 
@@ -30,7 +56,12 @@ type App C.cef_app_t
 func NewApp(proxy AppProxy) *App {
 	result := (*App)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_app_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_app_proxy(result.toNative())
+		_, on_before_command_line_processing := proxy.(AppOnBeforeCommandLineProcessingProxy)
+		_, on_register_custom_schemes := proxy.(AppOnRegisterCustomSchemesProxy)
+		_, get_resource_bundle_handler := proxy.(AppGetResourceBundleHandlerProxy)
+		_, get_browser_process_handler := proxy.(AppGetBrowserProcessHandlerProxy)
+		_, get_render_process_handler := proxy.(AppGetRenderProcessHandlerProxy)
+		C.gocef_set_app_proxy(result.toNative(), cefBool(on_before_command_line_processing), cefBool(on_register_custom_schemes), cefBool(get_resource_bundle_handler), cefBool(get_browser_process_handler), cefBool(get_render_process_handler))
 	}
 	return result
 }
@@ -39,14 +70,15 @@ func (d *App) toNative() *C.cef_app_t {
 	return (*C.cef_app_t)(d)
 }
 
-func lookupAppProxy(obj *BaseRefCounted) AppProxy {
+func lookupAppProxy(obj *BaseRefCounted, callback string) AppProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("App", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(AppProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type AppProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("App", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -68,13 +100,19 @@ func (d *App) Base() *BaseRefCounted {
 // modify command-line arguments for non-browser processes as this may result
 // in undefined behavior including crashes.
 func (d *App) OnBeforeCommandLineProcessing(process_type string, command_line *CommandLine) {
-	lookupAppProxy(d.Base()).OnBeforeCommandLineProcessing(d, process_type, command_line)
+	if proxy__, ok__ := lookupAppProxy(d.Base(), "OnBeforeCommandLineProcessing").(AppOnBeforeCommandLineProcessingProxy); ok__ {
+		proxy__.OnBeforeCommandLineProcessing(d, process_type, command_line)
+	}
 }
 
 //export gocef_app_on_before_command_line_processing
 func gocef_app_on_before_command_line_processing(self *C.cef_app_t, process_type *C.cef_string_t, command_line *C.cef_command_line_t) {
+	defer recoverProxyPanic("App", "OnBeforeCommandLineProcessing")
 	me__ := (*App)(self)
-	proxy__ := lookupAppProxy(me__.Base())
+	proxy__, ok__ := lookupAppProxy(me__.Base(), "OnBeforeCommandLineProcessing").(AppOnBeforeCommandLineProcessingProxy)
+	if !ok__ {
+		return
+	}
 	process_type_ := cefstrToString(process_type)
 	proxy__.OnBeforeCommandLineProcessing(me__, process_type_, (*CommandLine)(command_line))
 }
@@ -85,13 +123,19 @@ func gocef_app_on_before_command_line_processing(self *C.cef_app_t, process_type
 // each process and the registered schemes should be the same across all
 // processes.
 func (d *App) OnRegisterCustomSchemes(registrar *SchemeRegistrar) {
-	lookupAppProxy(d.Base()).OnRegisterCustomSchemes(d, registrar)
+	if proxy__, ok__ := lookupAppProxy(d.Base(), "OnRegisterCustomSchemes").(AppOnRegisterCustomSchemesProxy); ok__ {
+		proxy__.OnRegisterCustomSchemes(d, registrar)
+	}
 }
 
 //export gocef_app_on_register_custom_schemes
 func gocef_app_on_register_custom_schemes(self *C.cef_app_t, registrar *C.cef_scheme_registrar_t) {
+	defer recoverProxyPanic("App", "OnRegisterCustomSchemes")
 	me__ := (*App)(self)
-	proxy__ := lookupAppProxy(me__.Base())
+	proxy__, ok__ := lookupAppProxy(me__.Base(), "OnRegisterCustomSchemes").(AppOnRegisterCustomSchemesProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnRegisterCustomSchemes(me__, (*SchemeRegistrar)(registrar))
 }
 
@@ -101,13 +145,20 @@ func gocef_app_on_register_custom_schemes(self *C.cef_app_t, registrar *C.cef_sc
 // If no handler is returned resources will be loaded from pack files. This
 // function is called by the browser and render processes on multiple threads.
 func (d *App) GetResourceBundleHandler() *ResourceBundleHandler {
-	return lookupAppProxy(d.Base()).GetResourceBundleHandler(d)
+	if proxy__, ok__ := lookupAppProxy(d.Base(), "GetResourceBundleHandler").(AppGetResourceBundleHandlerProxy); ok__ {
+		return proxy__.GetResourceBundleHandler(d)
+	}
+	return nil
 }
 
 //export gocef_app_get_resource_bundle_handler
 func gocef_app_get_resource_bundle_handler(self *C.cef_app_t) *C.cef_resource_bundle_handler_t {
+	defer recoverProxyPanic("App", "GetResourceBundleHandler")
 	me__ := (*App)(self)
-	proxy__ := lookupAppProxy(me__.Base())
+	proxy__, ok__ := lookupAppProxy(me__.Base(), "GetResourceBundleHandler").(AppGetResourceBundleHandlerProxy)
+	if !ok__ {
+		return nil
+	}
 	return (proxy__.GetResourceBundleHandler(me__)).toNative()
 }
 
@@ -115,13 +166,20 @@ func gocef_app_get_resource_bundle_handler(self *C.cef_app_t) *C.cef_resource_bu
 // Return the handler for functionality specific to the browser process. This
 // function is called on multiple threads in the browser process.
 func (d *App) GetBrowserProcessHandler() *BrowserProcessHandler {
-	return lookupAppProxy(d.Base()).GetBrowserProcessHandler(d)
+	if proxy__, ok__ := lookupAppProxy(d.Base(), "GetBrowserProcessHandler").(AppGetBrowserProcessHandlerProxy); ok__ {
+		return proxy__.GetBrowserProcessHandler(d)
+	}
+	return nil
 }
 
 //export gocef_app_get_browser_process_handler
 func gocef_app_get_browser_process_handler(self *C.cef_app_t) *C.cef_browser_process_handler_t {
+	defer recoverProxyPanic("App", "GetBrowserProcessHandler")
 	me__ := (*App)(self)
-	proxy__ := lookupAppProxy(me__.Base())
+	proxy__, ok__ := lookupAppProxy(me__.Base(), "GetBrowserProcessHandler").(AppGetBrowserProcessHandlerProxy)
+	if !ok__ {
+		return nil
+	}
 	return (proxy__.GetBrowserProcessHandler(me__)).toNative()
 }
 
@@ -129,12 +187,19 @@ func gocef_app_get_browser_process_handler(self *C.cef_app_t) *C.cef_browser_pro
 // Return the handler for functionality specific to the render process. This
 // function is called on the render process main thread.
 func (d *App) GetRenderProcessHandler() *RenderProcessHandler {
-	return lookupAppProxy(d.Base()).GetRenderProcessHandler(d)
+	if proxy__, ok__ := lookupAppProxy(d.Base(), "GetRenderProcessHandler").(AppGetRenderProcessHandlerProxy); ok__ {
+		return proxy__.GetRenderProcessHandler(d)
+	}
+	return nil
 }
 
 //export gocef_app_get_render_process_handler
 func gocef_app_get_render_process_handler(self *C.cef_app_t) *C.cef_render_process_handler_t {
+	defer recoverProxyPanic("App", "GetRenderProcessHandler")
 	me__ := (*App)(self)
-	proxy__ := lookupAppProxy(me__.Base())
+	proxy__, ok__ := lookupAppProxy(me__.Base(), "GetRenderProcessHandler").(AppGetRenderProcessHandlerProxy)
+	if !ok__ {
+		return nil
+	}
 	return (proxy__.GetRenderProcessHandler(me__)).toNative()
 }
@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_app_proxy(cef_app_t *self);
+void gocef_set_app_proxy(cef_app_t *self, int on_before_command_line_processing, int on_register_custom_schemes, int get_resource_bundle_handler, int get_browser_process_handler, int get_render_process_handler);
 
 #endif // GOCEF_App_H_
@@ -6,15 +6,33 @@ import (
 	// #include "AudioHandler_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// AudioHandlerProxy defines methods required for using AudioHandler.
+// AudioHandlerProxy is implemented by the proxies used with AudioHandler. Embed
+// DefaultAudioHandler to satisfy it, then implement any of the optional
+// AudioHandler*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type AudioHandlerProxy interface {
+	isAudioHandlerProxy()
+}
+
+// DefaultAudioHandler may be embedded to satisfy AudioHandlerProxy.
+type DefaultAudioHandler struct{}
+
+func (DefaultAudioHandler) isAudioHandlerProxy() {}
+
+// AudioHandlerOnAudioStreamStartedProxy may be implemented by a AudioHandlerProxy to handle OnAudioStreamStarted.
+type AudioHandlerOnAudioStreamStartedProxy interface {
 	OnAudioStreamStarted(self *AudioHandler, browser *Browser, audio_stream_id, channels int32, channel_layout ChannelLayout, sample_rate, frames_per_buffer int32)
+}
+
+// AudioHandlerOnAudioStreamPacketProxy may be implemented by a AudioHandlerProxy to handle OnAudioStreamPacket.
+type AudioHandlerOnAudioStreamPacketProxy interface {
 	OnAudioStreamPacket(self *AudioHandler, browser *Browser, audio_stream_id int32, data **float32, frames int32, pts int64)
+}
+
+// AudioHandlerOnAudioStreamStoppedProxy may be implemented by a AudioHandlerProxy to handle OnAudioStreamStopped.
+type AudioHandlerOnAudioStreamStoppedProxy interface {
 	OnAudioStreamStopped(self *AudioHandler, browser *Browser, audio_stream_id int32)
 }
 
@@ -28,7 +46,10 @@ type AudioHandler C.cef_audio_handler_t
 func NewAudioHandler(proxy AudioHandlerProxy) *AudioHandler {
 	result := (*AudioHandler)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_audio_handler_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_audio_handler_proxy(result.toNative())
+		_, on_audio_stream_started := proxy.(AudioHandlerOnAudioStreamStartedProxy)
+		_, on_audio_stream_packet := proxy.(AudioHandlerOnAudioStreamPacketProxy)
+		_, on_audio_stream_stopped := proxy.(AudioHandlerOnAudioStreamStoppedProxy)
+		C.gocef_set_audio_handler_proxy(result.toNative(), cefBool(on_audio_stream_started), cefBool(on_audio_stream_packet), cefBool(on_audio_stream_stopped))
 	}
 	return result
 }
@@ -37,14 +58,15 @@ func (d *AudioHandler) toNative() *C.cef_audio_handler_t {
 	return (*C.cef_audio_handler_t)(d)
 }
 
-func lookupAudioHandlerProxy(obj *BaseRefCounted) AudioHandlerProxy {
+func lookupAudioHandlerProxy(obj *BaseRefCounted, callback string) AudioHandlerProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("AudioHandler", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(AudioHandlerProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type AudioHandlerProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("AudioHandler", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -65,13 +87,19 @@ func (d *AudioHandler) Base() *BaseRefCounted {
 // |frames_per_buffer| is the maximum number of frames that will occur in the
 // PCM packet passed to OnAudioStreamPacket.
 func (d *AudioHandler) OnAudioStreamStarted(browser *Browser, audio_stream_id, channels int32, channel_layout ChannelLayout, sample_rate, frames_per_buffer int32) {
-	lookupAudioHandlerProxy(d.Base()).OnAudioStreamStarted(d, browser, audio_stream_id, channels, channel_layout, sample_rate, frames_per_buffer)
+	if proxy__, ok__ := lookupAudioHandlerProxy(d.Base(), "OnAudioStreamStarted").(AudioHandlerOnAudioStreamStartedProxy); ok__ {
+		proxy__.OnAudioStreamStarted(d, browser, audio_stream_id, channels, channel_layout, sample_rate, frames_per_buffer)
+	}
 }
 
 //export gocef_audio_handler_on_audio_stream_started
 func gocef_audio_handler_on_audio_stream_started(self *C.cef_audio_handler_t, browser *C.cef_browser_t, audio_stream_id C.int, channels C.int, channel_layout C.cef_channel_layout_t, sample_rate C.int, frames_per_buffer C.int) {
+	defer recoverProxyPanic("AudioHandler", "OnAudioStreamStarted")
 	me__ := (*AudioHandler)(self)
-	proxy__ := lookupAudioHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupAudioHandlerProxy(me__.Base(), "OnAudioStreamStarted").(AudioHandlerOnAudioStreamStartedProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnAudioStreamStarted(me__, (*Browser)(browser), int32(audio_stream_id), int32(channels), ChannelLayout(channel_layout), int32(sample_rate), int32(frames_per_buffer))
 }
 
@@ -85,13 +113,19 @@ func gocef_audio_handler_on_audio_stream_started(self *C.cef_audio_handler_t, br
 // |channel_layout| value passed to OnAudioStreamStarted you can calculate the
 // size of the |data| array in bytes.
 func (d *AudioHandler) OnAudioStreamPacket(browser *Browser, audio_stream_id int32, data **float32, frames int32, pts int64) {
-	lookupAudioHandlerProxy(d.Base()).OnAudioStreamPacket(d, browser, audio_stream_id, data, frames, pts)
+	if proxy__, ok__ := lookupAudioHandlerProxy(d.Base(), "OnAudioStreamPacket").(AudioHandlerOnAudioStreamPacketProxy); ok__ {
+		proxy__.OnAudioStreamPacket(d, browser, audio_stream_id, data, frames, pts)
+	}
 }
 
 //export gocef_audio_handler_on_audio_stream_packet
 func gocef_audio_handler_on_audio_stream_packet(self *C.cef_audio_handler_t, browser *C.cef_browser_t, audio_stream_id C.int, data **C.float, frames C.int, pts C.int64) {
+	defer recoverProxyPanic("AudioHandler", "OnAudioStreamPacket")
 	me__ := (*AudioHandler)(self)
-	proxy__ := lookupAudioHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupAudioHandlerProxy(me__.Base(), "OnAudioStreamPacket").(AudioHandlerOnAudioStreamPacketProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnAudioStreamPacket(me__, (*Browser)(browser), int32(audio_stream_id), (**float32)(unsafe.Pointer(data)), int32(frames), int64(pts))
 }
 
@@ -100,12 +134,18 @@ func gocef_audio_handler_on_audio_stream_packet(self *C.cef_audio_handler_t, bro
 // OnAudioSteamStopped will always be called after OnAudioStreamStarted; both
 // functions may be called multiple times for the same stream.
 func (d *AudioHandler) OnAudioStreamStopped(browser *Browser, audio_stream_id int32) {
-	lookupAudioHandlerProxy(d.Base()).OnAudioStreamStopped(d, browser, audio_stream_id)
+	if proxy__, ok__ := lookupAudioHandlerProxy(d.Base(), "OnAudioStreamStopped").(AudioHandlerOnAudioStreamStoppedProxy); ok__ {
+		proxy__.OnAudioStreamStopped(d, browser, audio_stream_id)
+	}
 }
 
 //export gocef_audio_handler_on_audio_stream_stopped
 func gocef_audio_handler_on_audio_stream_stopped(self *C.cef_audio_handler_t, browser *C.cef_browser_t, audio_stream_id C.int) {
+	defer recoverProxyPanic("AudioHandler", "OnAudioStreamStopped")
 	me__ := (*AudioHandler)(self)
-	proxy__ := lookupAudioHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupAudioHandlerProxy(me__.Base(), "OnAudioStreamStopped").(AudioHandlerOnAudioStreamStoppedProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnAudioStreamStopped(me__, (*Browser)(browser), int32(audio_stream_id))
 }
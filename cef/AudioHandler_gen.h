@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_audio_handler_proxy(cef_audio_handler_t *self);
+void gocef_set_audio_handler_proxy(cef_audio_handler_t *self, int on_audio_stream_started, int on_audio_stream_packet, int on_audio_stream_stopped);
 
 #endif // GOCEF_AudioHandler_H_
@@ -1,11 +0,0 @@
-// Code generated - DO NOT EDIT.
-
-#ifndef GOCEF_AuthCallback_H_
-#define GOCEF_AuthCallback_H_
-#pragma once
-
-#include "capi_gen.h"
-
-void gocef_set_auth_callback_proxy(cef_auth_callback_t *self);
-
-#endif // GOCEF_AuthCallback_H_
@@ -36,18 +36,18 @@ func (d *BaseRefCounted) AddRef() {
 // Called to decrement the reference count for the object. If the reference
 // count falls to 0 the object should self-delete. Returns true (1) if the
 // resulting reference count is 0.
-func (d *BaseRefCounted) Release() int32 {
-	return int32(C.gocef_base_ref_counted_release(d.toNative(), d.release))
+func (d *BaseRefCounted) Release() bool {
+	return C.gocef_base_ref_counted_release(d.toNative(), d.release) != 0
 }
 
 // HasOneRef (has_one_ref)
 // Returns true (1) if the current reference count is 1.
-func (d *BaseRefCounted) HasOneRef() int32 {
-	return int32(C.gocef_base_ref_counted_has_one_ref(d.toNative(), d.has_one_ref))
+func (d *BaseRefCounted) HasOneRef() bool {
+	return C.gocef_base_ref_counted_has_one_ref(d.toNative(), d.has_one_ref) != 0
 }
 
 // HasAtLeastOneRef (has_at_least_one_ref)
 // Returns true (1) if the current reference count is at least 1.
-func (d *BaseRefCounted) HasAtLeastOneRef() int32 {
-	return int32(C.gocef_base_ref_counted_has_at_least_one_ref(d.toNative(), d.has_at_least_one_ref))
+func (d *BaseRefCounted) HasAtLeastOneRef() bool {
+	return C.gocef_base_ref_counted_has_at_least_one_ref(d.toNative(), d.has_at_least_one_ref) != 0
 }
@@ -1,11 +0,0 @@
-// Code generated - DO NOT EDIT.
-
-#ifndef GOCEF_BeforeDownloadCallback_H_
-#define GOCEF_BeforeDownloadCallback_H_
-#pragma once
-
-#include "capi_gen.h"
-
-void gocef_set_before_download_callback_proxy(cef_before_download_callback_t *self);
-
-#endif // GOCEF_BeforeDownloadCallback_H_
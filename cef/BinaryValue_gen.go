@@ -34,32 +34,33 @@ func (d *BinaryValue) Base() *BaseRefCounted {
 // the underlying data is owned by another object (e.g. list or dictionary)
 // and that other object is then modified or destroyed. Do not call any other
 // functions if this function returns false (0).
-func (d *BinaryValue) IsValid() int32 {
-	return int32(C.gocef_binary_value_is_valid(d.toNative(), d.is_valid))
+func (d *BinaryValue) IsValid() bool {
+	return C.gocef_binary_value_is_valid(d.toNative(), d.is_valid) != 0
 }
 
 // IsOwned (is_owned)
 // Returns true (1) if this object is currently owned by another object.
-func (d *BinaryValue) IsOwned() int32 {
-	return int32(C.gocef_binary_value_is_owned(d.toNative(), d.is_owned))
+func (d *BinaryValue) IsOwned() bool {
+	return C.gocef_binary_value_is_owned(d.toNative(), d.is_owned) != 0
 }
 
 // IsSame (is_same)
 // Returns true (1) if this object and |that| object have the same underlying
 // data.
-func (d *BinaryValue) IsSame(that *BinaryValue) int32 {
-	return int32(C.gocef_binary_value_is_same(d.toNative(), that.toNative(), d.is_same))
+func (d *BinaryValue) IsSame(that *BinaryValue) bool {
+	return C.gocef_binary_value_is_same(d.toNative(), that.toNative(), d.is_same) != 0
 }
 
 // IsEqual (is_equal)
 // Returns true (1) if this object and |that| object have an equivalent
 // underlying value but are not necessarily the same object.
-func (d *BinaryValue) IsEqual(that *BinaryValue) int32 {
-	return int32(C.gocef_binary_value_is_equal(d.toNative(), that.toNative(), d.is_equal))
+func (d *BinaryValue) IsEqual(that *BinaryValue) bool {
+	return C.gocef_binary_value_is_equal(d.toNative(), that.toNative(), d.is_equal) != 0
 }
 
 // Copy (copy)
 // Returns a copy of this object. The data in this object will also be copied.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *BinaryValue) Copy() *BinaryValue {
 	return (*BinaryValue)(C.gocef_binary_value_copy(d.toNative(), d.copy))
 }
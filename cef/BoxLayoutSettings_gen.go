@@ -13,7 +13,7 @@ type BoxLayoutSettings struct {
 	// Horizontal (horizontal)
 	// If true (1) the layout will be horizontal, otherwise the layout will be
 	// vertical.
-	Horizontal int32
+	Horizontal bool
 	// InsideBorderHorizontalSpacing (inside_border_horizontal_spacing)
 	// Adds additional horizontal space between the child view area and the host
 	// view border.
@@ -55,7 +55,7 @@ func (d *BoxLayoutSettings) toNative(native *C.cef_box_layout_settings_t) *C.cef
 	if d == nil {
 		return nil
 	}
-	native.horizontal = C.int(d.Horizontal)
+	native.horizontal = cefBool(d.Horizontal)
 	native.inside_border_horizontal_spacing = C.int(d.InsideBorderHorizontalSpacing)
 	native.inside_border_vertical_spacing = C.int(d.InsideBorderVerticalSpacing)
 	d.InsideBorderInsets.toNative(&native.inside_border_insets)
@@ -77,7 +77,7 @@ func (n *C.cef_box_layout_settings_t) toGo() *BoxLayoutSettings {
 }
 
 func (n *C.cef_box_layout_settings_t) intoGo(d *BoxLayoutSettings) {
-	d.Horizontal = int32(n.horizontal)
+	d.Horizontal = n.horizontal != 0
 	d.InsideBorderHorizontalSpacing = int32(n.inside_border_horizontal_spacing)
 	d.InsideBorderVerticalSpacing = int32(n.inside_border_vertical_spacing)
 	n.inside_border_insets.intoGo(&d.InsideBorderInsets)
@@ -86,6 +86,7 @@ func (d *BrowserHost) Base() *BaseRefCounted {
 
 // GetBrowser (get_browser)
 // Returns the hosted browser object.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *BrowserHost) GetBrowser() *Browser {
 	return (*Browser)(C.gocef_browser_host_get_browser(d.toNative(), d.get_browser))
 }
@@ -99,8 +100,8 @@ func (d *BrowserHost) GetBrowser() *Browser {
 // the event handler allows the close or if |force_close| is true (1). See
 // cef_life_span_handler_t::do_close() documentation for additional usage
 // information.
-func (d *BrowserHost) CloseBrowser(force_close int32) {
-	C.gocef_browser_host_close_browser(d.toNative(), C.int(force_close), d.close_browser)
+func (d *BrowserHost) CloseBrowser(force_close bool) {
+	C.gocef_browser_host_close_browser(d.toNative(), cefBool(force_close), d.close_browser)
 }
 
 // TryCloseBrowser (try_close_browser)
@@ -110,14 +111,14 @@ func (d *BrowserHost) CloseBrowser(force_close int32) {
 // is pending and true (1) after the close has completed. See close_browser()
 // and cef_life_span_handler_t::do_close() documentation for additional usage
 // information. This function must be called on the browser process UI thread.
-func (d *BrowserHost) TryCloseBrowser() int32 {
-	return int32(C.gocef_browser_host_try_close_browser(d.toNative(), d.try_close_browser))
+func (d *BrowserHost) TryCloseBrowser() bool {
+	return C.gocef_browser_host_try_close_browser(d.toNative(), d.try_close_browser) != 0
 }
 
 // SetFocus (set_focus)
 // Set whether the browser is focused.
-func (d *BrowserHost) SetFocus(focus int32) {
-	C.gocef_browser_host_set_focus(d.toNative(), C.int(focus), d.set_focus)
+func (d *BrowserHost) SetFocus(focus bool) {
+	C.gocef_browser_host_set_focus(d.toNative(), cefBool(focus), d.set_focus)
 }
 
 // GetWindowHandle (get_window_handle)
@@ -139,18 +140,20 @@ func (d *BrowserHost) GetOpenerWindowHandle() unsafe.Pointer {
 
 // HasView (has_view)
 // Returns true (1) if this browser is wrapped in a cef_browser_view_t.
-func (d *BrowserHost) HasView() int32 {
-	return int32(C.gocef_browser_host_has_view(d.toNative(), d.has_view))
+func (d *BrowserHost) HasView() bool {
+	return C.gocef_browser_host_has_view(d.toNative(), d.has_view) != 0
 }
 
 // GetClient (get_client)
 // Returns the client for this browser.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *BrowserHost) GetClient() *Client {
 	return (*Client)(C.gocef_browser_host_get_client(d.toNative(), d.get_client))
 }
 
 // GetRequestContext (get_request_context)
 // Returns the request context for this browser.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *BrowserHost) GetRequestContext() *RequestContext {
 	return (*RequestContext)(C.gocef_browser_host_get_request_context(d.toNative(), d.get_request_context))
 }
@@ -185,7 +188,7 @@ func (d *BrowserHost) SetZoomLevel(zoomLevel float64) {
 // selected by default. |callback| will be executed after the dialog is
 // dismissed or immediately if another dialog is already pending. The dialog
 // will be initiated asynchronously on the UI thread.
-func (d *BrowserHost) RunFileDialog(mode FileDialogMode, title, default_file_path string, accept_filters StringList, selected_accept_filter int32, callback *RunFileDialogCallback) {
+func (d *BrowserHost) RunFileDialog(mode FileDialogMode, title, default_file_path string, accept_filters []string, selected_accept_filter int32, callback *RunFileDialogCallback) {
 	title_ := C.cef_string_userfree_alloc()
 	setCEFStr(title, title_)
 	defer func() {
@@ -196,7 +199,9 @@ func (d *BrowserHost) RunFileDialog(mode FileDialogMode, title, default_file_pat
 	defer func() {
 		C.cef_string_userfree_free(default_file_path_)
 	}()
-	C.gocef_browser_host_run_file_dialog(d.toNative(), C.cef_file_dialog_mode_t(mode), (*C.cef_string_t)(title_), (*C.cef_string_t)(default_file_path_), C.cef_string_list_t(accept_filters), C.int(selected_accept_filter), callback.toNative(), d.run_file_dialog)
+	accept_filters_ := newCEFStringList(accept_filters)
+	defer C.cef_string_list_free(accept_filters_)
+	C.gocef_browser_host_run_file_dialog(d.toNative(), C.cef_file_dialog_mode_t(mode), (*C.cef_string_t)(title_), (*C.cef_string_t)(default_file_path_), accept_filters_, C.int(selected_accept_filter), callback.toNative(), d.run_file_dialog)
 }
 
 // StartDownload (start_download)
@@ -221,13 +226,13 @@ func (d *BrowserHost) StartDownload(url string) {
 // to |max_image_size| and is the only result. A |max_image_size| of 0 means
 // unlimited. If |bypass_cache| is true (1) then |image_url| is requested from
 // the server even if it is present in the browser cache.
-func (d *BrowserHost) DownloadImage(image_url string, is_favicon int32, max_image_size uint32, bypass_cache int32, callback *DownloadImageCallback) {
+func (d *BrowserHost) DownloadImage(image_url string, is_favicon bool, max_image_size uint32, bypass_cache bool, callback *DownloadImageCallback) {
 	image_url_ := C.cef_string_userfree_alloc()
 	setCEFStr(image_url, image_url_)
 	defer func() {
 		C.cef_string_userfree_free(image_url_)
 	}()
-	C.gocef_browser_host_download_image(d.toNative(), (*C.cef_string_t)(image_url_), C.int(is_favicon), C.uint32(max_image_size), C.int(bypass_cache), callback.toNative(), d.download_image)
+	C.gocef_browser_host_download_image(d.toNative(), (*C.cef_string_t)(image_url_), cefBool(is_favicon), C.uint32(max_image_size), cefBool(bypass_cache), callback.toNative(), d.download_image)
 }
 
 // Print (print)
@@ -260,19 +265,19 @@ func (d *BrowserHost) PrintToPdf(path string, settings *PDFPrintSettings, callba
 // whether this is the first request or a follow-up. The cef_find_handler_t
 // instance, if any, returned via cef_client_t::GetFindHandler will be called
 // to report find results.
-func (d *BrowserHost) Find(identifier int32, searchText string, forward, matchCase, findNext int32) {
+func (d *BrowserHost) Find(identifier int32, searchText string, forward, matchCase, findNext bool) {
 	searchText_ := C.cef_string_userfree_alloc()
 	setCEFStr(searchText, searchText_)
 	defer func() {
 		C.cef_string_userfree_free(searchText_)
 	}()
-	C.gocef_browser_host_find(d.toNative(), C.int(identifier), (*C.cef_string_t)(searchText_), C.int(forward), C.int(matchCase), C.int(findNext), d.find)
+	C.gocef_browser_host_find(d.toNative(), C.int(identifier), (*C.cef_string_t)(searchText_), cefBool(forward), cefBool(matchCase), cefBool(findNext), d.find)
 }
 
 // StopFinding (stop_finding)
 // Cancel all searches that are currently going on.
-func (d *BrowserHost) StopFinding(clearSelection int32) {
-	C.gocef_browser_host_stop_finding(d.toNative(), C.int(clearSelection), d.stop_finding)
+func (d *BrowserHost) StopFinding(clearSelection bool) {
+	C.gocef_browser_host_stop_finding(d.toNative(), cefBool(clearSelection), d.stop_finding)
 }
 
 // ShowDevTools (show_dev_tools)
@@ -296,8 +301,8 @@ func (d *BrowserHost) CloseDevTools() {
 // HasDevTools (has_dev_tools)
 // Returns true (1) if this browser currently has an associated DevTools
 // browser. Must be called on the browser process UI thread.
-func (d *BrowserHost) HasDevTools() int32 {
-	return int32(C.gocef_browser_host_has_dev_tools(d.toNative(), d.has_dev_tools))
+func (d *BrowserHost) HasDevTools() bool {
+	return C.gocef_browser_host_has_dev_tools(d.toNative(), d.has_dev_tools) != 0
 }
 
 // GetNavigationEntries (get_navigation_entries)
@@ -305,20 +310,20 @@ func (d *BrowserHost) HasDevTools() int32 {
 // specified visitor. If |current_only| is true (1) only the current
 // navigation entry will be sent, otherwise all navigation entries will be
 // sent.
-func (d *BrowserHost) GetNavigationEntries(visitor *NavigationEntryVisitor, current_only int32) {
-	C.gocef_browser_host_get_navigation_entries(d.toNative(), visitor.toNative(), C.int(current_only), d.get_navigation_entries)
+func (d *BrowserHost) GetNavigationEntries(visitor *NavigationEntryVisitor, current_only bool) {
+	C.gocef_browser_host_get_navigation_entries(d.toNative(), visitor.toNative(), cefBool(current_only), d.get_navigation_entries)
 }
 
 // SetMouseCursorChangeDisabled (set_mouse_cursor_change_disabled)
 // Set whether mouse cursor change is disabled.
-func (d *BrowserHost) SetMouseCursorChangeDisabled(disabled int32) {
-	C.gocef_browser_host_set_mouse_cursor_change_disabled(d.toNative(), C.int(disabled), d.set_mouse_cursor_change_disabled)
+func (d *BrowserHost) SetMouseCursorChangeDisabled(disabled bool) {
+	C.gocef_browser_host_set_mouse_cursor_change_disabled(d.toNative(), cefBool(disabled), d.set_mouse_cursor_change_disabled)
 }
 
 // IsMouseCursorChangeDisabled (is_mouse_cursor_change_disabled)
 // Returns true (1) if mouse cursor change is disabled.
-func (d *BrowserHost) IsMouseCursorChangeDisabled() int32 {
-	return int32(C.gocef_browser_host_is_mouse_cursor_change_disabled(d.toNative(), d.is_mouse_cursor_change_disabled))
+func (d *BrowserHost) IsMouseCursorChangeDisabled() bool {
+	return C.gocef_browser_host_is_mouse_cursor_change_disabled(d.toNative(), d.is_mouse_cursor_change_disabled) != 0
 }
 
 // ReplaceMisspelling (replace_misspelling)
@@ -346,8 +351,8 @@ func (d *BrowserHost) AddWordToDictionary(word string) {
 
 // IsWindowRenderingDisabled (is_window_rendering_disabled)
 // Returns true (1) if window rendering is disabled.
-func (d *BrowserHost) IsWindowRenderingDisabled() int32 {
-	return int32(C.gocef_browser_host_is_window_rendering_disabled(d.toNative(), d.is_window_rendering_disabled))
+func (d *BrowserHost) IsWindowRenderingDisabled() bool {
+	return C.gocef_browser_host_is_window_rendering_disabled(d.toNative(), d.is_window_rendering_disabled) != 0
 }
 
 // WasResized (was_resized)
@@ -363,8 +368,8 @@ func (d *BrowserHost) WasResized() {
 // Notify the browser that it has been hidden or shown. Layouting and
 // cef_render_handler_t::OnPaint notification will stop when the browser is
 // hidden. This function is only used when window rendering is disabled.
-func (d *BrowserHost) WasHidden(hidden int32) {
-	C.gocef_browser_host_was_hidden(d.toNative(), C.int(hidden), d.was_hidden)
+func (d *BrowserHost) WasHidden(hidden bool) {
+	C.gocef_browser_host_was_hidden(d.toNative(), cefBool(hidden), d.was_hidden)
 }
 
 // NotifyScreenInfoChanged (notify_screen_info_changed)
@@ -402,15 +407,15 @@ func (d *BrowserHost) SendKeyEvent(event *KeyEvent) {
 // SendMouseClickEvent (send_mouse_click_event)
 // Send a mouse click event to the browser. The |x| and |y| coordinates are
 // relative to the upper-left corner of the view.
-func (d *BrowserHost) SendMouseClickEvent(event *MouseEvent, type_r MouseButtonType, mouseUp, clickCount int32) {
-	C.gocef_browser_host_send_mouse_click_event(d.toNative(), event.toNative(&C.cef_mouse_event_t{}), C.cef_mouse_button_type_t(type_r), C.int(mouseUp), C.int(clickCount), d.send_mouse_click_event)
+func (d *BrowserHost) SendMouseClickEvent(event *MouseEvent, type_r MouseButtonType, mouseUp bool, clickCount int32) {
+	C.gocef_browser_host_send_mouse_click_event(d.toNative(), event.toNative(&C.cef_mouse_event_t{}), C.cef_mouse_button_type_t(type_r), cefBool(mouseUp), C.int(clickCount), d.send_mouse_click_event)
 }
 
 // SendMouseMoveEvent (send_mouse_move_event)
 // Send a mouse move event to the browser. The |x| and |y| coordinates are
 // relative to the upper-left corner of the view.
-func (d *BrowserHost) SendMouseMoveEvent(event *MouseEvent, mouseLeave int32) {
-	C.gocef_browser_host_send_mouse_move_event(d.toNative(), event.toNative(&C.cef_mouse_event_t{}), C.int(mouseLeave), d.send_mouse_move_event)
+func (d *BrowserHost) SendMouseMoveEvent(event *MouseEvent, mouseLeave bool) {
+	C.gocef_browser_host_send_mouse_move_event(d.toNative(), event.toNative(&C.cef_mouse_event_t{}), cefBool(mouseLeave), d.send_mouse_move_event)
 }
 
 // SendMouseWheelEvent (send_mouse_wheel_event)
@@ -431,8 +436,8 @@ func (d *BrowserHost) SendTouchEvent(event *TouchEvent) {
 
 // SendFocusEvent (send_focus_event)
 // Send a focus event to the browser.
-func (d *BrowserHost) SendFocusEvent(setFocus int32) {
-	C.gocef_browser_host_send_focus_event(d.toNative(), C.int(setFocus), d.send_focus_event)
+func (d *BrowserHost) SendFocusEvent(setFocus bool) {
+	C.gocef_browser_host_send_focus_event(d.toNative(), cefBool(setFocus), d.send_focus_event)
 }
 
 // SendCaptureLostEvent (send_capture_lost_event)
@@ -521,8 +526,8 @@ func (d *BrowserHost) ImeCommitText(text string, replacement_range *Range, relat
 // contents. If |keep_selection| is false (0) the current selection, if any,
 // will be discarded. See comments on ImeSetComposition for usage. This
 // function is only used when window rendering is disabled.
-func (d *BrowserHost) ImeFinishComposingText(keep_selection int32) {
-	C.gocef_browser_host_ime_finish_composing_text(d.toNative(), C.int(keep_selection), d.ime_finish_composing_text)
+func (d *BrowserHost) ImeFinishComposingText(keep_selection bool) {
+	C.gocef_browser_host_ime_finish_composing_text(d.toNative(), cefBool(keep_selection), d.ime_finish_composing_text)
 }
 
 // ImeCancelComposition (ime_cancel_composition)
@@ -598,6 +603,7 @@ func (d *BrowserHost) DragSourceSystemDragEnded() {
 // GetVisibleNavigationEntry (get_visible_navigation_entry)
 // Returns the current visible navigation entry for this browser. This
 // function can only be called on the UI thread.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *BrowserHost) GetVisibleNavigationEntry() *NavigationEntry {
 	return (*NavigationEntry)(C.gocef_browser_host_get_visible_navigation_entry(d.toNative(), d.get_visible_navigation_entry))
 }
@@ -634,13 +640,14 @@ func (d *BrowserHost) SetAccessibilityState(accessibility_state State) {
 // Enable notifications of auto resize via
 // cef_display_handler_t::OnAutoResize. Notifications are disabled by default.
 // |min_size| and |max_size| define the range of allowed sizes.
-func (d *BrowserHost) SetAutoResizeEnabled(enabled int32, min_size, max_size *Size) {
-	C.gocef_browser_host_set_auto_resize_enabled(d.toNative(), C.int(enabled), min_size.toNative(&C.cef_size_t{}), max_size.toNative(&C.cef_size_t{}), d.set_auto_resize_enabled)
+func (d *BrowserHost) SetAutoResizeEnabled(enabled bool, min_size, max_size *Size) {
+	C.gocef_browser_host_set_auto_resize_enabled(d.toNative(), cefBool(enabled), min_size.toNative(&C.cef_size_t{}), max_size.toNative(&C.cef_size_t{}), d.set_auto_resize_enabled)
 }
 
 // GetExtension (get_extension)
 // Returns the extension hosted in this browser or NULL if no extension is
 // hosted. See cef_request_tContext::LoadExtension for details.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *BrowserHost) GetExtension() *Extension {
 	return (*Extension)(C.gocef_browser_host_get_extension(d.toNative(), d.get_extension))
 }
@@ -649,19 +656,19 @@ func (d *BrowserHost) GetExtension() *Extension {
 // Returns true (1) if this browser is hosting an extension background script.
 // Background hosts do not have a window and are not displayable. See
 // cef_request_tContext::LoadExtension for details.
-func (d *BrowserHost) IsBackgroundHost() int32 {
-	return int32(C.gocef_browser_host_is_background_host(d.toNative(), d.is_background_host))
+func (d *BrowserHost) IsBackgroundHost() bool {
+	return C.gocef_browser_host_is_background_host(d.toNative(), d.is_background_host) != 0
 }
 
 // SetAudioMuted (set_audio_muted)
 //  Set whether the browser's audio is muted.
-func (d *BrowserHost) SetAudioMuted(mute int32) {
-	C.gocef_browser_host_set_audio_muted(d.toNative(), C.int(mute), d.set_audio_muted)
+func (d *BrowserHost) SetAudioMuted(mute bool) {
+	C.gocef_browser_host_set_audio_muted(d.toNative(), cefBool(mute), d.set_audio_muted)
 }
 
 // IsAudioMuted (is_audio_muted)
 // Returns true (1) if the browser's audio is muted.  This function can only
 // be called on the UI thread.
-func (d *BrowserHost) IsAudioMuted() int32 {
-	return int32(C.gocef_browser_host_is_audio_muted(d.toNative(), d.is_audio_muted))
+func (d *BrowserHost) IsAudioMuted() bool {
+	return C.gocef_browser_host_is_audio_muted(d.toNative(), d.is_audio_muted) != 0
 }
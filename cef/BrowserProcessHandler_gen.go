@@ -6,17 +6,43 @@ import (
 	// #include "BrowserProcessHandler_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// BrowserProcessHandlerProxy defines methods required for using BrowserProcessHandler.
+// BrowserProcessHandlerProxy is implemented by the proxies used with BrowserProcessHandler. Embed
+// DefaultBrowserProcessHandler to satisfy it, then implement any of the optional
+// BrowserProcessHandler*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type BrowserProcessHandlerProxy interface {
+	isBrowserProcessHandlerProxy()
+}
+
+// DefaultBrowserProcessHandler may be embedded to satisfy BrowserProcessHandlerProxy.
+type DefaultBrowserProcessHandler struct{}
+
+func (DefaultBrowserProcessHandler) isBrowserProcessHandlerProxy() {}
+
+// BrowserProcessHandlerOnContextInitializedProxy may be implemented by a BrowserProcessHandlerProxy to handle OnContextInitialized.
+type BrowserProcessHandlerOnContextInitializedProxy interface {
 	OnContextInitialized(self *BrowserProcessHandler)
+}
+
+// BrowserProcessHandlerOnBeforeChildProcessLaunchProxy may be implemented by a BrowserProcessHandlerProxy to handle OnBeforeChildProcessLaunch.
+type BrowserProcessHandlerOnBeforeChildProcessLaunchProxy interface {
 	OnBeforeChildProcessLaunch(self *BrowserProcessHandler, command_line *CommandLine)
+}
+
+// BrowserProcessHandlerOnRenderProcessThreadCreatedProxy may be implemented by a BrowserProcessHandlerProxy to handle OnRenderProcessThreadCreated.
+type BrowserProcessHandlerOnRenderProcessThreadCreatedProxy interface {
 	OnRenderProcessThreadCreated(self *BrowserProcessHandler, extra_info *ListValue)
+}
+
+// BrowserProcessHandlerGetPrintHandlerProxy may be implemented by a BrowserProcessHandlerProxy to handle GetPrintHandler.
+type BrowserProcessHandlerGetPrintHandlerProxy interface {
 	GetPrintHandler(self *BrowserProcessHandler) *PrintHandler
+}
+
+// BrowserProcessHandlerOnScheduleMessagePumpWorkProxy may be implemented by a BrowserProcessHandlerProxy to handle OnScheduleMessagePumpWork.
+type BrowserProcessHandlerOnScheduleMessagePumpWorkProxy interface {
 	OnScheduleMessagePumpWork(self *BrowserProcessHandler, delay_ms int64)
 }
 
@@ -31,7 +57,12 @@ type BrowserProcessHandler C.cef_browser_process_handler_t
 func NewBrowserProcessHandler(proxy BrowserProcessHandlerProxy) *BrowserProcessHandler {
 	result := (*BrowserProcessHandler)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_browser_process_handler_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_browser_process_handler_proxy(result.toNative())
+		_, on_context_initialized := proxy.(BrowserProcessHandlerOnContextInitializedProxy)
+		_, on_before_child_process_launch := proxy.(BrowserProcessHandlerOnBeforeChildProcessLaunchProxy)
+		_, on_render_process_thread_created := proxy.(BrowserProcessHandlerOnRenderProcessThreadCreatedProxy)
+		_, get_print_handler := proxy.(BrowserProcessHandlerGetPrintHandlerProxy)
+		_, on_schedule_message_pump_work := proxy.(BrowserProcessHandlerOnScheduleMessagePumpWorkProxy)
+		C.gocef_set_browser_process_handler_proxy(result.toNative(), cefBool(on_context_initialized), cefBool(on_before_child_process_launch), cefBool(on_render_process_thread_created), cefBool(get_print_handler), cefBool(on_schedule_message_pump_work))
 	}
 	return result
 }
@@ -40,14 +71,15 @@ func (d *BrowserProcessHandler) toNative() *C.cef_browser_process_handler_t {
 	return (*C.cef_browser_process_handler_t)(d)
 }
 
-func lookupBrowserProcessHandlerProxy(obj *BaseRefCounted) BrowserProcessHandlerProxy {
+func lookupBrowserProcessHandlerProxy(obj *BaseRefCounted, callback string) BrowserProcessHandlerProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("BrowserProcessHandler", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(BrowserProcessHandlerProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type BrowserProcessHandlerProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("BrowserProcessHandler", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -62,13 +94,19 @@ func (d *BrowserProcessHandler) Base() *BaseRefCounted {
 // Called on the browser process UI thread immediately after the CEF context
 // has been initialized.
 func (d *BrowserProcessHandler) OnContextInitialized() {
-	lookupBrowserProcessHandlerProxy(d.Base()).OnContextInitialized(d)
+	if proxy__, ok__ := lookupBrowserProcessHandlerProxy(d.Base(), "OnContextInitialized").(BrowserProcessHandlerOnContextInitializedProxy); ok__ {
+		proxy__.OnContextInitialized(d)
+	}
 }
 
 //export gocef_browser_process_handler_on_context_initialized
 func gocef_browser_process_handler_on_context_initialized(self *C.cef_browser_process_handler_t) {
+	defer recoverProxyPanic("BrowserProcessHandler", "OnContextInitialized")
 	me__ := (*BrowserProcessHandler)(self)
-	proxy__ := lookupBrowserProcessHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupBrowserProcessHandlerProxy(me__.Base(), "OnContextInitialized").(BrowserProcessHandlerOnContextInitializedProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnContextInitialized(me__)
 }
 
@@ -79,13 +117,19 @@ func gocef_browser_process_handler_on_context_initialized(self *C.cef_browser_pr
 // opportunity to modify the child process command line. Do not keep a
 // reference to |command_line| outside of this function.
 func (d *BrowserProcessHandler) OnBeforeChildProcessLaunch(command_line *CommandLine) {
-	lookupBrowserProcessHandlerProxy(d.Base()).OnBeforeChildProcessLaunch(d, command_line)
+	if proxy__, ok__ := lookupBrowserProcessHandlerProxy(d.Base(), "OnBeforeChildProcessLaunch").(BrowserProcessHandlerOnBeforeChildProcessLaunchProxy); ok__ {
+		proxy__.OnBeforeChildProcessLaunch(d, command_line)
+	}
 }
 
 //export gocef_browser_process_handler_on_before_child_process_launch
 func gocef_browser_process_handler_on_before_child_process_launch(self *C.cef_browser_process_handler_t, command_line *C.cef_command_line_t) {
+	defer recoverProxyPanic("BrowserProcessHandler", "OnBeforeChildProcessLaunch")
 	me__ := (*BrowserProcessHandler)(self)
-	proxy__ := lookupBrowserProcessHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupBrowserProcessHandlerProxy(me__.Base(), "OnBeforeChildProcessLaunch").(BrowserProcessHandlerOnBeforeChildProcessLaunchProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnBeforeChildProcessLaunch(me__, (*CommandLine)(command_line))
 }
 
@@ -96,13 +140,19 @@ func gocef_browser_process_handler_on_before_child_process_launch(self *C.cef_br
 // cef_render_process_handler_t::on_render_thread_created() in the render
 // process. Do not keep a reference to |extra_info| outside of this function.
 func (d *BrowserProcessHandler) OnRenderProcessThreadCreated(extra_info *ListValue) {
-	lookupBrowserProcessHandlerProxy(d.Base()).OnRenderProcessThreadCreated(d, extra_info)
+	if proxy__, ok__ := lookupBrowserProcessHandlerProxy(d.Base(), "OnRenderProcessThreadCreated").(BrowserProcessHandlerOnRenderProcessThreadCreatedProxy); ok__ {
+		proxy__.OnRenderProcessThreadCreated(d, extra_info)
+	}
 }
 
 //export gocef_browser_process_handler_on_render_process_thread_created
 func gocef_browser_process_handler_on_render_process_thread_created(self *C.cef_browser_process_handler_t, extra_info *C.cef_list_value_t) {
+	defer recoverProxyPanic("BrowserProcessHandler", "OnRenderProcessThreadCreated")
 	me__ := (*BrowserProcessHandler)(self)
-	proxy__ := lookupBrowserProcessHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupBrowserProcessHandlerProxy(me__.Base(), "OnRenderProcessThreadCreated").(BrowserProcessHandlerOnRenderProcessThreadCreatedProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnRenderProcessThreadCreated(me__, (*ListValue)(extra_info))
 }
 
@@ -110,13 +160,20 @@ func gocef_browser_process_handler_on_render_process_thread_created(self *C.cef_
 // Return the handler for printing on Linux. If a print handler is not
 // provided then printing will not be supported on the Linux platform.
 func (d *BrowserProcessHandler) GetPrintHandler() *PrintHandler {
-	return lookupBrowserProcessHandlerProxy(d.Base()).GetPrintHandler(d)
+	if proxy__, ok__ := lookupBrowserProcessHandlerProxy(d.Base(), "GetPrintHandler").(BrowserProcessHandlerGetPrintHandlerProxy); ok__ {
+		return proxy__.GetPrintHandler(d)
+	}
+	return nil
 }
 
 //export gocef_browser_process_handler_get_print_handler
 func gocef_browser_process_handler_get_print_handler(self *C.cef_browser_process_handler_t) *C.cef_print_handler_t {
+	defer recoverProxyPanic("BrowserProcessHandler", "GetPrintHandler")
 	me__ := (*BrowserProcessHandler)(self)
-	proxy__ := lookupBrowserProcessHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupBrowserProcessHandlerProxy(me__.Base(), "GetPrintHandler").(BrowserProcessHandlerGetPrintHandlerProxy)
+	if !ok__ {
+		return nil
+	}
 	return (proxy__.GetPrintHandler(me__)).toNative()
 }
 
@@ -133,12 +190,18 @@ func gocef_browser_process_handler_get_print_handler(self *C.cef_browser_process
 // specified delay and any currently pending scheduled call should be
 // cancelled.
 func (d *BrowserProcessHandler) OnScheduleMessagePumpWork(delay_ms int64) {
-	lookupBrowserProcessHandlerProxy(d.Base()).OnScheduleMessagePumpWork(d, delay_ms)
+	if proxy__, ok__ := lookupBrowserProcessHandlerProxy(d.Base(), "OnScheduleMessagePumpWork").(BrowserProcessHandlerOnScheduleMessagePumpWorkProxy); ok__ {
+		proxy__.OnScheduleMessagePumpWork(d, delay_ms)
+	}
 }
 
 //export gocef_browser_process_handler_on_schedule_message_pump_work
 func gocef_browser_process_handler_on_schedule_message_pump_work(self *C.cef_browser_process_handler_t, delay_ms C.int64) {
+	defer recoverProxyPanic("BrowserProcessHandler", "OnScheduleMessagePumpWork")
 	me__ := (*BrowserProcessHandler)(self)
-	proxy__ := lookupBrowserProcessHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupBrowserProcessHandlerProxy(me__.Base(), "OnScheduleMessagePumpWork").(BrowserProcessHandlerOnScheduleMessagePumpWorkProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnScheduleMessagePumpWork(me__, int64(delay_ms))
 }
@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_browser_process_handler_proxy(cef_browser_process_handler_t *self);
+void gocef_set_browser_process_handler_proxy(cef_browser_process_handler_t *self, int on_context_initialized, int on_before_child_process_launch, int on_render_process_thread_created, int get_print_handler, int on_schedule_message_pump_work);
 
 #endif // GOCEF_BrowserProcessHandler_H_
@@ -6,17 +6,39 @@ import (
 	// #include "BrowserViewDelegate_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// BrowserViewDelegateProxy defines methods required for using BrowserViewDelegate.
+// BrowserViewDelegateProxy is implemented by the proxies used with BrowserViewDelegate. Embed
+// DefaultBrowserViewDelegate to satisfy it, then implement any of the optional
+// BrowserViewDelegate*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type BrowserViewDelegateProxy interface {
+	isBrowserViewDelegateProxy()
+}
+
+// DefaultBrowserViewDelegate may be embedded to satisfy BrowserViewDelegateProxy.
+type DefaultBrowserViewDelegate struct{}
+
+func (DefaultBrowserViewDelegate) isBrowserViewDelegateProxy() {}
+
+// BrowserViewDelegateOnBrowserCreatedProxy may be implemented by a BrowserViewDelegateProxy to handle OnBrowserCreated.
+type BrowserViewDelegateOnBrowserCreatedProxy interface {
 	OnBrowserCreated(self *BrowserViewDelegate, browser_view *BrowserView, browser *Browser)
+}
+
+// BrowserViewDelegateOnBrowserDestroyedProxy may be implemented by a BrowserViewDelegateProxy to handle OnBrowserDestroyed.
+type BrowserViewDelegateOnBrowserDestroyedProxy interface {
 	OnBrowserDestroyed(self *BrowserViewDelegate, browser_view *BrowserView, browser *Browser)
-	GetDelegateForPopupBrowserView(self *BrowserViewDelegate, browser_view *BrowserView, settings *BrowserSettings, client *Client, is_devtools int32) *BrowserViewDelegate
-	OnPopupBrowserViewCreated(self *BrowserViewDelegate, browser_view, popup_browser_view *BrowserView, is_devtools int32) int32
+}
+
+// BrowserViewDelegateGetDelegateForPopupBrowserViewProxy may be implemented by a BrowserViewDelegateProxy to handle GetDelegateForPopupBrowserView.
+type BrowserViewDelegateGetDelegateForPopupBrowserViewProxy interface {
+	GetDelegateForPopupBrowserView(self *BrowserViewDelegate, browser_view *BrowserView, settings *BrowserSettings, client *Client, is_devtools bool) *BrowserViewDelegate
+}
+
+// BrowserViewDelegateOnPopupBrowserViewCreatedProxy may be implemented by a BrowserViewDelegateProxy to handle OnPopupBrowserViewCreated.
+type BrowserViewDelegateOnPopupBrowserViewCreatedProxy interface {
+	OnPopupBrowserViewCreated(self *BrowserViewDelegate, browser_view, popup_browser_view *BrowserView, is_devtools bool) bool
 }
 
 // BrowserViewDelegate (cef_browser_view_delegate_t from include/capi/views/cef_browser_view_delegate_capi.h)
@@ -30,7 +52,11 @@ type BrowserViewDelegate C.cef_browser_view_delegate_t
 func NewBrowserViewDelegate(proxy BrowserViewDelegateProxy) *BrowserViewDelegate {
 	result := (*BrowserViewDelegate)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_browser_view_delegate_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_browser_view_delegate_proxy(result.toNative())
+		_, on_browser_created := proxy.(BrowserViewDelegateOnBrowserCreatedProxy)
+		_, on_browser_destroyed := proxy.(BrowserViewDelegateOnBrowserDestroyedProxy)
+		_, get_delegate_for_popup_browser_view := proxy.(BrowserViewDelegateGetDelegateForPopupBrowserViewProxy)
+		_, on_popup_browser_view_created := proxy.(BrowserViewDelegateOnPopupBrowserViewCreatedProxy)
+		C.gocef_set_browser_view_delegate_proxy(result.toNative(), cefBool(on_browser_created), cefBool(on_browser_destroyed), cefBool(get_delegate_for_popup_browser_view), cefBool(on_popup_browser_view_created))
 	}
 	return result
 }
@@ -39,14 +65,15 @@ func (d *BrowserViewDelegate) toNative() *C.cef_browser_view_delegate_t {
 	return (*C.cef_browser_view_delegate_t)(d)
 }
 
-func lookupBrowserViewDelegateProxy(obj *BaseRefCounted) BrowserViewDelegateProxy {
+func lookupBrowserViewDelegateProxy(obj *BaseRefCounted, callback string) BrowserViewDelegateProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("BrowserViewDelegate", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(BrowserViewDelegateProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type BrowserViewDelegateProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("BrowserViewDelegate", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -63,13 +90,19 @@ func (d *BrowserViewDelegate) Base() *ViewDelegate {
 // is called for |browser| and before on_popup_browser_view_created() is
 // called for |browser|'s parent delegate if |browser| is a popup.
 func (d *BrowserViewDelegate) OnBrowserCreated(browser_view *BrowserView, browser *Browser) {
-	lookupBrowserViewDelegateProxy(d.Base().Base()).OnBrowserCreated(d, browser_view, browser)
+	if proxy__, ok__ := lookupBrowserViewDelegateProxy(d.Base().Base(), "OnBrowserCreated").(BrowserViewDelegateOnBrowserCreatedProxy); ok__ {
+		proxy__.OnBrowserCreated(d, browser_view, browser)
+	}
 }
 
 //export gocef_browser_view_delegate_on_browser_created
 func gocef_browser_view_delegate_on_browser_created(self *C.cef_browser_view_delegate_t, browser_view *C.cef_browser_view_t, browser *C.cef_browser_t) {
+	defer recoverProxyPanic("BrowserViewDelegate", "OnBrowserCreated")
 	me__ := (*BrowserViewDelegate)(self)
-	proxy__ := lookupBrowserViewDelegateProxy(me__.Base().Base())
+	proxy__, ok__ := lookupBrowserViewDelegateProxy(me__.Base().Base(), "OnBrowserCreated").(BrowserViewDelegateOnBrowserCreatedProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnBrowserCreated(me__, (*BrowserView)(browser_view), (*Browser)(browser))
 }
 
@@ -79,13 +112,19 @@ func gocef_browser_view_delegate_on_browser_created(self *C.cef_browser_view_del
 // |browser| after this callback returns. This function will be called before
 // cef_life_span_handler_t::on_before_close() is called for |browser|.
 func (d *BrowserViewDelegate) OnBrowserDestroyed(browser_view *BrowserView, browser *Browser) {
-	lookupBrowserViewDelegateProxy(d.Base().Base()).OnBrowserDestroyed(d, browser_view, browser)
+	if proxy__, ok__ := lookupBrowserViewDelegateProxy(d.Base().Base(), "OnBrowserDestroyed").(BrowserViewDelegateOnBrowserDestroyedProxy); ok__ {
+		proxy__.OnBrowserDestroyed(d, browser_view, browser)
+	}
 }
 
 //export gocef_browser_view_delegate_on_browser_destroyed
 func gocef_browser_view_delegate_on_browser_destroyed(self *C.cef_browser_view_delegate_t, browser_view *C.cef_browser_view_t, browser *C.cef_browser_t) {
+	defer recoverProxyPanic("BrowserViewDelegate", "OnBrowserDestroyed")
 	me__ := (*BrowserViewDelegate)(self)
-	proxy__ := lookupBrowserViewDelegateProxy(me__.Base().Base())
+	proxy__, ok__ := lookupBrowserViewDelegateProxy(me__.Base().Base(), "OnBrowserDestroyed").(BrowserViewDelegateOnBrowserDestroyedProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnBrowserDestroyed(me__, (*BrowserView)(browser_view), (*Browser)(browser))
 }
 
@@ -95,16 +134,23 @@ func gocef_browser_view_delegate_on_browser_destroyed(self *C.cef_browser_view_d
 // cef_life_span_handler_t::on_before_popup(). |is_devtools| will be true (1)
 // if the popup will be a DevTools browser. Return the delegate that will be
 // used for the new popup BrowserView.
-func (d *BrowserViewDelegate) GetDelegateForPopupBrowserView(browser_view *BrowserView, settings *BrowserSettings, client *Client, is_devtools int32) *BrowserViewDelegate {
-	return lookupBrowserViewDelegateProxy(d.Base().Base()).GetDelegateForPopupBrowserView(d, browser_view, settings, client, is_devtools)
+func (d *BrowserViewDelegate) GetDelegateForPopupBrowserView(browser_view *BrowserView, settings *BrowserSettings, client *Client, is_devtools bool) *BrowserViewDelegate {
+	if proxy__, ok__ := lookupBrowserViewDelegateProxy(d.Base().Base(), "GetDelegateForPopupBrowserView").(BrowserViewDelegateGetDelegateForPopupBrowserViewProxy); ok__ {
+		return proxy__.GetDelegateForPopupBrowserView(d, browser_view, settings, client, is_devtools)
+	}
+	return nil
 }
 
 //export gocef_browser_view_delegate_get_delegate_for_popup_browser_view
 func gocef_browser_view_delegate_get_delegate_for_popup_browser_view(self *C.cef_browser_view_delegate_t, browser_view *C.cef_browser_view_t, settings *C.cef_browser_settings_t, client *C.cef_client_t, is_devtools C.int) *C.cef_browser_view_delegate_t {
+	defer recoverProxyPanic("BrowserViewDelegate", "GetDelegateForPopupBrowserView")
 	me__ := (*BrowserViewDelegate)(self)
-	proxy__ := lookupBrowserViewDelegateProxy(me__.Base().Base())
+	proxy__, ok__ := lookupBrowserViewDelegateProxy(me__.Base().Base(), "GetDelegateForPopupBrowserView").(BrowserViewDelegateGetDelegateForPopupBrowserViewProxy)
+	if !ok__ {
+		return nil
+	}
 	settings_ := settings.toGo()
-	return (proxy__.GetDelegateForPopupBrowserView(me__, (*BrowserView)(browser_view), settings_, (*Client)(client), int32(is_devtools))).toNative()
+	return (proxy__.GetDelegateForPopupBrowserView(me__, (*BrowserView)(browser_view), settings_, (*Client)(client), is_devtools != 0)).toNative()
 }
 
 // OnPopupBrowserViewCreated (on_popup_browser_view_created)
@@ -115,13 +161,20 @@ func gocef_browser_view_delegate_get_delegate_for_popup_browser_view(self *C.cef
 // browser. Optionally add |popup_browser_view| to the views hierarchy
 // yourself and return true (1). Otherwise return false (0) and a default
 // cef_window_t will be created for the popup.
-func (d *BrowserViewDelegate) OnPopupBrowserViewCreated(browser_view, popup_browser_view *BrowserView, is_devtools int32) int32 {
-	return lookupBrowserViewDelegateProxy(d.Base().Base()).OnPopupBrowserViewCreated(d, browser_view, popup_browser_view, is_devtools)
+func (d *BrowserViewDelegate) OnPopupBrowserViewCreated(browser_view, popup_browser_view *BrowserView, is_devtools bool) bool {
+	if proxy__, ok__ := lookupBrowserViewDelegateProxy(d.Base().Base(), "OnPopupBrowserViewCreated").(BrowserViewDelegateOnPopupBrowserViewCreatedProxy); ok__ {
+		return proxy__.OnPopupBrowserViewCreated(d, browser_view, popup_browser_view, is_devtools)
+	}
+	return false
 }
 
 //export gocef_browser_view_delegate_on_popup_browser_view_created
 func gocef_browser_view_delegate_on_popup_browser_view_created(self *C.cef_browser_view_delegate_t, browser_view *C.cef_browser_view_t, popup_browser_view *C.cef_browser_view_t, is_devtools C.int) C.int {
+	defer recoverProxyPanic("BrowserViewDelegate", "OnPopupBrowserViewCreated")
 	me__ := (*BrowserViewDelegate)(self)
-	proxy__ := lookupBrowserViewDelegateProxy(me__.Base().Base())
-	return C.int(proxy__.OnPopupBrowserViewCreated(me__, (*BrowserView)(browser_view), (*BrowserView)(popup_browser_view), int32(is_devtools)))
+	proxy__, ok__ := lookupBrowserViewDelegateProxy(me__.Base().Base(), "OnPopupBrowserViewCreated").(BrowserViewDelegateOnPopupBrowserViewCreatedProxy)
+	if !ok__ {
+		return 0
+	}
+	return cefBool(proxy__.OnPopupBrowserViewCreated(me__, (*BrowserView)(browser_view), (*BrowserView)(popup_browser_view), is_devtools != 0))
 }
@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_browser_view_delegate_proxy(cef_browser_view_delegate_t *self);
+void gocef_set_browser_view_delegate_proxy(cef_browser_view_delegate_t *self, int on_browser_created, int on_browser_destroyed, int get_delegate_for_popup_browser_view, int on_popup_browser_view_created);
 
 #endif // GOCEF_BrowserViewDelegate_H_
@@ -27,6 +27,7 @@ func (d *BrowserView) Base() *View {
 // GetBrowser (get_browser)
 // Returns the cef_browser_t hosted by this BrowserView. Will return NULL if
 // the browser has not yet been created or has already been destroyed.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *BrowserView) GetBrowser() *Browser {
 	return (*Browser)(C.gocef_browser_view_get_browser(d.toNative(), d.get_browser))
 }
@@ -39,6 +40,6 @@ func (d *BrowserView) GetBrowser() *Browser {
 // If |prefer_accelerators| is false (0) then the matching accelerator will
 // only be triggered if the event is not handled by web content or by
 // cef_keyboard_handler_t. The default value is false (0).
-func (d *BrowserView) SetPreferAccelerators(prefer_accelerators int32) {
-	C.gocef_browser_view_set_prefer_accelerators(d.toNative(), C.int(prefer_accelerators), d.set_prefer_accelerators)
+func (d *BrowserView) SetPreferAccelerators(prefer_accelerators bool) {
+	C.gocef_browser_view_set_prefer_accelerators(d.toNative(), cefBool(prefer_accelerators), d.set_prefer_accelerators)
 }
@@ -48,14 +48,15 @@ func (d *Browser) Base() *BaseRefCounted {
 // GetHost (get_host)
 // Returns the browser host object. This function can only be called in the
 // browser process.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *Browser) GetHost() *BrowserHost {
 	return (*BrowserHost)(C.gocef_browser_get_host(d.toNative(), d.get_host))
 }
 
 // CanGoBack (can_go_back)
 // Returns true (1) if the browser can navigate backwards.
-func (d *Browser) CanGoBack() int32 {
-	return int32(C.gocef_browser_can_go_back(d.toNative(), d.can_go_back))
+func (d *Browser) CanGoBack() bool {
+	return C.gocef_browser_can_go_back(d.toNative(), d.can_go_back) != 0
 }
 
 // GoBack (go_back)
@@ -66,8 +67,8 @@ func (d *Browser) GoBack() {
 
 // CanGoForward (can_go_forward)
 // Returns true (1) if the browser can navigate forwards.
-func (d *Browser) CanGoForward() int32 {
-	return int32(C.gocef_browser_can_go_forward(d.toNative(), d.can_go_forward))
+func (d *Browser) CanGoForward() bool {
+	return C.gocef_browser_can_go_forward(d.toNative(), d.can_go_forward) != 0
 }
 
 // GoForward (go_forward)
@@ -78,8 +79,8 @@ func (d *Browser) GoForward() {
 
 // IsLoading (is_loading)
 // Returns true (1) if the browser is currently loading.
-func (d *Browser) IsLoading() int32 {
-	return int32(C.gocef_browser_is_loading(d.toNative(), d.is_loading))
+func (d *Browser) IsLoading() bool {
+	return C.gocef_browser_is_loading(d.toNative(), d.is_loading) != 0
 }
 
 // Reload (reload)
@@ -110,42 +111,46 @@ func (d *Browser) GetIdentifier() int32 {
 // IsSame (is_same)
 // Returns true (1) if this object is pointing to the same handle as |that|
 // object.
-func (d *Browser) IsSame(that *Browser) int32 {
-	return int32(C.gocef_browser_is_same(d.toNative(), that.toNative(), d.is_same))
+func (d *Browser) IsSame(that *Browser) bool {
+	return C.gocef_browser_is_same(d.toNative(), that.toNative(), d.is_same) != 0
 }
 
 // IsPopup (is_popup)
 // Returns true (1) if the window is a popup window.
-func (d *Browser) IsPopup() int32 {
-	return int32(C.gocef_browser_is_popup(d.toNative(), d.is_popup))
+func (d *Browser) IsPopup() bool {
+	return C.gocef_browser_is_popup(d.toNative(), d.is_popup) != 0
 }
 
 // HasDocument (has_document)
 // Returns true (1) if a document has been loaded in the browser.
-func (d *Browser) HasDocument() int32 {
-	return int32(C.gocef_browser_has_document(d.toNative(), d.has_document))
+func (d *Browser) HasDocument() bool {
+	return C.gocef_browser_has_document(d.toNative(), d.has_document) != 0
 }
 
 // GetMainFrame (get_main_frame)
 // Returns the main (top-level) frame for the browser window.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *Browser) GetMainFrame() *Frame {
 	return (*Frame)(C.gocef_browser_get_main_frame(d.toNative(), d.get_main_frame))
 }
 
 // GetFocusedFrame (get_focused_frame)
 // Returns the focused frame for the browser window.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *Browser) GetFocusedFrame() *Frame {
 	return (*Frame)(C.gocef_browser_get_focused_frame(d.toNative(), d.get_focused_frame))
 }
 
 // GetFrameByident (get_frame_byident)
 // Returns the frame with the specified identifier, or NULL if not found.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *Browser) GetFrameByident(identifier int64) *Frame {
 	return (*Frame)(C.gocef_browser_get_frame_byident(d.toNative(), C.int64(identifier), d.get_frame_byident))
 }
 
 // GetFrame (get_frame)
 // Returns the frame with the specified name, or NULL if not found.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *Browser) GetFrame(name string) *Frame {
 	name_ := C.cef_string_userfree_alloc()
 	setCEFStr(name, name_)
@@ -169,13 +174,16 @@ func (d *Browser) GetFrameIdentifiers(identifiersCount *uint64, identifiers *int
 
 // GetFrameNames (get_frame_names)
 // Returns the names of all existing frames.
-func (d *Browser) GetFrameNames(names StringList) {
-	C.gocef_browser_get_frame_names(d.toNative(), C.cef_string_list_t(names), d.get_frame_names)
+func (d *Browser) GetFrameNames() []string {
+	names_ := C.cef_string_list_alloc()
+	defer C.cef_string_list_free(names_)
+	C.gocef_browser_get_frame_names(d.toNative(), names_, d.get_frame_names)
+	return cefStringListToGo(names_)
 }
 
 // SendProcessMessage (send_process_message)
 // Send a message to the specified |target_process|. Returns true (1) if the
 // message was sent successfully.
-func (d *Browser) SendProcessMessage(target_process ProcessID, message *ProcessMessage) int32 {
-	return int32(C.gocef_browser_send_process_message(d.toNative(), C.cef_process_id_t(target_process), message.toNative(), d.send_process_message))
+func (d *Browser) SendProcessMessage(target_process ProcessID, message *ProcessMessage) bool {
+	return C.gocef_browser_send_process_message(d.toNative(), C.cef_process_id_t(target_process), message.toNative(), d.send_process_message) != 0
 }
@@ -3,9 +3,13 @@
 #include "ButtonDelegate_gen.h"
 #include "_cgo_export.h"
 
-void gocef_set_button_delegate_proxy(cef_button_delegate_t *self) {
+void gocef_set_button_delegate_proxy(cef_button_delegate_t *self, int on_button_pressed, int on_button_state_changed) {
 	// Casts to (void *) added to avoid warnings since Go callbacks can't have
 	// some modifiers, such as 'const' applied to their parameter signatures.
-	self->on_button_pressed = (void *)&gocef_button_delegate_on_button_pressed;
-	self->on_button_state_changed = (void *)&gocef_button_delegate_on_button_state_changed;
+	if (on_button_pressed) {
+		self->on_button_pressed = (void *)&gocef_button_delegate_on_button_pressed;
+	}
+	if (on_button_state_changed) {
+		self->on_button_state_changed = (void *)&gocef_button_delegate_on_button_state_changed;
+	}
 }
@@ -6,14 +6,28 @@ import (
 	// #include "ButtonDelegate_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// ButtonDelegateProxy defines methods required for using ButtonDelegate.
+// ButtonDelegateProxy is implemented by the proxies used with ButtonDelegate. Embed
+// DefaultButtonDelegate to satisfy it, then implement any of the optional
+// ButtonDelegate*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type ButtonDelegateProxy interface {
+	isButtonDelegateProxy()
+}
+
+// DefaultButtonDelegate may be embedded to satisfy ButtonDelegateProxy.
+type DefaultButtonDelegate struct{}
+
+func (DefaultButtonDelegate) isButtonDelegateProxy() {}
+
+// ButtonDelegateOnButtonPressedProxy may be implemented by a ButtonDelegateProxy to handle OnButtonPressed.
+type ButtonDelegateOnButtonPressedProxy interface {
 	OnButtonPressed(self *ButtonDelegate, button *Button)
+}
+
+// ButtonDelegateOnButtonStateChangedProxy may be implemented by a ButtonDelegateProxy to handle OnButtonStateChanged.
+type ButtonDelegateOnButtonStateChangedProxy interface {
 	OnButtonStateChanged(self *ButtonDelegate, button *Button)
 }
 
@@ -28,7 +42,9 @@ type ButtonDelegate C.cef_button_delegate_t
 func NewButtonDelegate(proxy ButtonDelegateProxy) *ButtonDelegate {
 	result := (*ButtonDelegate)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_button_delegate_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_button_delegate_proxy(result.toNative())
+		_, on_button_pressed := proxy.(ButtonDelegateOnButtonPressedProxy)
+		_, on_button_state_changed := proxy.(ButtonDelegateOnButtonStateChangedProxy)
+		C.gocef_set_button_delegate_proxy(result.toNative(), cefBool(on_button_pressed), cefBool(on_button_state_changed))
 	}
 	return result
 }
@@ -37,14 +53,15 @@ func (d *ButtonDelegate) toNative() *C.cef_button_delegate_t {
 	return (*C.cef_button_delegate_t)(d)
 }
 
-func lookupButtonDelegateProxy(obj *BaseRefCounted) ButtonDelegateProxy {
+func lookupButtonDelegateProxy(obj *BaseRefCounted, callback string) ButtonDelegateProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("ButtonDelegate", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(ButtonDelegateProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type ButtonDelegateProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("ButtonDelegate", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -58,25 +75,37 @@ func (d *ButtonDelegate) Base() *ViewDelegate {
 // OnButtonPressed (on_button_pressed)
 // Called when |button| is pressed.
 func (d *ButtonDelegate) OnButtonPressed(button *Button) {
-	lookupButtonDelegateProxy(d.Base().Base()).OnButtonPressed(d, button)
+	if proxy__, ok__ := lookupButtonDelegateProxy(d.Base().Base(), "OnButtonPressed").(ButtonDelegateOnButtonPressedProxy); ok__ {
+		proxy__.OnButtonPressed(d, button)
+	}
 }
 
 //export gocef_button_delegate_on_button_pressed
 func gocef_button_delegate_on_button_pressed(self *C.cef_button_delegate_t, button *C.cef_button_t) {
+	defer recoverProxyPanic("ButtonDelegate", "OnButtonPressed")
 	me__ := (*ButtonDelegate)(self)
-	proxy__ := lookupButtonDelegateProxy(me__.Base().Base())
+	proxy__, ok__ := lookupButtonDelegateProxy(me__.Base().Base(), "OnButtonPressed").(ButtonDelegateOnButtonPressedProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnButtonPressed(me__, (*Button)(button))
 }
 
 // OnButtonStateChanged (on_button_state_changed)
 // Called when the state of |button| changes.
 func (d *ButtonDelegate) OnButtonStateChanged(button *Button) {
-	lookupButtonDelegateProxy(d.Base().Base()).OnButtonStateChanged(d, button)
+	if proxy__, ok__ := lookupButtonDelegateProxy(d.Base().Base(), "OnButtonStateChanged").(ButtonDelegateOnButtonStateChangedProxy); ok__ {
+		proxy__.OnButtonStateChanged(d, button)
+	}
 }
 
 //export gocef_button_delegate_on_button_state_changed
 func gocef_button_delegate_on_button_state_changed(self *C.cef_button_delegate_t, button *C.cef_button_t) {
+	defer recoverProxyPanic("ButtonDelegate", "OnButtonStateChanged")
 	me__ := (*ButtonDelegate)(self)
-	proxy__ := lookupButtonDelegateProxy(me__.Base().Base())
+	proxy__, ok__ := lookupButtonDelegateProxy(me__.Base().Base(), "OnButtonStateChanged").(ButtonDelegateOnButtonStateChangedProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnButtonStateChanged(me__, (*Button)(button))
 }
@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_button_delegate_proxy(cef_button_delegate_t *self);
+void gocef_set_button_delegate_proxy(cef_button_delegate_t *self, int on_button_pressed, int on_button_state_changed);
 
 #endif // GOCEF_ButtonDelegate_H_
@@ -31,6 +31,7 @@ func (d *Button) Base() *View {
 
 // AsLabelButton (as_label_button)
 // Returns this Button as a LabelButton or NULL if this is not a LabelButton.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *Button) AsLabelButton() *LabelButton {
 	return (*LabelButton)(C.gocef_button_as_label_button(d.toNative(), d.as_label_button))
 }
@@ -49,8 +50,8 @@ func (d *Button) GetState() ButtonState {
 
 // SetInkDropEnabled (set_ink_drop_enabled)
 // Sets the Button will use an ink drop effect for displaying state changes.
-func (d *Button) SetInkDropEnabled(enabled int32) {
-	C.gocef_button_set_ink_drop_enabled(d.toNative(), C.int(enabled), d.set_ink_drop_enabled)
+func (d *Button) SetInkDropEnabled(enabled bool) {
+	C.gocef_button_set_ink_drop_enabled(d.toNative(), cefBool(enabled), d.set_ink_drop_enabled)
 }
 
 // SetTooltipText (set_tooltip_text)
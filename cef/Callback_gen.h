@@ -1,11 +0,0 @@
-// Code generated - DO NOT EDIT.
-
-#ifndef GOCEF_Callback_H_
-#define GOCEF_Callback_H_
-#pragma once
-
-#include "capi_gen.h"
-
-void gocef_set_callback_proxy(cef_callback_t *self);
-
-#endif // GOCEF_Callback_H_
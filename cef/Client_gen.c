@@ -3,22 +3,52 @@
 #include "Client_gen.h"
 #include "_cgo_export.h"
 
-void gocef_set_client_proxy(cef_client_t *self) {
+void gocef_set_client_proxy(cef_client_t *self, int get_audio_handler, int get_context_menu_handler, int get_dialog_handler, int get_display_handler, int get_download_handler, int get_drag_handler, int get_find_handler, int get_focus_handler, int get_jsdialog_handler, int get_keyboard_handler, int get_life_span_handler, int get_load_handler, int get_render_handler, int get_request_handler, int on_process_message_received) {
 	// Casts to (void *) added to avoid warnings since Go callbacks can't have
 	// some modifiers, such as 'const' applied to their parameter signatures.
-	self->get_audio_handler = (void *)&gocef_client_get_audio_handler;
-	self->get_context_menu_handler = (void *)&gocef_client_get_context_menu_handler;
-	self->get_dialog_handler = (void *)&gocef_client_get_dialog_handler;
-	self->get_display_handler = (void *)&gocef_client_get_display_handler;
-	self->get_download_handler = (void *)&gocef_client_get_download_handler;
-	self->get_drag_handler = (void *)&gocef_client_get_drag_handler;
-	self->get_find_handler = (void *)&gocef_client_get_find_handler;
-	self->get_focus_handler = (void *)&gocef_client_get_focus_handler;
-	self->get_jsdialog_handler = (void *)&gocef_client_get_jsdialog_handler;
-	self->get_keyboard_handler = (void *)&gocef_client_get_keyboard_handler;
-	self->get_life_span_handler = (void *)&gocef_client_get_life_span_handler;
-	self->get_load_handler = (void *)&gocef_client_get_load_handler;
-	self->get_render_handler = (void *)&gocef_client_get_render_handler;
-	self->get_request_handler = (void *)&gocef_client_get_request_handler;
-	self->on_process_message_received = (void *)&gocef_client_on_process_message_received;
+	if (get_audio_handler) {
+		self->get_audio_handler = (void *)&gocef_client_get_audio_handler;
+	}
+	if (get_context_menu_handler) {
+		self->get_context_menu_handler = (void *)&gocef_client_get_context_menu_handler;
+	}
+	if (get_dialog_handler) {
+		self->get_dialog_handler = (void *)&gocef_client_get_dialog_handler;
+	}
+	if (get_display_handler) {
+		self->get_display_handler = (void *)&gocef_client_get_display_handler;
+	}
+	if (get_download_handler) {
+		self->get_download_handler = (void *)&gocef_client_get_download_handler;
+	}
+	if (get_drag_handler) {
+		self->get_drag_handler = (void *)&gocef_client_get_drag_handler;
+	}
+	if (get_find_handler) {
+		self->get_find_handler = (void *)&gocef_client_get_find_handler;
+	}
+	if (get_focus_handler) {
+		self->get_focus_handler = (void *)&gocef_client_get_focus_handler;
+	}
+	if (get_jsdialog_handler) {
+		self->get_jsdialog_handler = (void *)&gocef_client_get_jsdialog_handler;
+	}
+	if (get_keyboard_handler) {
+		self->get_keyboard_handler = (void *)&gocef_client_get_keyboard_handler;
+	}
+	if (get_life_span_handler) {
+		self->get_life_span_handler = (void *)&gocef_client_get_life_span_handler;
+	}
+	if (get_load_handler) {
+		self->get_load_handler = (void *)&gocef_client_get_load_handler;
+	}
+	if (get_render_handler) {
+		self->get_render_handler = (void *)&gocef_client_get_render_handler;
+	}
+	if (get_request_handler) {
+		self->get_request_handler = (void *)&gocef_client_get_request_handler;
+	}
+	if (on_process_message_received) {
+		self->on_process_message_received = (void *)&gocef_client_on_process_message_received;
+	}
 }
@@ -6,28 +6,94 @@ import (
 	// #include "Client_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// ClientProxy defines methods required for using Client.
+// ClientProxy is implemented by the proxies used with Client. Embed
+// DefaultClient to satisfy it, then implement any of the optional
+// Client*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type ClientProxy interface {
+	isClientProxy()
+}
+
+// DefaultClient may be embedded to satisfy ClientProxy.
+type DefaultClient struct{}
+
+func (DefaultClient) isClientProxy() {}
+
+// ClientGetAudioHandlerProxy may be implemented by a ClientProxy to handle GetAudioHandler.
+type ClientGetAudioHandlerProxy interface {
 	GetAudioHandler(self *Client) *AudioHandler
+}
+
+// ClientGetContextMenuHandlerProxy may be implemented by a ClientProxy to handle GetContextMenuHandler.
+type ClientGetContextMenuHandlerProxy interface {
 	GetContextMenuHandler(self *Client) *ContextMenuHandler
+}
+
+// ClientGetDialogHandlerProxy may be implemented by a ClientProxy to handle GetDialogHandler.
+type ClientGetDialogHandlerProxy interface {
 	GetDialogHandler(self *Client) *DialogHandler
+}
+
+// ClientGetDisplayHandlerProxy may be implemented by a ClientProxy to handle GetDisplayHandler.
+type ClientGetDisplayHandlerProxy interface {
 	GetDisplayHandler(self *Client) *DisplayHandler
+}
+
+// ClientGetDownloadHandlerProxy may be implemented by a ClientProxy to handle GetDownloadHandler.
+type ClientGetDownloadHandlerProxy interface {
 	GetDownloadHandler(self *Client) *DownloadHandler
+}
+
+// ClientGetDragHandlerProxy may be implemented by a ClientProxy to handle GetDragHandler.
+type ClientGetDragHandlerProxy interface {
 	GetDragHandler(self *Client) *DragHandler
+}
+
+// ClientGetFindHandlerProxy may be implemented by a ClientProxy to handle GetFindHandler.
+type ClientGetFindHandlerProxy interface {
 	GetFindHandler(self *Client) *FindHandler
+}
+
+// ClientGetFocusHandlerProxy may be implemented by a ClientProxy to handle GetFocusHandler.
+type ClientGetFocusHandlerProxy interface {
 	GetFocusHandler(self *Client) *FocusHandler
+}
+
+// ClientGetJsdialogHandlerProxy may be implemented by a ClientProxy to handle GetJsdialogHandler.
+type ClientGetJsdialogHandlerProxy interface {
 	GetJsdialogHandler(self *Client) *JsdialogHandler
+}
+
+// ClientGetKeyboardHandlerProxy may be implemented by a ClientProxy to handle GetKeyboardHandler.
+type ClientGetKeyboardHandlerProxy interface {
 	GetKeyboardHandler(self *Client) *KeyboardHandler
+}
+
+// ClientGetLifeSpanHandlerProxy may be implemented by a ClientProxy to handle GetLifeSpanHandler.
+type ClientGetLifeSpanHandlerProxy interface {
 	GetLifeSpanHandler(self *Client) *LifeSpanHandler
+}
+
+// ClientGetLoadHandlerProxy may be implemented by a ClientProxy to handle GetLoadHandler.
+type ClientGetLoadHandlerProxy interface {
 	GetLoadHandler(self *Client) *LoadHandler
+}
+
+// ClientGetRenderHandlerProxy may be implemented by a ClientProxy to handle GetRenderHandler.
+type ClientGetRenderHandlerProxy interface {
 	GetRenderHandler(self *Client) *RenderHandler
+}
+
+// ClientGetRequestHandlerProxy may be implemented by a ClientProxy to handle GetRequestHandler.
+type ClientGetRequestHandlerProxy interface {
 	GetRequestHandler(self *Client) *RequestHandler
-	OnProcessMessageReceived(self *Client, browser *Browser, source_process ProcessID, message *ProcessMessage) int32
+}
+
+// ClientOnProcessMessageReceivedProxy may be implemented by a ClientProxy to handle OnProcessMessageReceived.
+type ClientOnProcessMessageReceivedProxy interface {
+	OnProcessMessageReceived(self *Client, browser *Browser, source_process ProcessID, message *ProcessMessage) bool
 }
 
 // Client (cef_client_t from include/capi/cef_client_capi.h)
@@ -39,7 +105,22 @@ type Client C.cef_client_t
 func NewClient(proxy ClientProxy) *Client {
 	result := (*Client)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_client_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_client_proxy(result.toNative())
+		_, get_audio_handler := proxy.(ClientGetAudioHandlerProxy)
+		_, get_context_menu_handler := proxy.(ClientGetContextMenuHandlerProxy)
+		_, get_dialog_handler := proxy.(ClientGetDialogHandlerProxy)
+		_, get_display_handler := proxy.(ClientGetDisplayHandlerProxy)
+		_, get_download_handler := proxy.(ClientGetDownloadHandlerProxy)
+		_, get_drag_handler := proxy.(ClientGetDragHandlerProxy)
+		_, get_find_handler := proxy.(ClientGetFindHandlerProxy)
+		_, get_focus_handler := proxy.(ClientGetFocusHandlerProxy)
+		_, get_jsdialog_handler := proxy.(ClientGetJsdialogHandlerProxy)
+		_, get_keyboard_handler := proxy.(ClientGetKeyboardHandlerProxy)
+		_, get_life_span_handler := proxy.(ClientGetLifeSpanHandlerProxy)
+		_, get_load_handler := proxy.(ClientGetLoadHandlerProxy)
+		_, get_render_handler := proxy.(ClientGetRenderHandlerProxy)
+		_, get_request_handler := proxy.(ClientGetRequestHandlerProxy)
+		_, on_process_message_received := proxy.(ClientOnProcessMessageReceivedProxy)
+		C.gocef_set_client_proxy(result.toNative(), cefBool(get_audio_handler), cefBool(get_context_menu_handler), cefBool(get_dialog_handler), cefBool(get_display_handler), cefBool(get_download_handler), cefBool(get_drag_handler), cefBool(get_find_handler), cefBool(get_focus_handler), cefBool(get_jsdialog_handler), cefBool(get_keyboard_handler), cefBool(get_life_span_handler), cefBool(get_load_handler), cefBool(get_render_handler), cefBool(get_request_handler), cefBool(on_process_message_received))
 	}
 	return result
 }
@@ -48,14 +129,15 @@ func (d *Client) toNative() *C.cef_client_t {
 	return (*C.cef_client_t)(d)
 }
 
-func lookupClientProxy(obj *BaseRefCounted) ClientProxy {
+func lookupClientProxy(obj *BaseRefCounted, callback string) ClientProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("Client", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(ClientProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type ClientProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("Client", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -69,13 +151,20 @@ func (d *Client) Base() *BaseRefCounted {
 // GetAudioHandler (get_audio_handler)
 // Return the handler for audio rendering events.
 func (d *Client) GetAudioHandler() *AudioHandler {
-	return lookupClientProxy(d.Base()).GetAudioHandler(d)
+	if proxy__, ok__ := lookupClientProxy(d.Base(), "GetAudioHandler").(ClientGetAudioHandlerProxy); ok__ {
+		return proxy__.GetAudioHandler(d)
+	}
+	return nil
 }
 
 //export gocef_client_get_audio_handler
 func gocef_client_get_audio_handler(self *C.cef_client_t) *C.cef_audio_handler_t {
+	defer recoverProxyPanic("Client", "GetAudioHandler")
 	me__ := (*Client)(self)
-	proxy__ := lookupClientProxy(me__.Base())
+	proxy__, ok__ := lookupClientProxy(me__.Base(), "GetAudioHandler").(ClientGetAudioHandlerProxy)
+	if !ok__ {
+		return nil
+	}
 	return (proxy__.GetAudioHandler(me__)).toNative()
 }
 
@@ -83,13 +172,20 @@ func gocef_client_get_audio_handler(self *C.cef_client_t) *C.cef_audio_handler_t
 // Return the handler for context menus. If no handler is provided the default
 // implementation will be used.
 func (d *Client) GetContextMenuHandler() *ContextMenuHandler {
-	return lookupClientProxy(d.Base()).GetContextMenuHandler(d)
+	if proxy__, ok__ := lookupClientProxy(d.Base(), "GetContextMenuHandler").(ClientGetContextMenuHandlerProxy); ok__ {
+		return proxy__.GetContextMenuHandler(d)
+	}
+	return nil
 }
 
 //export gocef_client_get_context_menu_handler
 func gocef_client_get_context_menu_handler(self *C.cef_client_t) *C.cef_context_menu_handler_t {
+	defer recoverProxyPanic("Client", "GetContextMenuHandler")
 	me__ := (*Client)(self)
-	proxy__ := lookupClientProxy(me__.Base())
+	proxy__, ok__ := lookupClientProxy(me__.Base(), "GetContextMenuHandler").(ClientGetContextMenuHandlerProxy)
+	if !ok__ {
+		return nil
+	}
 	return (proxy__.GetContextMenuHandler(me__)).toNative()
 }
 
@@ -97,26 +193,40 @@ func gocef_client_get_context_menu_handler(self *C.cef_client_t) *C.cef_context_
 // Return the handler for dialogs. If no handler is provided the default
 // implementation will be used.
 func (d *Client) GetDialogHandler() *DialogHandler {
-	return lookupClientProxy(d.Base()).GetDialogHandler(d)
+	if proxy__, ok__ := lookupClientProxy(d.Base(), "GetDialogHandler").(ClientGetDialogHandlerProxy); ok__ {
+		return proxy__.GetDialogHandler(d)
+	}
+	return nil
 }
 
 //export gocef_client_get_dialog_handler
 func gocef_client_get_dialog_handler(self *C.cef_client_t) *C.cef_dialog_handler_t {
+	defer recoverProxyPanic("Client", "GetDialogHandler")
 	me__ := (*Client)(self)
-	proxy__ := lookupClientProxy(me__.Base())
+	proxy__, ok__ := lookupClientProxy(me__.Base(), "GetDialogHandler").(ClientGetDialogHandlerProxy)
+	if !ok__ {
+		return nil
+	}
 	return (proxy__.GetDialogHandler(me__)).toNative()
 }
 
 // GetDisplayHandler (get_display_handler)
 // Return the handler for browser display state events.
 func (d *Client) GetDisplayHandler() *DisplayHandler {
-	return lookupClientProxy(d.Base()).GetDisplayHandler(d)
+	if proxy__, ok__ := lookupClientProxy(d.Base(), "GetDisplayHandler").(ClientGetDisplayHandlerProxy); ok__ {
+		return proxy__.GetDisplayHandler(d)
+	}
+	return nil
 }
 
 //export gocef_client_get_display_handler
 func gocef_client_get_display_handler(self *C.cef_client_t) *C.cef_display_handler_t {
+	defer recoverProxyPanic("Client", "GetDisplayHandler")
 	me__ := (*Client)(self)
-	proxy__ := lookupClientProxy(me__.Base())
+	proxy__, ok__ := lookupClientProxy(me__.Base(), "GetDisplayHandler").(ClientGetDisplayHandlerProxy)
+	if !ok__ {
+		return nil
+	}
 	return (proxy__.GetDisplayHandler(me__)).toNative()
 }
 
@@ -124,52 +234,80 @@ func gocef_client_get_display_handler(self *C.cef_client_t) *C.cef_display_handl
 // Return the handler for download events. If no handler is returned downloads
 // will not be allowed.
 func (d *Client) GetDownloadHandler() *DownloadHandler {
-	return lookupClientProxy(d.Base()).GetDownloadHandler(d)
+	if proxy__, ok__ := lookupClientProxy(d.Base(), "GetDownloadHandler").(ClientGetDownloadHandlerProxy); ok__ {
+		return proxy__.GetDownloadHandler(d)
+	}
+	return nil
 }
 
 //export gocef_client_get_download_handler
 func gocef_client_get_download_handler(self *C.cef_client_t) *C.cef_download_handler_t {
+	defer recoverProxyPanic("Client", "GetDownloadHandler")
 	me__ := (*Client)(self)
-	proxy__ := lookupClientProxy(me__.Base())
+	proxy__, ok__ := lookupClientProxy(me__.Base(), "GetDownloadHandler").(ClientGetDownloadHandlerProxy)
+	if !ok__ {
+		return nil
+	}
 	return (proxy__.GetDownloadHandler(me__)).toNative()
 }
 
 // GetDragHandler (get_drag_handler)
 // Return the handler for drag events.
 func (d *Client) GetDragHandler() *DragHandler {
-	return lookupClientProxy(d.Base()).GetDragHandler(d)
+	if proxy__, ok__ := lookupClientProxy(d.Base(), "GetDragHandler").(ClientGetDragHandlerProxy); ok__ {
+		return proxy__.GetDragHandler(d)
+	}
+	return nil
 }
 
 //export gocef_client_get_drag_handler
 func gocef_client_get_drag_handler(self *C.cef_client_t) *C.cef_drag_handler_t {
+	defer recoverProxyPanic("Client", "GetDragHandler")
 	me__ := (*Client)(self)
-	proxy__ := lookupClientProxy(me__.Base())
+	proxy__, ok__ := lookupClientProxy(me__.Base(), "GetDragHandler").(ClientGetDragHandlerProxy)
+	if !ok__ {
+		return nil
+	}
 	return (proxy__.GetDragHandler(me__)).toNative()
 }
 
 // GetFindHandler (get_find_handler)
 // Return the handler for find result events.
 func (d *Client) GetFindHandler() *FindHandler {
-	return lookupClientProxy(d.Base()).GetFindHandler(d)
+	if proxy__, ok__ := lookupClientProxy(d.Base(), "GetFindHandler").(ClientGetFindHandlerProxy); ok__ {
+		return proxy__.GetFindHandler(d)
+	}
+	return nil
 }
 
 //export gocef_client_get_find_handler
 func gocef_client_get_find_handler(self *C.cef_client_t) *C.cef_find_handler_t {
+	defer recoverProxyPanic("Client", "GetFindHandler")
 	me__ := (*Client)(self)
-	proxy__ := lookupClientProxy(me__.Base())
+	proxy__, ok__ := lookupClientProxy(me__.Base(), "GetFindHandler").(ClientGetFindHandlerProxy)
+	if !ok__ {
+		return nil
+	}
 	return (proxy__.GetFindHandler(me__)).toNative()
 }
 
 // GetFocusHandler (get_focus_handler)
 // Return the handler for focus events.
 func (d *Client) GetFocusHandler() *FocusHandler {
-	return lookupClientProxy(d.Base()).GetFocusHandler(d)
+	if proxy__, ok__ := lookupClientProxy(d.Base(), "GetFocusHandler").(ClientGetFocusHandlerProxy); ok__ {
+		return proxy__.GetFocusHandler(d)
+	}
+	return nil
 }
 
 //export gocef_client_get_focus_handler
 func gocef_client_get_focus_handler(self *C.cef_client_t) *C.cef_focus_handler_t {
+	defer recoverProxyPanic("Client", "GetFocusHandler")
 	me__ := (*Client)(self)
-	proxy__ := lookupClientProxy(me__.Base())
+	proxy__, ok__ := lookupClientProxy(me__.Base(), "GetFocusHandler").(ClientGetFocusHandlerProxy)
+	if !ok__ {
+		return nil
+	}
 	return (proxy__.GetFocusHandler(me__)).toNative()
 }
 
@@ -177,78 +315,120 @@ func gocef_client_get_focus_handler(self *C.cef_client_t) *C.cef_focus_handler_t
 // Return the handler for JavaScript dialogs. If no handler is provided the
 // default implementation will be used.
 func (d *Client) GetJsdialogHandler() *JsdialogHandler {
-	return lookupClientProxy(d.Base()).GetJsdialogHandler(d)
+	if proxy__, ok__ := lookupClientProxy(d.Base(), "GetJsdialogHandler").(ClientGetJsdialogHandlerProxy); ok__ {
+		return proxy__.GetJsdialogHandler(d)
+	}
+	return nil
 }
 
 //export gocef_client_get_jsdialog_handler
 func gocef_client_get_jsdialog_handler(self *C.cef_client_t) *C.cef_jsdialog_handler_t {
+	defer recoverProxyPanic("Client", "GetJsdialogHandler")
 	me__ := (*Client)(self)
-	proxy__ := lookupClientProxy(me__.Base())
+	proxy__, ok__ := lookupClientProxy(me__.Base(), "GetJsdialogHandler").(ClientGetJsdialogHandlerProxy)
+	if !ok__ {
+		return nil
+	}
 	return (proxy__.GetJsdialogHandler(me__)).toNative()
 }
 
 // GetKeyboardHandler (get_keyboard_handler)
 // Return the handler for keyboard events.
 func (d *Client) GetKeyboardHandler() *KeyboardHandler {
-	return lookupClientProxy(d.Base()).GetKeyboardHandler(d)
+	if proxy__, ok__ := lookupClientProxy(d.Base(), "GetKeyboardHandler").(ClientGetKeyboardHandlerProxy); ok__ {
+		return proxy__.GetKeyboardHandler(d)
+	}
+	return nil
 }
 
 //export gocef_client_get_keyboard_handler
 func gocef_client_get_keyboard_handler(self *C.cef_client_t) *C.cef_keyboard_handler_t {
+	defer recoverProxyPanic("Client", "GetKeyboardHandler")
 	me__ := (*Client)(self)
-	proxy__ := lookupClientProxy(me__.Base())
+	proxy__, ok__ := lookupClientProxy(me__.Base(), "GetKeyboardHandler").(ClientGetKeyboardHandlerProxy)
+	if !ok__ {
+		return nil
+	}
 	return (proxy__.GetKeyboardHandler(me__)).toNative()
 }
 
 // GetLifeSpanHandler (get_life_span_handler)
 // Return the handler for browser life span events.
 func (d *Client) GetLifeSpanHandler() *LifeSpanHandler {
-	return lookupClientProxy(d.Base()).GetLifeSpanHandler(d)
+	if proxy__, ok__ := lookupClientProxy(d.Base(), "GetLifeSpanHandler").(ClientGetLifeSpanHandlerProxy); ok__ {
+		return proxy__.GetLifeSpanHandler(d)
+	}
+	return nil
 }
 
 //export gocef_client_get_life_span_handler
 func gocef_client_get_life_span_handler(self *C.cef_client_t) *C.cef_life_span_handler_t {
+	defer recoverProxyPanic("Client", "GetLifeSpanHandler")
 	me__ := (*Client)(self)
-	proxy__ := lookupClientProxy(me__.Base())
+	proxy__, ok__ := lookupClientProxy(me__.Base(), "GetLifeSpanHandler").(ClientGetLifeSpanHandlerProxy)
+	if !ok__ {
+		return nil
+	}
 	return (proxy__.GetLifeSpanHandler(me__)).toNative()
 }
 
 // GetLoadHandler (get_load_handler)
 // Return the handler for browser load status events.
 func (d *Client) GetLoadHandler() *LoadHandler {
-	return lookupClientProxy(d.Base()).GetLoadHandler(d)
+	if proxy__, ok__ := lookupClientProxy(d.Base(), "GetLoadHandler").(ClientGetLoadHandlerProxy); ok__ {
+		return proxy__.GetLoadHandler(d)
+	}
+	return nil
 }
 
 //export gocef_client_get_load_handler
 func gocef_client_get_load_handler(self *C.cef_client_t) *C.cef_load_handler_t {
+	defer recoverProxyPanic("Client", "GetLoadHandler")
 	me__ := (*Client)(self)
-	proxy__ := lookupClientProxy(me__.Base())
+	proxy__, ok__ := lookupClientProxy(me__.Base(), "GetLoadHandler").(ClientGetLoadHandlerProxy)
+	if !ok__ {
+		return nil
+	}
 	return (proxy__.GetLoadHandler(me__)).toNative()
 }
 
 // GetRenderHandler (get_render_handler)
 // Return the handler for off-screen rendering events.
 func (d *Client) GetRenderHandler() *RenderHandler {
-	return lookupClientProxy(d.Base()).GetRenderHandler(d)
+	if proxy__, ok__ := lookupClientProxy(d.Base(), "GetRenderHandler").(ClientGetRenderHandlerProxy); ok__ {
+		return proxy__.GetRenderHandler(d)
+	}
+	return nil
 }
 
 //export gocef_client_get_render_handler
 func gocef_client_get_render_handler(self *C.cef_client_t) *C.cef_render_handler_t {
+	defer recoverProxyPanic("Client", "GetRenderHandler")
 	me__ := (*Client)(self)
-	proxy__ := lookupClientProxy(me__.Base())
+	proxy__, ok__ := lookupClientProxy(me__.Base(), "GetRenderHandler").(ClientGetRenderHandlerProxy)
+	if !ok__ {
+		return nil
+	}
 	return (proxy__.GetRenderHandler(me__)).toNative()
 }
 
 // GetRequestHandler (get_request_handler)
 // Return the handler for browser request events.
 func (d *Client) GetRequestHandler() *RequestHandler {
-	return lookupClientProxy(d.Base()).GetRequestHandler(d)
+	if proxy__, ok__ := lookupClientProxy(d.Base(), "GetRequestHandler").(ClientGetRequestHandlerProxy); ok__ {
+		return proxy__.GetRequestHandler(d)
+	}
+	return nil
 }
 
 //export gocef_client_get_request_handler
 func gocef_client_get_request_handler(self *C.cef_client_t) *C.cef_request_handler_t {
+	defer recoverProxyPanic("Client", "GetRequestHandler")
 	me__ := (*Client)(self)
-	proxy__ := lookupClientProxy(me__.Base())
+	proxy__, ok__ := lookupClientProxy(me__.Base(), "GetRequestHandler").(ClientGetRequestHandlerProxy)
+	if !ok__ {
+		return nil
+	}
 	return (proxy__.GetRequestHandler(me__)).toNative()
 }
 
@@ -256,13 +436,20 @@ func gocef_client_get_request_handler(self *C.cef_client_t) *C.cef_request_handl
 // Called when a new message is received from a different process. Return true
 // (1) if the message was handled or false (0) otherwise. Do not keep a
 // reference to or attempt to access the message outside of this callback.
-func (d *Client) OnProcessMessageReceived(browser *Browser, source_process ProcessID, message *ProcessMessage) int32 {
-	return lookupClientProxy(d.Base()).OnProcessMessageReceived(d, browser, source_process, message)
+func (d *Client) OnProcessMessageReceived(browser *Browser, source_process ProcessID, message *ProcessMessage) bool {
+	if proxy__, ok__ := lookupClientProxy(d.Base(), "OnProcessMessageReceived").(ClientOnProcessMessageReceivedProxy); ok__ {
+		return proxy__.OnProcessMessageReceived(d, browser, source_process, message)
+	}
+	return false
 }
 
 //export gocef_client_on_process_message_received
 func gocef_client_on_process_message_received(self *C.cef_client_t, browser *C.cef_browser_t, source_process C.cef_process_id_t, message *C.cef_process_message_t) C.int {
+	defer recoverProxyPanic("Client", "OnProcessMessageReceived")
 	me__ := (*Client)(self)
-	proxy__ := lookupClientProxy(me__.Base())
-	return C.int(proxy__.OnProcessMessageReceived(me__, (*Browser)(browser), ProcessID(source_process), (*ProcessMessage)(message)))
+	proxy__, ok__ := lookupClientProxy(me__.Base(), "OnProcessMessageReceived").(ClientOnProcessMessageReceivedProxy)
+	if !ok__ {
+		return 0
+	}
+	return cefBool(proxy__.OnProcessMessageReceived(me__, (*Browser)(browser), ProcessID(source_process), (*ProcessMessage)(message)))
 }
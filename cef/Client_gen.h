@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_client_proxy(cef_client_t *self);
+void gocef_set_client_proxy(cef_client_t *self, int get_audio_handler, int get_context_menu_handler, int get_dialog_handler, int get_display_handler, int get_download_handler, int get_drag_handler, int get_find_handler, int get_focus_handler, int get_jsdialog_handler, int get_keyboard_handler, int get_life_span_handler, int get_load_handler, int get_render_handler, int get_request_handler, int on_process_message_received);
 
 #endif // GOCEF_Client_H_
@@ -52,19 +52,20 @@ func (d *CommandLine) Base() *BaseRefCounted {
 // IsValid (is_valid)
 // Returns true (1) if this object is valid. Do not call any other functions
 // if this function returns false (0).
-func (d *CommandLine) IsValid() int32 {
-	return int32(C.gocef_command_line_is_valid(d.toNative(), d.is_valid))
+func (d *CommandLine) IsValid() bool {
+	return C.gocef_command_line_is_valid(d.toNative(), d.is_valid) != 0
 }
 
 // IsReadOnly (is_read_only)
 // Returns true (1) if the values of this object are read-only. Some APIs may
 // expose read-only objects.
-func (d *CommandLine) IsReadOnly() int32 {
-	return int32(C.gocef_command_line_is_read_only(d.toNative(), d.is_read_only))
+func (d *CommandLine) IsReadOnly() bool {
+	return C.gocef_command_line_is_read_only(d.toNative(), d.is_read_only) != 0
 }
 
 // Copy (copy)
 // Returns a writable copy of this object.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *CommandLine) Copy() *CommandLine {
 	return (*CommandLine)(C.gocef_command_line_copy(d.toNative(), d.copy))
 }
@@ -104,8 +105,11 @@ func (d *CommandLine) Reset() {
 // GetArgv (get_argv)
 // Retrieve the original command line string as a vector of strings. The argv
 // array: { program, [(--|-|/)switch[=value]]*, [--], [argument]* }
-func (d *CommandLine) GetArgv(argv StringList) {
-	C.gocef_command_line_get_argv(d.toNative(), C.cef_string_list_t(argv), d.get_argv)
+func (d *CommandLine) GetArgv() []string {
+	argv_ := C.cef_string_list_alloc()
+	defer C.cef_string_list_free(argv_)
+	C.gocef_command_line_get_argv(d.toNative(), argv_, d.get_argv)
+	return cefStringListToGo(argv_)
 }
 
 // GetCommandLineString (get_command_line_string)
@@ -136,19 +140,19 @@ func (d *CommandLine) SetProgram(program string) {
 
 // HasSwitches (has_switches)
 // Returns true (1) if the command line has switches.
-func (d *CommandLine) HasSwitches() int32 {
-	return int32(C.gocef_command_line_has_switches(d.toNative(), d.has_switches))
+func (d *CommandLine) HasSwitches() bool {
+	return C.gocef_command_line_has_switches(d.toNative(), d.has_switches) != 0
 }
 
 // HasSwitch (has_switch)
 // Returns true (1) if the command line contains the given switch.
-func (d *CommandLine) HasSwitch(name string) int32 {
+func (d *CommandLine) HasSwitch(name string) bool {
 	name_ := C.cef_string_userfree_alloc()
 	setCEFStr(name, name_)
 	defer func() {
 		C.cef_string_userfree_free(name_)
 	}()
-	return int32(C.gocef_command_line_has_switch(d.toNative(), (*C.cef_string_t)(name_), d.has_switch))
+	return C.gocef_command_line_has_switch(d.toNative(), (*C.cef_string_t)(name_), d.has_switch) != 0
 }
 
 // GetSwitchValue (get_switch_value)
@@ -167,8 +171,11 @@ func (d *CommandLine) GetSwitchValue(name string) string {
 // GetSwitches (get_switches)
 // Returns the map of switch names and values. If a switch has no value an
 // NULL string is returned.
-func (d *CommandLine) GetSwitches(switches StringMap) {
-	C.gocef_command_line_get_switches(d.toNative(), C.cef_string_map_t(switches), d.get_switches)
+func (d *CommandLine) GetSwitches() map[string]string {
+	switches_ := C.cef_string_map_alloc()
+	defer C.cef_string_map_free(switches_)
+	C.gocef_command_line_get_switches(d.toNative(), switches_, d.get_switches)
+	return cefStringMapToGo(switches_)
 }
 
 // AppendSwitch (append_switch)
@@ -201,14 +208,17 @@ func (d *CommandLine) AppendSwitchWithValue(name, value string) {
 
 // HasArguments (has_arguments)
 // True if there are remaining command line arguments.
-func (d *CommandLine) HasArguments() int32 {
-	return int32(C.gocef_command_line_has_arguments(d.toNative(), d.has_arguments))
+func (d *CommandLine) HasArguments() bool {
+	return C.gocef_command_line_has_arguments(d.toNative(), d.has_arguments) != 0
 }
 
 // GetArguments (get_arguments)
 // Get the remaining command line arguments.
-func (d *CommandLine) GetArguments(arguments StringList) {
-	C.gocef_command_line_get_arguments(d.toNative(), C.cef_string_list_t(arguments), d.get_arguments)
+func (d *CommandLine) GetArguments() []string {
+	arguments_ := C.cef_string_list_alloc()
+	defer C.cef_string_list_free(arguments_)
+	C.gocef_command_line_get_arguments(d.toNative(), arguments_, d.get_arguments)
+	return cefStringListToGo(arguments_)
 }
 
 // AppendArgument (append_argument)
@@ -6,13 +6,23 @@ import (
 	// #include "CompletionCallback_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// CompletionCallbackProxy defines methods required for using CompletionCallback.
+// CompletionCallbackProxy is implemented by the proxies used with CompletionCallback. Embed
+// DefaultCompletionCallback to satisfy it, then implement any of the optional
+// CompletionCallback*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type CompletionCallbackProxy interface {
+	isCompletionCallbackProxy()
+}
+
+// DefaultCompletionCallback may be embedded to satisfy CompletionCallbackProxy.
+type DefaultCompletionCallback struct{}
+
+func (DefaultCompletionCallback) isCompletionCallbackProxy() {}
+
+// CompletionCallbackOnCompleteProxy may be implemented by a CompletionCallbackProxy to handle OnComplete.
+type CompletionCallbackOnCompleteProxy interface {
 	OnComplete(self *CompletionCallback)
 }
 
@@ -25,7 +35,8 @@ type CompletionCallback C.cef_completion_callback_t
 func NewCompletionCallback(proxy CompletionCallbackProxy) *CompletionCallback {
 	result := (*CompletionCallback)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_completion_callback_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_completion_callback_proxy(result.toNative())
+		_, on_complete := proxy.(CompletionCallbackOnCompleteProxy)
+		C.gocef_set_completion_callback_proxy(result.toNative(), cefBool(on_complete))
 	}
 	return result
 }
@@ -34,14 +45,15 @@ func (d *CompletionCallback) toNative() *C.cef_completion_callback_t {
 	return (*C.cef_completion_callback_t)(d)
 }
 
-func lookupCompletionCallbackProxy(obj *BaseRefCounted) CompletionCallbackProxy {
+func lookupCompletionCallbackProxy(obj *BaseRefCounted, callback string) CompletionCallbackProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("CompletionCallback", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(CompletionCallbackProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type CompletionCallbackProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("CompletionCallback", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -55,12 +67,18 @@ func (d *CompletionCallback) Base() *BaseRefCounted {
 // OnComplete (on_complete)
 // Method that will be called once the task is complete.
 func (d *CompletionCallback) OnComplete() {
-	lookupCompletionCallbackProxy(d.Base()).OnComplete(d)
+	if proxy__, ok__ := lookupCompletionCallbackProxy(d.Base(), "OnComplete").(CompletionCallbackOnCompleteProxy); ok__ {
+		proxy__.OnComplete(d)
+	}
 }
 
 //export gocef_completion_callback_on_complete
 func gocef_completion_callback_on_complete(self *C.cef_completion_callback_t) {
+	defer recoverProxyPanic("CompletionCallback", "OnComplete")
 	me__ := (*CompletionCallback)(self)
-	proxy__ := lookupCompletionCallbackProxy(me__.Base())
+	proxy__, ok__ := lookupCompletionCallbackProxy(me__.Base(), "OnComplete").(CompletionCallbackOnCompleteProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnComplete(me__)
 }
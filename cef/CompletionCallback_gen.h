@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_completion_callback_proxy(cef_completion_callback_t *self);
+void gocef_set_completion_callback_proxy(cef_completion_callback_t *self, int on_complete);
 
 #endif // GOCEF_CompletionCallback_H_
@@ -23,7 +23,7 @@ type CompositionUnderline struct {
 	BackgroundColor Color
 	// Thick (thick)
 	// Set to true (1) for thick underline.
-	Thick int32
+	Thick bool
 }
 
 // NewCompositionUnderline creates a new CompositionUnderline.
@@ -38,7 +38,7 @@ func (d *CompositionUnderline) toNative(native *C.cef_composition_underline_t) *
 	d.Range.toNative(&native._range)
 	native.color = C.cef_color_t(d.Color)
 	native.background_color = C.cef_color_t(d.BackgroundColor)
-	native.thick = C.int(d.Thick)
+	native.thick = cefBool(d.Thick)
 	return native
 }
 
@@ -55,5 +55,5 @@ func (n *C.cef_composition_underline_t) intoGo(d *CompositionUnderline) {
 	n._range.intoGo(&d.Range)
 	d.Color = Color(n.color)
 	d.BackgroundColor = Color(n.background_color)
-	d.Thick = int32(n.thick)
+	d.Thick = n.thick != 0
 }
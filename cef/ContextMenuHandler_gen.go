@@ -6,16 +6,38 @@ import (
 	// #include "ContextMenuHandler_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// ContextMenuHandlerProxy defines methods required for using ContextMenuHandler.
+// ContextMenuHandlerProxy is implemented by the proxies used with ContextMenuHandler. Embed
+// DefaultContextMenuHandler to satisfy it, then implement any of the optional
+// ContextMenuHandler*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type ContextMenuHandlerProxy interface {
+	isContextMenuHandlerProxy()
+}
+
+// DefaultContextMenuHandler may be embedded to satisfy ContextMenuHandlerProxy.
+type DefaultContextMenuHandler struct{}
+
+func (DefaultContextMenuHandler) isContextMenuHandlerProxy() {}
+
+// ContextMenuHandlerOnBeforeContextMenuProxy may be implemented by a ContextMenuHandlerProxy to handle OnBeforeContextMenu.
+type ContextMenuHandlerOnBeforeContextMenuProxy interface {
 	OnBeforeContextMenu(self *ContextMenuHandler, browser *Browser, frame *Frame, params *ContextMenuParams, model *MenuModel)
-	RunContextMenu(self *ContextMenuHandler, browser *Browser, frame *Frame, params *ContextMenuParams, model *MenuModel, callback *RunContextMenuCallback) int32
-	OnContextMenuCommand(self *ContextMenuHandler, browser *Browser, frame *Frame, params *ContextMenuParams, command_id int32, event_flags EventFlags) int32
+}
+
+// ContextMenuHandlerRunContextMenuProxy may be implemented by a ContextMenuHandlerProxy to handle RunContextMenu.
+type ContextMenuHandlerRunContextMenuProxy interface {
+	RunContextMenu(self *ContextMenuHandler, browser *Browser, frame *Frame, params *ContextMenuParams, model *MenuModel, callback *RunContextMenuCallback) bool
+}
+
+// ContextMenuHandlerOnContextMenuCommandProxy may be implemented by a ContextMenuHandlerProxy to handle OnContextMenuCommand.
+type ContextMenuHandlerOnContextMenuCommandProxy interface {
+	OnContextMenuCommand(self *ContextMenuHandler, browser *Browser, frame *Frame, params *ContextMenuParams, command_id int32, event_flags EventFlags) bool
+}
+
+// ContextMenuHandlerOnContextMenuDismissedProxy may be implemented by a ContextMenuHandlerProxy to handle OnContextMenuDismissed.
+type ContextMenuHandlerOnContextMenuDismissedProxy interface {
 	OnContextMenuDismissed(self *ContextMenuHandler, browser *Browser, frame *Frame)
 }
 
@@ -29,7 +51,11 @@ type ContextMenuHandler C.cef_context_menu_handler_t
 func NewContextMenuHandler(proxy ContextMenuHandlerProxy) *ContextMenuHandler {
 	result := (*ContextMenuHandler)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_context_menu_handler_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_context_menu_handler_proxy(result.toNative())
+		_, on_before_context_menu := proxy.(ContextMenuHandlerOnBeforeContextMenuProxy)
+		_, run_context_menu := proxy.(ContextMenuHandlerRunContextMenuProxy)
+		_, on_context_menu_command := proxy.(ContextMenuHandlerOnContextMenuCommandProxy)
+		_, on_context_menu_dismissed := proxy.(ContextMenuHandlerOnContextMenuDismissedProxy)
+		C.gocef_set_context_menu_handler_proxy(result.toNative(), cefBool(on_before_context_menu), cefBool(run_context_menu), cefBool(on_context_menu_command), cefBool(on_context_menu_dismissed))
 	}
 	return result
 }
@@ -38,14 +64,15 @@ func (d *ContextMenuHandler) toNative() *C.cef_context_menu_handler_t {
 	return (*C.cef_context_menu_handler_t)(d)
 }
 
-func lookupContextMenuHandlerProxy(obj *BaseRefCounted) ContextMenuHandlerProxy {
+func lookupContextMenuHandlerProxy(obj *BaseRefCounted, callback string) ContextMenuHandlerProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("ContextMenuHandler", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(ContextMenuHandlerProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type ContextMenuHandlerProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("ContextMenuHandler", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -63,13 +90,19 @@ func (d *ContextMenuHandler) Base() *BaseRefCounted {
 // modified to show a custom menu. Do not keep references to |params| or
 // |model| outside of this callback.
 func (d *ContextMenuHandler) OnBeforeContextMenu(browser *Browser, frame *Frame, params *ContextMenuParams, model *MenuModel) {
-	lookupContextMenuHandlerProxy(d.Base()).OnBeforeContextMenu(d, browser, frame, params, model)
+	if proxy__, ok__ := lookupContextMenuHandlerProxy(d.Base(), "OnBeforeContextMenu").(ContextMenuHandlerOnBeforeContextMenuProxy); ok__ {
+		proxy__.OnBeforeContextMenu(d, browser, frame, params, model)
+	}
 }
 
 //export gocef_context_menu_handler_on_before_context_menu
 func gocef_context_menu_handler_on_before_context_menu(self *C.cef_context_menu_handler_t, browser *C.cef_browser_t, frame *C.cef_frame_t, params *C.cef_context_menu_params_t, model *C.cef_menu_model_t) {
+	defer recoverProxyPanic("ContextMenuHandler", "OnBeforeContextMenu")
 	me__ := (*ContextMenuHandler)(self)
-	proxy__ := lookupContextMenuHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupContextMenuHandlerProxy(me__.Base(), "OnBeforeContextMenu").(ContextMenuHandlerOnBeforeContextMenuProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnBeforeContextMenu(me__, (*Browser)(browser), (*Frame)(frame), (*ContextMenuParams)(params), (*MenuModel)(model))
 }
 
@@ -80,15 +113,22 @@ func gocef_context_menu_handler_on_before_context_menu(self *C.cef_context_menu_
 // (1) and execute |callback| either synchronously or asynchronously with the
 // selected command ID. For default display return false (0). Do not keep
 // references to |params| or |model| outside of this callback.
-func (d *ContextMenuHandler) RunContextMenu(browser *Browser, frame *Frame, params *ContextMenuParams, model *MenuModel, callback *RunContextMenuCallback) int32 {
-	return lookupContextMenuHandlerProxy(d.Base()).RunContextMenu(d, browser, frame, params, model, callback)
+func (d *ContextMenuHandler) RunContextMenu(browser *Browser, frame *Frame, params *ContextMenuParams, model *MenuModel, callback *RunContextMenuCallback) bool {
+	if proxy__, ok__ := lookupContextMenuHandlerProxy(d.Base(), "RunContextMenu").(ContextMenuHandlerRunContextMenuProxy); ok__ {
+		return proxy__.RunContextMenu(d, browser, frame, params, model, callback)
+	}
+	return false
 }
 
 //export gocef_context_menu_handler_run_context_menu
 func gocef_context_menu_handler_run_context_menu(self *C.cef_context_menu_handler_t, browser *C.cef_browser_t, frame *C.cef_frame_t, params *C.cef_context_menu_params_t, model *C.cef_menu_model_t, callback *C.cef_run_context_menu_callback_t) C.int {
+	defer recoverProxyPanic("ContextMenuHandler", "RunContextMenu")
 	me__ := (*ContextMenuHandler)(self)
-	proxy__ := lookupContextMenuHandlerProxy(me__.Base())
-	return C.int(proxy__.RunContextMenu(me__, (*Browser)(browser), (*Frame)(frame), (*ContextMenuParams)(params), (*MenuModel)(model), (*RunContextMenuCallback)(callback)))
+	proxy__, ok__ := lookupContextMenuHandlerProxy(me__.Base(), "RunContextMenu").(ContextMenuHandlerRunContextMenuProxy)
+	if !ok__ {
+		return 0
+	}
+	return cefBool(proxy__.RunContextMenu(me__, (*Browser)(browser), (*Frame)(frame), (*ContextMenuParams)(params), (*MenuModel)(model), (*RunContextMenuCallback)(callback)))
 }
 
 // OnContextMenuCommand (on_context_menu_command)
@@ -99,27 +139,40 @@ func gocef_context_menu_handler_run_context_menu(self *C.cef_context_menu_handle
 // MENU_ID_USER_LAST. |params| will have the same values as what was passed to
 // on_before_context_menu(). Do not keep a reference to |params| outside of
 // this callback.
-func (d *ContextMenuHandler) OnContextMenuCommand(browser *Browser, frame *Frame, params *ContextMenuParams, command_id int32, event_flags EventFlags) int32 {
-	return lookupContextMenuHandlerProxy(d.Base()).OnContextMenuCommand(d, browser, frame, params, command_id, event_flags)
+func (d *ContextMenuHandler) OnContextMenuCommand(browser *Browser, frame *Frame, params *ContextMenuParams, command_id int32, event_flags EventFlags) bool {
+	if proxy__, ok__ := lookupContextMenuHandlerProxy(d.Base(), "OnContextMenuCommand").(ContextMenuHandlerOnContextMenuCommandProxy); ok__ {
+		return proxy__.OnContextMenuCommand(d, browser, frame, params, command_id, event_flags)
+	}
+	return false
 }
 
 //export gocef_context_menu_handler_on_context_menu_command
 func gocef_context_menu_handler_on_context_menu_command(self *C.cef_context_menu_handler_t, browser *C.cef_browser_t, frame *C.cef_frame_t, params *C.cef_context_menu_params_t, command_id C.int, event_flags C.cef_event_flags_t) C.int {
+	defer recoverProxyPanic("ContextMenuHandler", "OnContextMenuCommand")
 	me__ := (*ContextMenuHandler)(self)
-	proxy__ := lookupContextMenuHandlerProxy(me__.Base())
-	return C.int(proxy__.OnContextMenuCommand(me__, (*Browser)(browser), (*Frame)(frame), (*ContextMenuParams)(params), int32(command_id), EventFlags(event_flags)))
+	proxy__, ok__ := lookupContextMenuHandlerProxy(me__.Base(), "OnContextMenuCommand").(ContextMenuHandlerOnContextMenuCommandProxy)
+	if !ok__ {
+		return 0
+	}
+	return cefBool(proxy__.OnContextMenuCommand(me__, (*Browser)(browser), (*Frame)(frame), (*ContextMenuParams)(params), int32(command_id), EventFlags(event_flags)))
 }
 
 // OnContextMenuDismissed (on_context_menu_dismissed)
 // Called when the context menu is dismissed irregardless of whether the menu
 // was NULL or a command was selected.
 func (d *ContextMenuHandler) OnContextMenuDismissed(browser *Browser, frame *Frame) {
-	lookupContextMenuHandlerProxy(d.Base()).OnContextMenuDismissed(d, browser, frame)
+	if proxy__, ok__ := lookupContextMenuHandlerProxy(d.Base(), "OnContextMenuDismissed").(ContextMenuHandlerOnContextMenuDismissedProxy); ok__ {
+		proxy__.OnContextMenuDismissed(d, browser, frame)
+	}
 }
 
 //export gocef_context_menu_handler_on_context_menu_dismissed
 func gocef_context_menu_handler_on_context_menu_dismissed(self *C.cef_context_menu_handler_t, browser *C.cef_browser_t, frame *C.cef_frame_t) {
+	defer recoverProxyPanic("ContextMenuHandler", "OnContextMenuDismissed")
 	me__ := (*ContextMenuHandler)(self)
-	proxy__ := lookupContextMenuHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupContextMenuHandlerProxy(me__.Base(), "OnContextMenuDismissed").(ContextMenuHandlerOnContextMenuDismissedProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnContextMenuDismissed(me__, (*Browser)(browser), (*Frame)(frame))
 }
@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_context_menu_handler_proxy(cef_context_menu_handler_t *self);
+void gocef_set_context_menu_handler_proxy(cef_context_menu_handler_t *self, int on_before_context_menu, int run_context_menu, int on_context_menu_command, int on_context_menu_dismissed);
 
 #endif // GOCEF_ContextMenuHandler_H_
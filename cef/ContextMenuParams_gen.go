@@ -91,8 +91,8 @@ func (d *ContextMenuParams) GetSourceUrl() string {
 // HasImageContents (has_image_contents)
 // Returns true (1) if the context menu was invoked on an image which has non-
 // NULL contents.
-func (d *ContextMenuParams) HasImageContents() int32 {
-	return int32(C.gocef_context_menu_params_has_image_contents(d.toNative(), d.has_image_contents))
+func (d *ContextMenuParams) HasImageContents() bool {
+	return C.gocef_context_menu_params_has_image_contents(d.toNative(), d.has_image_contents) != 0
 }
 
 // GetTitleText (get_title_text)
@@ -158,21 +158,24 @@ func (d *ContextMenuParams) GetMisspelledWord() string {
 // Returns true (1) if suggestions exist, false (0) otherwise. Fills in
 // |suggestions| from the spell check service for the misspelled word if there
 // is one.
-func (d *ContextMenuParams) GetDictionarySuggestions(suggestions StringList) int32 {
-	return int32(C.gocef_context_menu_params_get_dictionary_suggestions(d.toNative(), C.cef_string_list_t(suggestions), d.get_dictionary_suggestions))
+func (d *ContextMenuParams) GetDictionarySuggestions() ([]string, bool) {
+	suggestions_ := C.cef_string_list_alloc()
+	defer C.cef_string_list_free(suggestions_)
+	result__ := C.gocef_context_menu_params_get_dictionary_suggestions(d.toNative(), suggestions_, d.get_dictionary_suggestions) != 0
+	return cefStringListToGo(suggestions_), result__
 }
 
 // IsEditable (is_editable)
 // Returns true (1) if the context menu was invoked on an editable node.
-func (d *ContextMenuParams) IsEditable() int32 {
-	return int32(C.gocef_context_menu_params_is_editable(d.toNative(), d.is_editable))
+func (d *ContextMenuParams) IsEditable() bool {
+	return C.gocef_context_menu_params_is_editable(d.toNative(), d.is_editable) != 0
 }
 
 // IsSpellCheckEnabled (is_spell_check_enabled)
 // Returns true (1) if the context menu was invoked on an editable node where
 // spell-check is enabled.
-func (d *ContextMenuParams) IsSpellCheckEnabled() int32 {
-	return int32(C.gocef_context_menu_params_is_spell_check_enabled(d.toNative(), d.is_spell_check_enabled))
+func (d *ContextMenuParams) IsSpellCheckEnabled() bool {
+	return C.gocef_context_menu_params_is_spell_check_enabled(d.toNative(), d.is_spell_check_enabled) != 0
 }
 
 // GetEditStateFlags (get_edit_state_flags)
@@ -186,12 +189,12 @@ func (d *ContextMenuParams) GetEditStateFlags() ContextMenuEditStateFlags {
 // Returns true (1) if the context menu contains items specified by the
 // renderer process (for example, plugin placeholder or pepper plugin menu
 // items).
-func (d *ContextMenuParams) IsCustomMenu() int32 {
-	return int32(C.gocef_context_menu_params_is_custom_menu(d.toNative(), d.is_custom_menu))
+func (d *ContextMenuParams) IsCustomMenu() bool {
+	return C.gocef_context_menu_params_is_custom_menu(d.toNative(), d.is_custom_menu) != 0
 }
 
 // IsPepperMenu (is_pepper_menu)
 // Returns true (1) if the context menu was invoked from a pepper plugin.
-func (d *ContextMenuParams) IsPepperMenu() int32 {
-	return int32(C.gocef_context_menu_params_is_pepper_menu(d.toNative(), d.is_pepper_menu))
+func (d *ContextMenuParams) IsPepperMenu() bool {
+	return C.gocef_context_menu_params_is_pepper_menu(d.toNative(), d.is_pepper_menu) != 0
 }
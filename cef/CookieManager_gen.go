@@ -34,16 +34,18 @@ func (d *CookieManager) Base() *BaseRefCounted {
 // "https", "ws" and "wss") will always be supported. If |callback| is non-
 // NULL it will be executed asnychronously on the IO thread after the change
 // has been applied. Must be called before any cookies are accessed.
-func (d *CookieManager) SetSupportedSchemes(schemes StringList, callback *CompletionCallback) {
-	C.gocef_cookie_manager_set_supported_schemes(d.toNative(), C.cef_string_list_t(schemes), callback.toNative(), d.set_supported_schemes)
+func (d *CookieManager) SetSupportedSchemes(schemes []string, callback *CompletionCallback) {
+	schemes_ := newCEFStringList(schemes)
+	defer C.cef_string_list_free(schemes_)
+	C.gocef_cookie_manager_set_supported_schemes(d.toNative(), schemes_, callback.toNative(), d.set_supported_schemes)
 }
 
 // VisitAllCookies (visit_all_cookies)
 // Visit all cookies on the IO thread. The returned cookies are ordered by
 // longest path, then by earliest creation date. Returns false (0) if cookies
 // cannot be accessed.
-func (d *CookieManager) VisitAllCookies(visitor *CookieVisitor) int32 {
-	return int32(C.gocef_cookie_manager_visit_all_cookies(d.toNative(), visitor.toNative(), d.visit_all_cookies))
+func (d *CookieManager) VisitAllCookies(visitor *CookieVisitor) bool {
+	return C.gocef_cookie_manager_visit_all_cookies(d.toNative(), visitor.toNative(), d.visit_all_cookies) != 0
 }
 
 // VisitUrlCookies (visit_url_cookies)
@@ -52,13 +54,13 @@ func (d *CookieManager) VisitAllCookies(visitor *CookieVisitor) int32 {
 // HTTP-only cookies will also be included in the results. The returned
 // cookies are ordered by longest path, then by earliest creation date.
 // Returns false (0) if cookies cannot be accessed.
-func (d *CookieManager) VisitUrlCookies(url string, includeHttpOnly int32, visitor *CookieVisitor) int32 {
+func (d *CookieManager) VisitUrlCookies(url string, includeHttpOnly bool, visitor *CookieVisitor) bool {
 	url_ := C.cef_string_userfree_alloc()
 	setCEFStr(url, url_)
 	defer func() {
 		C.cef_string_userfree_free(url_)
 	}()
-	return int32(C.gocef_cookie_manager_visit_url_cookies(d.toNative(), (*C.cef_string_t)(url_), C.int(includeHttpOnly), visitor.toNative(), d.visit_url_cookies))
+	return C.gocef_cookie_manager_visit_url_cookies(d.toNative(), (*C.cef_string_t)(url_), cefBool(includeHttpOnly), visitor.toNative(), d.visit_url_cookies) != 0
 }
 
 // SetCookie (set_cookie)
@@ -69,13 +71,13 @@ func (d *CookieManager) VisitUrlCookies(url string, includeHttpOnly int32, visit
 // such characters are found. If |callback| is non-NULL it will be executed
 // asnychronously on the IO thread after the cookie has been set. Returns
 // false (0) if an invalid URL is specified or if cookies cannot be accessed.
-func (d *CookieManager) SetCookie(url string, cookie *Cookie, callback *SetCookieCallback) int32 {
+func (d *CookieManager) SetCookie(url string, cookie *Cookie, callback *SetCookieCallback) bool {
 	url_ := C.cef_string_userfree_alloc()
 	setCEFStr(url, url_)
 	defer func() {
 		C.cef_string_userfree_free(url_)
 	}()
-	return int32(C.gocef_cookie_manager_set_cookie(d.toNative(), (*C.cef_string_t)(url_), cookie.toNative(&C.cef_cookie_t{}), callback.toNative(), d.set_cookie))
+	return C.gocef_cookie_manager_set_cookie(d.toNative(), (*C.cef_string_t)(url_), cookie.toNative(&C.cef_cookie_t{}), callback.toNative(), d.set_cookie) != 0
 }
 
 // DeleteCookies (delete_cookies)
@@ -88,7 +90,7 @@ func (d *CookieManager) SetCookie(url string, cookie *Cookie, callback *SetCooki
 // have been deleted. Returns false (0) if a non-NULL invalid URL is specified
 // or if cookies cannot be accessed. Cookies can alternately be deleted using
 // the Visit*Cookies() functions.
-func (d *CookieManager) DeleteCookies(url, cookie_name string, callback *DeleteCookiesCallback) int32 {
+func (d *CookieManager) DeleteCookies(url, cookie_name string, callback *DeleteCookiesCallback) bool {
 	url_ := C.cef_string_userfree_alloc()
 	setCEFStr(url, url_)
 	defer func() {
@@ -99,7 +101,7 @@ func (d *CookieManager) DeleteCookies(url, cookie_name string, callback *DeleteC
 	defer func() {
 		C.cef_string_userfree_free(cookie_name_)
 	}()
-	return int32(C.gocef_cookie_manager_delete_cookies(d.toNative(), (*C.cef_string_t)(url_), (*C.cef_string_t)(cookie_name_), callback.toNative(), d.delete_cookies))
+	return C.gocef_cookie_manager_delete_cookies(d.toNative(), (*C.cef_string_t)(url_), (*C.cef_string_t)(cookie_name_), callback.toNative(), d.delete_cookies) != 0
 }
 
 // SetStoragePath (set_storage_path)
@@ -111,19 +113,19 @@ func (d *CookieManager) DeleteCookies(url, cookie_name string, callback *DeleteC
 // browsers do not persist them. If |callback| is non-NULL it will be executed
 // asnychronously on the IO thread after the manager's storage has been
 // initialized. Returns false (0) if cookies cannot be accessed.
-func (d *CookieManager) SetStoragePath(path string, persist_session_cookies int32, callback *CompletionCallback) int32 {
+func (d *CookieManager) SetStoragePath(path string, persist_session_cookies bool, callback *CompletionCallback) bool {
 	path_ := C.cef_string_userfree_alloc()
 	setCEFStr(path, path_)
 	defer func() {
 		C.cef_string_userfree_free(path_)
 	}()
-	return int32(C.gocef_cookie_manager_set_storage_path(d.toNative(), (*C.cef_string_t)(path_), C.int(persist_session_cookies), callback.toNative(), d.set_storage_path))
+	return C.gocef_cookie_manager_set_storage_path(d.toNative(), (*C.cef_string_t)(path_), cefBool(persist_session_cookies), callback.toNative(), d.set_storage_path) != 0
 }
 
 // FlushStore (flush_store)
 // Flush the backing store (if any) to disk. If |callback| is non-NULL it will
 // be executed asnychronously on the IO thread after the flush is complete.
 // Returns false (0) if cookies cannot be accessed.
-func (d *CookieManager) FlushStore(callback *CompletionCallback) int32 {
-	return int32(C.gocef_cookie_manager_flush_store(d.toNative(), callback.toNative(), d.flush_store))
+func (d *CookieManager) FlushStore(callback *CompletionCallback) bool {
+	return C.gocef_cookie_manager_flush_store(d.toNative(), callback.toNative(), d.flush_store) != 0
 }
@@ -6,14 +6,24 @@ import (
 	// #include "CookieVisitor_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// CookieVisitorProxy defines methods required for using CookieVisitor.
+// CookieVisitorProxy is implemented by the proxies used with CookieVisitor. Embed
+// DefaultCookieVisitor to satisfy it, then implement any of the optional
+// CookieVisitor*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type CookieVisitorProxy interface {
-	Visit(self *CookieVisitor, cookie *Cookie, count, total int32, deleteCookie *int32) int32
+	isCookieVisitorProxy()
+}
+
+// DefaultCookieVisitor may be embedded to satisfy CookieVisitorProxy.
+type DefaultCookieVisitor struct{}
+
+func (DefaultCookieVisitor) isCookieVisitorProxy() {}
+
+// CookieVisitorVisitProxy may be implemented by a CookieVisitorProxy to handle Visit.
+type CookieVisitorVisitProxy interface {
+	Visit(self *CookieVisitor, cookie *Cookie, count, total int32, deleteCookie *bool) bool
 }
 
 // CookieVisitor (cef_cookie_visitor_t from include/capi/cef_cookie_capi.h)
@@ -26,7 +36,8 @@ type CookieVisitor C.cef_cookie_visitor_t
 func NewCookieVisitor(proxy CookieVisitorProxy) *CookieVisitor {
 	result := (*CookieVisitor)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_cookie_visitor_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_cookie_visitor_proxy(result.toNative())
+		_, visit := proxy.(CookieVisitorVisitProxy)
+		C.gocef_set_cookie_visitor_proxy(result.toNative(), cefBool(visit))
 	}
 	return result
 }
@@ -35,14 +46,15 @@ func (d *CookieVisitor) toNative() *C.cef_cookie_visitor_t {
 	return (*C.cef_cookie_visitor_t)(d)
 }
 
-func lookupCookieVisitorProxy(obj *BaseRefCounted) CookieVisitorProxy {
+func lookupCookieVisitorProxy(obj *BaseRefCounted, callback string) CookieVisitorProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("CookieVisitor", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(CookieVisitorProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type CookieVisitorProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("CookieVisitor", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -59,14 +71,25 @@ func (d *CookieVisitor) Base() *BaseRefCounted {
 // |deleteCookie| to true (1) to delete the cookie currently being visited.
 // Return false (0) to stop visiting cookies. This function may never be
 // called if no cookies are found.
-func (d *CookieVisitor) Visit(cookie *Cookie, count, total int32, deleteCookie *int32) int32 {
-	return lookupCookieVisitorProxy(d.Base()).Visit(d, cookie, count, total, deleteCookie)
+func (d *CookieVisitor) Visit(cookie *Cookie, count, total int32, deleteCookie *bool) bool {
+	if proxy__, ok__ := lookupCookieVisitorProxy(d.Base(), "Visit").(CookieVisitorVisitProxy); ok__ {
+		return proxy__.Visit(d, cookie, count, total, deleteCookie)
+	}
+	return false
 }
 
 //export gocef_cookie_visitor_visit
 func gocef_cookie_visitor_visit(self *C.cef_cookie_visitor_t, cookie *C.cef_cookie_t, count C.int, total C.int, deleteCookie *C.int) C.int {
+	defer recoverProxyPanic("CookieVisitor", "Visit")
 	me__ := (*CookieVisitor)(self)
-	proxy__ := lookupCookieVisitorProxy(me__.Base())
+	proxy__, ok__ := lookupCookieVisitorProxy(me__.Base(), "Visit").(CookieVisitorVisitProxy)
+	if !ok__ {
+		return 0
+	}
 	cookie_ := cookie.toGo()
-	return C.int(proxy__.Visit(me__, cookie_, int32(count), int32(total), (*int32)(deleteCookie)))
+	deleteCookie_ := *deleteCookie != 0
+	defer func() {
+		*deleteCookie = cefBool(deleteCookie_)
+	}()
+	return cefBool(proxy__.Visit(me__, cookie_, int32(count), int32(total), &deleteCookie_))
 }
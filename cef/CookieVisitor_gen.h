@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_cookie_visitor_proxy(cef_cookie_visitor_t *self);
+void gocef_set_cookie_visitor_proxy(cef_cookie_visitor_t *self, int visit);
 
 #endif // GOCEF_CookieVisitor_H_
@@ -27,10 +27,10 @@ type Cookie struct {
 	Path string
 	// Secure (secure)
 	// If |secure| is true the cookie will only be sent for HTTPS requests.
-	Secure int32
+	Secure bool
 	// Httponly (httponly)
 	// If |httponly| is true the cookie will only be sent for HTTP requests.
-	Httponly int32
+	Httponly bool
 	// Creation (creation)
 	// The cookie creation date. This is automatically populated by the system on
 	// cookie creation.
@@ -41,7 +41,7 @@ type Cookie struct {
 	LastAccess Time
 	// HasExpires (has_expires)
 	// The cookie expiration date is only valid if |has_expires| is true.
-	HasExpires int32
+	HasExpires bool
 	// Expires (expires)
 	Expires Time
 }
@@ -59,11 +59,11 @@ func (d *Cookie) toNative(native *C.cef_cookie_t) *C.cef_cookie_t {
 	setCEFStr(d.Value, &native.value)
 	setCEFStr(d.Domain, &native.domain)
 	setCEFStr(d.Path, &native.path)
-	native.secure = C.int(d.Secure)
-	native.httponly = C.int(d.Httponly)
+	native.secure = cefBool(d.Secure)
+	native.httponly = cefBool(d.Httponly)
 	d.Creation.toNative(&native.creation)
 	d.LastAccess.toNative(&native.last_access)
-	native.has_expires = C.int(d.HasExpires)
+	native.has_expires = cefBool(d.HasExpires)
 	d.Expires.toNative(&native.expires)
 	return native
 }
@@ -82,10 +82,10 @@ func (n *C.cef_cookie_t) intoGo(d *Cookie) {
 	d.Value = cefstrToString(&n.value)
 	d.Domain = cefstrToString(&n.domain)
 	d.Path = cefstrToString(&n.path)
-	d.Secure = int32(n.secure)
-	d.Httponly = int32(n.httponly)
+	d.Secure = n.secure != 0
+	d.Httponly = n.httponly != 0
 	n.creation.intoGo(&d.Creation)
 	n.last_access.intoGo(&d.LastAccess)
-	d.HasExpires = int32(n.has_expires)
+	d.HasExpires = n.has_expires != 0
 	n.expires.intoGo(&d.Expires)
 }
@@ -6,13 +6,23 @@ import (
 	// #include "DeleteCookiesCallback_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// DeleteCookiesCallbackProxy defines methods required for using DeleteCookiesCallback.
+// DeleteCookiesCallbackProxy is implemented by the proxies used with DeleteCookiesCallback. Embed
+// DefaultDeleteCookiesCallback to satisfy it, then implement any of the optional
+// DeleteCookiesCallback*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type DeleteCookiesCallbackProxy interface {
+	isDeleteCookiesCallbackProxy()
+}
+
+// DefaultDeleteCookiesCallback may be embedded to satisfy DeleteCookiesCallbackProxy.
+type DefaultDeleteCookiesCallback struct{}
+
+func (DefaultDeleteCookiesCallback) isDeleteCookiesCallbackProxy() {}
+
+// DeleteCookiesCallbackOnCompleteProxy may be implemented by a DeleteCookiesCallbackProxy to handle OnComplete.
+type DeleteCookiesCallbackOnCompleteProxy interface {
 	OnComplete(self *DeleteCookiesCallback, num_deleted int32)
 }
 
@@ -26,7 +36,8 @@ type DeleteCookiesCallback C.cef_delete_cookies_callback_t
 func NewDeleteCookiesCallback(proxy DeleteCookiesCallbackProxy) *DeleteCookiesCallback {
 	result := (*DeleteCookiesCallback)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_delete_cookies_callback_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_delete_cookies_callback_proxy(result.toNative())
+		_, on_complete := proxy.(DeleteCookiesCallbackOnCompleteProxy)
+		C.gocef_set_delete_cookies_callback_proxy(result.toNative(), cefBool(on_complete))
 	}
 	return result
 }
@@ -35,14 +46,15 @@ func (d *DeleteCookiesCallback) toNative() *C.cef_delete_cookies_callback_t {
 	return (*C.cef_delete_cookies_callback_t)(d)
 }
 
-func lookupDeleteCookiesCallbackProxy(obj *BaseRefCounted) DeleteCookiesCallbackProxy {
+func lookupDeleteCookiesCallbackProxy(obj *BaseRefCounted, callback string) DeleteCookiesCallbackProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("DeleteCookiesCallback", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(DeleteCookiesCallbackProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type DeleteCookiesCallbackProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("DeleteCookiesCallback", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -57,12 +69,18 @@ func (d *DeleteCookiesCallback) Base() *BaseRefCounted {
 // Method that will be called upon completion. |num_deleted| will be the
 // number of cookies that were deleted or -1 if unknown.
 func (d *DeleteCookiesCallback) OnComplete(num_deleted int32) {
-	lookupDeleteCookiesCallbackProxy(d.Base()).OnComplete(d, num_deleted)
+	if proxy__, ok__ := lookupDeleteCookiesCallbackProxy(d.Base(), "OnComplete").(DeleteCookiesCallbackOnCompleteProxy); ok__ {
+		proxy__.OnComplete(d, num_deleted)
+	}
 }
 
 //export gocef_delete_cookies_callback_on_complete
 func gocef_delete_cookies_callback_on_complete(self *C.cef_delete_cookies_callback_t, num_deleted C.int) {
+	defer recoverProxyPanic("DeleteCookiesCallback", "OnComplete")
 	me__ := (*DeleteCookiesCallback)(self)
-	proxy__ := lookupDeleteCookiesCallbackProxy(me__.Base())
+	proxy__, ok__ := lookupDeleteCookiesCallbackProxy(me__.Base(), "OnComplete").(DeleteCookiesCallbackOnCompleteProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnComplete(me__, int32(num_deleted))
 }
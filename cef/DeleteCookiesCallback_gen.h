@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_delete_cookies_callback_proxy(cef_delete_cookies_callback_t *self);
+void gocef_set_delete_cookies_callback_proxy(cef_delete_cookies_callback_t *self, int on_complete);
 
 #endif // GOCEF_DeleteCookiesCallback_H_
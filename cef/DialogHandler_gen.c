@@ -3,8 +3,10 @@
 #include "DialogHandler_gen.h"
 #include "_cgo_export.h"
 
-void gocef_set_dialog_handler_proxy(cef_dialog_handler_t *self) {
+void gocef_set_dialog_handler_proxy(cef_dialog_handler_t *self, int on_file_dialog) {
 	// Casts to (void *) added to avoid warnings since Go callbacks can't have
 	// some modifiers, such as 'const' applied to their parameter signatures.
-	self->on_file_dialog = (void *)&gocef_dialog_handler_on_file_dialog;
+	if (on_file_dialog) {
+		self->on_file_dialog = (void *)&gocef_dialog_handler_on_file_dialog;
+	}
 }
@@ -6,14 +6,24 @@ import (
 	// #include "DialogHandler_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// DialogHandlerProxy defines methods required for using DialogHandler.
+// DialogHandlerProxy is implemented by the proxies used with DialogHandler. Embed
+// DefaultDialogHandler to satisfy it, then implement any of the optional
+// DialogHandler*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type DialogHandlerProxy interface {
-	OnFileDialog(self *DialogHandler, browser *Browser, mode FileDialogMode, title, default_file_path string, accept_filters StringList, selected_accept_filter int32, callback *FileDialogCallback) int32
+	isDialogHandlerProxy()
+}
+
+// DefaultDialogHandler may be embedded to satisfy DialogHandlerProxy.
+type DefaultDialogHandler struct{}
+
+func (DefaultDialogHandler) isDialogHandlerProxy() {}
+
+// DialogHandlerOnFileDialogProxy may be implemented by a DialogHandlerProxy to handle OnFileDialog.
+type DialogHandlerOnFileDialogProxy interface {
+	OnFileDialog(self *DialogHandler, browser *Browser, mode FileDialogMode, title, default_file_path string, accept_filters []string, selected_accept_filter int32, callback *FileDialogCallback) bool
 }
 
 // DialogHandler (cef_dialog_handler_t from include/capi/cef_dialog_handler_capi.h)
@@ -26,7 +36,8 @@ type DialogHandler C.cef_dialog_handler_t
 func NewDialogHandler(proxy DialogHandlerProxy) *DialogHandler {
 	result := (*DialogHandler)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_dialog_handler_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_dialog_handler_proxy(result.toNative())
+		_, on_file_dialog := proxy.(DialogHandlerOnFileDialogProxy)
+		C.gocef_set_dialog_handler_proxy(result.toNative(), cefBool(on_file_dialog))
 	}
 	return result
 }
@@ -35,14 +46,15 @@ func (d *DialogHandler) toNative() *C.cef_dialog_handler_t {
 	return (*C.cef_dialog_handler_t)(d)
 }
 
-func lookupDialogHandlerProxy(obj *BaseRefCounted) DialogHandlerProxy {
+func lookupDialogHandlerProxy(obj *BaseRefCounted, callback string) DialogHandlerProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("DialogHandler", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(DialogHandlerProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type DialogHandlerProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("DialogHandler", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -67,15 +79,23 @@ func (d *DialogHandler) Base() *BaseRefCounted {
 // the filter that should be selected by default. To display a custom dialog
 // return true (1) and execute |callback| either inline or at a later time. To
 // display the default dialog return false (0).
-func (d *DialogHandler) OnFileDialog(browser *Browser, mode FileDialogMode, title, default_file_path string, accept_filters StringList, selected_accept_filter int32, callback *FileDialogCallback) int32 {
-	return lookupDialogHandlerProxy(d.Base()).OnFileDialog(d, browser, mode, title, default_file_path, accept_filters, selected_accept_filter, callback)
+func (d *DialogHandler) OnFileDialog(browser *Browser, mode FileDialogMode, title, default_file_path string, accept_filters []string, selected_accept_filter int32, callback *FileDialogCallback) bool {
+	if proxy__, ok__ := lookupDialogHandlerProxy(d.Base(), "OnFileDialog").(DialogHandlerOnFileDialogProxy); ok__ {
+		return proxy__.OnFileDialog(d, browser, mode, title, default_file_path, accept_filters, selected_accept_filter, callback)
+	}
+	return false
 }
 
 //export gocef_dialog_handler_on_file_dialog
 func gocef_dialog_handler_on_file_dialog(self *C.cef_dialog_handler_t, browser *C.cef_browser_t, mode C.cef_file_dialog_mode_t, title *C.cef_string_t, default_file_path *C.cef_string_t, accept_filters C.cef_string_list_t, selected_accept_filter C.int, callback *C.cef_file_dialog_callback_t) C.int {
+	defer recoverProxyPanic("DialogHandler", "OnFileDialog")
 	me__ := (*DialogHandler)(self)
-	proxy__ := lookupDialogHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupDialogHandlerProxy(me__.Base(), "OnFileDialog").(DialogHandlerOnFileDialogProxy)
+	if !ok__ {
+		return 0
+	}
 	title_ := cefstrToString(title)
 	default_file_path_ := cefstrToString(default_file_path)
-	return C.int(proxy__.OnFileDialog(me__, (*Browser)(browser), FileDialogMode(mode), title_, default_file_path_, StringList(accept_filters), int32(selected_accept_filter), (*FileDialogCallback)(callback)))
+	accept_filters_ := cefStringListToGo(accept_filters)
+	return cefBool(proxy__.OnFileDialog(me__, (*Browser)(browser), FileDialogMode(mode), title_, default_file_path_, accept_filters_, int32(selected_accept_filter), (*FileDialogCallback)(callback)))
 }
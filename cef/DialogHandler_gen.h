@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_dialog_handler_proxy(cef_dialog_handler_t *self);
+void gocef_set_dialog_handler_proxy(cef_dialog_handler_t *self, int on_file_dialog);
 
 #endif // GOCEF_DialogHandler_H_
@@ -56,43 +56,44 @@ func (d *DictionaryValue) Base() *BaseRefCounted {
 // the underlying data is owned by another object (e.g. list or dictionary)
 // and that other object is then modified or destroyed. Do not call any other
 // functions if this function returns false (0).
-func (d *DictionaryValue) IsValid() int32 {
-	return int32(C.gocef_dictionary_value_is_valid(d.toNative(), d.is_valid))
+func (d *DictionaryValue) IsValid() bool {
+	return C.gocef_dictionary_value_is_valid(d.toNative(), d.is_valid) != 0
 }
 
 // IsOwned (is_owned)
 // Returns true (1) if this object is currently owned by another object.
-func (d *DictionaryValue) IsOwned() int32 {
-	return int32(C.gocef_dictionary_value_is_owned(d.toNative(), d.is_owned))
+func (d *DictionaryValue) IsOwned() bool {
+	return C.gocef_dictionary_value_is_owned(d.toNative(), d.is_owned) != 0
 }
 
 // IsReadOnly (is_read_only)
 // Returns true (1) if the values of this object are read-only. Some APIs may
 // expose read-only objects.
-func (d *DictionaryValue) IsReadOnly() int32 {
-	return int32(C.gocef_dictionary_value_is_read_only(d.toNative(), d.is_read_only))
+func (d *DictionaryValue) IsReadOnly() bool {
+	return C.gocef_dictionary_value_is_read_only(d.toNative(), d.is_read_only) != 0
 }
 
 // IsSame (is_same)
 // Returns true (1) if this object and |that| object have the same underlying
 // data. If true (1) modifications to this object will also affect |that|
 // object and vice-versa.
-func (d *DictionaryValue) IsSame(that *DictionaryValue) int32 {
-	return int32(C.gocef_dictionary_value_is_same(d.toNative(), that.toNative(), d.is_same))
+func (d *DictionaryValue) IsSame(that *DictionaryValue) bool {
+	return C.gocef_dictionary_value_is_same(d.toNative(), that.toNative(), d.is_same) != 0
 }
 
 // IsEqual (is_equal)
 // Returns true (1) if this object and |that| object have an equivalent
 // underlying value but are not necessarily the same object.
-func (d *DictionaryValue) IsEqual(that *DictionaryValue) int32 {
-	return int32(C.gocef_dictionary_value_is_equal(d.toNative(), that.toNative(), d.is_equal))
+func (d *DictionaryValue) IsEqual(that *DictionaryValue) bool {
+	return C.gocef_dictionary_value_is_equal(d.toNative(), that.toNative(), d.is_equal) != 0
 }
 
 // Copy (copy)
 // Returns a writable copy of this object. If |exclude_NULL_children| is true
 // (1) any NULL dictionaries or lists will be excluded from the copy.
-func (d *DictionaryValue) Copy(exclude_empty_children int32) *DictionaryValue {
-	return (*DictionaryValue)(C.gocef_dictionary_value_copy(d.toNative(), C.int(exclude_empty_children), d.copy))
+// The caller owns a reference to the returned value; see Adopt().
+func (d *DictionaryValue) Copy(exclude_empty_children bool) *DictionaryValue {
+	return (*DictionaryValue)(C.gocef_dictionary_value_copy(d.toNative(), cefBool(exclude_empty_children), d.copy))
 }
 
 // GetSize (get_size)
@@ -103,37 +104,40 @@ func (d *DictionaryValue) GetSize() uint64 {
 
 // Clear (clear)
 // Removes all values. Returns true (1) on success.
-func (d *DictionaryValue) Clear() int32 {
-	return int32(C.gocef_dictionary_value_clear(d.toNative(), d.clear))
+func (d *DictionaryValue) Clear() bool {
+	return C.gocef_dictionary_value_clear(d.toNative(), d.clear) != 0
 }
 
 // HasKey (has_key)
 // Returns true (1) if the current dictionary has a value for the given key.
-func (d *DictionaryValue) HasKey(key string) int32 {
+func (d *DictionaryValue) HasKey(key string) bool {
 	key_ := C.cef_string_userfree_alloc()
 	setCEFStr(key, key_)
 	defer func() {
 		C.cef_string_userfree_free(key_)
 	}()
-	return int32(C.gocef_dictionary_value_has_key(d.toNative(), (*C.cef_string_t)(key_), d.has_key))
+	return C.gocef_dictionary_value_has_key(d.toNative(), (*C.cef_string_t)(key_), d.has_key) != 0
 }
 
 // GetKeys (get_keys)
 // Reads all keys for this dictionary into the specified vector.
-func (d *DictionaryValue) GetKeys(keys StringList) int32 {
-	return int32(C.gocef_dictionary_value_get_keys(d.toNative(), C.cef_string_list_t(keys), d.get_keys))
+func (d *DictionaryValue) GetKeys() ([]string, bool) {
+	keys_ := C.cef_string_list_alloc()
+	defer C.cef_string_list_free(keys_)
+	result__ := C.gocef_dictionary_value_get_keys(d.toNative(), keys_, d.get_keys) != 0
+	return cefStringListToGo(keys_), result__
 }
 
 // Remove (remove)
 // Removes the value at the specified key. Returns true (1) is the value was
 // removed successfully.
-func (d *DictionaryValue) Remove(key string) int32 {
+func (d *DictionaryValue) Remove(key string) bool {
 	key_ := C.cef_string_userfree_alloc()
 	setCEFStr(key, key_)
 	defer func() {
 		C.cef_string_userfree_free(key_)
 	}()
-	return int32(C.gocef_dictionary_value_remove(d.toNative(), (*C.cef_string_t)(key_), d.remove))
+	return C.gocef_dictionary_value_remove(d.toNative(), (*C.cef_string_t)(key_), d.remove) != 0
 }
 
 // GetType (get_type)
@@ -153,6 +157,7 @@ func (d *DictionaryValue) GetType(key string) ValueType {
 // object. For complex types (binary, dictionary and list) the returned value
 // will reference existing data and modifications to the value will modify
 // this object.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *DictionaryValue) GetValue(key string) *Value {
 	key_ := C.cef_string_userfree_alloc()
 	setCEFStr(key, key_)
@@ -164,13 +169,13 @@ func (d *DictionaryValue) GetValue(key string) *Value {
 
 // GetBool (get_bool)
 // Returns the value at the specified key as type bool.
-func (d *DictionaryValue) GetBool(key string) int32 {
+func (d *DictionaryValue) GetBool(key string) bool {
 	key_ := C.cef_string_userfree_alloc()
 	setCEFStr(key, key_)
 	defer func() {
 		C.cef_string_userfree_free(key_)
 	}()
-	return int32(C.gocef_dictionary_value_get_bool(d.toNative(), (*C.cef_string_t)(key_), d.get_bool))
+	return C.gocef_dictionary_value_get_bool(d.toNative(), (*C.cef_string_t)(key_), d.get_bool) != 0
 }
 
 // GetInt (get_int)
@@ -210,6 +215,7 @@ func (d *DictionaryValue) GetString(key string) string {
 // GetBinary (get_binary)
 // Returns the value at the specified key as type binary. The returned value
 // will reference existing data.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *DictionaryValue) GetBinary(key string) *BinaryValue {
 	key_ := C.cef_string_userfree_alloc()
 	setCEFStr(key, key_)
@@ -223,6 +229,7 @@ func (d *DictionaryValue) GetBinary(key string) *BinaryValue {
 // Returns the value at the specified key as type dictionary. The returned
 // value will reference existing data and modifications to the value will
 // modify this object.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *DictionaryValue) GetDictionary(key string) *DictionaryValue {
 	key_ := C.cef_string_userfree_alloc()
 	setCEFStr(key, key_)
@@ -236,6 +243,7 @@ func (d *DictionaryValue) GetDictionary(key string) *DictionaryValue {
 // Returns the value at the specified key as type list. The returned value
 // will reference existing data and modifications to the value will modify
 // this object.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *DictionaryValue) GetList(key string) *ListValue {
 	key_ := C.cef_string_userfree_alloc()
 	setCEFStr(key, key_)
@@ -252,67 +260,67 @@ func (d *DictionaryValue) GetList(key string) *ListValue {
 // |value| represents complex data (binary, dictionary or list) then the
 // underlying data will be referenced and modifications to |value| will modify
 // this object.
-func (d *DictionaryValue) SetValue(key string, value *Value) int32 {
+func (d *DictionaryValue) SetValue(key string, value *Value) bool {
 	key_ := C.cef_string_userfree_alloc()
 	setCEFStr(key, key_)
 	defer func() {
 		C.cef_string_userfree_free(key_)
 	}()
-	return int32(C.gocef_dictionary_value_set_value(d.toNative(), (*C.cef_string_t)(key_), value.toNative(), d.set_value))
+	return C.gocef_dictionary_value_set_value(d.toNative(), (*C.cef_string_t)(key_), value.toNative(), d.set_value) != 0
 }
 
 // SetNull (set_null)
 // Sets the value at the specified key as type null. Returns true (1) if the
 // value was set successfully.
-func (d *DictionaryValue) SetNull(key string) int32 {
+func (d *DictionaryValue) SetNull(key string) bool {
 	key_ := C.cef_string_userfree_alloc()
 	setCEFStr(key, key_)
 	defer func() {
 		C.cef_string_userfree_free(key_)
 	}()
-	return int32(C.gocef_dictionary_value_set_null(d.toNative(), (*C.cef_string_t)(key_), d.set_null))
+	return C.gocef_dictionary_value_set_null(d.toNative(), (*C.cef_string_t)(key_), d.set_null) != 0
 }
 
 // SetBool (set_bool)
 // Sets the value at the specified key as type bool. Returns true (1) if the
 // value was set successfully.
-func (d *DictionaryValue) SetBool(key string, value int32) int32 {
+func (d *DictionaryValue) SetBool(key string, value bool) bool {
 	key_ := C.cef_string_userfree_alloc()
 	setCEFStr(key, key_)
 	defer func() {
 		C.cef_string_userfree_free(key_)
 	}()
-	return int32(C.gocef_dictionary_value_set_bool(d.toNative(), (*C.cef_string_t)(key_), C.int(value), d.set_bool))
+	return C.gocef_dictionary_value_set_bool(d.toNative(), (*C.cef_string_t)(key_), cefBool(value), d.set_bool) != 0
 }
 
 // SetInt (set_int)
 // Sets the value at the specified key as type int. Returns true (1) if the
 // value was set successfully.
-func (d *DictionaryValue) SetInt(key string, value int32) int32 {
+func (d *DictionaryValue) SetInt(key string, value int32) bool {
 	key_ := C.cef_string_userfree_alloc()
 	setCEFStr(key, key_)
 	defer func() {
 		C.cef_string_userfree_free(key_)
 	}()
-	return int32(C.gocef_dictionary_value_set_int(d.toNative(), (*C.cef_string_t)(key_), C.int(value), d.set_int))
+	return C.gocef_dictionary_value_set_int(d.toNative(), (*C.cef_string_t)(key_), C.int(value), d.set_int) != 0
 }
 
 // SetDouble (set_double)
 // Sets the value at the specified key as type double. Returns true (1) if the
 // value was set successfully.
-func (d *DictionaryValue) SetDouble(key string, value float64) int32 {
+func (d *DictionaryValue) SetDouble(key string, value float64) bool {
 	key_ := C.cef_string_userfree_alloc()
 	setCEFStr(key, key_)
 	defer func() {
 		C.cef_string_userfree_free(key_)
 	}()
-	return int32(C.gocef_dictionary_value_set_double(d.toNative(), (*C.cef_string_t)(key_), C.double(value), d.set_double))
+	return C.gocef_dictionary_value_set_double(d.toNative(), (*C.cef_string_t)(key_), C.double(value), d.set_double) != 0
 }
 
 // SetString (set_string)
 // Sets the value at the specified key as type string. Returns true (1) if the
 // value was set successfully.
-func (d *DictionaryValue) SetString(key, value string) int32 {
+func (d *DictionaryValue) SetString(key, value string) bool {
 	key_ := C.cef_string_userfree_alloc()
 	setCEFStr(key, key_)
 	defer func() {
@@ -323,7 +331,7 @@ func (d *DictionaryValue) SetString(key, value string) int32 {
 	defer func() {
 		C.cef_string_userfree_free(value_)
 	}()
-	return int32(C.gocef_dictionary_value_set_string(d.toNative(), (*C.cef_string_t)(key_), (*C.cef_string_t)(value_), d.set_string))
+	return C.gocef_dictionary_value_set_string(d.toNative(), (*C.cef_string_t)(key_), (*C.cef_string_t)(value_), d.set_string) != 0
 }
 
 // SetBinary (set_binary)
@@ -332,13 +340,13 @@ func (d *DictionaryValue) SetString(key, value string) int32 {
 // then the value will be copied and the |value| reference will not change.
 // Otherwise, ownership will be transferred to this object and the |value|
 // reference will be invalidated.
-func (d *DictionaryValue) SetBinary(key string, value *BinaryValue) int32 {
+func (d *DictionaryValue) SetBinary(key string, value *BinaryValue) bool {
 	key_ := C.cef_string_userfree_alloc()
 	setCEFStr(key, key_)
 	defer func() {
 		C.cef_string_userfree_free(key_)
 	}()
-	return int32(C.gocef_dictionary_value_set_binary(d.toNative(), (*C.cef_string_t)(key_), value.toNative(), d.set_binary))
+	return C.gocef_dictionary_value_set_binary(d.toNative(), (*C.cef_string_t)(key_), value.toNative(), d.set_binary) != 0
 }
 
 // SetDictionary (set_dictionary)
@@ -347,13 +355,13 @@ func (d *DictionaryValue) SetBinary(key string, value *BinaryValue) int32 {
 // then the value will be copied and the |value| reference will not change.
 // Otherwise, ownership will be transferred to this object and the |value|
 // reference will be invalidated.
-func (d *DictionaryValue) SetDictionary(key string, value *DictionaryValue) int32 {
+func (d *DictionaryValue) SetDictionary(key string, value *DictionaryValue) bool {
 	key_ := C.cef_string_userfree_alloc()
 	setCEFStr(key, key_)
 	defer func() {
 		C.cef_string_userfree_free(key_)
 	}()
-	return int32(C.gocef_dictionary_value_set_dictionary(d.toNative(), (*C.cef_string_t)(key_), value.toNative(), d.set_dictionary))
+	return C.gocef_dictionary_value_set_dictionary(d.toNative(), (*C.cef_string_t)(key_), value.toNative(), d.set_dictionary) != 0
 }
 
 // SetList (set_list)
@@ -362,11 +370,11 @@ func (d *DictionaryValue) SetDictionary(key string, value *DictionaryValue) int3
 // then the value will be copied and the |value| reference will not change.
 // Otherwise, ownership will be transferred to this object and the |value|
 // reference will be invalidated.
-func (d *DictionaryValue) SetList(key string, value *ListValue) int32 {
+func (d *DictionaryValue) SetList(key string, value *ListValue) bool {
 	key_ := C.cef_string_userfree_alloc()
 	setCEFStr(key, key_)
 	defer func() {
 		C.cef_string_userfree_free(key_)
 	}()
-	return int32(C.gocef_dictionary_value_set_list(d.toNative(), (*C.cef_string_t)(key_), value.toNative(), d.set_list))
+	return C.gocef_dictionary_value_set_list(d.toNative(), (*C.cef_string_t)(key_), value.toNative(), d.set_list) != 0
 }
@@ -6,21 +6,63 @@ import (
 	// #include "DisplayHandler_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// DisplayHandlerProxy defines methods required for using DisplayHandler.
+// DisplayHandlerProxy is implemented by the proxies used with DisplayHandler. Embed
+// DefaultDisplayHandler to satisfy it, then implement any of the optional
+// DisplayHandler*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type DisplayHandlerProxy interface {
+	isDisplayHandlerProxy()
+}
+
+// DefaultDisplayHandler may be embedded to satisfy DisplayHandlerProxy.
+type DefaultDisplayHandler struct{}
+
+func (DefaultDisplayHandler) isDisplayHandlerProxy() {}
+
+// DisplayHandlerOnAddressChangeProxy may be implemented by a DisplayHandlerProxy to handle OnAddressChange.
+type DisplayHandlerOnAddressChangeProxy interface {
 	OnAddressChange(self *DisplayHandler, browser *Browser, frame *Frame, url string)
+}
+
+// DisplayHandlerOnTitleChangeProxy may be implemented by a DisplayHandlerProxy to handle OnTitleChange.
+type DisplayHandlerOnTitleChangeProxy interface {
 	OnTitleChange(self *DisplayHandler, browser *Browser, title string)
-	OnFaviconUrlchange(self *DisplayHandler, browser *Browser, icon_urls StringList)
-	OnFullscreenModeChange(self *DisplayHandler, browser *Browser, fullscreen int32)
-	OnTooltip(self *DisplayHandler, browser *Browser, text *string) int32
+}
+
+// DisplayHandlerOnFaviconUrlchangeProxy may be implemented by a DisplayHandlerProxy to handle OnFaviconUrlchange.
+type DisplayHandlerOnFaviconUrlchangeProxy interface {
+	OnFaviconUrlchange(self *DisplayHandler, browser *Browser, icon_urls []string)
+}
+
+// DisplayHandlerOnFullscreenModeChangeProxy may be implemented by a DisplayHandlerProxy to handle OnFullscreenModeChange.
+type DisplayHandlerOnFullscreenModeChangeProxy interface {
+	OnFullscreenModeChange(self *DisplayHandler, browser *Browser, fullscreen bool)
+}
+
+// DisplayHandlerOnTooltipProxy may be implemented by a DisplayHandlerProxy to handle OnTooltip.
+type DisplayHandlerOnTooltipProxy interface {
+	OnTooltip(self *DisplayHandler, browser *Browser, text *string) bool
+}
+
+// DisplayHandlerOnStatusMessageProxy may be implemented by a DisplayHandlerProxy to handle OnStatusMessage.
+type DisplayHandlerOnStatusMessageProxy interface {
 	OnStatusMessage(self *DisplayHandler, browser *Browser, value string)
-	OnConsoleMessage(self *DisplayHandler, browser *Browser, level LogSeverity, message, source string, line int32) int32
-	OnAutoResize(self *DisplayHandler, browser *Browser, new_size *Size) int32
+}
+
+// DisplayHandlerOnConsoleMessageProxy may be implemented by a DisplayHandlerProxy to handle OnConsoleMessage.
+type DisplayHandlerOnConsoleMessageProxy interface {
+	OnConsoleMessage(self *DisplayHandler, browser *Browser, level LogSeverity, message, source string, line int32) bool
+}
+
+// DisplayHandlerOnAutoResizeProxy may be implemented by a DisplayHandlerProxy to handle OnAutoResize.
+type DisplayHandlerOnAutoResizeProxy interface {
+	OnAutoResize(self *DisplayHandler, browser *Browser, new_size *Size) bool
+}
+
+// DisplayHandlerOnLoadingProgressChangeProxy may be implemented by a DisplayHandlerProxy to handle OnLoadingProgressChange.
+type DisplayHandlerOnLoadingProgressChangeProxy interface {
 	OnLoadingProgressChange(self *DisplayHandler, browser *Browser, progress float64)
 }
 
@@ -34,7 +76,16 @@ type DisplayHandler C.cef_display_handler_t
 func NewDisplayHandler(proxy DisplayHandlerProxy) *DisplayHandler {
 	result := (*DisplayHandler)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_display_handler_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_display_handler_proxy(result.toNative())
+		_, on_address_change := proxy.(DisplayHandlerOnAddressChangeProxy)
+		_, on_title_change := proxy.(DisplayHandlerOnTitleChangeProxy)
+		_, on_favicon_urlchange := proxy.(DisplayHandlerOnFaviconUrlchangeProxy)
+		_, on_fullscreen_mode_change := proxy.(DisplayHandlerOnFullscreenModeChangeProxy)
+		_, on_tooltip := proxy.(DisplayHandlerOnTooltipProxy)
+		_, on_status_message := proxy.(DisplayHandlerOnStatusMessageProxy)
+		_, on_console_message := proxy.(DisplayHandlerOnConsoleMessageProxy)
+		_, on_auto_resize := proxy.(DisplayHandlerOnAutoResizeProxy)
+		_, on_loading_progress_change := proxy.(DisplayHandlerOnLoadingProgressChangeProxy)
+		C.gocef_set_display_handler_proxy(result.toNative(), cefBool(on_address_change), cefBool(on_title_change), cefBool(on_favicon_urlchange), cefBool(on_fullscreen_mode_change), cefBool(on_tooltip), cefBool(on_status_message), cefBool(on_console_message), cefBool(on_auto_resize), cefBool(on_loading_progress_change))
 	}
 	return result
 }
@@ -43,14 +94,15 @@ func (d *DisplayHandler) toNative() *C.cef_display_handler_t {
 	return (*C.cef_display_handler_t)(d)
 }
 
-func lookupDisplayHandlerProxy(obj *BaseRefCounted) DisplayHandlerProxy {
+func lookupDisplayHandlerProxy(obj *BaseRefCounted, callback string) DisplayHandlerProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("DisplayHandler", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(DisplayHandlerProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type DisplayHandlerProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("DisplayHandler", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -64,13 +116,19 @@ func (d *DisplayHandler) Base() *BaseRefCounted {
 // OnAddressChange (on_address_change)
 // Called when a frame's address has changed.
 func (d *DisplayHandler) OnAddressChange(browser *Browser, frame *Frame, url string) {
-	lookupDisplayHandlerProxy(d.Base()).OnAddressChange(d, browser, frame, url)
+	if proxy__, ok__ := lookupDisplayHandlerProxy(d.Base(), "OnAddressChange").(DisplayHandlerOnAddressChangeProxy); ok__ {
+		proxy__.OnAddressChange(d, browser, frame, url)
+	}
 }
 
 //export gocef_display_handler_on_address_change
 func gocef_display_handler_on_address_change(self *C.cef_display_handler_t, browser *C.cef_browser_t, frame *C.cef_frame_t, url *C.cef_string_t) {
+	defer recoverProxyPanic("DisplayHandler", "OnAddressChange")
 	me__ := (*DisplayHandler)(self)
-	proxy__ := lookupDisplayHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupDisplayHandlerProxy(me__.Base(), "OnAddressChange").(DisplayHandlerOnAddressChangeProxy)
+	if !ok__ {
+		return
+	}
 	url_ := cefstrToString(url)
 	proxy__.OnAddressChange(me__, (*Browser)(browser), (*Frame)(frame), url_)
 }
@@ -78,28 +136,41 @@ func gocef_display_handler_on_address_change(self *C.cef_display_handler_t, brow
 // OnTitleChange (on_title_change)
 // Called when the page title changes.
 func (d *DisplayHandler) OnTitleChange(browser *Browser, title string) {
-	lookupDisplayHandlerProxy(d.Base()).OnTitleChange(d, browser, title)
+	if proxy__, ok__ := lookupDisplayHandlerProxy(d.Base(), "OnTitleChange").(DisplayHandlerOnTitleChangeProxy); ok__ {
+		proxy__.OnTitleChange(d, browser, title)
+	}
 }
 
 //export gocef_display_handler_on_title_change
 func gocef_display_handler_on_title_change(self *C.cef_display_handler_t, browser *C.cef_browser_t, title *C.cef_string_t) {
+	defer recoverProxyPanic("DisplayHandler", "OnTitleChange")
 	me__ := (*DisplayHandler)(self)
-	proxy__ := lookupDisplayHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupDisplayHandlerProxy(me__.Base(), "OnTitleChange").(DisplayHandlerOnTitleChangeProxy)
+	if !ok__ {
+		return
+	}
 	title_ := cefstrToString(title)
 	proxy__.OnTitleChange(me__, (*Browser)(browser), title_)
 }
 
 // OnFaviconUrlchange (on_favicon_urlchange)
 // Called when the page icon changes.
-func (d *DisplayHandler) OnFaviconUrlchange(browser *Browser, icon_urls StringList) {
-	lookupDisplayHandlerProxy(d.Base()).OnFaviconUrlchange(d, browser, icon_urls)
+func (d *DisplayHandler) OnFaviconUrlchange(browser *Browser, icon_urls []string) {
+	if proxy__, ok__ := lookupDisplayHandlerProxy(d.Base(), "OnFaviconUrlchange").(DisplayHandlerOnFaviconUrlchangeProxy); ok__ {
+		proxy__.OnFaviconUrlchange(d, browser, icon_urls)
+	}
 }
 
 //export gocef_display_handler_on_favicon_urlchange
 func gocef_display_handler_on_favicon_urlchange(self *C.cef_display_handler_t, browser *C.cef_browser_t, icon_urls C.cef_string_list_t) {
+	defer recoverProxyPanic("DisplayHandler", "OnFaviconUrlchange")
 	me__ := (*DisplayHandler)(self)
-	proxy__ := lookupDisplayHandlerProxy(me__.Base())
-	proxy__.OnFaviconUrlchange(me__, (*Browser)(browser), StringList(icon_urls))
+	proxy__, ok__ := lookupDisplayHandlerProxy(me__.Base(), "OnFaviconUrlchange").(DisplayHandlerOnFaviconUrlchangeProxy)
+	if !ok__ {
+		return
+	}
+	icon_urls_ := cefStringListToGo(icon_urls)
+	proxy__.OnFaviconUrlchange(me__, (*Browser)(browser), icon_urls_)
 }
 
 // OnFullscreenModeChange (on_fullscreen_mode_change)
@@ -108,15 +179,21 @@ func gocef_display_handler_on_favicon_urlchange(self *C.cef_display_handler_t, b
 // the browser content area. If |fullscreen| is false (0) the content will
 // automatically return to its original size and position. The client is
 // responsible for resizing the browser if desired.
-func (d *DisplayHandler) OnFullscreenModeChange(browser *Browser, fullscreen int32) {
-	lookupDisplayHandlerProxy(d.Base()).OnFullscreenModeChange(d, browser, fullscreen)
+func (d *DisplayHandler) OnFullscreenModeChange(browser *Browser, fullscreen bool) {
+	if proxy__, ok__ := lookupDisplayHandlerProxy(d.Base(), "OnFullscreenModeChange").(DisplayHandlerOnFullscreenModeChangeProxy); ok__ {
+		proxy__.OnFullscreenModeChange(d, browser, fullscreen)
+	}
 }
 
 //export gocef_display_handler_on_fullscreen_mode_change
 func gocef_display_handler_on_fullscreen_mode_change(self *C.cef_display_handler_t, browser *C.cef_browser_t, fullscreen C.int) {
+	defer recoverProxyPanic("DisplayHandler", "OnFullscreenModeChange")
 	me__ := (*DisplayHandler)(self)
-	proxy__ := lookupDisplayHandlerProxy(me__.Base())
-	proxy__.OnFullscreenModeChange(me__, (*Browser)(browser), int32(fullscreen))
+	proxy__, ok__ := lookupDisplayHandlerProxy(me__.Base(), "OnFullscreenModeChange").(DisplayHandlerOnFullscreenModeChangeProxy)
+	if !ok__ {
+		return
+	}
+	proxy__.OnFullscreenModeChange(me__, (*Browser)(browser), fullscreen != 0)
 }
 
 // OnTooltip (on_tooltip)
@@ -126,29 +203,45 @@ func gocef_display_handler_on_fullscreen_mode_change(self *C.cef_display_handler
 // |text| and then return false (0) to allow the browser to display the
 // tooltip. When window rendering is disabled the application is responsible
 // for drawing tooltips and the return value is ignored.
-func (d *DisplayHandler) OnTooltip(browser *Browser, text *string) int32 {
-	return lookupDisplayHandlerProxy(d.Base()).OnTooltip(d, browser, text)
+func (d *DisplayHandler) OnTooltip(browser *Browser, text *string) bool {
+	if proxy__, ok__ := lookupDisplayHandlerProxy(d.Base(), "OnTooltip").(DisplayHandlerOnTooltipProxy); ok__ {
+		return proxy__.OnTooltip(d, browser, text)
+	}
+	return false
 }
 
 //export gocef_display_handler_on_tooltip
 func gocef_display_handler_on_tooltip(self *C.cef_display_handler_t, browser *C.cef_browser_t, text *C.cef_string_t) C.int {
+	defer recoverProxyPanic("DisplayHandler", "OnTooltip")
 	me__ := (*DisplayHandler)(self)
-	proxy__ := lookupDisplayHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupDisplayHandlerProxy(me__.Base(), "OnTooltip").(DisplayHandlerOnTooltipProxy)
+	if !ok__ {
+		return 0
+	}
 	text_ := cefstrToString(text)
-	return C.int(proxy__.OnTooltip(me__, (*Browser)(browser), &text_))
+	defer func() {
+		setCEFStr(text_, text)
+	}()
+	return cefBool(proxy__.OnTooltip(me__, (*Browser)(browser), &text_))
 }
 
 // OnStatusMessage (on_status_message)
 // Called when the browser receives a status message. |value| contains the
 // text that will be displayed in the status message.
 func (d *DisplayHandler) OnStatusMessage(browser *Browser, value string) {
-	lookupDisplayHandlerProxy(d.Base()).OnStatusMessage(d, browser, value)
+	if proxy__, ok__ := lookupDisplayHandlerProxy(d.Base(), "OnStatusMessage").(DisplayHandlerOnStatusMessageProxy); ok__ {
+		proxy__.OnStatusMessage(d, browser, value)
+	}
 }
 
 //export gocef_display_handler_on_status_message
 func gocef_display_handler_on_status_message(self *C.cef_display_handler_t, browser *C.cef_browser_t, value *C.cef_string_t) {
+	defer recoverProxyPanic("DisplayHandler", "OnStatusMessage")
 	me__ := (*DisplayHandler)(self)
-	proxy__ := lookupDisplayHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupDisplayHandlerProxy(me__.Base(), "OnStatusMessage").(DisplayHandlerOnStatusMessageProxy)
+	if !ok__ {
+		return
+	}
 	value_ := cefstrToString(value)
 	proxy__.OnStatusMessage(me__, (*Browser)(browser), value_)
 }
@@ -156,17 +249,24 @@ func gocef_display_handler_on_status_message(self *C.cef_display_handler_t, brow
 // OnConsoleMessage (on_console_message)
 // Called to display a console message. Return true (1) to stop the message
 // from being output to the console.
-func (d *DisplayHandler) OnConsoleMessage(browser *Browser, level LogSeverity, message, source string, line int32) int32 {
-	return lookupDisplayHandlerProxy(d.Base()).OnConsoleMessage(d, browser, level, message, source, line)
+func (d *DisplayHandler) OnConsoleMessage(browser *Browser, level LogSeverity, message, source string, line int32) bool {
+	if proxy__, ok__ := lookupDisplayHandlerProxy(d.Base(), "OnConsoleMessage").(DisplayHandlerOnConsoleMessageProxy); ok__ {
+		return proxy__.OnConsoleMessage(d, browser, level, message, source, line)
+	}
+	return false
 }
 
 //export gocef_display_handler_on_console_message
 func gocef_display_handler_on_console_message(self *C.cef_display_handler_t, browser *C.cef_browser_t, level C.cef_log_severity_t, message *C.cef_string_t, source *C.cef_string_t, line C.int) C.int {
+	defer recoverProxyPanic("DisplayHandler", "OnConsoleMessage")
 	me__ := (*DisplayHandler)(self)
-	proxy__ := lookupDisplayHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupDisplayHandlerProxy(me__.Base(), "OnConsoleMessage").(DisplayHandlerOnConsoleMessageProxy)
+	if !ok__ {
+		return 0
+	}
 	message_ := cefstrToString(message)
 	source_ := cefstrToString(source)
-	return C.int(proxy__.OnConsoleMessage(me__, (*Browser)(browser), LogSeverity(level), message_, source_, int32(line)))
+	return cefBool(proxy__.OnConsoleMessage(me__, (*Browser)(browser), LogSeverity(level), message_, source_, int32(line)))
 }
 
 // OnAutoResize (on_auto_resize)
@@ -174,28 +274,41 @@ func gocef_display_handler_on_console_message(self *C.cef_display_handler_t, bro
 // cef_browser_host_t::SetAutoResizeEnabled and the contents have auto-
 // resized. |new_size| will be the desired size in view coordinates. Return
 // true (1) if the resize was handled or false (0) for default handling.
-func (d *DisplayHandler) OnAutoResize(browser *Browser, new_size *Size) int32 {
-	return lookupDisplayHandlerProxy(d.Base()).OnAutoResize(d, browser, new_size)
+func (d *DisplayHandler) OnAutoResize(browser *Browser, new_size *Size) bool {
+	if proxy__, ok__ := lookupDisplayHandlerProxy(d.Base(), "OnAutoResize").(DisplayHandlerOnAutoResizeProxy); ok__ {
+		return proxy__.OnAutoResize(d, browser, new_size)
+	}
+	return false
 }
 
 //export gocef_display_handler_on_auto_resize
 func gocef_display_handler_on_auto_resize(self *C.cef_display_handler_t, browser *C.cef_browser_t, new_size *C.cef_size_t) C.int {
+	defer recoverProxyPanic("DisplayHandler", "OnAutoResize")
 	me__ := (*DisplayHandler)(self)
-	proxy__ := lookupDisplayHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupDisplayHandlerProxy(me__.Base(), "OnAutoResize").(DisplayHandlerOnAutoResizeProxy)
+	if !ok__ {
+		return 0
+	}
 	new_size_ := new_size.toGo()
-	return C.int(proxy__.OnAutoResize(me__, (*Browser)(browser), new_size_))
+	return cefBool(proxy__.OnAutoResize(me__, (*Browser)(browser), new_size_))
 }
 
 // OnLoadingProgressChange (on_loading_progress_change)
 // Called when the overall page loading progress has changed. |progress|
 // ranges from 0.0 to 1.0.
 func (d *DisplayHandler) OnLoadingProgressChange(browser *Browser, progress float64) {
-	lookupDisplayHandlerProxy(d.Base()).OnLoadingProgressChange(d, browser, progress)
+	if proxy__, ok__ := lookupDisplayHandlerProxy(d.Base(), "OnLoadingProgressChange").(DisplayHandlerOnLoadingProgressChangeProxy); ok__ {
+		proxy__.OnLoadingProgressChange(d, browser, progress)
+	}
 }
 
 //export gocef_display_handler_on_loading_progress_change
 func gocef_display_handler_on_loading_progress_change(self *C.cef_display_handler_t, browser *C.cef_browser_t, progress C.double) {
+	defer recoverProxyPanic("DisplayHandler", "OnLoadingProgressChange")
 	me__ := (*DisplayHandler)(self)
-	proxy__ := lookupDisplayHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupDisplayHandlerProxy(me__.Base(), "OnLoadingProgressChange").(DisplayHandlerOnLoadingProgressChangeProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnLoadingProgressChange(me__, (*Browser)(browser), float64(progress))
 }
@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_display_handler_proxy(cef_display_handler_t *self);
+void gocef_set_display_handler_proxy(cef_display_handler_t *self, int on_address_change, int on_title_change, int on_favicon_urlchange, int on_fullscreen_mode_change, int on_tooltip, int on_status_message, int on_console_message, int on_auto_resize, int on_loading_progress_change);
 
 #endif // GOCEF_DisplayHandler_H_
@@ -44,18 +44,21 @@ func (d *Domdocument) GetType() DOMDocumentType {
 
 // GetDocument (get_document)
 // Returns the root document node.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *Domdocument) GetDocument() *Domnode {
 	return (*Domnode)(C.gocef_domdocument_get_document(d.toNative(), d.get_document))
 }
 
 // GetBody (get_body)
 // Returns the BODY node of an HTML document.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *Domdocument) GetBody() *Domnode {
 	return (*Domnode)(C.gocef_domdocument_get_body(d.toNative(), d.get_body))
 }
 
 // GetHead (get_head)
 // Returns the HEAD node of an HTML document.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *Domdocument) GetHead() *Domnode {
 	return (*Domnode)(C.gocef_domdocument_get_head(d.toNative(), d.get_head))
 }
@@ -69,6 +72,7 @@ func (d *Domdocument) GetTitle() string {
 
 // GetElementById (get_element_by_id)
 // Returns the document element with the specified ID value.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *Domdocument) GetElementById(id string) *Domnode {
 	id_ := C.cef_string_userfree_alloc()
 	setCEFStr(id, id_)
@@ -80,14 +84,15 @@ func (d *Domdocument) GetElementById(id string) *Domnode {
 
 // GetFocusedNode (get_focused_node)
 // Returns the node that currently has keyboard focus.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *Domdocument) GetFocusedNode() *Domnode {
 	return (*Domnode)(C.gocef_domdocument_get_focused_node(d.toNative(), d.get_focused_node))
 }
 
 // HasSelection (has_selection)
 // Returns true (1) if a portion of the document is selected.
-func (d *Domdocument) HasSelection() int32 {
-	return int32(C.gocef_domdocument_has_selection(d.toNative(), d.has_selection))
+func (d *Domdocument) HasSelection() bool {
+	return C.gocef_domdocument_has_selection(d.toNative(), d.has_selection) != 0
 }
 
 // GetSelectionStartOffset (get_selection_start_offset)
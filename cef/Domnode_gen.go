@@ -56,26 +56,26 @@ func (d *Domnode) GetType() DOMNodeType {
 
 // IsText (is_text)
 // Returns true (1) if this is a text node.
-func (d *Domnode) IsText() int32 {
-	return int32(C.gocef_domnode_is_text(d.toNative(), d.is_text))
+func (d *Domnode) IsText() bool {
+	return C.gocef_domnode_is_text(d.toNative(), d.is_text) != 0
 }
 
 // IsElement (is_element)
 // Returns true (1) if this is an element node.
-func (d *Domnode) IsElement() int32 {
-	return int32(C.gocef_domnode_is_element(d.toNative(), d.is_element))
+func (d *Domnode) IsElement() bool {
+	return C.gocef_domnode_is_element(d.toNative(), d.is_element) != 0
 }
 
 // IsEditable (is_editable)
 // Returns true (1) if this is an editable node.
-func (d *Domnode) IsEditable() int32 {
-	return int32(C.gocef_domnode_is_editable(d.toNative(), d.is_editable))
+func (d *Domnode) IsEditable() bool {
+	return C.gocef_domnode_is_editable(d.toNative(), d.is_editable) != 0
 }
 
 // IsFormControlElement (is_form_control_element)
 // Returns true (1) if this is a form control element node.
-func (d *Domnode) IsFormControlElement() int32 {
-	return int32(C.gocef_domnode_is_form_control_element(d.toNative(), d.is_form_control_element))
+func (d *Domnode) IsFormControlElement() bool {
+	return C.gocef_domnode_is_form_control_element(d.toNative(), d.is_form_control_element) != 0
 }
 
 // GetFormControlElementType (get_form_control_element_type)
@@ -88,8 +88,8 @@ func (d *Domnode) GetFormControlElementType() string {
 // IsSame (is_same)
 // Returns true (1) if this object is pointing to the same handle as |that|
 // object.
-func (d *Domnode) IsSame(that *Domnode) int32 {
-	return int32(C.gocef_domnode_is_same(d.toNative(), that.toNative(), d.is_same))
+func (d *Domnode) IsSame(that *Domnode) bool {
+	return C.gocef_domnode_is_same(d.toNative(), that.toNative(), d.is_same) != 0
 }
 
 // GetName (get_name)
@@ -108,13 +108,13 @@ func (d *Domnode) GetValue() string {
 
 // SetValue (set_value)
 // Set the value of this node. Returns true (1) on success.
-func (d *Domnode) SetValue(value string) int32 {
+func (d *Domnode) SetValue(value string) bool {
 	value_ := C.cef_string_userfree_alloc()
 	setCEFStr(value, value_)
 	defer func() {
 		C.cef_string_userfree_free(value_)
 	}()
-	return int32(C.gocef_domnode_set_value(d.toNative(), (*C.cef_string_t)(value_), d.set_value))
+	return C.gocef_domnode_set_value(d.toNative(), (*C.cef_string_t)(value_), d.set_value) != 0
 }
 
 // GetAsMarkup (get_as_markup)
@@ -126,42 +126,48 @@ func (d *Domnode) GetAsMarkup() string {
 
 // GetDocument (get_document)
 // Returns the document associated with this node.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *Domnode) GetDocument() *Domdocument {
 	return (*Domdocument)(C.gocef_domnode_get_document(d.toNative(), d.get_document))
 }
 
 // GetParent (get_parent)
 // Returns the parent node.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *Domnode) GetParent() *Domnode {
 	return (*Domnode)(C.gocef_domnode_get_parent(d.toNative(), d.get_parent))
 }
 
 // GetPreviousSibling (get_previous_sibling)
 // Returns the previous sibling node.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *Domnode) GetPreviousSibling() *Domnode {
 	return (*Domnode)(C.gocef_domnode_get_previous_sibling(d.toNative(), d.get_previous_sibling))
 }
 
 // GetNextSibling (get_next_sibling)
 // Returns the next sibling node.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *Domnode) GetNextSibling() *Domnode {
 	return (*Domnode)(C.gocef_domnode_get_next_sibling(d.toNative(), d.get_next_sibling))
 }
 
 // HasChildren (has_children)
 // Returns true (1) if this node has child nodes.
-func (d *Domnode) HasChildren() int32 {
-	return int32(C.gocef_domnode_has_children(d.toNative(), d.has_children))
+func (d *Domnode) HasChildren() bool {
+	return C.gocef_domnode_has_children(d.toNative(), d.has_children) != 0
 }
 
 // GetFirstChild (get_first_child)
 // Return the first child node.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *Domnode) GetFirstChild() *Domnode {
 	return (*Domnode)(C.gocef_domnode_get_first_child(d.toNative(), d.get_first_child))
 }
 
 // GetLastChild (get_last_child)
 // Returns the last child node.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *Domnode) GetLastChild() *Domnode {
 	return (*Domnode)(C.gocef_domnode_get_last_child(d.toNative(), d.get_last_child))
 }
@@ -175,19 +181,19 @@ func (d *Domnode) GetElementTagName() string {
 
 // HasElementAttributes (has_element_attributes)
 // Returns true (1) if this element has attributes.
-func (d *Domnode) HasElementAttributes() int32 {
-	return int32(C.gocef_domnode_has_element_attributes(d.toNative(), d.has_element_attributes))
+func (d *Domnode) HasElementAttributes() bool {
+	return C.gocef_domnode_has_element_attributes(d.toNative(), d.has_element_attributes) != 0
 }
 
 // HasElementAttribute (has_element_attribute)
 // Returns true (1) if this element has an attribute named |attrName|.
-func (d *Domnode) HasElementAttribute(attrName string) int32 {
+func (d *Domnode) HasElementAttribute(attrName string) bool {
 	attrName_ := C.cef_string_userfree_alloc()
 	setCEFStr(attrName, attrName_)
 	defer func() {
 		C.cef_string_userfree_free(attrName_)
 	}()
-	return int32(C.gocef_domnode_has_element_attribute(d.toNative(), (*C.cef_string_t)(attrName_), d.has_element_attribute))
+	return C.gocef_domnode_has_element_attribute(d.toNative(), (*C.cef_string_t)(attrName_), d.has_element_attribute) != 0
 }
 
 // GetElementAttribute (get_element_attribute)
@@ -204,14 +210,17 @@ func (d *Domnode) GetElementAttribute(attrName string) string {
 
 // GetElementAttributes (get_element_attributes)
 // Returns a map of all element attributes.
-func (d *Domnode) GetElementAttributes(attrMap StringMap) {
-	C.gocef_domnode_get_element_attributes(d.toNative(), C.cef_string_map_t(attrMap), d.get_element_attributes)
+func (d *Domnode) GetElementAttributes() map[string]string {
+	attrMap_ := C.cef_string_map_alloc()
+	defer C.cef_string_map_free(attrMap_)
+	C.gocef_domnode_get_element_attributes(d.toNative(), attrMap_, d.get_element_attributes)
+	return cefStringMapToGo(attrMap_)
 }
 
 // SetElementAttribute (set_element_attribute)
 // Set the value for the element attribute named |attrName|. Returns true (1)
 // on success.
-func (d *Domnode) SetElementAttribute(attrName, value string) int32 {
+func (d *Domnode) SetElementAttribute(attrName, value string) bool {
 	attrName_ := C.cef_string_userfree_alloc()
 	setCEFStr(attrName, attrName_)
 	defer func() {
@@ -222,7 +231,7 @@ func (d *Domnode) SetElementAttribute(attrName, value string) int32 {
 	defer func() {
 		C.cef_string_userfree_free(value_)
 	}()
-	return int32(C.gocef_domnode_set_element_attribute(d.toNative(), (*C.cef_string_t)(attrName_), (*C.cef_string_t)(value_), d.set_element_attribute))
+	return C.gocef_domnode_set_element_attribute(d.toNative(), (*C.cef_string_t)(attrName_), (*C.cef_string_t)(value_), d.set_element_attribute) != 0
 }
 
 // GetElementInnerText (get_element_inner_text)
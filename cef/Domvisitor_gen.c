@@ -1,10 +1,12 @@
 // Code generated - DO NOT EDIT.
 
-#include "PrintJobCallback_gen.h"
+#include "Domvisitor_gen.h"
 #include "_cgo_export.h"
 
-void gocef_set_print_job_callback_proxy(cef_print_job_callback_t *self) {
+void gocef_set_domvisitor_proxy(cef_domvisitor_t *self, int visit) {
 	// Casts to (void *) added to avoid warnings since Go callbacks can't have
 	// some modifiers, such as 'const' applied to their parameter signatures.
-	self->cont = (void *)&gocef_print_job_callback_cont;
+	if (visit) {
+		self->visit = (void *)&gocef_domvisitor_visit;
+	}
 }
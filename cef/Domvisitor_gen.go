@@ -3,20 +3,62 @@
 package cef
 
 import (
-	// #include "capi_gen.h"
-	// void gocef_domvisitor_visit(cef_domvisitor_t * self, cef_domdocument_t * document, void (CEF_CALLBACK *callback__)(cef_domvisitor_t *, cef_domdocument_t *)) { return callback__(self, document); }
+	// #include "Domvisitor_gen.h"
 	"C"
+	"unsafe"
 )
 
+// DomvisitorProxy is implemented by the proxies used with Domvisitor. Embed
+// DefaultDomvisitor to satisfy it, then implement any of the optional
+// Domvisitor*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
+type DomvisitorProxy interface {
+	isDomvisitorProxy()
+}
+
+// DefaultDomvisitor may be embedded to satisfy DomvisitorProxy.
+type DefaultDomvisitor struct{}
+
+func (DefaultDomvisitor) isDomvisitorProxy() {}
+
+// DomvisitorVisitProxy may be implemented by a DomvisitorProxy to handle Visit.
+type DomvisitorVisitProxy interface {
+	Visit(self *Domvisitor, document *Domdocument)
+}
+
 // Domvisitor (cef_domvisitor_t from include/capi/cef_dom_capi.h)
 // Structure to implement for visiting the DOM. The functions of this structure
 // will be called on the render process main thread.
 type Domvisitor C.cef_domvisitor_t
 
+// NewDomvisitor creates a new Domvisitor with the specified proxy. Passing
+// in nil will result in default handling, if applicable.
+func NewDomvisitor(proxy DomvisitorProxy) *Domvisitor {
+	result := (*Domvisitor)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_domvisitor_t, proxy)))
+	if proxy != nil {
+		_, visit := proxy.(DomvisitorVisitProxy)
+		C.gocef_set_domvisitor_proxy(result.toNative(), cefBool(visit))
+	}
+	return result
+}
+
 func (d *Domvisitor) toNative() *C.cef_domvisitor_t {
 	return (*C.cef_domvisitor_t)(d)
 }
 
+func lookupDomvisitorProxy(obj *BaseRefCounted, callback string) DomvisitorProxy {
+	proxy, exists := lookupProxy(obj)
+	if !exists {
+		reportProxyError("Domvisitor", callback, ErrProxyNotFound, nil)
+		return nil
+	}
+	actual, ok := proxy.(DomvisitorProxy)
+	if !ok && proxy != nil {
+		reportProxyError("Domvisitor", callback, ErrProxyWrongType, nil)
+	}
+	return actual
+}
+
 // Base (base)
 // Base structure.
 func (d *Domvisitor) Base() *BaseRefCounted {
@@ -30,5 +72,18 @@ func (d *Domvisitor) Base() *BaseRefCounted {
 // keep references to or attempt to access any DOM objects outside the scope
 // of this function.
 func (d *Domvisitor) Visit(document *Domdocument) {
-	C.gocef_domvisitor_visit(d.toNative(), document.toNative(), d.visit)
+	if proxy__, ok__ := lookupDomvisitorProxy(d.Base(), "Visit").(DomvisitorVisitProxy); ok__ {
+		proxy__.Visit(d, document)
+	}
+}
+
+//export gocef_domvisitor_visit
+func gocef_domvisitor_visit(self *C.cef_domvisitor_t, document *C.cef_domdocument_t) {
+	defer recoverProxyPanic("Domvisitor", "Visit")
+	me__ := (*Domvisitor)(self)
+	proxy__, ok__ := lookupDomvisitorProxy(me__.Base(), "Visit").(DomvisitorVisitProxy)
+	if !ok__ {
+		return
+	}
+	proxy__.Visit(me__, (*Domdocument)(document))
 }
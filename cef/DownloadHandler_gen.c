@@ -3,9 +3,13 @@
 #include "DownloadHandler_gen.h"
 #include "_cgo_export.h"
 
-void gocef_set_download_handler_proxy(cef_download_handler_t *self) {
+void gocef_set_download_handler_proxy(cef_download_handler_t *self, int on_before_download, int on_download_updated) {
 	// Casts to (void *) added to avoid warnings since Go callbacks can't have
 	// some modifiers, such as 'const' applied to their parameter signatures.
-	self->on_before_download = (void *)&gocef_download_handler_on_before_download;
-	self->on_download_updated = (void *)&gocef_download_handler_on_download_updated;
+	if (on_before_download) {
+		self->on_before_download = (void *)&gocef_download_handler_on_before_download;
+	}
+	if (on_download_updated) {
+		self->on_download_updated = (void *)&gocef_download_handler_on_download_updated;
+	}
 }
@@ -6,14 +6,28 @@ import (
 	// #include "DownloadHandler_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// DownloadHandlerProxy defines methods required for using DownloadHandler.
+// DownloadHandlerProxy is implemented by the proxies used with DownloadHandler. Embed
+// DefaultDownloadHandler to satisfy it, then implement any of the optional
+// DownloadHandler*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type DownloadHandlerProxy interface {
+	isDownloadHandlerProxy()
+}
+
+// DefaultDownloadHandler may be embedded to satisfy DownloadHandlerProxy.
+type DefaultDownloadHandler struct{}
+
+func (DefaultDownloadHandler) isDownloadHandlerProxy() {}
+
+// DownloadHandlerOnBeforeDownloadProxy may be implemented by a DownloadHandlerProxy to handle OnBeforeDownload.
+type DownloadHandlerOnBeforeDownloadProxy interface {
 	OnBeforeDownload(self *DownloadHandler, browser *Browser, download_item *DownloadItem, suggested_name string, callback *BeforeDownloadCallback)
+}
+
+// DownloadHandlerOnDownloadUpdatedProxy may be implemented by a DownloadHandlerProxy to handle OnDownloadUpdated.
+type DownloadHandlerOnDownloadUpdatedProxy interface {
 	OnDownloadUpdated(self *DownloadHandler, browser *Browser, download_item *DownloadItem, callback *DownloadItemCallback)
 }
 
@@ -27,7 +41,9 @@ type DownloadHandler C.cef_download_handler_t
 func NewDownloadHandler(proxy DownloadHandlerProxy) *DownloadHandler {
 	result := (*DownloadHandler)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_download_handler_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_download_handler_proxy(result.toNative())
+		_, on_before_download := proxy.(DownloadHandlerOnBeforeDownloadProxy)
+		_, on_download_updated := proxy.(DownloadHandlerOnDownloadUpdatedProxy)
+		C.gocef_set_download_handler_proxy(result.toNative(), cefBool(on_before_download), cefBool(on_download_updated))
 	}
 	return result
 }
@@ -36,14 +52,15 @@ func (d *DownloadHandler) toNative() *C.cef_download_handler_t {
 	return (*C.cef_download_handler_t)(d)
 }
 
-func lookupDownloadHandlerProxy(obj *BaseRefCounted) DownloadHandlerProxy {
+func lookupDownloadHandlerProxy(obj *BaseRefCounted, callback string) DownloadHandlerProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("DownloadHandler", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(DownloadHandlerProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type DownloadHandlerProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("DownloadHandler", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -61,13 +78,19 @@ func (d *DownloadHandler) Base() *BaseRefCounted {
 // download if desired. Do not keep a reference to |download_item| outside of
 // this function.
 func (d *DownloadHandler) OnBeforeDownload(browser *Browser, download_item *DownloadItem, suggested_name string, callback *BeforeDownloadCallback) {
-	lookupDownloadHandlerProxy(d.Base()).OnBeforeDownload(d, browser, download_item, suggested_name, callback)
+	if proxy__, ok__ := lookupDownloadHandlerProxy(d.Base(), "OnBeforeDownload").(DownloadHandlerOnBeforeDownloadProxy); ok__ {
+		proxy__.OnBeforeDownload(d, browser, download_item, suggested_name, callback)
+	}
 }
 
 //export gocef_download_handler_on_before_download
 func gocef_download_handler_on_before_download(self *C.cef_download_handler_t, browser *C.cef_browser_t, download_item *C.cef_download_item_t, suggested_name *C.cef_string_t, callback *C.cef_before_download_callback_t) {
+	defer recoverProxyPanic("DownloadHandler", "OnBeforeDownload")
 	me__ := (*DownloadHandler)(self)
-	proxy__ := lookupDownloadHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupDownloadHandlerProxy(me__.Base(), "OnBeforeDownload").(DownloadHandlerOnBeforeDownloadProxy)
+	if !ok__ {
+		return
+	}
 	suggested_name_ := cefstrToString(suggested_name)
 	proxy__.OnBeforeDownload(me__, (*Browser)(browser), (*DownloadItem)(download_item), suggested_name_, (*BeforeDownloadCallback)(callback))
 }
@@ -79,12 +102,18 @@ func gocef_download_handler_on_before_download(self *C.cef_download_handler_t, b
 // download if desired. Do not keep a reference to |download_item| outside of
 // this function.
 func (d *DownloadHandler) OnDownloadUpdated(browser *Browser, download_item *DownloadItem, callback *DownloadItemCallback) {
-	lookupDownloadHandlerProxy(d.Base()).OnDownloadUpdated(d, browser, download_item, callback)
+	if proxy__, ok__ := lookupDownloadHandlerProxy(d.Base(), "OnDownloadUpdated").(DownloadHandlerOnDownloadUpdatedProxy); ok__ {
+		proxy__.OnDownloadUpdated(d, browser, download_item, callback)
+	}
 }
 
 //export gocef_download_handler_on_download_updated
 func gocef_download_handler_on_download_updated(self *C.cef_download_handler_t, browser *C.cef_browser_t, download_item *C.cef_download_item_t, callback *C.cef_download_item_callback_t) {
+	defer recoverProxyPanic("DownloadHandler", "OnDownloadUpdated")
 	me__ := (*DownloadHandler)(self)
-	proxy__ := lookupDownloadHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupDownloadHandlerProxy(me__.Base(), "OnDownloadUpdated").(DownloadHandlerOnDownloadUpdatedProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnDownloadUpdated(me__, (*Browser)(browser), (*DownloadItem)(download_item), (*DownloadItemCallback)(callback))
 }
@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_download_handler_proxy(cef_download_handler_t *self);
+void gocef_set_download_handler_proxy(cef_download_handler_t *self, int on_before_download, int on_download_updated);
 
 #endif // GOCEF_DownloadHandler_H_
@@ -6,13 +6,23 @@ import (
 	// #include "DownloadImageCallback_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// DownloadImageCallbackProxy defines methods required for using DownloadImageCallback.
+// DownloadImageCallbackProxy is implemented by the proxies used with DownloadImageCallback. Embed
+// DefaultDownloadImageCallback to satisfy it, then implement any of the optional
+// DownloadImageCallback*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type DownloadImageCallbackProxy interface {
+	isDownloadImageCallbackProxy()
+}
+
+// DefaultDownloadImageCallback may be embedded to satisfy DownloadImageCallbackProxy.
+type DefaultDownloadImageCallback struct{}
+
+func (DefaultDownloadImageCallback) isDownloadImageCallbackProxy() {}
+
+// DownloadImageCallbackOnDownloadImageFinishedProxy may be implemented by a DownloadImageCallbackProxy to handle OnDownloadImageFinished.
+type DownloadImageCallbackOnDownloadImageFinishedProxy interface {
 	OnDownloadImageFinished(self *DownloadImageCallback, image_url string, http_status_code int32, image *Image)
 }
 
@@ -26,7 +36,8 @@ type DownloadImageCallback C.cef_download_image_callback_t
 func NewDownloadImageCallback(proxy DownloadImageCallbackProxy) *DownloadImageCallback {
 	result := (*DownloadImageCallback)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_download_image_callback_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_download_image_callback_proxy(result.toNative())
+		_, on_download_image_finished := proxy.(DownloadImageCallbackOnDownloadImageFinishedProxy)
+		C.gocef_set_download_image_callback_proxy(result.toNative(), cefBool(on_download_image_finished))
 	}
 	return result
 }
@@ -35,14 +46,15 @@ func (d *DownloadImageCallback) toNative() *C.cef_download_image_callback_t {
 	return (*C.cef_download_image_callback_t)(d)
 }
 
-func lookupDownloadImageCallbackProxy(obj *BaseRefCounted) DownloadImageCallbackProxy {
+func lookupDownloadImageCallbackProxy(obj *BaseRefCounted, callback string) DownloadImageCallbackProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("DownloadImageCallback", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(DownloadImageCallbackProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type DownloadImageCallbackProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("DownloadImageCallback", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -59,13 +71,19 @@ func (d *DownloadImageCallback) Base() *BaseRefCounted {
 // resulting HTTP status code. |image| is the resulting image, possibly at
 // multiple scale factors, or NULL if the download failed.
 func (d *DownloadImageCallback) OnDownloadImageFinished(image_url string, http_status_code int32, image *Image) {
-	lookupDownloadImageCallbackProxy(d.Base()).OnDownloadImageFinished(d, image_url, http_status_code, image)
+	if proxy__, ok__ := lookupDownloadImageCallbackProxy(d.Base(), "OnDownloadImageFinished").(DownloadImageCallbackOnDownloadImageFinishedProxy); ok__ {
+		proxy__.OnDownloadImageFinished(d, image_url, http_status_code, image)
+	}
 }
 
 //export gocef_download_image_callback_on_download_image_finished
 func gocef_download_image_callback_on_download_image_finished(self *C.cef_download_image_callback_t, image_url *C.cef_string_t, http_status_code C.int, image *C.cef_image_t) {
+	defer recoverProxyPanic("DownloadImageCallback", "OnDownloadImageFinished")
 	me__ := (*DownloadImageCallback)(self)
-	proxy__ := lookupDownloadImageCallbackProxy(me__.Base())
+	proxy__, ok__ := lookupDownloadImageCallbackProxy(me__.Base(), "OnDownloadImageFinished").(DownloadImageCallbackOnDownloadImageFinishedProxy)
+	if !ok__ {
+		return
+	}
 	image_url_ := cefstrToString(image_url)
 	proxy__.OnDownloadImageFinished(me__, image_url_, int32(http_status_code), (*Image)(image))
 }
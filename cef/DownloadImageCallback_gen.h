@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_download_image_callback_proxy(cef_download_image_callback_t *self);
+void gocef_set_download_image_callback_proxy(cef_download_image_callback_t *self, int on_download_image_finished);
 
 #endif // GOCEF_DownloadImageCallback_H_
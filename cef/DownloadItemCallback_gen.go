@@ -3,51 +3,21 @@
 package cef
 
 import (
-	// #include "DownloadItemCallback_gen.h"
+	// #include "capi_gen.h"
+	// void gocef_download_item_callback_cancel(cef_download_item_callback_t * self, void (CEF_CALLBACK *callback__)(cef_download_item_callback_t *)) { return callback__(self); }
+	// void gocef_download_item_callback_pause(cef_download_item_callback_t * self, void (CEF_CALLBACK *callback__)(cef_download_item_callback_t *)) { return callback__(self); }
+	// void gocef_download_item_callback_resume(cef_download_item_callback_t * self, void (CEF_CALLBACK *callback__)(cef_download_item_callback_t *)) { return callback__(self); }
 	"C"
-	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// DownloadItemCallbackProxy defines methods required for using DownloadItemCallback.
-type DownloadItemCallbackProxy interface {
-	Cancel(self *DownloadItemCallback)
-	Pause(self *DownloadItemCallback)
-	Resume(self *DownloadItemCallback)
-}
-
 // DownloadItemCallback (cef_download_item_callback_t from include/capi/cef_download_handler_capi.h)
 // Callback structure used to asynchronously cancel a download.
 type DownloadItemCallback C.cef_download_item_callback_t
 
-// NewDownloadItemCallback creates a new DownloadItemCallback with the specified proxy. Passing
-// in nil will result in default handling, if applicable.
-func NewDownloadItemCallback(proxy DownloadItemCallbackProxy) *DownloadItemCallback {
-	result := (*DownloadItemCallback)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_download_item_callback_t, proxy)))
-	if proxy != nil {
-		C.gocef_set_download_item_callback_proxy(result.toNative())
-	}
-	return result
-}
-
 func (d *DownloadItemCallback) toNative() *C.cef_download_item_callback_t {
 	return (*C.cef_download_item_callback_t)(d)
 }
 
-func lookupDownloadItemCallbackProxy(obj *BaseRefCounted) DownloadItemCallbackProxy {
-	proxy, exists := lookupProxy(obj)
-	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
-	}
-	actual, ok := proxy.(DownloadItemCallbackProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type DownloadItemCallbackProxy"))
-	}
-	return actual
-}
-
 // Base (base)
 // Base structure.
 func (d *DownloadItemCallback) Base() *BaseRefCounted {
@@ -57,38 +27,17 @@ func (d *DownloadItemCallback) Base() *BaseRefCounted {
 // Cancel (cancel)
 // Call to cancel the download.
 func (d *DownloadItemCallback) Cancel() {
-	lookupDownloadItemCallbackProxy(d.Base()).Cancel(d)
-}
-
-//export gocef_download_item_callback_cancel
-func gocef_download_item_callback_cancel(self *C.cef_download_item_callback_t) {
-	me__ := (*DownloadItemCallback)(self)
-	proxy__ := lookupDownloadItemCallbackProxy(me__.Base())
-	proxy__.Cancel(me__)
+	C.gocef_download_item_callback_cancel(d.toNative(), d.cancel)
 }
 
 // Pause (pause)
 // Call to pause the download.
 func (d *DownloadItemCallback) Pause() {
-	lookupDownloadItemCallbackProxy(d.Base()).Pause(d)
-}
-
-//export gocef_download_item_callback_pause
-func gocef_download_item_callback_pause(self *C.cef_download_item_callback_t) {
-	me__ := (*DownloadItemCallback)(self)
-	proxy__ := lookupDownloadItemCallbackProxy(me__.Base())
-	proxy__.Pause(me__)
+	C.gocef_download_item_callback_pause(d.toNative(), d.pause)
 }
 
 // Resume (resume)
 // Call to resume the download.
 func (d *DownloadItemCallback) Resume() {
-	lookupDownloadItemCallbackProxy(d.Base()).Resume(d)
-}
-
-//export gocef_download_item_callback_resume
-func gocef_download_item_callback_resume(self *C.cef_download_item_callback_t) {
-	me__ := (*DownloadItemCallback)(self)
-	proxy__ := lookupDownloadItemCallbackProxy(me__.Base())
-	proxy__.Resume(me__)
+	C.gocef_download_item_callback_resume(d.toNative(), d.resume)
 }
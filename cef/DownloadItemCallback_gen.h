@@ -1,11 +0,0 @@
-// Code generated - DO NOT EDIT.
-
-#ifndef GOCEF_DownloadItemCallback_H_
-#define GOCEF_DownloadItemCallback_H_
-#pragma once
-
-#include "capi_gen.h"
-
-void gocef_set_download_item_callback_proxy(cef_download_item_callback_t *self);
-
-#endif // GOCEF_DownloadItemCallback_H_
@@ -41,26 +41,26 @@ func (d *DownloadItem) Base() *BaseRefCounted {
 // IsValid (is_valid)
 // Returns true (1) if this object is valid. Do not call any other functions
 // if this function returns false (0).
-func (d *DownloadItem) IsValid() int32 {
-	return int32(C.gocef_download_item_is_valid(d.toNative(), d.is_valid))
+func (d *DownloadItem) IsValid() bool {
+	return C.gocef_download_item_is_valid(d.toNative(), d.is_valid) != 0
 }
 
 // IsInProgress (is_in_progress)
 // Returns true (1) if the download is in progress.
-func (d *DownloadItem) IsInProgress() int32 {
-	return int32(C.gocef_download_item_is_in_progress(d.toNative(), d.is_in_progress))
+func (d *DownloadItem) IsInProgress() bool {
+	return C.gocef_download_item_is_in_progress(d.toNative(), d.is_in_progress) != 0
 }
 
 // IsComplete (is_complete)
 // Returns true (1) if the download is complete.
-func (d *DownloadItem) IsComplete() int32 {
-	return int32(C.gocef_download_item_is_complete(d.toNative(), d.is_complete))
+func (d *DownloadItem) IsComplete() bool {
+	return C.gocef_download_item_is_complete(d.toNative(), d.is_complete) != 0
 }
 
 // IsCanceled (is_canceled)
 // Returns true (1) if the download has been canceled or interrupted.
-func (d *DownloadItem) IsCanceled() int32 {
-	return int32(C.gocef_download_item_is_canceled(d.toNative(), d.is_canceled))
+func (d *DownloadItem) IsCanceled() bool {
+	return C.gocef_download_item_is_canceled(d.toNative(), d.is_canceled) != 0
 }
 
 // GetCurrentSpeed (get_current_speed)
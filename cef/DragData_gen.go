@@ -49,32 +49,33 @@ func (d *DragData) Base() *BaseRefCounted {
 
 // Clone (clone)
 // Returns a copy of the current object.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *DragData) Clone() *DragData {
 	return (*DragData)(C.gocef_drag_data_clone(d.toNative(), d.clone))
 }
 
 // IsReadOnly (is_read_only)
 // Returns true (1) if this object is read-only.
-func (d *DragData) IsReadOnly() int32 {
-	return int32(C.gocef_drag_data_is_read_only(d.toNative(), d.is_read_only))
+func (d *DragData) IsReadOnly() bool {
+	return C.gocef_drag_data_is_read_only(d.toNative(), d.is_read_only) != 0
 }
 
 // IsLink (is_link)
 // Returns true (1) if the drag data is a link.
-func (d *DragData) IsLink() int32 {
-	return int32(C.gocef_drag_data_is_link(d.toNative(), d.is_link))
+func (d *DragData) IsLink() bool {
+	return C.gocef_drag_data_is_link(d.toNative(), d.is_link) != 0
 }
 
 // IsFragment (is_fragment)
 // Returns true (1) if the drag data is a text or html fragment.
-func (d *DragData) IsFragment() int32 {
-	return int32(C.gocef_drag_data_is_fragment(d.toNative(), d.is_fragment))
+func (d *DragData) IsFragment() bool {
+	return C.gocef_drag_data_is_fragment(d.toNative(), d.is_fragment) != 0
 }
 
 // IsFile (is_file)
 // Returns true (1) if the drag data is a file.
-func (d *DragData) IsFile() int32 {
-	return int32(C.gocef_drag_data_is_file(d.toNative(), d.is_file))
+func (d *DragData) IsFile() bool {
+	return C.gocef_drag_data_is_file(d.toNative(), d.is_file) != 0
 }
 
 // GetLinkUrl (get_link_url)
@@ -139,8 +140,11 @@ func (d *DragData) GetFileContents(writer *StreamWriter) uint64 {
 // GetFileNames (get_file_names)
 // Retrieve the list of file names that are being dragged into the browser
 // window.
-func (d *DragData) GetFileNames(names StringList) int32 {
-	return int32(C.gocef_drag_data_get_file_names(d.toNative(), C.cef_string_list_t(names), d.get_file_names))
+func (d *DragData) GetFileNames() ([]string, bool) {
+	names_ := C.cef_string_list_alloc()
+	defer C.cef_string_list_free(names_)
+	result__ := C.gocef_drag_data_get_file_names(d.toNative(), names_, d.get_file_names) != 0
+	return cefStringListToGo(names_), result__
 }
 
 // SetLinkUrl (set_link_url)
@@ -236,6 +240,7 @@ func (d *DragData) AddFile(path, display_name string) {
 // GetImage (get_image)
 // Get the image representation of drag data. May return NULL if no image
 // representation is available.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *DragData) GetImage() *Image {
 	return (*Image)(C.gocef_drag_data_get_image(d.toNative(), d.get_image))
 }
@@ -251,6 +256,6 @@ func (d *DragData) GetImageHotspot() Point {
 
 // HasImage (has_image)
 // Returns true (1) if an image representation of drag data is available.
-func (d *DragData) HasImage() int32 {
-	return int32(C.gocef_drag_data_has_image(d.toNative(), d.has_image))
+func (d *DragData) HasImage() bool {
+	return C.gocef_drag_data_has_image(d.toNative(), d.has_image) != 0
 }
@@ -6,15 +6,29 @@ import (
 	// #include "DragHandler_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// DragHandlerProxy defines methods required for using DragHandler.
+// DragHandlerProxy is implemented by the proxies used with DragHandler. Embed
+// DefaultDragHandler to satisfy it, then implement any of the optional
+// DragHandler*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type DragHandlerProxy interface {
-	OnDragEnter(self *DragHandler, browser *Browser, dragData *DragData, mask DragOperationsMask) int32
-	OnDraggableRegionsChanged(self *DragHandler, browser *Browser, regionsCount uint64, regions *DraggableRegion)
+	isDragHandlerProxy()
+}
+
+// DefaultDragHandler may be embedded to satisfy DragHandlerProxy.
+type DefaultDragHandler struct{}
+
+func (DefaultDragHandler) isDragHandlerProxy() {}
+
+// DragHandlerOnDragEnterProxy may be implemented by a DragHandlerProxy to handle OnDragEnter.
+type DragHandlerOnDragEnterProxy interface {
+	OnDragEnter(self *DragHandler, browser *Browser, dragData *DragData, mask DragOperationsMask) bool
+}
+
+// DragHandlerOnDraggableRegionsChangedProxy may be implemented by a DragHandlerProxy to handle OnDraggableRegionsChanged.
+type DragHandlerOnDraggableRegionsChangedProxy interface {
+	OnDraggableRegionsChanged(self *DragHandler, browser *Browser, regions []DraggableRegion)
 }
 
 // DragHandler (cef_drag_handler_t from include/capi/cef_drag_handler_capi.h)
@@ -27,7 +41,9 @@ type DragHandler C.cef_drag_handler_t
 func NewDragHandler(proxy DragHandlerProxy) *DragHandler {
 	result := (*DragHandler)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_drag_handler_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_drag_handler_proxy(result.toNative())
+		_, on_drag_enter := proxy.(DragHandlerOnDragEnterProxy)
+		_, on_draggable_regions_changed := proxy.(DragHandlerOnDraggableRegionsChangedProxy)
+		C.gocef_set_drag_handler_proxy(result.toNative(), cefBool(on_drag_enter), cefBool(on_draggable_regions_changed))
 	}
 	return result
 }
@@ -36,14 +52,15 @@ func (d *DragHandler) toNative() *C.cef_drag_handler_t {
 	return (*C.cef_drag_handler_t)(d)
 }
 
-func lookupDragHandlerProxy(obj *BaseRefCounted) DragHandlerProxy {
+func lookupDragHandlerProxy(obj *BaseRefCounted, callback string) DragHandlerProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("DragHandler", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(DragHandlerProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type DragHandlerProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("DragHandler", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -59,15 +76,22 @@ func (d *DragHandler) Base() *BaseRefCounted {
 // contains the drag event data and |mask| represents the type of drag
 // operation. Return false (0) for default drag handling behavior or true (1)
 // to cancel the drag event.
-func (d *DragHandler) OnDragEnter(browser *Browser, dragData *DragData, mask DragOperationsMask) int32 {
-	return lookupDragHandlerProxy(d.Base()).OnDragEnter(d, browser, dragData, mask)
+func (d *DragHandler) OnDragEnter(browser *Browser, dragData *DragData, mask DragOperationsMask) bool {
+	if proxy__, ok__ := lookupDragHandlerProxy(d.Base(), "OnDragEnter").(DragHandlerOnDragEnterProxy); ok__ {
+		return proxy__.OnDragEnter(d, browser, dragData, mask)
+	}
+	return false
 }
 
 //export gocef_drag_handler_on_drag_enter
 func gocef_drag_handler_on_drag_enter(self *C.cef_drag_handler_t, browser *C.cef_browser_t, dragData *C.cef_drag_data_t, mask C.cef_drag_operations_mask_t) C.int {
+	defer recoverProxyPanic("DragHandler", "OnDragEnter")
 	me__ := (*DragHandler)(self)
-	proxy__ := lookupDragHandlerProxy(me__.Base())
-	return C.int(proxy__.OnDragEnter(me__, (*Browser)(browser), (*DragData)(dragData), DragOperationsMask(mask)))
+	proxy__, ok__ := lookupDragHandlerProxy(me__.Base(), "OnDragEnter").(DragHandlerOnDragEnterProxy)
+	if !ok__ {
+		return 0
+	}
+	return cefBool(proxy__.OnDragEnter(me__, (*Browser)(browser), (*DragData)(dragData), DragOperationsMask(mask)))
 }
 
 // OnDraggableRegionsChanged (on_draggable_regions_changed)
@@ -76,14 +100,26 @@ func gocef_drag_handler_on_drag_enter(self *C.cef_drag_handler_t, browser *C.cef
 // draggable regions are never defined in a document this function will also
 // never be called. If the last draggable region is removed from a document
 // this function will be called with an NULL vector.
-func (d *DragHandler) OnDraggableRegionsChanged(browser *Browser, regionsCount uint64, regions *DraggableRegion) {
-	lookupDragHandlerProxy(d.Base()).OnDraggableRegionsChanged(d, browser, regionsCount, regions)
+func (d *DragHandler) OnDraggableRegionsChanged(browser *Browser, regions []DraggableRegion) {
+	if proxy__, ok__ := lookupDragHandlerProxy(d.Base(), "OnDraggableRegionsChanged").(DragHandlerOnDraggableRegionsChangedProxy); ok__ {
+		proxy__.OnDraggableRegionsChanged(d, browser, regions)
+	}
 }
 
 //export gocef_drag_handler_on_draggable_regions_changed
 func gocef_drag_handler_on_draggable_regions_changed(self *C.cef_drag_handler_t, browser *C.cef_browser_t, regionsCount C.size_t, regions *C.cef_draggable_region_t) {
+	defer recoverProxyPanic("DragHandler", "OnDraggableRegionsChanged")
 	me__ := (*DragHandler)(self)
-	proxy__ := lookupDragHandlerProxy(me__.Base())
-	regions_ := regions.toGo()
-	proxy__.OnDraggableRegionsChanged(me__, (*Browser)(browser), uint64(regionsCount), regions_)
+	proxy__, ok__ := lookupDragHandlerProxy(me__.Base(), "OnDraggableRegionsChanged").(DragHandlerOnDraggableRegionsChangedProxy)
+	if !ok__ {
+		return
+	}
+	regions_ := make([]DraggableRegion, regionsCount)
+	if regionsCount > 0 {
+		regions__p := (*[1<<30 - 1]C.cef_draggable_region_t)(unsafe.Pointer(regions))
+		for i := range regions_ {
+			regions__p[i].intoGo(&regions_[i])
+		}
+	}
+	proxy__.OnDraggableRegionsChanged(me__, (*Browser)(browser), regions_)
 }
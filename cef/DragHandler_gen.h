@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_drag_handler_proxy(cef_drag_handler_t *self);
+void gocef_set_drag_handler_proxy(cef_drag_handler_t *self, int on_drag_enter, int on_draggable_regions_changed);
 
 #endif // GOCEF_DragHandler_H_
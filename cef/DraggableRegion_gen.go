@@ -15,7 +15,7 @@ type DraggableRegion struct {
 	Bounds Rect
 	// Draggable (draggable)
 	// True (1) this this region is draggable and false (0) otherwise.
-	Draggable int32
+	Draggable bool
 }
 
 // NewDraggableRegion creates a new DraggableRegion.
@@ -28,7 +28,7 @@ func (d *DraggableRegion) toNative(native *C.cef_draggable_region_t) *C.cef_drag
 		return nil
 	}
 	d.Bounds.toNative(&native.bounds)
-	native.draggable = C.int(d.Draggable)
+	native.draggable = cefBool(d.Draggable)
 	return native
 }
 
@@ -43,5 +43,5 @@ func (n *C.cef_draggable_region_t) toGo() *DraggableRegion {
 
 func (n *C.cef_draggable_region_t) intoGo(d *DraggableRegion) {
 	n.bounds.intoGo(&d.Bounds)
-	d.Draggable = int32(n.draggable)
+	d.Draggable = n.draggable != 0
 }
@@ -3,15 +3,31 @@
 #include "ExtensionHandler_gen.h"
 #include "_cgo_export.h"
 
-void gocef_set_extension_handler_proxy(cef_extension_handler_t *self) {
+void gocef_set_extension_handler_proxy(cef_extension_handler_t *self, int on_extension_load_failed, int on_extension_loaded, int on_extension_unloaded, int on_before_background_browser, int on_before_browser, int get_active_browser, int can_access_browser, int get_extension_resource) {
 	// Casts to (void *) added to avoid warnings since Go callbacks can't have
 	// some modifiers, such as 'const' applied to their parameter signatures.
-	self->on_extension_load_failed = (void *)&gocef_extension_handler_on_extension_load_failed;
-	self->on_extension_loaded = (void *)&gocef_extension_handler_on_extension_loaded;
-	self->on_extension_unloaded = (void *)&gocef_extension_handler_on_extension_unloaded;
-	self->on_before_background_browser = (void *)&gocef_extension_handler_on_before_background_browser;
-	self->on_before_browser = (void *)&gocef_extension_handler_on_before_browser;
-	self->get_active_browser = (void *)&gocef_extension_handler_get_active_browser;
-	self->can_access_browser = (void *)&gocef_extension_handler_can_access_browser;
-	self->get_extension_resource = (void *)&gocef_extension_handler_get_extension_resource;
+	if (on_extension_load_failed) {
+		self->on_extension_load_failed = (void *)&gocef_extension_handler_on_extension_load_failed;
+	}
+	if (on_extension_loaded) {
+		self->on_extension_loaded = (void *)&gocef_extension_handler_on_extension_loaded;
+	}
+	if (on_extension_unloaded) {
+		self->on_extension_unloaded = (void *)&gocef_extension_handler_on_extension_unloaded;
+	}
+	if (on_before_background_browser) {
+		self->on_before_background_browser = (void *)&gocef_extension_handler_on_before_background_browser;
+	}
+	if (on_before_browser) {
+		self->on_before_browser = (void *)&gocef_extension_handler_on_before_browser;
+	}
+	if (get_active_browser) {
+		self->get_active_browser = (void *)&gocef_extension_handler_get_active_browser;
+	}
+	if (can_access_browser) {
+		self->can_access_browser = (void *)&gocef_extension_handler_can_access_browser;
+	}
+	if (get_extension_resource) {
+		self->get_extension_resource = (void *)&gocef_extension_handler_get_extension_resource;
+	}
 }
@@ -6,21 +6,59 @@ import (
 	// #include "ExtensionHandler_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// ExtensionHandlerProxy defines methods required for using ExtensionHandler.
+// ExtensionHandlerProxy is implemented by the proxies used with ExtensionHandler. Embed
+// DefaultExtensionHandler to satisfy it, then implement any of the optional
+// ExtensionHandler*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type ExtensionHandlerProxy interface {
+	isExtensionHandlerProxy()
+}
+
+// DefaultExtensionHandler may be embedded to satisfy ExtensionHandlerProxy.
+type DefaultExtensionHandler struct{}
+
+func (DefaultExtensionHandler) isExtensionHandlerProxy() {}
+
+// ExtensionHandlerOnExtensionLoadFailedProxy may be implemented by a ExtensionHandlerProxy to handle OnExtensionLoadFailed.
+type ExtensionHandlerOnExtensionLoadFailedProxy interface {
 	OnExtensionLoadFailed(self *ExtensionHandler, result Errorcode)
+}
+
+// ExtensionHandlerOnExtensionLoadedProxy may be implemented by a ExtensionHandlerProxy to handle OnExtensionLoaded.
+type ExtensionHandlerOnExtensionLoadedProxy interface {
 	OnExtensionLoaded(self *ExtensionHandler, extension *Extension)
+}
+
+// ExtensionHandlerOnExtensionUnloadedProxy may be implemented by a ExtensionHandlerProxy to handle OnExtensionUnloaded.
+type ExtensionHandlerOnExtensionUnloadedProxy interface {
 	OnExtensionUnloaded(self *ExtensionHandler, extension *Extension)
-	OnBeforeBackgroundBrowser(self *ExtensionHandler, extension *Extension, url string, client **Client, settings *BrowserSettings) int32
-	OnBeforeBrowser(self *ExtensionHandler, extension *Extension, browser, active_browser *Browser, index int32, url string, active int32, windowInfo *WindowInfo, client **Client, settings *BrowserSettings) int32
-	GetActiveBrowser(self *ExtensionHandler, extension *Extension, browser *Browser, include_incognito int32) *Browser
-	CanAccessBrowser(self *ExtensionHandler, extension *Extension, browser *Browser, include_incognito int32, target_browser *Browser) int32
-	GetExtensionResource(self *ExtensionHandler, extension *Extension, browser *Browser, file string, callback *GetExtensionResourceCallback) int32
+}
+
+// ExtensionHandlerOnBeforeBackgroundBrowserProxy may be implemented by a ExtensionHandlerProxy to handle OnBeforeBackgroundBrowser.
+type ExtensionHandlerOnBeforeBackgroundBrowserProxy interface {
+	OnBeforeBackgroundBrowser(self *ExtensionHandler, extension *Extension, url string, client **Client, settings *BrowserSettings) bool
+}
+
+// ExtensionHandlerOnBeforeBrowserProxy may be implemented by a ExtensionHandlerProxy to handle OnBeforeBrowser.
+type ExtensionHandlerOnBeforeBrowserProxy interface {
+	OnBeforeBrowser(self *ExtensionHandler, extension *Extension, browser, active_browser *Browser, index int32, url string, active bool, windowInfo *WindowInfo, client **Client, settings *BrowserSettings) bool
+}
+
+// ExtensionHandlerGetActiveBrowserProxy may be implemented by a ExtensionHandlerProxy to handle GetActiveBrowser.
+type ExtensionHandlerGetActiveBrowserProxy interface {
+	GetActiveBrowser(self *ExtensionHandler, extension *Extension, browser *Browser, include_incognito bool) *Browser
+}
+
+// ExtensionHandlerCanAccessBrowserProxy may be implemented by a ExtensionHandlerProxy to handle CanAccessBrowser.
+type ExtensionHandlerCanAccessBrowserProxy interface {
+	CanAccessBrowser(self *ExtensionHandler, extension *Extension, browser *Browser, include_incognito bool, target_browser *Browser) bool
+}
+
+// ExtensionHandlerGetExtensionResourceProxy may be implemented by a ExtensionHandlerProxy to handle GetExtensionResource.
+type ExtensionHandlerGetExtensionResourceProxy interface {
+	GetExtensionResource(self *ExtensionHandler, extension *Extension, browser *Browser, file string, callback *GetExtensionResourceCallback) bool
 }
 
 // ExtensionHandler (cef_extension_handler_t from include/capi/cef_extension_handler_capi.h)
@@ -34,7 +72,15 @@ type ExtensionHandler C.cef_extension_handler_t
 func NewExtensionHandler(proxy ExtensionHandlerProxy) *ExtensionHandler {
 	result := (*ExtensionHandler)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_extension_handler_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_extension_handler_proxy(result.toNative())
+		_, on_extension_load_failed := proxy.(ExtensionHandlerOnExtensionLoadFailedProxy)
+		_, on_extension_loaded := proxy.(ExtensionHandlerOnExtensionLoadedProxy)
+		_, on_extension_unloaded := proxy.(ExtensionHandlerOnExtensionUnloadedProxy)
+		_, on_before_background_browser := proxy.(ExtensionHandlerOnBeforeBackgroundBrowserProxy)
+		_, on_before_browser := proxy.(ExtensionHandlerOnBeforeBrowserProxy)
+		_, get_active_browser := proxy.(ExtensionHandlerGetActiveBrowserProxy)
+		_, can_access_browser := proxy.(ExtensionHandlerCanAccessBrowserProxy)
+		_, get_extension_resource := proxy.(ExtensionHandlerGetExtensionResourceProxy)
+		C.gocef_set_extension_handler_proxy(result.toNative(), cefBool(on_extension_load_failed), cefBool(on_extension_loaded), cefBool(on_extension_unloaded), cefBool(on_before_background_browser), cefBool(on_before_browser), cefBool(get_active_browser), cefBool(can_access_browser), cefBool(get_extension_resource))
 	}
 	return result
 }
@@ -43,14 +89,15 @@ func (d *ExtensionHandler) toNative() *C.cef_extension_handler_t {
 	return (*C.cef_extension_handler_t)(d)
 }
 
-func lookupExtensionHandlerProxy(obj *BaseRefCounted) ExtensionHandlerProxy {
+func lookupExtensionHandlerProxy(obj *BaseRefCounted, callback string) ExtensionHandlerProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("ExtensionHandler", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(ExtensionHandlerProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type ExtensionHandlerProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("ExtensionHandler", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -65,13 +112,19 @@ func (d *ExtensionHandler) Base() *BaseRefCounted {
 // Called if the cef_request_tContext::LoadExtension request fails. |result|
 // will be the error code.
 func (d *ExtensionHandler) OnExtensionLoadFailed(result Errorcode) {
-	lookupExtensionHandlerProxy(d.Base()).OnExtensionLoadFailed(d, result)
+	if proxy__, ok__ := lookupExtensionHandlerProxy(d.Base(), "OnExtensionLoadFailed").(ExtensionHandlerOnExtensionLoadFailedProxy); ok__ {
+		proxy__.OnExtensionLoadFailed(d, result)
+	}
 }
 
 //export gocef_extension_handler_on_extension_load_failed
 func gocef_extension_handler_on_extension_load_failed(self *C.cef_extension_handler_t, result C.cef_errorcode_t) {
+	defer recoverProxyPanic("ExtensionHandler", "OnExtensionLoadFailed")
 	me__ := (*ExtensionHandler)(self)
-	proxy__ := lookupExtensionHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupExtensionHandlerProxy(me__.Base(), "OnExtensionLoadFailed").(ExtensionHandlerOnExtensionLoadFailedProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnExtensionLoadFailed(me__, Errorcode(result))
 }
 
@@ -79,26 +132,38 @@ func gocef_extension_handler_on_extension_load_failed(self *C.cef_extension_hand
 // Called if the cef_request_tContext::LoadExtension request succeeds.
 // |extension| is the loaded extension.
 func (d *ExtensionHandler) OnExtensionLoaded(extension *Extension) {
-	lookupExtensionHandlerProxy(d.Base()).OnExtensionLoaded(d, extension)
+	if proxy__, ok__ := lookupExtensionHandlerProxy(d.Base(), "OnExtensionLoaded").(ExtensionHandlerOnExtensionLoadedProxy); ok__ {
+		proxy__.OnExtensionLoaded(d, extension)
+	}
 }
 
 //export gocef_extension_handler_on_extension_loaded
 func gocef_extension_handler_on_extension_loaded(self *C.cef_extension_handler_t, extension *C.cef_extension_t) {
+	defer recoverProxyPanic("ExtensionHandler", "OnExtensionLoaded")
 	me__ := (*ExtensionHandler)(self)
-	proxy__ := lookupExtensionHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupExtensionHandlerProxy(me__.Base(), "OnExtensionLoaded").(ExtensionHandlerOnExtensionLoadedProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnExtensionLoaded(me__, (*Extension)(extension))
 }
 
 // OnExtensionUnloaded (on_extension_unloaded)
 // Called after the cef_extension_t::Unload request has completed.
 func (d *ExtensionHandler) OnExtensionUnloaded(extension *Extension) {
-	lookupExtensionHandlerProxy(d.Base()).OnExtensionUnloaded(d, extension)
+	if proxy__, ok__ := lookupExtensionHandlerProxy(d.Base(), "OnExtensionUnloaded").(ExtensionHandlerOnExtensionUnloadedProxy); ok__ {
+		proxy__.OnExtensionUnloaded(d, extension)
+	}
 }
 
 //export gocef_extension_handler_on_extension_unloaded
 func gocef_extension_handler_on_extension_unloaded(self *C.cef_extension_handler_t, extension *C.cef_extension_t) {
+	defer recoverProxyPanic("ExtensionHandler", "OnExtensionUnloaded")
 	me__ := (*ExtensionHandler)(self)
-	proxy__ := lookupExtensionHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupExtensionHandlerProxy(me__.Base(), "OnExtensionUnloaded").(ExtensionHandlerOnExtensionUnloadedProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnExtensionUnloaded(me__, (*Extension)(extension))
 }
 
@@ -116,19 +181,29 @@ func gocef_extension_handler_on_extension_unloaded(self *C.cef_extension_handler
 // cef_browser_host_t::IsBackgroundHost will return true (1) for the resulting
 // browser. See https://developer.chrome.com/extensions/event_pages for more
 // information about extension background script usage.
-func (d *ExtensionHandler) OnBeforeBackgroundBrowser(extension *Extension, url string, client **Client, settings *BrowserSettings) int32 {
-	return lookupExtensionHandlerProxy(d.Base()).OnBeforeBackgroundBrowser(d, extension, url, client, settings)
+func (d *ExtensionHandler) OnBeforeBackgroundBrowser(extension *Extension, url string, client **Client, settings *BrowserSettings) bool {
+	if proxy__, ok__ := lookupExtensionHandlerProxy(d.Base(), "OnBeforeBackgroundBrowser").(ExtensionHandlerOnBeforeBackgroundBrowserProxy); ok__ {
+		return proxy__.OnBeforeBackgroundBrowser(d, extension, url, client, settings)
+	}
+	return false
 }
 
 //export gocef_extension_handler_on_before_background_browser
 func gocef_extension_handler_on_before_background_browser(self *C.cef_extension_handler_t, extension *C.cef_extension_t, url *C.cef_string_t, client **C.cef_client_t, settings *C.cef_browser_settings_t) C.int {
+	defer recoverProxyPanic("ExtensionHandler", "OnBeforeBackgroundBrowser")
 	me__ := (*ExtensionHandler)(self)
-	proxy__ := lookupExtensionHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupExtensionHandlerProxy(me__.Base(), "OnBeforeBackgroundBrowser").(ExtensionHandlerOnBeforeBackgroundBrowserProxy)
+	if !ok__ {
+		return 0
+	}
 	url_ := cefstrToString(url)
 	client_ := (*Client)(*client)
 	client__p := &client_
 	settings_ := settings.toGo()
-	return C.int(proxy__.OnBeforeBackgroundBrowser(me__, (*Extension)(extension), url_, client__p, settings_))
+	defer func() {
+		settings_.toNative(settings)
+	}()
+	return cefBool(proxy__.OnBeforeBackgroundBrowser(me__, (*Extension)(extension), url_, client__p, settings_))
 }
 
 // OnBeforeBrowser (on_before_browser)
@@ -145,20 +220,33 @@ func gocef_extension_handler_on_before_background_browser(self *C.cef_extension_
 // indicated by a call to cef_life_span_handler_t::OnAfterCreated. Any
 // modifications to |windowInfo| will be ignored if |active_browser| is
 // wrapped in a cef_browser_view_t.
-func (d *ExtensionHandler) OnBeforeBrowser(extension *Extension, browser, active_browser *Browser, index int32, url string, active int32, windowInfo *WindowInfo, client **Client, settings *BrowserSettings) int32 {
-	return lookupExtensionHandlerProxy(d.Base()).OnBeforeBrowser(d, extension, browser, active_browser, index, url, active, windowInfo, client, settings)
+func (d *ExtensionHandler) OnBeforeBrowser(extension *Extension, browser, active_browser *Browser, index int32, url string, active bool, windowInfo *WindowInfo, client **Client, settings *BrowserSettings) bool {
+	if proxy__, ok__ := lookupExtensionHandlerProxy(d.Base(), "OnBeforeBrowser").(ExtensionHandlerOnBeforeBrowserProxy); ok__ {
+		return proxy__.OnBeforeBrowser(d, extension, browser, active_browser, index, url, active, windowInfo, client, settings)
+	}
+	return false
 }
 
 //export gocef_extension_handler_on_before_browser
 func gocef_extension_handler_on_before_browser(self *C.cef_extension_handler_t, extension *C.cef_extension_t, browser *C.cef_browser_t, active_browser *C.cef_browser_t, index C.int, url *C.cef_string_t, active C.int, windowInfo *C.cef_window_info_t, client **C.cef_client_t, settings *C.cef_browser_settings_t) C.int {
+	defer recoverProxyPanic("ExtensionHandler", "OnBeforeBrowser")
 	me__ := (*ExtensionHandler)(self)
-	proxy__ := lookupExtensionHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupExtensionHandlerProxy(me__.Base(), "OnBeforeBrowser").(ExtensionHandlerOnBeforeBrowserProxy)
+	if !ok__ {
+		return 0
+	}
 	url_ := cefstrToString(url)
 	windowInfo_ := windowInfo.toGo()
+	defer func() {
+		windowInfo_.toNative(windowInfo)
+	}()
 	client_ := (*Client)(*client)
 	client__p := &client_
 	settings_ := settings.toGo()
-	return C.int(proxy__.OnBeforeBrowser(me__, (*Extension)(extension), (*Browser)(browser), (*Browser)(active_browser), int32(index), url_, int32(active), windowInfo_, client__p, settings_))
+	defer func() {
+		settings_.toNative(settings)
+	}()
+	return cefBool(proxy__.OnBeforeBrowser(me__, (*Extension)(extension), (*Browser)(browser), (*Browser)(active_browser), int32(index), url_, active != 0, windowInfo_, client__p, settings_))
 }
 
 // GetActiveBrowser (get_active_browser)
@@ -169,15 +257,22 @@ func gocef_extension_handler_on_before_browser(self *C.cef_extension_handler_t,
 // the same cef_request_tContext as |browser|. Incognito browsers should not
 // be considered unless the source extension has incognito access enabled, in
 // which case |include_incognito| will be true (1).
-func (d *ExtensionHandler) GetActiveBrowser(extension *Extension, browser *Browser, include_incognito int32) *Browser {
-	return lookupExtensionHandlerProxy(d.Base()).GetActiveBrowser(d, extension, browser, include_incognito)
+func (d *ExtensionHandler) GetActiveBrowser(extension *Extension, browser *Browser, include_incognito bool) *Browser {
+	if proxy__, ok__ := lookupExtensionHandlerProxy(d.Base(), "GetActiveBrowser").(ExtensionHandlerGetActiveBrowserProxy); ok__ {
+		return proxy__.GetActiveBrowser(d, extension, browser, include_incognito)
+	}
+	return nil
 }
 
 //export gocef_extension_handler_get_active_browser
 func gocef_extension_handler_get_active_browser(self *C.cef_extension_handler_t, extension *C.cef_extension_t, browser *C.cef_browser_t, include_incognito C.int) *C.cef_browser_t {
+	defer recoverProxyPanic("ExtensionHandler", "GetActiveBrowser")
 	me__ := (*ExtensionHandler)(self)
-	proxy__ := lookupExtensionHandlerProxy(me__.Base())
-	return (proxy__.GetActiveBrowser(me__, (*Extension)(extension), (*Browser)(browser), int32(include_incognito))).toNative()
+	proxy__, ok__ := lookupExtensionHandlerProxy(me__.Base(), "GetActiveBrowser").(ExtensionHandlerGetActiveBrowserProxy)
+	if !ok__ {
+		return nil
+	}
+	return (proxy__.GetActiveBrowser(me__, (*Extension)(extension), (*Browser)(browser), include_incognito != 0)).toNative()
 }
 
 // CanAccessBrowser (can_access_browser)
@@ -187,15 +282,22 @@ func gocef_extension_handler_get_active_browser(self *C.cef_extension_handler_t,
 // to allow access of false (0) to deny access. Access to incognito browsers
 // should not be allowed unless the source extension has incognito access
 // enabled, in which case |include_incognito| will be true (1).
-func (d *ExtensionHandler) CanAccessBrowser(extension *Extension, browser *Browser, include_incognito int32, target_browser *Browser) int32 {
-	return lookupExtensionHandlerProxy(d.Base()).CanAccessBrowser(d, extension, browser, include_incognito, target_browser)
+func (d *ExtensionHandler) CanAccessBrowser(extension *Extension, browser *Browser, include_incognito bool, target_browser *Browser) bool {
+	if proxy__, ok__ := lookupExtensionHandlerProxy(d.Base(), "CanAccessBrowser").(ExtensionHandlerCanAccessBrowserProxy); ok__ {
+		return proxy__.CanAccessBrowser(d, extension, browser, include_incognito, target_browser)
+	}
+	return false
 }
 
 //export gocef_extension_handler_can_access_browser
 func gocef_extension_handler_can_access_browser(self *C.cef_extension_handler_t, extension *C.cef_extension_t, browser *C.cef_browser_t, include_incognito C.int, target_browser *C.cef_browser_t) C.int {
+	defer recoverProxyPanic("ExtensionHandler", "CanAccessBrowser")
 	me__ := (*ExtensionHandler)(self)
-	proxy__ := lookupExtensionHandlerProxy(me__.Base())
-	return C.int(proxy__.CanAccessBrowser(me__, (*Extension)(extension), (*Browser)(browser), int32(include_incognito), (*Browser)(target_browser)))
+	proxy__, ok__ := lookupExtensionHandlerProxy(me__.Base(), "CanAccessBrowser").(ExtensionHandlerCanAccessBrowserProxy)
+	if !ok__ {
+		return 0
+	}
+	return cefBool(proxy__.CanAccessBrowser(me__, (*Extension)(extension), (*Browser)(browser), include_incognito != 0, (*Browser)(target_browser)))
 }
 
 // GetExtensionResource (get_extension_resource)
@@ -207,14 +309,21 @@ func gocef_extension_handler_can_access_browser(self *C.cef_extension_handler_t,
 // the default behavior which reads the resource from the extension directory
 // on disk return false (0). Localization substitutions will not be applied to
 // resources handled via this function.
-func (d *ExtensionHandler) GetExtensionResource(extension *Extension, browser *Browser, file string, callback *GetExtensionResourceCallback) int32 {
-	return lookupExtensionHandlerProxy(d.Base()).GetExtensionResource(d, extension, browser, file, callback)
+func (d *ExtensionHandler) GetExtensionResource(extension *Extension, browser *Browser, file string, callback *GetExtensionResourceCallback) bool {
+	if proxy__, ok__ := lookupExtensionHandlerProxy(d.Base(), "GetExtensionResource").(ExtensionHandlerGetExtensionResourceProxy); ok__ {
+		return proxy__.GetExtensionResource(d, extension, browser, file, callback)
+	}
+	return false
 }
 
 //export gocef_extension_handler_get_extension_resource
 func gocef_extension_handler_get_extension_resource(self *C.cef_extension_handler_t, extension *C.cef_extension_t, browser *C.cef_browser_t, file *C.cef_string_t, callback *C.cef_get_extension_resource_callback_t) C.int {
+	defer recoverProxyPanic("ExtensionHandler", "GetExtensionResource")
 	me__ := (*ExtensionHandler)(self)
-	proxy__ := lookupExtensionHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupExtensionHandlerProxy(me__.Base(), "GetExtensionResource").(ExtensionHandlerGetExtensionResourceProxy)
+	if !ok__ {
+		return 0
+	}
 	file_ := cefstrToString(file)
-	return C.int(proxy__.GetExtensionResource(me__, (*Extension)(extension), (*Browser)(browser), file_, (*GetExtensionResourceCallback)(callback)))
+	return cefBool(proxy__.GetExtensionResource(me__, (*Extension)(extension), (*Browser)(browser), file_, (*GetExtensionResourceCallback)(callback)))
 }
@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_extension_handler_proxy(cef_extension_handler_t *self);
+void gocef_set_extension_handler_proxy(cef_extension_handler_t *self, int on_extension_load_failed, int on_extension_loaded, int on_extension_unloaded, int on_before_background_browser, int on_before_browser, int get_active_browser, int can_access_browser, int get_extension_resource);
 
 #endif // GOCEF_ExtensionHandler_H_
@@ -51,6 +51,7 @@ func (d *Extension) GetPath() string {
 // GetManifest (get_manifest)
 // Returns the extension manifest contents as a cef_dictionary_value_t object.
 // See https://developer.chrome.com/extensions/manifest for details.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *Extension) GetManifest() *DictionaryValue {
 	return (*DictionaryValue)(C.gocef_extension_get_manifest(d.toNative(), d.get_manifest))
 }
@@ -59,14 +60,15 @@ func (d *Extension) GetManifest() *DictionaryValue {
 // Returns true (1) if this object is the same extension as |that| object.
 // Extensions are considered the same if identifier, path and loader context
 // match.
-func (d *Extension) IsSame(that *Extension) int32 {
-	return int32(C.gocef_extension_is_same(d.toNative(), that.toNative(), d.is_same))
+func (d *Extension) IsSame(that *Extension) bool {
+	return C.gocef_extension_is_same(d.toNative(), that.toNative(), d.is_same) != 0
 }
 
 // GetHandler (get_handler)
 // Returns the handler for this extension. Will return NULL for internal
 // extensions or if no handler was passed to
 // cef_request_tContext::LoadExtension.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *Extension) GetHandler() *ExtensionHandler {
 	return (*ExtensionHandler)(C.gocef_extension_get_handler(d.toNative(), d.get_handler))
 }
@@ -76,6 +78,7 @@ func (d *Extension) GetHandler() *ExtensionHandler {
 // for internal extensions or if the extension has been unloaded. See the
 // cef_request_tContext::LoadExtension documentation for more information
 // about loader contexts. Must be called on the browser process UI thread.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *Extension) GetLoaderContext() *RequestContext {
 	return (*RequestContext)(C.gocef_extension_get_loader_context(d.toNative(), d.get_loader_context))
 }
@@ -83,8 +86,8 @@ func (d *Extension) GetLoaderContext() *RequestContext {
 // IsLoaded (is_loaded)
 // Returns true (1) if this extension is currently loaded. Must be called on
 // the browser process UI thread.
-func (d *Extension) IsLoaded() int32 {
-	return int32(C.gocef_extension_is_loaded(d.toNative(), d.is_loaded))
+func (d *Extension) IsLoaded() bool {
+	return C.gocef_extension_is_loaded(d.toNative(), d.is_loaded) != 0
 }
 
 // Unload (unload)
@@ -1,11 +0,0 @@
-// Code generated - DO NOT EDIT.
-
-#ifndef GOCEF_FileDialogCallback_H_
-#define GOCEF_FileDialogCallback_H_
-#pragma once
-
-#include "capi_gen.h"
-
-void gocef_set_file_dialog_callback_proxy(cef_file_dialog_callback_t *self);
-
-#endif // GOCEF_FileDialogCallback_H_
@@ -3,8 +3,10 @@
 #include "FindHandler_gen.h"
 #include "_cgo_export.h"
 
-void gocef_set_find_handler_proxy(cef_find_handler_t *self) {
+void gocef_set_find_handler_proxy(cef_find_handler_t *self, int on_find_result) {
 	// Casts to (void *) added to avoid warnings since Go callbacks can't have
 	// some modifiers, such as 'const' applied to their parameter signatures.
-	self->on_find_result = (void *)&gocef_find_handler_on_find_result;
+	if (on_find_result) {
+		self->on_find_result = (void *)&gocef_find_handler_on_find_result;
+	}
 }
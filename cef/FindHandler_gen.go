@@ -6,14 +6,24 @@ import (
 	// #include "FindHandler_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// FindHandlerProxy defines methods required for using FindHandler.
+// FindHandlerProxy is implemented by the proxies used with FindHandler. Embed
+// DefaultFindHandler to satisfy it, then implement any of the optional
+// FindHandler*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type FindHandlerProxy interface {
-	OnFindResult(self *FindHandler, browser *Browser, identifier, count int32, selectionRect *Rect, activeMatchOrdinal, finalUpdate int32)
+	isFindHandlerProxy()
+}
+
+// DefaultFindHandler may be embedded to satisfy FindHandlerProxy.
+type DefaultFindHandler struct{}
+
+func (DefaultFindHandler) isFindHandlerProxy() {}
+
+// FindHandlerOnFindResultProxy may be implemented by a FindHandlerProxy to handle OnFindResult.
+type FindHandlerOnFindResultProxy interface {
+	OnFindResult(self *FindHandler, browser *Browser, identifier, count int32, selectionRect *Rect, activeMatchOrdinal int32, finalUpdate bool)
 }
 
 // FindHandler (cef_find_handler_t from include/capi/cef_find_handler_capi.h)
@@ -26,7 +36,8 @@ type FindHandler C.cef_find_handler_t
 func NewFindHandler(proxy FindHandlerProxy) *FindHandler {
 	result := (*FindHandler)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_find_handler_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_find_handler_proxy(result.toNative())
+		_, on_find_result := proxy.(FindHandlerOnFindResultProxy)
+		C.gocef_set_find_handler_proxy(result.toNative(), cefBool(on_find_result))
 	}
 	return result
 }
@@ -35,14 +46,15 @@ func (d *FindHandler) toNative() *C.cef_find_handler_t {
 	return (*C.cef_find_handler_t)(d)
 }
 
-func lookupFindHandlerProxy(obj *BaseRefCounted) FindHandlerProxy {
+func lookupFindHandlerProxy(obj *BaseRefCounted, callback string) FindHandlerProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("FindHandler", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(FindHandlerProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type FindHandlerProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("FindHandler", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -60,14 +72,20 @@ func (d *FindHandler) Base() *BaseRefCounted {
 // match was found (in window coordinates), |activeMatchOrdinal| is the
 // current position in the search results, and |finalUpdate| is true (1) if
 // this is the last find notification.
-func (d *FindHandler) OnFindResult(browser *Browser, identifier, count int32, selectionRect *Rect, activeMatchOrdinal, finalUpdate int32) {
-	lookupFindHandlerProxy(d.Base()).OnFindResult(d, browser, identifier, count, selectionRect, activeMatchOrdinal, finalUpdate)
+func (d *FindHandler) OnFindResult(browser *Browser, identifier, count int32, selectionRect *Rect, activeMatchOrdinal int32, finalUpdate bool) {
+	if proxy__, ok__ := lookupFindHandlerProxy(d.Base(), "OnFindResult").(FindHandlerOnFindResultProxy); ok__ {
+		proxy__.OnFindResult(d, browser, identifier, count, selectionRect, activeMatchOrdinal, finalUpdate)
+	}
 }
 
 //export gocef_find_handler_on_find_result
 func gocef_find_handler_on_find_result(self *C.cef_find_handler_t, browser *C.cef_browser_t, identifier C.int, count C.int, selectionRect *C.cef_rect_t, activeMatchOrdinal C.int, finalUpdate C.int) {
+	defer recoverProxyPanic("FindHandler", "OnFindResult")
 	me__ := (*FindHandler)(self)
-	proxy__ := lookupFindHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupFindHandlerProxy(me__.Base(), "OnFindResult").(FindHandlerOnFindResultProxy)
+	if !ok__ {
+		return
+	}
 	selectionRect_ := selectionRect.toGo()
-	proxy__.OnFindResult(me__, (*Browser)(browser), int32(identifier), int32(count), selectionRect_, int32(activeMatchOrdinal), int32(finalUpdate))
+	proxy__.OnFindResult(me__, (*Browser)(browser), int32(identifier), int32(count), selectionRect_, int32(activeMatchOrdinal), finalUpdate != 0)
 }
@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_find_handler_proxy(cef_find_handler_t *self);
+void gocef_set_find_handler_proxy(cef_find_handler_t *self, int on_find_result);
 
 #endif // GOCEF_FindHandler_H_
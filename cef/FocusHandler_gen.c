@@ -3,10 +3,16 @@
 #include "FocusHandler_gen.h"
 #include "_cgo_export.h"
 
-void gocef_set_focus_handler_proxy(cef_focus_handler_t *self) {
+void gocef_set_focus_handler_proxy(cef_focus_handler_t *self, int on_take_focus, int on_set_focus, int on_got_focus) {
 	// Casts to (void *) added to avoid warnings since Go callbacks can't have
 	// some modifiers, such as 'const' applied to their parameter signatures.
-	self->on_take_focus = (void *)&gocef_focus_handler_on_take_focus;
-	self->on_set_focus = (void *)&gocef_focus_handler_on_set_focus;
-	self->on_got_focus = (void *)&gocef_focus_handler_on_got_focus;
+	if (on_take_focus) {
+		self->on_take_focus = (void *)&gocef_focus_handler_on_take_focus;
+	}
+	if (on_set_focus) {
+		self->on_set_focus = (void *)&gocef_focus_handler_on_set_focus;
+	}
+	if (on_got_focus) {
+		self->on_got_focus = (void *)&gocef_focus_handler_on_got_focus;
+	}
 }
@@ -6,15 +6,33 @@ import (
 	// #include "FocusHandler_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// FocusHandlerProxy defines methods required for using FocusHandler.
+// FocusHandlerProxy is implemented by the proxies used with FocusHandler. Embed
+// DefaultFocusHandler to satisfy it, then implement any of the optional
+// FocusHandler*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type FocusHandlerProxy interface {
-	OnTakeFocus(self *FocusHandler, browser *Browser, next int32)
-	OnSetFocus(self *FocusHandler, browser *Browser, source FocusSource) int32
+	isFocusHandlerProxy()
+}
+
+// DefaultFocusHandler may be embedded to satisfy FocusHandlerProxy.
+type DefaultFocusHandler struct{}
+
+func (DefaultFocusHandler) isFocusHandlerProxy() {}
+
+// FocusHandlerOnTakeFocusProxy may be implemented by a FocusHandlerProxy to handle OnTakeFocus.
+type FocusHandlerOnTakeFocusProxy interface {
+	OnTakeFocus(self *FocusHandler, browser *Browser, next bool)
+}
+
+// FocusHandlerOnSetFocusProxy may be implemented by a FocusHandlerProxy to handle OnSetFocus.
+type FocusHandlerOnSetFocusProxy interface {
+	OnSetFocus(self *FocusHandler, browser *Browser, source FocusSource) bool
+}
+
+// FocusHandlerOnGotFocusProxy may be implemented by a FocusHandlerProxy to handle OnGotFocus.
+type FocusHandlerOnGotFocusProxy interface {
 	OnGotFocus(self *FocusHandler, browser *Browser)
 }
 
@@ -28,7 +46,10 @@ type FocusHandler C.cef_focus_handler_t
 func NewFocusHandler(proxy FocusHandlerProxy) *FocusHandler {
 	result := (*FocusHandler)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_focus_handler_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_focus_handler_proxy(result.toNative())
+		_, on_take_focus := proxy.(FocusHandlerOnTakeFocusProxy)
+		_, on_set_focus := proxy.(FocusHandlerOnSetFocusProxy)
+		_, on_got_focus := proxy.(FocusHandlerOnGotFocusProxy)
+		C.gocef_set_focus_handler_proxy(result.toNative(), cefBool(on_take_focus), cefBool(on_set_focus), cefBool(on_got_focus))
 	}
 	return result
 }
@@ -37,14 +58,15 @@ func (d *FocusHandler) toNative() *C.cef_focus_handler_t {
 	return (*C.cef_focus_handler_t)(d)
 }
 
-func lookupFocusHandlerProxy(obj *BaseRefCounted) FocusHandlerProxy {
+func lookupFocusHandlerProxy(obj *BaseRefCounted, callback string) FocusHandlerProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("FocusHandler", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(FocusHandlerProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type FocusHandlerProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("FocusHandler", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -60,41 +82,60 @@ func (d *FocusHandler) Base() *BaseRefCounted {
 // focus was on the last HTML element and the user pressed the TAB key. |next|
 // will be true (1) if the browser is giving focus to the next component and
 // false (0) if the browser is giving focus to the previous component.
-func (d *FocusHandler) OnTakeFocus(browser *Browser, next int32) {
-	lookupFocusHandlerProxy(d.Base()).OnTakeFocus(d, browser, next)
+func (d *FocusHandler) OnTakeFocus(browser *Browser, next bool) {
+	if proxy__, ok__ := lookupFocusHandlerProxy(d.Base(), "OnTakeFocus").(FocusHandlerOnTakeFocusProxy); ok__ {
+		proxy__.OnTakeFocus(d, browser, next)
+	}
 }
 
 //export gocef_focus_handler_on_take_focus
 func gocef_focus_handler_on_take_focus(self *C.cef_focus_handler_t, browser *C.cef_browser_t, next C.int) {
+	defer recoverProxyPanic("FocusHandler", "OnTakeFocus")
 	me__ := (*FocusHandler)(self)
-	proxy__ := lookupFocusHandlerProxy(me__.Base())
-	proxy__.OnTakeFocus(me__, (*Browser)(browser), int32(next))
+	proxy__, ok__ := lookupFocusHandlerProxy(me__.Base(), "OnTakeFocus").(FocusHandlerOnTakeFocusProxy)
+	if !ok__ {
+		return
+	}
+	proxy__.OnTakeFocus(me__, (*Browser)(browser), next != 0)
 }
 
 // OnSetFocus (on_set_focus)
 // Called when the browser component is requesting focus. |source| indicates
 // where the focus request is originating from. Return false (0) to allow the
 // focus to be set or true (1) to cancel setting the focus.
-func (d *FocusHandler) OnSetFocus(browser *Browser, source FocusSource) int32 {
-	return lookupFocusHandlerProxy(d.Base()).OnSetFocus(d, browser, source)
+func (d *FocusHandler) OnSetFocus(browser *Browser, source FocusSource) bool {
+	if proxy__, ok__ := lookupFocusHandlerProxy(d.Base(), "OnSetFocus").(FocusHandlerOnSetFocusProxy); ok__ {
+		return proxy__.OnSetFocus(d, browser, source)
+	}
+	return false
 }
 
 //export gocef_focus_handler_on_set_focus
 func gocef_focus_handler_on_set_focus(self *C.cef_focus_handler_t, browser *C.cef_browser_t, source C.cef_focus_source_t) C.int {
+	defer recoverProxyPanic("FocusHandler", "OnSetFocus")
 	me__ := (*FocusHandler)(self)
-	proxy__ := lookupFocusHandlerProxy(me__.Base())
-	return C.int(proxy__.OnSetFocus(me__, (*Browser)(browser), FocusSource(source)))
+	proxy__, ok__ := lookupFocusHandlerProxy(me__.Base(), "OnSetFocus").(FocusHandlerOnSetFocusProxy)
+	if !ok__ {
+		return 0
+	}
+	return cefBool(proxy__.OnSetFocus(me__, (*Browser)(browser), FocusSource(source)))
 }
 
 // OnGotFocus (on_got_focus)
 // Called when the browser component has received focus.
 func (d *FocusHandler) OnGotFocus(browser *Browser) {
-	lookupFocusHandlerProxy(d.Base()).OnGotFocus(d, browser)
+	if proxy__, ok__ := lookupFocusHandlerProxy(d.Base(), "OnGotFocus").(FocusHandlerOnGotFocusProxy); ok__ {
+		proxy__.OnGotFocus(d, browser)
+	}
 }
 
 //export gocef_focus_handler_on_got_focus
 func gocef_focus_handler_on_got_focus(self *C.cef_focus_handler_t, browser *C.cef_browser_t) {
+	defer recoverProxyPanic("FocusHandler", "OnGotFocus")
 	me__ := (*FocusHandler)(self)
-	proxy__ := lookupFocusHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupFocusHandlerProxy(me__.Base(), "OnGotFocus").(FocusHandlerOnGotFocusProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnGotFocus(me__, (*Browser)(browser))
 }
@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_focus_handler_proxy(cef_focus_handler_t *self);
+void gocef_set_focus_handler_proxy(cef_focus_handler_t *self, int on_take_focus, int on_set_focus, int on_got_focus);
 
 #endif // GOCEF_FocusHandler_H_
@@ -50,8 +50,8 @@ func (d *Frame) Base() *BaseRefCounted {
 
 // IsValid (is_valid)
 // True if this object is currently attached to a valid frame.
-func (d *Frame) IsValid() int32 {
-	return int32(C.gocef_frame_is_valid(d.toNative(), d.is_valid))
+func (d *Frame) IsValid() bool {
+	return C.gocef_frame_is_valid(d.toNative(), d.is_valid) != 0
 }
 
 // Undo (undo)
@@ -175,14 +175,14 @@ func (d *Frame) ExecuteJavaScript(code, script_url string, start_line int32) {
 
 // IsMain (is_main)
 // Returns true (1) if this is the main (top-level) frame.
-func (d *Frame) IsMain() int32 {
-	return int32(C.gocef_frame_is_main(d.toNative(), d.is_main))
+func (d *Frame) IsMain() bool {
+	return C.gocef_frame_is_main(d.toNative(), d.is_main) != 0
 }
 
 // IsFocused (is_focused)
 // Returns true (1) if this is the focused frame.
-func (d *Frame) IsFocused() int32 {
-	return int32(C.gocef_frame_is_focused(d.toNative(), d.is_focused))
+func (d *Frame) IsFocused() bool {
+	return C.gocef_frame_is_focused(d.toNative(), d.is_focused) != 0
 }
 
 // GetName (get_name)
@@ -206,6 +206,7 @@ func (d *Frame) GetIdentifier() int64 {
 // GetParent (get_parent)
 // Returns the parent of this frame or NULL if this is the main (top-level)
 // frame.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *Frame) GetParent() *Frame {
 	return (*Frame)(C.gocef_frame_get_parent(d.toNative(), d.get_parent))
 }
@@ -219,6 +220,7 @@ func (d *Frame) GetUrl() string {
 
 // GetBrowser (get_browser)
 // Returns the browser that this frame belongs to.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *Frame) GetBrowser() *Browser {
 	return (*Browser)(C.gocef_frame_get_browser(d.toNative(), d.get_browser))
 }
@@ -226,6 +228,7 @@ func (d *Frame) GetBrowser() *Browser {
 // GetV8context (get_v8context)
 // Get the V8 context associated with the frame. This function can only be
 // called from the render process.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *Frame) GetV8context() *V8context {
 	return (*V8context)(C.gocef_frame_get_v8context(d.toNative(), d.get_v8context))
 }
@@ -1,11 +0,0 @@
-// Code generated - DO NOT EDIT.
-
-#ifndef GOCEF_GetExtensionResourceCallback_H_
-#define GOCEF_GetExtensionResourceCallback_H_
-#pragma once
-
-#include "capi_gen.h"
-
-void gocef_set_get_extension_resource_callback_proxy(cef_get_extension_resource_callback_t *self);
-
-#endif // GOCEF_GetExtensionResourceCallback_H_
@@ -42,15 +42,15 @@ func (d *Image) Base() *BaseRefCounted {
 
 // IsEmpty (is_empty)
 // Returns true (1) if this Image is NULL.
-func (d *Image) IsEmpty() int32 {
-	return int32(C.gocef_image_is_empty(d.toNative(), d.is_empty))
+func (d *Image) IsEmpty() bool {
+	return C.gocef_image_is_empty(d.toNative(), d.is_empty) != 0
 }
 
 // IsSame (is_same)
 // Returns true (1) if this Image and |that| Image share the same underlying
 // storage. Will also return true (1) if both images are NULL.
-func (d *Image) IsSame(that *Image) int32 {
-	return int32(C.gocef_image_is_same(d.toNative(), that.toNative(), d.is_same))
+func (d *Image) IsSame(that *Image) bool {
+	return C.gocef_image_is_same(d.toNative(), that.toNative(), d.is_same) != 0
 }
 
 // AddBitmap (add_bitmap)
@@ -59,24 +59,24 @@ func (d *Image) IsSame(that *Image) int32 {
 // representation size in pixel coordinates. |pixel_data| is the array of
 // pixel data and should be |pixel_width| x |pixel_height| x 4 bytes in size.
 // |color_type| and |alpha_type| values specify the pixel format.
-func (d *Image) AddBitmap(scale_factor float32, pixel_width, pixel_height int32, color_type ColorType, alpha_type AlphaType, pixel_data unsafe.Pointer, pixel_data_size uint64) int32 {
-	return int32(C.gocef_image_add_bitmap(d.toNative(), C.float(scale_factor), C.int(pixel_width), C.int(pixel_height), C.cef_color_type_t(color_type), C.cef_alpha_type_t(alpha_type), pixel_data, C.size_t(pixel_data_size), d.add_bitmap))
+func (d *Image) AddBitmap(scale_factor float32, pixel_width, pixel_height int32, color_type ColorType, alpha_type AlphaType, pixel_data unsafe.Pointer, pixel_data_size uint64) bool {
+	return C.gocef_image_add_bitmap(d.toNative(), C.float(scale_factor), C.int(pixel_width), C.int(pixel_height), C.cef_color_type_t(color_type), C.cef_alpha_type_t(alpha_type), pixel_data, C.size_t(pixel_data_size), d.add_bitmap) != 0
 }
 
 // AddPng (add_png)
 // Add a PNG image representation for |scale_factor|. |png_data| is the image
 // data of size |png_data_size|. Any alpha transparency in the PNG data will
 // be maintained.
-func (d *Image) AddPng(scale_factor float32, png_data unsafe.Pointer, png_data_size uint64) int32 {
-	return int32(C.gocef_image_add_png(d.toNative(), C.float(scale_factor), png_data, C.size_t(png_data_size), d.add_png))
+func (d *Image) AddPng(scale_factor float32, png_data unsafe.Pointer, png_data_size uint64) bool {
+	return C.gocef_image_add_png(d.toNative(), C.float(scale_factor), png_data, C.size_t(png_data_size), d.add_png) != 0
 }
 
 // AddJpeg (add_jpeg)
 // Create a JPEG image representation for |scale_factor|. |jpeg_data| is the
 // image data of size |jpeg_data_size|. The JPEG format does not support
 // transparency so the alpha byte will be set to 0xFF for all pixels.
-func (d *Image) AddJpeg(scale_factor float32, jpeg_data unsafe.Pointer, jpeg_data_size uint64) int32 {
-	return int32(C.gocef_image_add_jpeg(d.toNative(), C.float(scale_factor), jpeg_data, C.size_t(jpeg_data_size), d.add_jpeg))
+func (d *Image) AddJpeg(scale_factor float32, jpeg_data unsafe.Pointer, jpeg_data_size uint64) bool {
+	return C.gocef_image_add_jpeg(d.toNative(), C.float(scale_factor), jpeg_data, C.size_t(jpeg_data_size), d.add_jpeg) != 0
 }
 
 // GetWidth (get_width)
@@ -94,14 +94,14 @@ func (d *Image) GetHeight() uint64 {
 // HasRepresentation (has_representation)
 // Returns true (1) if this image contains a representation for
 // |scale_factor|.
-func (d *Image) HasRepresentation(scale_factor float32) int32 {
-	return int32(C.gocef_image_has_representation(d.toNative(), C.float(scale_factor), d.has_representation))
+func (d *Image) HasRepresentation(scale_factor float32) bool {
+	return C.gocef_image_has_representation(d.toNative(), C.float(scale_factor), d.has_representation) != 0
 }
 
 // RemoveRepresentation (remove_representation)
 // Removes the representation for |scale_factor|. Returns true (1) on success.
-func (d *Image) RemoveRepresentation(scale_factor float32) int32 {
-	return int32(C.gocef_image_remove_representation(d.toNative(), C.float(scale_factor), d.remove_representation))
+func (d *Image) RemoveRepresentation(scale_factor float32) bool {
+	return C.gocef_image_remove_representation(d.toNative(), C.float(scale_factor), d.remove_representation) != 0
 }
 
 // GetRepresentationInfo (get_representation_info)
@@ -109,8 +109,8 @@ func (d *Image) RemoveRepresentation(scale_factor float32) int32 {
 // |scale_factor|. |actual_scale_factor| is the actual scale factor for the
 // representation. |pixel_width| and |pixel_height| are the representation
 // size in pixel coordinates. Returns true (1) on success.
-func (d *Image) GetRepresentationInfo(scale_factor float32, actual_scale_factor *float32, pixel_width, pixel_height *int32) int32 {
-	return int32(C.gocef_image_get_representation_info(d.toNative(), C.float(scale_factor), (*C.float)(actual_scale_factor), (*C.int)(pixel_width), (*C.int)(pixel_height), d.get_representation_info))
+func (d *Image) GetRepresentationInfo(scale_factor float32, actual_scale_factor *float32, pixel_width, pixel_height *int32) bool {
+	return C.gocef_image_get_representation_info(d.toNative(), C.float(scale_factor), (*C.float)(actual_scale_factor), (*C.int)(pixel_width), (*C.int)(pixel_height), d.get_representation_info) != 0
 }
 
 // GetAsBitmap (get_as_bitmap)
@@ -120,6 +120,7 @@ func (d *Image) GetRepresentationInfo(scale_factor float32, actual_scale_factor
 // |pixel_height| are the output representation size in pixel coordinates.
 // Returns a cef_binary_value_t containing the pixel data on success or NULL
 // on failure.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *Image) GetAsBitmap(scale_factor float32, color_type ColorType, alpha_type AlphaType, pixel_width, pixel_height *int32) *BinaryValue {
 	return (*BinaryValue)(C.gocef_image_get_as_bitmap(d.toNative(), C.float(scale_factor), C.cef_color_type_t(color_type), C.cef_alpha_type_t(alpha_type), (*C.int)(pixel_width), (*C.int)(pixel_height), d.get_as_bitmap))
 }
@@ -131,8 +132,9 @@ func (d *Image) GetAsBitmap(scale_factor float32, color_type ColorType, alpha_ty
 // the output representation size in pixel coordinates. Returns a
 // cef_binary_value_t containing the PNG image data on success or NULL on
 // failure.
-func (d *Image) GetAsPng(scale_factor float32, with_transparency int32, pixel_width, pixel_height *int32) *BinaryValue {
-	return (*BinaryValue)(C.gocef_image_get_as_png(d.toNative(), C.float(scale_factor), C.int(with_transparency), (*C.int)(pixel_width), (*C.int)(pixel_height), d.get_as_png))
+// The caller owns a reference to the returned value; see Adopt().
+func (d *Image) GetAsPng(scale_factor float32, with_transparency bool, pixel_width, pixel_height *int32) *BinaryValue {
+	return (*BinaryValue)(C.gocef_image_get_as_png(d.toNative(), C.float(scale_factor), cefBool(with_transparency), (*C.int)(pixel_width), (*C.int)(pixel_height), d.get_as_png))
 }
 
 // GetAsJpeg (get_as_jpeg)
@@ -143,6 +145,7 @@ func (d *Image) GetAsPng(scale_factor float32, with_transparency int32, pixel_wi
 // the output representation size in pixel coordinates. Returns a
 // cef_binary_value_t containing the JPEG image data on success or NULL on
 // failure.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *Image) GetAsJpeg(scale_factor float32, quality int32, pixel_width, pixel_height *int32) *BinaryValue {
 	return (*BinaryValue)(C.gocef_image_get_as_jpeg(d.toNative(), C.float(scale_factor), C.int(quality), (*C.int)(pixel_width), (*C.int)(pixel_height), d.get_as_jpeg))
 }
@@ -1,11 +0,0 @@
-// Code generated - DO NOT EDIT.
-
-#ifndef GOCEF_JsdialogCallback_H_
-#define GOCEF_JsdialogCallback_H_
-#pragma once
-
-#include "capi_gen.h"
-
-void gocef_set_jsdialog_callback_proxy(cef_jsdialog_callback_t *self);
-
-#endif // GOCEF_JsdialogCallback_H_
@@ -6,16 +6,38 @@ import (
 	// #include "JsdialogHandler_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// JsdialogHandlerProxy defines methods required for using JsdialogHandler.
+// JsdialogHandlerProxy is implemented by the proxies used with JsdialogHandler. Embed
+// DefaultJsdialogHandler to satisfy it, then implement any of the optional
+// JsdialogHandler*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type JsdialogHandlerProxy interface {
-	OnJsdialog(self *JsdialogHandler, browser *Browser, origin_url string, dialog_type JsdialogType, message_text, default_prompt_text string, callback *JsdialogCallback, suppress_message *int32) int32
-	OnBeforeUnloadDialog(self *JsdialogHandler, browser *Browser, message_text string, is_reload int32, callback *JsdialogCallback) int32
+	isJsdialogHandlerProxy()
+}
+
+// DefaultJsdialogHandler may be embedded to satisfy JsdialogHandlerProxy.
+type DefaultJsdialogHandler struct{}
+
+func (DefaultJsdialogHandler) isJsdialogHandlerProxy() {}
+
+// JsdialogHandlerOnJsdialogProxy may be implemented by a JsdialogHandlerProxy to handle OnJsdialog.
+type JsdialogHandlerOnJsdialogProxy interface {
+	OnJsdialog(self *JsdialogHandler, browser *Browser, origin_url string, dialog_type JsdialogType, message_text, default_prompt_text string, callback *JsdialogCallback, suppress_message *bool) bool
+}
+
+// JsdialogHandlerOnBeforeUnloadDialogProxy may be implemented by a JsdialogHandlerProxy to handle OnBeforeUnloadDialog.
+type JsdialogHandlerOnBeforeUnloadDialogProxy interface {
+	OnBeforeUnloadDialog(self *JsdialogHandler, browser *Browser, message_text string, is_reload bool, callback *JsdialogCallback) bool
+}
+
+// JsdialogHandlerOnResetDialogStateProxy may be implemented by a JsdialogHandlerProxy to handle OnResetDialogState.
+type JsdialogHandlerOnResetDialogStateProxy interface {
 	OnResetDialogState(self *JsdialogHandler, browser *Browser)
+}
+
+// JsdialogHandlerOnDialogClosedProxy may be implemented by a JsdialogHandlerProxy to handle OnDialogClosed.
+type JsdialogHandlerOnDialogClosedProxy interface {
 	OnDialogClosed(self *JsdialogHandler, browser *Browser)
 }
 
@@ -29,7 +51,11 @@ type JsdialogHandler C.cef_jsdialog_handler_t
 func NewJsdialogHandler(proxy JsdialogHandlerProxy) *JsdialogHandler {
 	result := (*JsdialogHandler)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_jsdialog_handler_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_jsdialog_handler_proxy(result.toNative())
+		_, on_jsdialog := proxy.(JsdialogHandlerOnJsdialogProxy)
+		_, on_before_unload_dialog := proxy.(JsdialogHandlerOnBeforeUnloadDialogProxy)
+		_, on_reset_dialog_state := proxy.(JsdialogHandlerOnResetDialogStateProxy)
+		_, on_dialog_closed := proxy.(JsdialogHandlerOnDialogClosedProxy)
+		C.gocef_set_jsdialog_handler_proxy(result.toNative(), cefBool(on_jsdialog), cefBool(on_before_unload_dialog), cefBool(on_reset_dialog_state), cefBool(on_dialog_closed))
 	}
 	return result
 }
@@ -38,14 +64,15 @@ func (d *JsdialogHandler) toNative() *C.cef_jsdialog_handler_t {
 	return (*C.cef_jsdialog_handler_t)(d)
 }
 
-func lookupJsdialogHandlerProxy(obj *BaseRefCounted) JsdialogHandlerProxy {
+func lookupJsdialogHandlerProxy(obj *BaseRefCounted, callback string) JsdialogHandlerProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("JsdialogHandler", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(JsdialogHandlerProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type JsdialogHandlerProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("JsdialogHandler", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -72,18 +99,29 @@ func (d *JsdialogHandler) Base() *BaseRefCounted {
 // Custom dialogs may be either modal or modeless. If a custom dialog is used
 // the application must execute |callback| once the custom dialog is
 // dismissed.
-func (d *JsdialogHandler) OnJsdialog(browser *Browser, origin_url string, dialog_type JsdialogType, message_text, default_prompt_text string, callback *JsdialogCallback, suppress_message *int32) int32 {
-	return lookupJsdialogHandlerProxy(d.Base()).OnJsdialog(d, browser, origin_url, dialog_type, message_text, default_prompt_text, callback, suppress_message)
+func (d *JsdialogHandler) OnJsdialog(browser *Browser, origin_url string, dialog_type JsdialogType, message_text, default_prompt_text string, callback *JsdialogCallback, suppress_message *bool) bool {
+	if proxy__, ok__ := lookupJsdialogHandlerProxy(d.Base(), "OnJsdialog").(JsdialogHandlerOnJsdialogProxy); ok__ {
+		return proxy__.OnJsdialog(d, browser, origin_url, dialog_type, message_text, default_prompt_text, callback, suppress_message)
+	}
+	return false
 }
 
 //export gocef_jsdialog_handler_on_jsdialog
 func gocef_jsdialog_handler_on_jsdialog(self *C.cef_jsdialog_handler_t, browser *C.cef_browser_t, origin_url *C.cef_string_t, dialog_type C.cef_jsdialog_type_t, message_text *C.cef_string_t, default_prompt_text *C.cef_string_t, callback *C.cef_jsdialog_callback_t, suppress_message *C.int) C.int {
+	defer recoverProxyPanic("JsdialogHandler", "OnJsdialog")
 	me__ := (*JsdialogHandler)(self)
-	proxy__ := lookupJsdialogHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupJsdialogHandlerProxy(me__.Base(), "OnJsdialog").(JsdialogHandlerOnJsdialogProxy)
+	if !ok__ {
+		return 0
+	}
 	origin_url_ := cefstrToString(origin_url)
 	message_text_ := cefstrToString(message_text)
 	default_prompt_text_ := cefstrToString(default_prompt_text)
-	return C.int(proxy__.OnJsdialog(me__, (*Browser)(browser), origin_url_, JsdialogType(dialog_type), message_text_, default_prompt_text_, (*JsdialogCallback)(callback), (*int32)(suppress_message)))
+	suppress_message_ := *suppress_message != 0
+	defer func() {
+		*suppress_message = cefBool(suppress_message_)
+	}()
+	return cefBool(proxy__.OnJsdialog(me__, (*Browser)(browser), origin_url_, JsdialogType(dialog_type), message_text_, default_prompt_text_, (*JsdialogCallback)(callback), &suppress_message_))
 }
 
 // OnBeforeUnloadDialog (on_before_unload_dialog)
@@ -93,16 +131,23 @@ func gocef_jsdialog_handler_on_jsdialog(self *C.cef_jsdialog_handler_t, browser
 // immediately. Custom dialogs may be either modal or modeless. If a custom
 // dialog is used the application must execute |callback| once the custom
 // dialog is dismissed.
-func (d *JsdialogHandler) OnBeforeUnloadDialog(browser *Browser, message_text string, is_reload int32, callback *JsdialogCallback) int32 {
-	return lookupJsdialogHandlerProxy(d.Base()).OnBeforeUnloadDialog(d, browser, message_text, is_reload, callback)
+func (d *JsdialogHandler) OnBeforeUnloadDialog(browser *Browser, message_text string, is_reload bool, callback *JsdialogCallback) bool {
+	if proxy__, ok__ := lookupJsdialogHandlerProxy(d.Base(), "OnBeforeUnloadDialog").(JsdialogHandlerOnBeforeUnloadDialogProxy); ok__ {
+		return proxy__.OnBeforeUnloadDialog(d, browser, message_text, is_reload, callback)
+	}
+	return false
 }
 
 //export gocef_jsdialog_handler_on_before_unload_dialog
 func gocef_jsdialog_handler_on_before_unload_dialog(self *C.cef_jsdialog_handler_t, browser *C.cef_browser_t, message_text *C.cef_string_t, is_reload C.int, callback *C.cef_jsdialog_callback_t) C.int {
+	defer recoverProxyPanic("JsdialogHandler", "OnBeforeUnloadDialog")
 	me__ := (*JsdialogHandler)(self)
-	proxy__ := lookupJsdialogHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupJsdialogHandlerProxy(me__.Base(), "OnBeforeUnloadDialog").(JsdialogHandlerOnBeforeUnloadDialogProxy)
+	if !ok__ {
+		return 0
+	}
 	message_text_ := cefstrToString(message_text)
-	return C.int(proxy__.OnBeforeUnloadDialog(me__, (*Browser)(browser), message_text_, int32(is_reload), (*JsdialogCallback)(callback)))
+	return cefBool(proxy__.OnBeforeUnloadDialog(me__, (*Browser)(browser), message_text_, is_reload != 0, (*JsdialogCallback)(callback)))
 }
 
 // OnResetDialogState (on_reset_dialog_state)
@@ -110,25 +155,37 @@ func gocef_jsdialog_handler_on_before_unload_dialog(self *C.cef_jsdialog_handler
 // be called due to events like page navigation irregardless of whether any
 // dialogs are currently pending.
 func (d *JsdialogHandler) OnResetDialogState(browser *Browser) {
-	lookupJsdialogHandlerProxy(d.Base()).OnResetDialogState(d, browser)
+	if proxy__, ok__ := lookupJsdialogHandlerProxy(d.Base(), "OnResetDialogState").(JsdialogHandlerOnResetDialogStateProxy); ok__ {
+		proxy__.OnResetDialogState(d, browser)
+	}
 }
 
 //export gocef_jsdialog_handler_on_reset_dialog_state
 func gocef_jsdialog_handler_on_reset_dialog_state(self *C.cef_jsdialog_handler_t, browser *C.cef_browser_t) {
+	defer recoverProxyPanic("JsdialogHandler", "OnResetDialogState")
 	me__ := (*JsdialogHandler)(self)
-	proxy__ := lookupJsdialogHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupJsdialogHandlerProxy(me__.Base(), "OnResetDialogState").(JsdialogHandlerOnResetDialogStateProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnResetDialogState(me__, (*Browser)(browser))
 }
 
 // OnDialogClosed (on_dialog_closed)
 // Called when the default implementation dialog is closed.
 func (d *JsdialogHandler) OnDialogClosed(browser *Browser) {
-	lookupJsdialogHandlerProxy(d.Base()).OnDialogClosed(d, browser)
+	if proxy__, ok__ := lookupJsdialogHandlerProxy(d.Base(), "OnDialogClosed").(JsdialogHandlerOnDialogClosedProxy); ok__ {
+		proxy__.OnDialogClosed(d, browser)
+	}
 }
 
 //export gocef_jsdialog_handler_on_dialog_closed
 func gocef_jsdialog_handler_on_dialog_closed(self *C.cef_jsdialog_handler_t, browser *C.cef_browser_t) {
+	defer recoverProxyPanic("JsdialogHandler", "OnDialogClosed")
 	me__ := (*JsdialogHandler)(self)
-	proxy__ := lookupJsdialogHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupJsdialogHandlerProxy(me__.Base(), "OnDialogClosed").(JsdialogHandlerOnDialogClosedProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnDialogClosed(me__, (*Browser)(browser))
 }
@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_jsdialog_handler_proxy(cef_jsdialog_handler_t *self);
+void gocef_set_jsdialog_handler_proxy(cef_jsdialog_handler_t *self, int on_jsdialog, int on_before_unload_dialog, int on_reset_dialog_state, int on_dialog_closed);
 
 #endif // GOCEF_JsdialogHandler_H_
@@ -30,7 +30,7 @@ type KeyEvent struct {
 	// Indicates whether the event is considered a "system key" event (see
 	// http://msdn.microsoft.com/en-us/library/ms646286(VS.85).aspx for details).
 	// This value will always be false on non-Windows platforms.
-	IsSystemKey int32
+	IsSystemKey bool
 	// Character (character)
 	// The character generated by the keystroke.
 	Character int16
@@ -41,7 +41,7 @@ type KeyEvent struct {
 	// FocusOnEditableField (focus_on_editable_field)
 	// True if the focus is currently on an editable field on the page. This is
 	// useful for determining if standard key events should be intercepted.
-	FocusOnEditableField int32
+	FocusOnEditableField bool
 }
 
 // NewKeyEvent creates a new KeyEvent.
@@ -57,10 +57,10 @@ func (d *KeyEvent) toNative(native *C.cef_key_event_t) *C.cef_key_event_t {
 	native.modifiers = C.uint32(d.Modifiers)
 	native.windows_key_code = C.int(d.WindowsKeyCode)
 	native.native_key_code = C.int(d.NativeKeyCode)
-	native.is_system_key = C.int(d.IsSystemKey)
+	native.is_system_key = cefBool(d.IsSystemKey)
 	native.character = C.char16(d.Character)
 	native.unmodified_character = C.char16(d.UnmodifiedCharacter)
-	native.focus_on_editable_field = C.int(d.FocusOnEditableField)
+	native.focus_on_editable_field = cefBool(d.FocusOnEditableField)
 	return native
 }
 
@@ -78,8 +78,8 @@ func (n *C.cef_key_event_t) intoGo(d *KeyEvent) {
 	d.Modifiers = uint32(n.modifiers)
 	d.WindowsKeyCode = int32(n.windows_key_code)
 	d.NativeKeyCode = int32(n.native_key_code)
-	d.IsSystemKey = int32(n.is_system_key)
+	d.IsSystemKey = n.is_system_key != 0
 	d.Character = int16(n.character)
 	d.UnmodifiedCharacter = int16(n.unmodified_character)
-	d.FocusOnEditableField = int32(n.focus_on_editable_field)
+	d.FocusOnEditableField = n.focus_on_editable_field != 0
 }
@@ -6,15 +6,29 @@ import (
 	// #include "KeyboardHandler_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// KeyboardHandlerProxy defines methods required for using KeyboardHandler.
+// KeyboardHandlerProxy is implemented by the proxies used with KeyboardHandler. Embed
+// DefaultKeyboardHandler to satisfy it, then implement any of the optional
+// KeyboardHandler*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type KeyboardHandlerProxy interface {
-	OnPreKeyEvent(self *KeyboardHandler, browser *Browser, event *KeyEvent, os_event unsafe.Pointer, is_keyboard_shortcut *int32) int32
-	OnKeyEvent(self *KeyboardHandler, browser *Browser, event *KeyEvent, os_event unsafe.Pointer) int32
+	isKeyboardHandlerProxy()
+}
+
+// DefaultKeyboardHandler may be embedded to satisfy KeyboardHandlerProxy.
+type DefaultKeyboardHandler struct{}
+
+func (DefaultKeyboardHandler) isKeyboardHandlerProxy() {}
+
+// KeyboardHandlerOnPreKeyEventProxy may be implemented by a KeyboardHandlerProxy to handle OnPreKeyEvent.
+type KeyboardHandlerOnPreKeyEventProxy interface {
+	OnPreKeyEvent(self *KeyboardHandler, browser *Browser, event *KeyEvent, os_event unsafe.Pointer, is_keyboard_shortcut *bool) bool
+}
+
+// KeyboardHandlerOnKeyEventProxy may be implemented by a KeyboardHandlerProxy to handle OnKeyEvent.
+type KeyboardHandlerOnKeyEventProxy interface {
+	OnKeyEvent(self *KeyboardHandler, browser *Browser, event *KeyEvent, os_event unsafe.Pointer) bool
 }
 
 // KeyboardHandler (cef_keyboard_handler_t from include/capi/cef_keyboard_handler_capi.h)
@@ -27,7 +41,9 @@ type KeyboardHandler C.cef_keyboard_handler_t
 func NewKeyboardHandler(proxy KeyboardHandlerProxy) *KeyboardHandler {
 	result := (*KeyboardHandler)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_keyboard_handler_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_keyboard_handler_proxy(result.toNative())
+		_, on_pre_key_event := proxy.(KeyboardHandlerOnPreKeyEventProxy)
+		_, on_key_event := proxy.(KeyboardHandlerOnKeyEventProxy)
+		C.gocef_set_keyboard_handler_proxy(result.toNative(), cefBool(on_pre_key_event), cefBool(on_key_event))
 	}
 	return result
 }
@@ -36,14 +52,15 @@ func (d *KeyboardHandler) toNative() *C.cef_keyboard_handler_t {
 	return (*C.cef_keyboard_handler_t)(d)
 }
 
-func lookupKeyboardHandlerProxy(obj *BaseRefCounted) KeyboardHandlerProxy {
+func lookupKeyboardHandlerProxy(obj *BaseRefCounted, callback string) KeyboardHandlerProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("KeyboardHandler", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(KeyboardHandlerProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type KeyboardHandlerProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("KeyboardHandler", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -60,16 +77,27 @@ func (d *KeyboardHandler) Base() *BaseRefCounted {
 // event message, if any. Return true (1) if the event was handled or false
 // (0) otherwise. If the event will be handled in on_key_event() as a keyboard
 // shortcut set |is_keyboard_shortcut| to true (1) and return false (0).
-func (d *KeyboardHandler) OnPreKeyEvent(browser *Browser, event *KeyEvent, os_event unsafe.Pointer, is_keyboard_shortcut *int32) int32 {
-	return lookupKeyboardHandlerProxy(d.Base()).OnPreKeyEvent(d, browser, event, os_event, is_keyboard_shortcut)
+func (d *KeyboardHandler) OnPreKeyEvent(browser *Browser, event *KeyEvent, os_event unsafe.Pointer, is_keyboard_shortcut *bool) bool {
+	if proxy__, ok__ := lookupKeyboardHandlerProxy(d.Base(), "OnPreKeyEvent").(KeyboardHandlerOnPreKeyEventProxy); ok__ {
+		return proxy__.OnPreKeyEvent(d, browser, event, os_event, is_keyboard_shortcut)
+	}
+	return false
 }
 
 //export gocef_keyboard_handler_on_pre_key_event
 func gocef_keyboard_handler_on_pre_key_event(self *C.cef_keyboard_handler_t, browser *C.cef_browser_t, event *C.cef_key_event_t, os_event unsafe.Pointer, is_keyboard_shortcut *C.int) C.int {
+	defer recoverProxyPanic("KeyboardHandler", "OnPreKeyEvent")
 	me__ := (*KeyboardHandler)(self)
-	proxy__ := lookupKeyboardHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupKeyboardHandlerProxy(me__.Base(), "OnPreKeyEvent").(KeyboardHandlerOnPreKeyEventProxy)
+	if !ok__ {
+		return 0
+	}
 	event_ := event.toGo()
-	return C.int(proxy__.OnPreKeyEvent(me__, (*Browser)(browser), event_, os_event, (*int32)(is_keyboard_shortcut)))
+	is_keyboard_shortcut_ := *is_keyboard_shortcut != 0
+	defer func() {
+		*is_keyboard_shortcut = cefBool(is_keyboard_shortcut_)
+	}()
+	return cefBool(proxy__.OnPreKeyEvent(me__, (*Browser)(browser), event_, os_event, &is_keyboard_shortcut_))
 }
 
 // OnKeyEvent (on_key_event)
@@ -77,14 +105,21 @@ func gocef_keyboard_handler_on_pre_key_event(self *C.cef_keyboard_handler_t, bro
 // handle the event. |event| contains information about the keyboard event.
 // |os_event| is the operating system event message, if any. Return true (1)
 // if the keyboard event was handled or false (0) otherwise.
-func (d *KeyboardHandler) OnKeyEvent(browser *Browser, event *KeyEvent, os_event unsafe.Pointer) int32 {
-	return lookupKeyboardHandlerProxy(d.Base()).OnKeyEvent(d, browser, event, os_event)
+func (d *KeyboardHandler) OnKeyEvent(browser *Browser, event *KeyEvent, os_event unsafe.Pointer) bool {
+	if proxy__, ok__ := lookupKeyboardHandlerProxy(d.Base(), "OnKeyEvent").(KeyboardHandlerOnKeyEventProxy); ok__ {
+		return proxy__.OnKeyEvent(d, browser, event, os_event)
+	}
+	return false
 }
 
 //export gocef_keyboard_handler_on_key_event
 func gocef_keyboard_handler_on_key_event(self *C.cef_keyboard_handler_t, browser *C.cef_browser_t, event *C.cef_key_event_t, os_event unsafe.Pointer) C.int {
+	defer recoverProxyPanic("KeyboardHandler", "OnKeyEvent")
 	me__ := (*KeyboardHandler)(self)
-	proxy__ := lookupKeyboardHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupKeyboardHandlerProxy(me__.Base(), "OnKeyEvent").(KeyboardHandlerOnKeyEventProxy)
+	if !ok__ {
+		return 0
+	}
 	event_ := event.toGo()
-	return C.int(proxy__.OnKeyEvent(me__, (*Browser)(browser), event_, os_event))
+	return cefBool(proxy__.OnKeyEvent(me__, (*Browser)(browser), event_, os_event))
 }
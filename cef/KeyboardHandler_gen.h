@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_keyboard_handler_proxy(cef_keyboard_handler_t *self);
+void gocef_set_keyboard_handler_proxy(cef_keyboard_handler_t *self, int on_pre_key_event, int on_key_event);
 
 #endif // GOCEF_KeyboardHandler_H_
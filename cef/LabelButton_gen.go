@@ -36,6 +36,7 @@ func (d *LabelButton) Base() *Button {
 // AsMenuButton (as_menu_button)
 // Returns this LabelButton as a MenuButton or NULL if this is not a
 // MenuButton.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *LabelButton) AsMenuButton() *MenuButton {
 	return (*MenuButton)(C.gocef_label_button_as_menu_button(d.toNative(), d.as_menu_button))
 }
@@ -70,6 +71,7 @@ func (d *LabelButton) SetImage(button_state ButtonState, image *Image) {
 // GetImage (get_image)
 // Returns the image shown for |button_state|. If no image exists for that
 // state then the image for CEF_BUTTON_STATE_NORMAL will be returned.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *LabelButton) GetImage(button_state ButtonState) *Image {
 	return (*Image)(C.gocef_label_button_get_image(d.toNative(), C.cef_button_state_t(button_state), d.get_image))
 }
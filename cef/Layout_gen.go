@@ -28,6 +28,7 @@ func (d *Layout) Base() *BaseRefCounted {
 
 // AsBoxLayout (as_box_layout)
 // Returns this Layout as a BoxLayout or NULL if this is not a BoxLayout.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *Layout) AsBoxLayout() *BoxLayout {
 	return (*BoxLayout)(C.gocef_layout_as_box_layout(d.toNative(), d.as_box_layout))
 }
@@ -40,6 +41,6 @@ func (d *Layout) AsFillLayout() *FillLayout {
 
 // IsValid (is_valid)
 // Returns true (1) if this Layout is valid.
-func (d *Layout) IsValid() int32 {
-	return int32(C.gocef_layout_is_valid(d.toNative(), d.is_valid))
+func (d *Layout) IsValid() bool {
+	return C.gocef_layout_is_valid(d.toNative(), d.is_valid) != 0
 }
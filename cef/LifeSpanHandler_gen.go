@@ -6,16 +6,38 @@ import (
 	// #include "LifeSpanHandler_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// LifeSpanHandlerProxy defines methods required for using LifeSpanHandler.
+// LifeSpanHandlerProxy is implemented by the proxies used with LifeSpanHandler. Embed
+// DefaultLifeSpanHandler to satisfy it, then implement any of the optional
+// LifeSpanHandler*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type LifeSpanHandlerProxy interface {
-	OnBeforePopup(self *LifeSpanHandler, browser *Browser, frame *Frame, target_url, target_frame_name string, target_disposition WindowOpenDisposition, user_gesture int32, popupFeatures *PopupFeatures, windowInfo *WindowInfo, client **Client, settings *BrowserSettings, no_javascript_access *int32) int32
+	isLifeSpanHandlerProxy()
+}
+
+// DefaultLifeSpanHandler may be embedded to satisfy LifeSpanHandlerProxy.
+type DefaultLifeSpanHandler struct{}
+
+func (DefaultLifeSpanHandler) isLifeSpanHandlerProxy() {}
+
+// LifeSpanHandlerOnBeforePopupProxy may be implemented by a LifeSpanHandlerProxy to handle OnBeforePopup.
+type LifeSpanHandlerOnBeforePopupProxy interface {
+	OnBeforePopup(self *LifeSpanHandler, browser *Browser, frame *Frame, target_url, target_frame_name string, target_disposition WindowOpenDisposition, user_gesture bool, popupFeatures *PopupFeatures, windowInfo *WindowInfo, client **Client, settings *BrowserSettings, no_javascript_access *bool) bool
+}
+
+// LifeSpanHandlerOnAfterCreatedProxy may be implemented by a LifeSpanHandlerProxy to handle OnAfterCreated.
+type LifeSpanHandlerOnAfterCreatedProxy interface {
 	OnAfterCreated(self *LifeSpanHandler, browser *Browser)
-	DoClose(self *LifeSpanHandler, browser *Browser) int32
+}
+
+// LifeSpanHandlerDoCloseProxy may be implemented by a LifeSpanHandlerProxy to handle DoClose.
+type LifeSpanHandlerDoCloseProxy interface {
+	DoClose(self *LifeSpanHandler, browser *Browser) bool
+}
+
+// LifeSpanHandlerOnBeforeCloseProxy may be implemented by a LifeSpanHandlerProxy to handle OnBeforeClose.
+type LifeSpanHandlerOnBeforeCloseProxy interface {
 	OnBeforeClose(self *LifeSpanHandler, browser *Browser)
 }
 
@@ -30,7 +52,11 @@ type LifeSpanHandler C.cef_life_span_handler_t
 func NewLifeSpanHandler(proxy LifeSpanHandlerProxy) *LifeSpanHandler {
 	result := (*LifeSpanHandler)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_life_span_handler_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_life_span_handler_proxy(result.toNative())
+		_, on_before_popup := proxy.(LifeSpanHandlerOnBeforePopupProxy)
+		_, on_after_created := proxy.(LifeSpanHandlerOnAfterCreatedProxy)
+		_, do_close := proxy.(LifeSpanHandlerDoCloseProxy)
+		_, on_before_close := proxy.(LifeSpanHandlerOnBeforeCloseProxy)
+		C.gocef_set_life_span_handler_proxy(result.toNative(), cefBool(on_before_popup), cefBool(on_after_created), cefBool(do_close), cefBool(on_before_close))
 	}
 	return result
 }
@@ -39,14 +65,15 @@ func (d *LifeSpanHandler) toNative() *C.cef_life_span_handler_t {
 	return (*C.cef_life_span_handler_t)(d)
 }
 
-func lookupLifeSpanHandlerProxy(obj *BaseRefCounted) LifeSpanHandlerProxy {
+func lookupLifeSpanHandlerProxy(obj *BaseRefCounted, callback string) LifeSpanHandlerProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("LifeSpanHandler", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(LifeSpanHandlerProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type LifeSpanHandlerProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("LifeSpanHandler", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -78,35 +105,58 @@ func (d *LifeSpanHandler) Base() *BaseRefCounted {
 // wrapped in a cef_browser_view_t. Popup browser creation will be canceled if
 // the parent browser is destroyed before the popup browser creation completes
 // (indicated by a call to OnAfterCreated for the popup browser).
-func (d *LifeSpanHandler) OnBeforePopup(browser *Browser, frame *Frame, target_url, target_frame_name string, target_disposition WindowOpenDisposition, user_gesture int32, popupFeatures *PopupFeatures, windowInfo *WindowInfo, client **Client, settings *BrowserSettings, no_javascript_access *int32) int32 {
-	return lookupLifeSpanHandlerProxy(d.Base()).OnBeforePopup(d, browser, frame, target_url, target_frame_name, target_disposition, user_gesture, popupFeatures, windowInfo, client, settings, no_javascript_access)
+func (d *LifeSpanHandler) OnBeforePopup(browser *Browser, frame *Frame, target_url, target_frame_name string, target_disposition WindowOpenDisposition, user_gesture bool, popupFeatures *PopupFeatures, windowInfo *WindowInfo, client **Client, settings *BrowserSettings, no_javascript_access *bool) bool {
+	if proxy__, ok__ := lookupLifeSpanHandlerProxy(d.Base(), "OnBeforePopup").(LifeSpanHandlerOnBeforePopupProxy); ok__ {
+		return proxy__.OnBeforePopup(d, browser, frame, target_url, target_frame_name, target_disposition, user_gesture, popupFeatures, windowInfo, client, settings, no_javascript_access)
+	}
+	return false
 }
 
 //export gocef_life_span_handler_on_before_popup
 func gocef_life_span_handler_on_before_popup(self *C.cef_life_span_handler_t, browser *C.cef_browser_t, frame *C.cef_frame_t, target_url *C.cef_string_t, target_frame_name *C.cef_string_t, target_disposition C.cef_window_open_disposition_t, user_gesture C.int, popupFeatures *C.cef_popup_features_t, windowInfo *C.cef_window_info_t, client **C.cef_client_t, settings *C.cef_browser_settings_t, no_javascript_access *C.int) C.int {
+	defer recoverProxyPanic("LifeSpanHandler", "OnBeforePopup")
 	me__ := (*LifeSpanHandler)(self)
-	proxy__ := lookupLifeSpanHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupLifeSpanHandlerProxy(me__.Base(), "OnBeforePopup").(LifeSpanHandlerOnBeforePopupProxy)
+	if !ok__ {
+		return 0
+	}
 	target_url_ := cefstrToString(target_url)
 	target_frame_name_ := cefstrToString(target_frame_name)
 	popupFeatures_ := popupFeatures.toGo()
 	windowInfo_ := windowInfo.toGo()
+	defer func() {
+		windowInfo_.toNative(windowInfo)
+	}()
 	client_ := (*Client)(*client)
 	client__p := &client_
 	settings_ := settings.toGo()
-	return C.int(proxy__.OnBeforePopup(me__, (*Browser)(browser), (*Frame)(frame), target_url_, target_frame_name_, WindowOpenDisposition(target_disposition), int32(user_gesture), popupFeatures_, windowInfo_, client__p, settings_, (*int32)(no_javascript_access)))
+	defer func() {
+		settings_.toNative(settings)
+	}()
+	no_javascript_access_ := *no_javascript_access != 0
+	defer func() {
+		*no_javascript_access = cefBool(no_javascript_access_)
+	}()
+	return cefBool(proxy__.OnBeforePopup(me__, (*Browser)(browser), (*Frame)(frame), target_url_, target_frame_name_, WindowOpenDisposition(target_disposition), user_gesture != 0, popupFeatures_, windowInfo_, client__p, settings_, &no_javascript_access_))
 }
 
 // OnAfterCreated (on_after_created)
 // Called after a new browser is created. This callback will be the first
 // notification that references |browser|.
 func (d *LifeSpanHandler) OnAfterCreated(browser *Browser) {
-	lookupLifeSpanHandlerProxy(d.Base()).OnAfterCreated(d, browser)
+	if proxy__, ok__ := lookupLifeSpanHandlerProxy(d.Base(), "OnAfterCreated").(LifeSpanHandlerOnAfterCreatedProxy); ok__ {
+		proxy__.OnAfterCreated(d, browser)
+	}
 }
 
 //export gocef_life_span_handler_on_after_created
 func gocef_life_span_handler_on_after_created(self *C.cef_life_span_handler_t, browser *C.cef_browser_t) {
+	defer recoverProxyPanic("LifeSpanHandler", "OnAfterCreated")
 	me__ := (*LifeSpanHandler)(self)
-	proxy__ := lookupLifeSpanHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupLifeSpanHandlerProxy(me__.Base(), "OnAfterCreated").(LifeSpanHandlerOnAfterCreatedProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnAfterCreated(me__, (*Browser)(browser))
 }
 
@@ -198,15 +248,22 @@ func gocef_life_span_handler_on_after_created(self *C.cef_life_span_handler_t, b
 // 11. Application exits by calling cef_quit_message_loop() if no other
 // browsers
 //     exist.
-func (d *LifeSpanHandler) DoClose(browser *Browser) int32 {
-	return lookupLifeSpanHandlerProxy(d.Base()).DoClose(d, browser)
+func (d *LifeSpanHandler) DoClose(browser *Browser) bool {
+	if proxy__, ok__ := lookupLifeSpanHandlerProxy(d.Base(), "DoClose").(LifeSpanHandlerDoCloseProxy); ok__ {
+		return proxy__.DoClose(d, browser)
+	}
+	return false
 }
 
 //export gocef_life_span_handler_do_close
 func gocef_life_span_handler_do_close(self *C.cef_life_span_handler_t, browser *C.cef_browser_t) C.int {
+	defer recoverProxyPanic("LifeSpanHandler", "DoClose")
 	me__ := (*LifeSpanHandler)(self)
-	proxy__ := lookupLifeSpanHandlerProxy(me__.Base())
-	return C.int(proxy__.DoClose(me__, (*Browser)(browser)))
+	proxy__, ok__ := lookupLifeSpanHandlerProxy(me__.Base(), "DoClose").(LifeSpanHandlerDoCloseProxy)
+	if !ok__ {
+		return 0
+	}
+	return cefBool(proxy__.DoClose(me__, (*Browser)(browser)))
 }
 
 // OnBeforeClose (on_before_close)
@@ -216,12 +273,18 @@ func gocef_life_span_handler_do_close(self *C.cef_life_span_handler_t, browser *
 // notification that references |browser|. See do_close() documentation for
 // additional usage information.
 func (d *LifeSpanHandler) OnBeforeClose(browser *Browser) {
-	lookupLifeSpanHandlerProxy(d.Base()).OnBeforeClose(d, browser)
+	if proxy__, ok__ := lookupLifeSpanHandlerProxy(d.Base(), "OnBeforeClose").(LifeSpanHandlerOnBeforeCloseProxy); ok__ {
+		proxy__.OnBeforeClose(d, browser)
+	}
 }
 
 //export gocef_life_span_handler_on_before_close
 func gocef_life_span_handler_on_before_close(self *C.cef_life_span_handler_t, browser *C.cef_browser_t) {
+	defer recoverProxyPanic("LifeSpanHandler", "OnBeforeClose")
 	me__ := (*LifeSpanHandler)(self)
-	proxy__ := lookupLifeSpanHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupLifeSpanHandlerProxy(me__.Base(), "OnBeforeClose").(LifeSpanHandlerOnBeforeCloseProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnBeforeClose(me__, (*Browser)(browser))
 }
@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_life_span_handler_proxy(cef_life_span_handler_t *self);
+void gocef_set_life_span_handler_proxy(cef_life_span_handler_t *self, int on_before_popup, int on_after_created, int do_close, int on_before_close);
 
 #endif // GOCEF_LifeSpanHandler_H_
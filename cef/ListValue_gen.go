@@ -54,40 +54,41 @@ func (d *ListValue) Base() *BaseRefCounted {
 // the underlying data is owned by another object (e.g. list or dictionary)
 // and that other object is then modified or destroyed. Do not call any other
 // functions if this function returns false (0).
-func (d *ListValue) IsValid() int32 {
-	return int32(C.gocef_list_value_is_valid(d.toNative(), d.is_valid))
+func (d *ListValue) IsValid() bool {
+	return C.gocef_list_value_is_valid(d.toNative(), d.is_valid) != 0
 }
 
 // IsOwned (is_owned)
 // Returns true (1) if this object is currently owned by another object.
-func (d *ListValue) IsOwned() int32 {
-	return int32(C.gocef_list_value_is_owned(d.toNative(), d.is_owned))
+func (d *ListValue) IsOwned() bool {
+	return C.gocef_list_value_is_owned(d.toNative(), d.is_owned) != 0
 }
 
 // IsReadOnly (is_read_only)
 // Returns true (1) if the values of this object are read-only. Some APIs may
 // expose read-only objects.
-func (d *ListValue) IsReadOnly() int32 {
-	return int32(C.gocef_list_value_is_read_only(d.toNative(), d.is_read_only))
+func (d *ListValue) IsReadOnly() bool {
+	return C.gocef_list_value_is_read_only(d.toNative(), d.is_read_only) != 0
 }
 
 // IsSame (is_same)
 // Returns true (1) if this object and |that| object have the same underlying
 // data. If true (1) modifications to this object will also affect |that|
 // object and vice-versa.
-func (d *ListValue) IsSame(that *ListValue) int32 {
-	return int32(C.gocef_list_value_is_same(d.toNative(), that.toNative(), d.is_same))
+func (d *ListValue) IsSame(that *ListValue) bool {
+	return C.gocef_list_value_is_same(d.toNative(), that.toNative(), d.is_same) != 0
 }
 
 // IsEqual (is_equal)
 // Returns true (1) if this object and |that| object have an equivalent
 // underlying value but are not necessarily the same object.
-func (d *ListValue) IsEqual(that *ListValue) int32 {
-	return int32(C.gocef_list_value_is_equal(d.toNative(), that.toNative(), d.is_equal))
+func (d *ListValue) IsEqual(that *ListValue) bool {
+	return C.gocef_list_value_is_equal(d.toNative(), that.toNative(), d.is_equal) != 0
 }
 
 // Copy (copy)
 // Returns a writable copy of this object.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *ListValue) Copy() *ListValue {
 	return (*ListValue)(C.gocef_list_value_copy(d.toNative(), d.copy))
 }
@@ -95,8 +96,8 @@ func (d *ListValue) Copy() *ListValue {
 // SetSize (set_size)
 // Sets the number of values. If the number of values is expanded all new
 // value slots will default to type null. Returns true (1) on success.
-func (d *ListValue) SetSize(size uint64) int32 {
-	return int32(C.gocef_list_value_set_size(d.toNative(), C.size_t(size), d.set_size))
+func (d *ListValue) SetSize(size uint64) bool {
+	return C.gocef_list_value_set_size(d.toNative(), C.size_t(size), d.set_size) != 0
 }
 
 // GetSize (get_size)
@@ -107,14 +108,14 @@ func (d *ListValue) GetSize() uint64 {
 
 // Clear (clear)
 // Removes all values. Returns true (1) on success.
-func (d *ListValue) Clear() int32 {
-	return int32(C.gocef_list_value_clear(d.toNative(), d.clear))
+func (d *ListValue) Clear() bool {
+	return C.gocef_list_value_clear(d.toNative(), d.clear) != 0
 }
 
 // Remove (remove)
 // Removes the value at the specified index.
-func (d *ListValue) Remove(index uint64) int32 {
-	return int32(C.gocef_list_value_remove(d.toNative(), C.size_t(index), d.remove))
+func (d *ListValue) Remove(index uint64) bool {
+	return C.gocef_list_value_remove(d.toNative(), C.size_t(index), d.remove) != 0
 }
 
 // GetType (get_type)
@@ -129,14 +130,15 @@ func (d *ListValue) GetType(index uint64) ValueType {
 // modify this object. For complex types (binary, dictionary and list) the
 // returned value will reference existing data and modifications to the value
 // will modify this object.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *ListValue) GetValue(index uint64) *Value {
 	return (*Value)(C.gocef_list_value_get_value(d.toNative(), C.size_t(index), d.get_value))
 }
 
 // GetBool (get_bool)
 // Returns the value at the specified index as type bool.
-func (d *ListValue) GetBool(index uint64) int32 {
-	return int32(C.gocef_list_value_get_bool(d.toNative(), C.size_t(index), d.get_bool))
+func (d *ListValue) GetBool(index uint64) bool {
+	return C.gocef_list_value_get_bool(d.toNative(), C.size_t(index), d.get_bool) != 0
 }
 
 // GetInt (get_int)
@@ -161,6 +163,7 @@ func (d *ListValue) GetString(index uint64) string {
 // GetBinary (get_binary)
 // Returns the value at the specified index as type binary. The returned value
 // will reference existing data.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *ListValue) GetBinary(index uint64) *BinaryValue {
 	return (*BinaryValue)(C.gocef_list_value_get_binary(d.toNative(), C.size_t(index), d.get_binary))
 }
@@ -169,6 +172,7 @@ func (d *ListValue) GetBinary(index uint64) *BinaryValue {
 // Returns the value at the specified index as type dictionary. The returned
 // value will reference existing data and modifications to the value will
 // modify this object.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *ListValue) GetDictionary(index uint64) *DictionaryValue {
 	return (*DictionaryValue)(C.gocef_list_value_get_dictionary(d.toNative(), C.size_t(index), d.get_dictionary))
 }
@@ -177,6 +181,7 @@ func (d *ListValue) GetDictionary(index uint64) *DictionaryValue {
 // Returns the value at the specified index as type list. The returned value
 // will reference existing data and modifications to the value will modify
 // this object.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *ListValue) GetList(index uint64) *ListValue {
 	return (*ListValue)(C.gocef_list_value_get_list(d.toNative(), C.size_t(index), d.get_list))
 }
@@ -188,48 +193,48 @@ func (d *ListValue) GetList(index uint64) *ListValue {
 // object. If |value| represents complex data (binary, dictionary or list)
 // then the underlying data will be referenced and modifications to |value|
 // will modify this object.
-func (d *ListValue) SetValue(index uint64, value *Value) int32 {
-	return int32(C.gocef_list_value_set_value(d.toNative(), C.size_t(index), value.toNative(), d.set_value))
+func (d *ListValue) SetValue(index uint64, value *Value) bool {
+	return C.gocef_list_value_set_value(d.toNative(), C.size_t(index), value.toNative(), d.set_value) != 0
 }
 
 // SetNull (set_null)
 // Sets the value at the specified index as type null. Returns true (1) if the
 // value was set successfully.
-func (d *ListValue) SetNull(index uint64) int32 {
-	return int32(C.gocef_list_value_set_null(d.toNative(), C.size_t(index), d.set_null))
+func (d *ListValue) SetNull(index uint64) bool {
+	return C.gocef_list_value_set_null(d.toNative(), C.size_t(index), d.set_null) != 0
 }
 
 // SetBool (set_bool)
 // Sets the value at the specified index as type bool. Returns true (1) if the
 // value was set successfully.
-func (d *ListValue) SetBool(index uint64, value int32) int32 {
-	return int32(C.gocef_list_value_set_bool(d.toNative(), C.size_t(index), C.int(value), d.set_bool))
+func (d *ListValue) SetBool(index uint64, value bool) bool {
+	return C.gocef_list_value_set_bool(d.toNative(), C.size_t(index), cefBool(value), d.set_bool) != 0
 }
 
 // SetInt (set_int)
 // Sets the value at the specified index as type int. Returns true (1) if the
 // value was set successfully.
-func (d *ListValue) SetInt(index uint64, value int32) int32 {
-	return int32(C.gocef_list_value_set_int(d.toNative(), C.size_t(index), C.int(value), d.set_int))
+func (d *ListValue) SetInt(index uint64, value int32) bool {
+	return C.gocef_list_value_set_int(d.toNative(), C.size_t(index), C.int(value), d.set_int) != 0
 }
 
 // SetDouble (set_double)
 // Sets the value at the specified index as type double. Returns true (1) if
 // the value was set successfully.
-func (d *ListValue) SetDouble(index uint64, value float64) int32 {
-	return int32(C.gocef_list_value_set_double(d.toNative(), C.size_t(index), C.double(value), d.set_double))
+func (d *ListValue) SetDouble(index uint64, value float64) bool {
+	return C.gocef_list_value_set_double(d.toNative(), C.size_t(index), C.double(value), d.set_double) != 0
 }
 
 // SetString (set_string)
 // Sets the value at the specified index as type string. Returns true (1) if
 // the value was set successfully.
-func (d *ListValue) SetString(index uint64, value string) int32 {
+func (d *ListValue) SetString(index uint64, value string) bool {
 	value_ := C.cef_string_userfree_alloc()
 	setCEFStr(value, value_)
 	defer func() {
 		C.cef_string_userfree_free(value_)
 	}()
-	return int32(C.gocef_list_value_set_string(d.toNative(), C.size_t(index), (*C.cef_string_t)(value_), d.set_string))
+	return C.gocef_list_value_set_string(d.toNative(), C.size_t(index), (*C.cef_string_t)(value_), d.set_string) != 0
 }
 
 // SetBinary (set_binary)
@@ -238,8 +243,8 @@ func (d *ListValue) SetString(index uint64, value string) int32 {
 // object then the value will be copied and the |value| reference will not
 // change. Otherwise, ownership will be transferred to this object and the
 // |value| reference will be invalidated.
-func (d *ListValue) SetBinary(index uint64, value *BinaryValue) int32 {
-	return int32(C.gocef_list_value_set_binary(d.toNative(), C.size_t(index), value.toNative(), d.set_binary))
+func (d *ListValue) SetBinary(index uint64, value *BinaryValue) bool {
+	return C.gocef_list_value_set_binary(d.toNative(), C.size_t(index), value.toNative(), d.set_binary) != 0
 }
 
 // SetDictionary (set_dictionary)
@@ -248,8 +253,8 @@ func (d *ListValue) SetBinary(index uint64, value *BinaryValue) int32 {
 // then the value will be copied and the |value| reference will not change.
 // Otherwise, ownership will be transferred to this object and the |value|
 // reference will be invalidated.
-func (d *ListValue) SetDictionary(index uint64, value *DictionaryValue) int32 {
-	return int32(C.gocef_list_value_set_dictionary(d.toNative(), C.size_t(index), value.toNative(), d.set_dictionary))
+func (d *ListValue) SetDictionary(index uint64, value *DictionaryValue) bool {
+	return C.gocef_list_value_set_dictionary(d.toNative(), C.size_t(index), value.toNative(), d.set_dictionary) != 0
 }
 
 // SetList (set_list)
@@ -258,6 +263,6 @@ func (d *ListValue) SetDictionary(index uint64, value *DictionaryValue) int32 {
 // then the value will be copied and the |value| reference will not change.
 // Otherwise, ownership will be transferred to this object and the |value|
 // reference will be invalidated.
-func (d *ListValue) SetList(index uint64, value *ListValue) int32 {
-	return int32(C.gocef_list_value_set_list(d.toNative(), C.size_t(index), value.toNative(), d.set_list))
+func (d *ListValue) SetList(index uint64, value *ListValue) bool {
+	return C.gocef_list_value_set_list(d.toNative(), C.size_t(index), value.toNative(), d.set_list) != 0
 }
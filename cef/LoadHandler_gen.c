@@ -3,11 +3,19 @@
 #include "LoadHandler_gen.h"
 #include "_cgo_export.h"
 
-void gocef_set_load_handler_proxy(cef_load_handler_t *self) {
+void gocef_set_load_handler_proxy(cef_load_handler_t *self, int on_loading_state_change, int on_load_start, int on_load_end, int on_load_error) {
 	// Casts to (void *) added to avoid warnings since Go callbacks can't have
 	// some modifiers, such as 'const' applied to their parameter signatures.
-	self->on_loading_state_change = (void *)&gocef_load_handler_on_loading_state_change;
-	self->on_load_start = (void *)&gocef_load_handler_on_load_start;
-	self->on_load_end = (void *)&gocef_load_handler_on_load_end;
-	self->on_load_error = (void *)&gocef_load_handler_on_load_error;
+	if (on_loading_state_change) {
+		self->on_loading_state_change = (void *)&gocef_load_handler_on_loading_state_change;
+	}
+	if (on_load_start) {
+		self->on_load_start = (void *)&gocef_load_handler_on_load_start;
+	}
+	if (on_load_end) {
+		self->on_load_end = (void *)&gocef_load_handler_on_load_end;
+	}
+	if (on_load_error) {
+		self->on_load_error = (void *)&gocef_load_handler_on_load_error;
+	}
 }
@@ -6,16 +6,38 @@ import (
 	// #include "LoadHandler_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// LoadHandlerProxy defines methods required for using LoadHandler.
+// LoadHandlerProxy is implemented by the proxies used with LoadHandler. Embed
+// DefaultLoadHandler to satisfy it, then implement any of the optional
+// LoadHandler*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type LoadHandlerProxy interface {
-	OnLoadingStateChange(self *LoadHandler, browser *Browser, isLoading, canGoBack, canGoForward int32)
+	isLoadHandlerProxy()
+}
+
+// DefaultLoadHandler may be embedded to satisfy LoadHandlerProxy.
+type DefaultLoadHandler struct{}
+
+func (DefaultLoadHandler) isLoadHandlerProxy() {}
+
+// LoadHandlerOnLoadingStateChangeProxy may be implemented by a LoadHandlerProxy to handle OnLoadingStateChange.
+type LoadHandlerOnLoadingStateChangeProxy interface {
+	OnLoadingStateChange(self *LoadHandler, browser *Browser, isLoading, canGoBack, canGoForward bool)
+}
+
+// LoadHandlerOnLoadStartProxy may be implemented by a LoadHandlerProxy to handle OnLoadStart.
+type LoadHandlerOnLoadStartProxy interface {
 	OnLoadStart(self *LoadHandler, browser *Browser, frame *Frame, transition_type TransitionType)
+}
+
+// LoadHandlerOnLoadEndProxy may be implemented by a LoadHandlerProxy to handle OnLoadEnd.
+type LoadHandlerOnLoadEndProxy interface {
 	OnLoadEnd(self *LoadHandler, browser *Browser, frame *Frame, httpStatusCode int32)
+}
+
+// LoadHandlerOnLoadErrorProxy may be implemented by a LoadHandlerProxy to handle OnLoadError.
+type LoadHandlerOnLoadErrorProxy interface {
 	OnLoadError(self *LoadHandler, browser *Browser, frame *Frame, errorCode Errorcode, errorText, failedUrl string)
 }
 
@@ -30,7 +52,11 @@ type LoadHandler C.cef_load_handler_t
 func NewLoadHandler(proxy LoadHandlerProxy) *LoadHandler {
 	result := (*LoadHandler)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_load_handler_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_load_handler_proxy(result.toNative())
+		_, on_loading_state_change := proxy.(LoadHandlerOnLoadingStateChangeProxy)
+		_, on_load_start := proxy.(LoadHandlerOnLoadStartProxy)
+		_, on_load_end := proxy.(LoadHandlerOnLoadEndProxy)
+		_, on_load_error := proxy.(LoadHandlerOnLoadErrorProxy)
+		C.gocef_set_load_handler_proxy(result.toNative(), cefBool(on_loading_state_change), cefBool(on_load_start), cefBool(on_load_end), cefBool(on_load_error))
 	}
 	return result
 }
@@ -39,14 +65,15 @@ func (d *LoadHandler) toNative() *C.cef_load_handler_t {
 	return (*C.cef_load_handler_t)(d)
 }
 
-func lookupLoadHandlerProxy(obj *BaseRefCounted) LoadHandlerProxy {
+func lookupLoadHandlerProxy(obj *BaseRefCounted, callback string) LoadHandlerProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("LoadHandler", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(LoadHandlerProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type LoadHandlerProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("LoadHandler", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -63,15 +90,21 @@ func (d *LoadHandler) Base() *BaseRefCounted {
 // action, and once when loading is terminated due to completion, cancellation
 // of failure. It will be called before any calls to OnLoadStart and after all
 // calls to OnLoadError and/or OnLoadEnd.
-func (d *LoadHandler) OnLoadingStateChange(browser *Browser, isLoading, canGoBack, canGoForward int32) {
-	lookupLoadHandlerProxy(d.Base()).OnLoadingStateChange(d, browser, isLoading, canGoBack, canGoForward)
+func (d *LoadHandler) OnLoadingStateChange(browser *Browser, isLoading, canGoBack, canGoForward bool) {
+	if proxy__, ok__ := lookupLoadHandlerProxy(d.Base(), "OnLoadingStateChange").(LoadHandlerOnLoadingStateChangeProxy); ok__ {
+		proxy__.OnLoadingStateChange(d, browser, isLoading, canGoBack, canGoForward)
+	}
 }
 
 //export gocef_load_handler_on_loading_state_change
 func gocef_load_handler_on_loading_state_change(self *C.cef_load_handler_t, browser *C.cef_browser_t, isLoading C.int, canGoBack C.int, canGoForward C.int) {
+	defer recoverProxyPanic("LoadHandler", "OnLoadingStateChange")
 	me__ := (*LoadHandler)(self)
-	proxy__ := lookupLoadHandlerProxy(me__.Base())
-	proxy__.OnLoadingStateChange(me__, (*Browser)(browser), int32(isLoading), int32(canGoBack), int32(canGoForward))
+	proxy__, ok__ := lookupLoadHandlerProxy(me__.Base(), "OnLoadingStateChange").(LoadHandlerOnLoadingStateChangeProxy)
+	if !ok__ {
+		return
+	}
+	proxy__.OnLoadingStateChange(me__, (*Browser)(browser), isLoading != 0, canGoBack != 0, canGoForward != 0)
 }
 
 // OnLoadStart (on_load_start)
@@ -86,13 +119,19 @@ func gocef_load_handler_on_loading_state_change(self *C.cef_load_handler_t, brow
 // navigations that fail or are canceled before commit. For notification of
 // overall browser load status use OnLoadingStateChange instead.
 func (d *LoadHandler) OnLoadStart(browser *Browser, frame *Frame, transition_type TransitionType) {
-	lookupLoadHandlerProxy(d.Base()).OnLoadStart(d, browser, frame, transition_type)
+	if proxy__, ok__ := lookupLoadHandlerProxy(d.Base(), "OnLoadStart").(LoadHandlerOnLoadStartProxy); ok__ {
+		proxy__.OnLoadStart(d, browser, frame, transition_type)
+	}
 }
 
 //export gocef_load_handler_on_load_start
 func gocef_load_handler_on_load_start(self *C.cef_load_handler_t, browser *C.cef_browser_t, frame *C.cef_frame_t, transition_type C.cef_transition_type_t) {
+	defer recoverProxyPanic("LoadHandler", "OnLoadStart")
 	me__ := (*LoadHandler)(self)
-	proxy__ := lookupLoadHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupLoadHandlerProxy(me__.Base(), "OnLoadStart").(LoadHandlerOnLoadStartProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnLoadStart(me__, (*Browser)(browser), (*Frame)(frame), TransitionType(transition_type))
 }
 
@@ -106,13 +145,19 @@ func gocef_load_handler_on_load_start(self *C.cef_load_handler_t, browser *C.cef
 // For notification of overall browser load status use OnLoadingStateChange
 // instead.
 func (d *LoadHandler) OnLoadEnd(browser *Browser, frame *Frame, httpStatusCode int32) {
-	lookupLoadHandlerProxy(d.Base()).OnLoadEnd(d, browser, frame, httpStatusCode)
+	if proxy__, ok__ := lookupLoadHandlerProxy(d.Base(), "OnLoadEnd").(LoadHandlerOnLoadEndProxy); ok__ {
+		proxy__.OnLoadEnd(d, browser, frame, httpStatusCode)
+	}
 }
 
 //export gocef_load_handler_on_load_end
 func gocef_load_handler_on_load_end(self *C.cef_load_handler_t, browser *C.cef_browser_t, frame *C.cef_frame_t, httpStatusCode C.int) {
+	defer recoverProxyPanic("LoadHandler", "OnLoadEnd")
 	me__ := (*LoadHandler)(self)
-	proxy__ := lookupLoadHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupLoadHandlerProxy(me__.Base(), "OnLoadEnd").(LoadHandlerOnLoadEndProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnLoadEnd(me__, (*Browser)(browser), (*Frame)(frame), int32(httpStatusCode))
 }
 
@@ -123,13 +168,19 @@ func gocef_load_handler_on_load_end(self *C.cef_load_handler_t, browser *C.cef_b
 // error text and |failedUrl| is the URL that failed to load. See
 // net\base\net_error_list.h for complete descriptions of the error codes.
 func (d *LoadHandler) OnLoadError(browser *Browser, frame *Frame, errorCode Errorcode, errorText, failedUrl string) {
-	lookupLoadHandlerProxy(d.Base()).OnLoadError(d, browser, frame, errorCode, errorText, failedUrl)
+	if proxy__, ok__ := lookupLoadHandlerProxy(d.Base(), "OnLoadError").(LoadHandlerOnLoadErrorProxy); ok__ {
+		proxy__.OnLoadError(d, browser, frame, errorCode, errorText, failedUrl)
+	}
 }
 
 //export gocef_load_handler_on_load_error
 func gocef_load_handler_on_load_error(self *C.cef_load_handler_t, browser *C.cef_browser_t, frame *C.cef_frame_t, errorCode C.cef_errorcode_t, errorText *C.cef_string_t, failedUrl *C.cef_string_t) {
+	defer recoverProxyPanic("LoadHandler", "OnLoadError")
 	me__ := (*LoadHandler)(self)
-	proxy__ := lookupLoadHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupLoadHandlerProxy(me__.Base(), "OnLoadError").(LoadHandlerOnLoadErrorProxy)
+	if !ok__ {
+		return
+	}
 	errorText_ := cefstrToString(errorText)
 	failedUrl_ := cefstrToString(failedUrl)
 	proxy__.OnLoadError(me__, (*Browser)(browser), (*Frame)(frame), Errorcode(errorCode), errorText_, failedUrl_)
@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_load_handler_proxy(cef_load_handler_t *self);
+void gocef_set_load_handler_proxy(cef_load_handler_t *self, int on_loading_state_change, int on_load_start, int on_load_end, int on_load_error);
 
 #endif // GOCEF_LoadHandler_H_
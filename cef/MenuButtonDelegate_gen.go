@@ -6,13 +6,23 @@ import (
 	// #include "MenuButtonDelegate_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// MenuButtonDelegateProxy defines methods required for using MenuButtonDelegate.
+// MenuButtonDelegateProxy is implemented by the proxies used with MenuButtonDelegate. Embed
+// DefaultMenuButtonDelegate to satisfy it, then implement any of the optional
+// MenuButtonDelegate*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type MenuButtonDelegateProxy interface {
+	isMenuButtonDelegateProxy()
+}
+
+// DefaultMenuButtonDelegate may be embedded to satisfy MenuButtonDelegateProxy.
+type DefaultMenuButtonDelegate struct{}
+
+func (DefaultMenuButtonDelegate) isMenuButtonDelegateProxy() {}
+
+// MenuButtonDelegateOnMenuButtonPressedProxy may be implemented by a MenuButtonDelegateProxy to handle OnMenuButtonPressed.
+type MenuButtonDelegateOnMenuButtonPressedProxy interface {
 	OnMenuButtonPressed(self *MenuButtonDelegate, menu_button *MenuButton, screen_point *Point, button_pressed_lock *MenuButtonPressedLock)
 }
 
@@ -27,7 +37,8 @@ type MenuButtonDelegate C.cef_menu_button_delegate_t
 func NewMenuButtonDelegate(proxy MenuButtonDelegateProxy) *MenuButtonDelegate {
 	result := (*MenuButtonDelegate)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_menu_button_delegate_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_menu_button_delegate_proxy(result.toNative())
+		_, on_menu_button_pressed := proxy.(MenuButtonDelegateOnMenuButtonPressedProxy)
+		C.gocef_set_menu_button_delegate_proxy(result.toNative(), cefBool(on_menu_button_pressed))
 	}
 	return result
 }
@@ -36,14 +47,15 @@ func (d *MenuButtonDelegate) toNative() *C.cef_menu_button_delegate_t {
 	return (*C.cef_menu_button_delegate_t)(d)
 }
 
-func lookupMenuButtonDelegateProxy(obj *BaseRefCounted) MenuButtonDelegateProxy {
+func lookupMenuButtonDelegateProxy(obj *BaseRefCounted, callback string) MenuButtonDelegateProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("MenuButtonDelegate", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(MenuButtonDelegateProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type MenuButtonDelegateProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("MenuButtonDelegate", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -60,13 +72,19 @@ func (d *MenuButtonDelegate) Base() *ButtonDelegate {
 // window keep a reference to |button_pressed_lock| until the popup is hidden
 // to maintain the pressed button state.
 func (d *MenuButtonDelegate) OnMenuButtonPressed(menu_button *MenuButton, screen_point *Point, button_pressed_lock *MenuButtonPressedLock) {
-	lookupMenuButtonDelegateProxy(d.Base().Base().Base()).OnMenuButtonPressed(d, menu_button, screen_point, button_pressed_lock)
+	if proxy__, ok__ := lookupMenuButtonDelegateProxy(d.Base().Base().Base(), "OnMenuButtonPressed").(MenuButtonDelegateOnMenuButtonPressedProxy); ok__ {
+		proxy__.OnMenuButtonPressed(d, menu_button, screen_point, button_pressed_lock)
+	}
 }
 
 //export gocef_menu_button_delegate_on_menu_button_pressed
 func gocef_menu_button_delegate_on_menu_button_pressed(self *C.cef_menu_button_delegate_t, menu_button *C.cef_menu_button_t, screen_point *C.cef_point_t, button_pressed_lock *C.cef_menu_button_pressed_lock_t) {
+	defer recoverProxyPanic("MenuButtonDelegate", "OnMenuButtonPressed")
 	me__ := (*MenuButtonDelegate)(self)
-	proxy__ := lookupMenuButtonDelegateProxy(me__.Base().Base().Base())
+	proxy__, ok__ := lookupMenuButtonDelegateProxy(me__.Base().Base().Base(), "OnMenuButtonPressed").(MenuButtonDelegateOnMenuButtonPressedProxy)
+	if !ok__ {
+		return
+	}
 	screen_point_ := screen_point.toGo()
 	button_pressed_lock_ := button_pressed_lock.toGo()
 	proxy__.OnMenuButtonPressed(me__, (*MenuButton)(menu_button), screen_point_, button_pressed_lock_)
@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_menu_button_delegate_proxy(cef_menu_button_delegate_t *self);
+void gocef_set_menu_button_delegate_proxy(cef_menu_button_delegate_t *self, int on_menu_button_pressed);
 
 #endif // GOCEF_MenuButtonDelegate_H_
@@ -6,20 +6,54 @@ import (
 	// #include "MenuModelDelegate_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// MenuModelDelegateProxy defines methods required for using MenuModelDelegate.
+// MenuModelDelegateProxy is implemented by the proxies used with MenuModelDelegate. Embed
+// DefaultMenuModelDelegate to satisfy it, then implement any of the optional
+// MenuModelDelegate*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type MenuModelDelegateProxy interface {
+	isMenuModelDelegateProxy()
+}
+
+// DefaultMenuModelDelegate may be embedded to satisfy MenuModelDelegateProxy.
+type DefaultMenuModelDelegate struct{}
+
+func (DefaultMenuModelDelegate) isMenuModelDelegateProxy() {}
+
+// MenuModelDelegateExecuteCommandProxy may be implemented by a MenuModelDelegateProxy to handle ExecuteCommand.
+type MenuModelDelegateExecuteCommandProxy interface {
 	ExecuteCommand(self *MenuModelDelegate, menu_model *MenuModel, command_id int32, event_flags EventFlags)
+}
+
+// MenuModelDelegateMouseOutsideMenuProxy may be implemented by a MenuModelDelegateProxy to handle MouseOutsideMenu.
+type MenuModelDelegateMouseOutsideMenuProxy interface {
 	MouseOutsideMenu(self *MenuModelDelegate, menu_model *MenuModel, screen_point *Point)
-	UnhandledOpenSubmenu(self *MenuModelDelegate, menu_model *MenuModel, is_rtl int32)
-	UnhandledCloseSubmenu(self *MenuModelDelegate, menu_model *MenuModel, is_rtl int32)
+}
+
+// MenuModelDelegateUnhandledOpenSubmenuProxy may be implemented by a MenuModelDelegateProxy to handle UnhandledOpenSubmenu.
+type MenuModelDelegateUnhandledOpenSubmenuProxy interface {
+	UnhandledOpenSubmenu(self *MenuModelDelegate, menu_model *MenuModel, is_rtl bool)
+}
+
+// MenuModelDelegateUnhandledCloseSubmenuProxy may be implemented by a MenuModelDelegateProxy to handle UnhandledCloseSubmenu.
+type MenuModelDelegateUnhandledCloseSubmenuProxy interface {
+	UnhandledCloseSubmenu(self *MenuModelDelegate, menu_model *MenuModel, is_rtl bool)
+}
+
+// MenuModelDelegateMenuWillShowProxy may be implemented by a MenuModelDelegateProxy to handle MenuWillShow.
+type MenuModelDelegateMenuWillShowProxy interface {
 	MenuWillShow(self *MenuModelDelegate, menu_model *MenuModel)
+}
+
+// MenuModelDelegateMenuClosedProxy may be implemented by a MenuModelDelegateProxy to handle MenuClosed.
+type MenuModelDelegateMenuClosedProxy interface {
 	MenuClosed(self *MenuModelDelegate, menu_model *MenuModel)
-	FormatLabel(self *MenuModelDelegate, menu_model *MenuModel, label *string) int32
+}
+
+// MenuModelDelegateFormatLabelProxy may be implemented by a MenuModelDelegateProxy to handle FormatLabel.
+type MenuModelDelegateFormatLabelProxy interface {
+	FormatLabel(self *MenuModelDelegate, menu_model *MenuModel, label *string) bool
 }
 
 // MenuModelDelegate (cef_menu_model_delegate_t from include/capi/cef_menu_model_delegate_capi.h)
@@ -33,7 +67,14 @@ type MenuModelDelegate C.cef_menu_model_delegate_t
 func NewMenuModelDelegate(proxy MenuModelDelegateProxy) *MenuModelDelegate {
 	result := (*MenuModelDelegate)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_menu_model_delegate_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_menu_model_delegate_proxy(result.toNative())
+		_, execute_command := proxy.(MenuModelDelegateExecuteCommandProxy)
+		_, mouse_outside_menu := proxy.(MenuModelDelegateMouseOutsideMenuProxy)
+		_, unhandled_open_submenu := proxy.(MenuModelDelegateUnhandledOpenSubmenuProxy)
+		_, unhandled_close_submenu := proxy.(MenuModelDelegateUnhandledCloseSubmenuProxy)
+		_, menu_will_show := proxy.(MenuModelDelegateMenuWillShowProxy)
+		_, menu_closed := proxy.(MenuModelDelegateMenuClosedProxy)
+		_, format_label := proxy.(MenuModelDelegateFormatLabelProxy)
+		C.gocef_set_menu_model_delegate_proxy(result.toNative(), cefBool(execute_command), cefBool(mouse_outside_menu), cefBool(unhandled_open_submenu), cefBool(unhandled_close_submenu), cefBool(menu_will_show), cefBool(menu_closed), cefBool(format_label))
 	}
 	return result
 }
@@ -42,14 +83,15 @@ func (d *MenuModelDelegate) toNative() *C.cef_menu_model_delegate_t {
 	return (*C.cef_menu_model_delegate_t)(d)
 }
 
-func lookupMenuModelDelegateProxy(obj *BaseRefCounted) MenuModelDelegateProxy {
+func lookupMenuModelDelegateProxy(obj *BaseRefCounted, callback string) MenuModelDelegateProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("MenuModelDelegate", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(MenuModelDelegateProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type MenuModelDelegateProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("MenuModelDelegate", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -64,13 +106,19 @@ func (d *MenuModelDelegate) Base() *BaseRefCounted {
 // Perform the action associated with the specified |command_id| and optional
 // |event_flags|.
 func (d *MenuModelDelegate) ExecuteCommand(menu_model *MenuModel, command_id int32, event_flags EventFlags) {
-	lookupMenuModelDelegateProxy(d.Base()).ExecuteCommand(d, menu_model, command_id, event_flags)
+	if proxy__, ok__ := lookupMenuModelDelegateProxy(d.Base(), "ExecuteCommand").(MenuModelDelegateExecuteCommandProxy); ok__ {
+		proxy__.ExecuteCommand(d, menu_model, command_id, event_flags)
+	}
 }
 
 //export gocef_menu_model_delegate_execute_command
 func gocef_menu_model_delegate_execute_command(self *C.cef_menu_model_delegate_t, menu_model *C.cef_menu_model_t, command_id C.int, event_flags C.cef_event_flags_t) {
+	defer recoverProxyPanic("MenuModelDelegate", "ExecuteCommand")
 	me__ := (*MenuModelDelegate)(self)
-	proxy__ := lookupMenuModelDelegateProxy(me__.Base())
+	proxy__, ok__ := lookupMenuModelDelegateProxy(me__.Base(), "ExecuteCommand").(MenuModelDelegateExecuteCommandProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.ExecuteCommand(me__, (*MenuModel)(menu_model), int32(command_id), EventFlags(event_flags))
 }
 
@@ -78,13 +126,19 @@ func gocef_menu_model_delegate_execute_command(self *C.cef_menu_model_delegate_t
 // Called when the user moves the mouse outside the menu and over the owning
 // window.
 func (d *MenuModelDelegate) MouseOutsideMenu(menu_model *MenuModel, screen_point *Point) {
-	lookupMenuModelDelegateProxy(d.Base()).MouseOutsideMenu(d, menu_model, screen_point)
+	if proxy__, ok__ := lookupMenuModelDelegateProxy(d.Base(), "MouseOutsideMenu").(MenuModelDelegateMouseOutsideMenuProxy); ok__ {
+		proxy__.MouseOutsideMenu(d, menu_model, screen_point)
+	}
 }
 
 //export gocef_menu_model_delegate_mouse_outside_menu
 func gocef_menu_model_delegate_mouse_outside_menu(self *C.cef_menu_model_delegate_t, menu_model *C.cef_menu_model_t, screen_point *C.cef_point_t) {
+	defer recoverProxyPanic("MenuModelDelegate", "MouseOutsideMenu")
 	me__ := (*MenuModelDelegate)(self)
-	proxy__ := lookupMenuModelDelegateProxy(me__.Base())
+	proxy__, ok__ := lookupMenuModelDelegateProxy(me__.Base(), "MouseOutsideMenu").(MenuModelDelegateMouseOutsideMenuProxy)
+	if !ok__ {
+		return
+	}
 	screen_point_ := screen_point.toGo()
 	proxy__.MouseOutsideMenu(me__, (*MenuModel)(menu_model), screen_point_)
 }
@@ -92,68 +146,102 @@ func gocef_menu_model_delegate_mouse_outside_menu(self *C.cef_menu_model_delegat
 // UnhandledOpenSubmenu (unhandled_open_submenu)
 // Called on unhandled open submenu keyboard commands. |is_rtl| will be true
 // (1) if the menu is displaying a right-to-left language.
-func (d *MenuModelDelegate) UnhandledOpenSubmenu(menu_model *MenuModel, is_rtl int32) {
-	lookupMenuModelDelegateProxy(d.Base()).UnhandledOpenSubmenu(d, menu_model, is_rtl)
+func (d *MenuModelDelegate) UnhandledOpenSubmenu(menu_model *MenuModel, is_rtl bool) {
+	if proxy__, ok__ := lookupMenuModelDelegateProxy(d.Base(), "UnhandledOpenSubmenu").(MenuModelDelegateUnhandledOpenSubmenuProxy); ok__ {
+		proxy__.UnhandledOpenSubmenu(d, menu_model, is_rtl)
+	}
 }
 
 //export gocef_menu_model_delegate_unhandled_open_submenu
 func gocef_menu_model_delegate_unhandled_open_submenu(self *C.cef_menu_model_delegate_t, menu_model *C.cef_menu_model_t, is_rtl C.int) {
+	defer recoverProxyPanic("MenuModelDelegate", "UnhandledOpenSubmenu")
 	me__ := (*MenuModelDelegate)(self)
-	proxy__ := lookupMenuModelDelegateProxy(me__.Base())
-	proxy__.UnhandledOpenSubmenu(me__, (*MenuModel)(menu_model), int32(is_rtl))
+	proxy__, ok__ := lookupMenuModelDelegateProxy(me__.Base(), "UnhandledOpenSubmenu").(MenuModelDelegateUnhandledOpenSubmenuProxy)
+	if !ok__ {
+		return
+	}
+	proxy__.UnhandledOpenSubmenu(me__, (*MenuModel)(menu_model), is_rtl != 0)
 }
 
 // UnhandledCloseSubmenu (unhandled_close_submenu)
 // Called on unhandled close submenu keyboard commands. |is_rtl| will be true
 // (1) if the menu is displaying a right-to-left language.
-func (d *MenuModelDelegate) UnhandledCloseSubmenu(menu_model *MenuModel, is_rtl int32) {
-	lookupMenuModelDelegateProxy(d.Base()).UnhandledCloseSubmenu(d, menu_model, is_rtl)
+func (d *MenuModelDelegate) UnhandledCloseSubmenu(menu_model *MenuModel, is_rtl bool) {
+	if proxy__, ok__ := lookupMenuModelDelegateProxy(d.Base(), "UnhandledCloseSubmenu").(MenuModelDelegateUnhandledCloseSubmenuProxy); ok__ {
+		proxy__.UnhandledCloseSubmenu(d, menu_model, is_rtl)
+	}
 }
 
 //export gocef_menu_model_delegate_unhandled_close_submenu
 func gocef_menu_model_delegate_unhandled_close_submenu(self *C.cef_menu_model_delegate_t, menu_model *C.cef_menu_model_t, is_rtl C.int) {
+	defer recoverProxyPanic("MenuModelDelegate", "UnhandledCloseSubmenu")
 	me__ := (*MenuModelDelegate)(self)
-	proxy__ := lookupMenuModelDelegateProxy(me__.Base())
-	proxy__.UnhandledCloseSubmenu(me__, (*MenuModel)(menu_model), int32(is_rtl))
+	proxy__, ok__ := lookupMenuModelDelegateProxy(me__.Base(), "UnhandledCloseSubmenu").(MenuModelDelegateUnhandledCloseSubmenuProxy)
+	if !ok__ {
+		return
+	}
+	proxy__.UnhandledCloseSubmenu(me__, (*MenuModel)(menu_model), is_rtl != 0)
 }
 
 // MenuWillShow (menu_will_show)
 // The menu is about to show.
 func (d *MenuModelDelegate) MenuWillShow(menu_model *MenuModel) {
-	lookupMenuModelDelegateProxy(d.Base()).MenuWillShow(d, menu_model)
+	if proxy__, ok__ := lookupMenuModelDelegateProxy(d.Base(), "MenuWillShow").(MenuModelDelegateMenuWillShowProxy); ok__ {
+		proxy__.MenuWillShow(d, menu_model)
+	}
 }
 
 //export gocef_menu_model_delegate_menu_will_show
 func gocef_menu_model_delegate_menu_will_show(self *C.cef_menu_model_delegate_t, menu_model *C.cef_menu_model_t) {
+	defer recoverProxyPanic("MenuModelDelegate", "MenuWillShow")
 	me__ := (*MenuModelDelegate)(self)
-	proxy__ := lookupMenuModelDelegateProxy(me__.Base())
+	proxy__, ok__ := lookupMenuModelDelegateProxy(me__.Base(), "MenuWillShow").(MenuModelDelegateMenuWillShowProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.MenuWillShow(me__, (*MenuModel)(menu_model))
 }
 
 // MenuClosed (menu_closed)
 // The menu has closed.
 func (d *MenuModelDelegate) MenuClosed(menu_model *MenuModel) {
-	lookupMenuModelDelegateProxy(d.Base()).MenuClosed(d, menu_model)
+	if proxy__, ok__ := lookupMenuModelDelegateProxy(d.Base(), "MenuClosed").(MenuModelDelegateMenuClosedProxy); ok__ {
+		proxy__.MenuClosed(d, menu_model)
+	}
 }
 
 //export gocef_menu_model_delegate_menu_closed
 func gocef_menu_model_delegate_menu_closed(self *C.cef_menu_model_delegate_t, menu_model *C.cef_menu_model_t) {
+	defer recoverProxyPanic("MenuModelDelegate", "MenuClosed")
 	me__ := (*MenuModelDelegate)(self)
-	proxy__ := lookupMenuModelDelegateProxy(me__.Base())
+	proxy__, ok__ := lookupMenuModelDelegateProxy(me__.Base(), "MenuClosed").(MenuModelDelegateMenuClosedProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.MenuClosed(me__, (*MenuModel)(menu_model))
 }
 
 // FormatLabel (format_label)
 // Optionally modify a menu item label. Return true (1) if |label| was
 // modified.
-func (d *MenuModelDelegate) FormatLabel(menu_model *MenuModel, label *string) int32 {
-	return lookupMenuModelDelegateProxy(d.Base()).FormatLabel(d, menu_model, label)
+func (d *MenuModelDelegate) FormatLabel(menu_model *MenuModel, label *string) bool {
+	if proxy__, ok__ := lookupMenuModelDelegateProxy(d.Base(), "FormatLabel").(MenuModelDelegateFormatLabelProxy); ok__ {
+		return proxy__.FormatLabel(d, menu_model, label)
+	}
+	return false
 }
 
 //export gocef_menu_model_delegate_format_label
 func gocef_menu_model_delegate_format_label(self *C.cef_menu_model_delegate_t, menu_model *C.cef_menu_model_t, label *C.cef_string_t) C.int {
+	defer recoverProxyPanic("MenuModelDelegate", "FormatLabel")
 	me__ := (*MenuModelDelegate)(self)
-	proxy__ := lookupMenuModelDelegateProxy(me__.Base())
+	proxy__, ok__ := lookupMenuModelDelegateProxy(me__.Base(), "FormatLabel").(MenuModelDelegateFormatLabelProxy)
+	if !ok__ {
+		return 0
+	}
 	label_ := cefstrToString(label)
-	return C.int(proxy__.FormatLabel(me__, (*MenuModel)(menu_model), &label_))
+	defer func() {
+		setCEFStr(label_, label)
+	}()
+	return cefBool(proxy__.FormatLabel(me__, (*MenuModel)(menu_model), &label_))
 }
@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_menu_model_delegate_proxy(cef_menu_model_delegate_t *self);
+void gocef_set_menu_model_delegate_proxy(cef_menu_model_delegate_t *self, int execute_command, int mouse_outside_menu, int unhandled_open_submenu, int unhandled_close_submenu, int menu_will_show, int menu_closed, int format_label);
 
 #endif // GOCEF_MenuModelDelegate_H_
@@ -82,14 +82,14 @@ func (d *MenuModel) Base() *BaseRefCounted {
 
 // IsSubMenu (is_sub_menu)
 // Returns true (1) if this menu is a submenu.
-func (d *MenuModel) IsSubMenu() int32 {
-	return int32(C.gocef_menu_model_is_sub_menu(d.toNative(), d.is_sub_menu))
+func (d *MenuModel) IsSubMenu() bool {
+	return C.gocef_menu_model_is_sub_menu(d.toNative(), d.is_sub_menu) != 0
 }
 
 // Clear (clear)
 // Clears the menu. Returns true (1) on success.
-func (d *MenuModel) Clear() int32 {
-	return int32(C.gocef_menu_model_clear(d.toNative(), d.clear))
+func (d *MenuModel) Clear() bool {
+	return C.gocef_menu_model_clear(d.toNative(), d.clear) != 0
 }
 
 // GetCount (get_count)
@@ -100,46 +100,47 @@ func (d *MenuModel) GetCount() int32 {
 
 // AddSeparator (add_separator)
 // Add a separator to the menu. Returns true (1) on success.
-func (d *MenuModel) AddSeparator() int32 {
-	return int32(C.gocef_menu_model_add_separator(d.toNative(), d.add_separator))
+func (d *MenuModel) AddSeparator() bool {
+	return C.gocef_menu_model_add_separator(d.toNative(), d.add_separator) != 0
 }
 
 // AddItem (add_item)
 // Add an item to the menu. Returns true (1) on success.
-func (d *MenuModel) AddItem(command_id int32, label string) int32 {
+func (d *MenuModel) AddItem(command_id int32, label string) bool {
 	label_ := C.cef_string_userfree_alloc()
 	setCEFStr(label, label_)
 	defer func() {
 		C.cef_string_userfree_free(label_)
 	}()
-	return int32(C.gocef_menu_model_add_item(d.toNative(), C.int(command_id), (*C.cef_string_t)(label_), d.add_item))
+	return C.gocef_menu_model_add_item(d.toNative(), C.int(command_id), (*C.cef_string_t)(label_), d.add_item) != 0
 }
 
 // AddCheckItem (add_check_item)
 // Add a check item to the menu. Returns true (1) on success.
-func (d *MenuModel) AddCheckItem(command_id int32, label string) int32 {
+func (d *MenuModel) AddCheckItem(command_id int32, label string) bool {
 	label_ := C.cef_string_userfree_alloc()
 	setCEFStr(label, label_)
 	defer func() {
 		C.cef_string_userfree_free(label_)
 	}()
-	return int32(C.gocef_menu_model_add_check_item(d.toNative(), C.int(command_id), (*C.cef_string_t)(label_), d.add_check_item))
+	return C.gocef_menu_model_add_check_item(d.toNative(), C.int(command_id), (*C.cef_string_t)(label_), d.add_check_item) != 0
 }
 
 // AddRadioItem (add_radio_item)
 // Add a radio item to the menu. Only a single item with the specified
 // |group_id| can be checked at a time. Returns true (1) on success.
-func (d *MenuModel) AddRadioItem(command_id int32, label string, group_id int32) int32 {
+func (d *MenuModel) AddRadioItem(command_id int32, label string, group_id int32) bool {
 	label_ := C.cef_string_userfree_alloc()
 	setCEFStr(label, label_)
 	defer func() {
 		C.cef_string_userfree_free(label_)
 	}()
-	return int32(C.gocef_menu_model_add_radio_item(d.toNative(), C.int(command_id), (*C.cef_string_t)(label_), C.int(group_id), d.add_radio_item))
+	return C.gocef_menu_model_add_radio_item(d.toNative(), C.int(command_id), (*C.cef_string_t)(label_), C.int(group_id), d.add_radio_item) != 0
 }
 
 // AddSubMenu (add_sub_menu)
 // Add a sub-menu to the menu. The new sub-menu is returned.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *MenuModel) AddSubMenu(command_id int32, label string) *MenuModel {
 	label_ := C.cef_string_userfree_alloc()
 	setCEFStr(label, label_)
@@ -152,50 +153,51 @@ func (d *MenuModel) AddSubMenu(command_id int32, label string) *MenuModel {
 // InsertSeparatorAt (insert_separator_at)
 // Insert a separator in the menu at the specified |index|. Returns true (1)
 // on success.
-func (d *MenuModel) InsertSeparatorAt(index int32) int32 {
-	return int32(C.gocef_menu_model_insert_separator_at(d.toNative(), C.int(index), d.insert_separator_at))
+func (d *MenuModel) InsertSeparatorAt(index int32) bool {
+	return C.gocef_menu_model_insert_separator_at(d.toNative(), C.int(index), d.insert_separator_at) != 0
 }
 
 // InsertItemAt (insert_item_at)
 // Insert an item in the menu at the specified |index|. Returns true (1) on
 // success.
-func (d *MenuModel) InsertItemAt(index, command_id int32, label string) int32 {
+func (d *MenuModel) InsertItemAt(index, command_id int32, label string) bool {
 	label_ := C.cef_string_userfree_alloc()
 	setCEFStr(label, label_)
 	defer func() {
 		C.cef_string_userfree_free(label_)
 	}()
-	return int32(C.gocef_menu_model_insert_item_at(d.toNative(), C.int(index), C.int(command_id), (*C.cef_string_t)(label_), d.insert_item_at))
+	return C.gocef_menu_model_insert_item_at(d.toNative(), C.int(index), C.int(command_id), (*C.cef_string_t)(label_), d.insert_item_at) != 0
 }
 
 // InsertCheckItemAt (insert_check_item_at)
 // Insert a check item in the menu at the specified |index|. Returns true (1)
 // on success.
-func (d *MenuModel) InsertCheckItemAt(index, command_id int32, label string) int32 {
+func (d *MenuModel) InsertCheckItemAt(index, command_id int32, label string) bool {
 	label_ := C.cef_string_userfree_alloc()
 	setCEFStr(label, label_)
 	defer func() {
 		C.cef_string_userfree_free(label_)
 	}()
-	return int32(C.gocef_menu_model_insert_check_item_at(d.toNative(), C.int(index), C.int(command_id), (*C.cef_string_t)(label_), d.insert_check_item_at))
+	return C.gocef_menu_model_insert_check_item_at(d.toNative(), C.int(index), C.int(command_id), (*C.cef_string_t)(label_), d.insert_check_item_at) != 0
 }
 
 // InsertRadioItemAt (insert_radio_item_at)
 // Insert a radio item in the menu at the specified |index|. Only a single
 // item with the specified |group_id| can be checked at a time. Returns true
 // (1) on success.
-func (d *MenuModel) InsertRadioItemAt(index, command_id int32, label string, group_id int32) int32 {
+func (d *MenuModel) InsertRadioItemAt(index, command_id int32, label string, group_id int32) bool {
 	label_ := C.cef_string_userfree_alloc()
 	setCEFStr(label, label_)
 	defer func() {
 		C.cef_string_userfree_free(label_)
 	}()
-	return int32(C.gocef_menu_model_insert_radio_item_at(d.toNative(), C.int(index), C.int(command_id), (*C.cef_string_t)(label_), C.int(group_id), d.insert_radio_item_at))
+	return C.gocef_menu_model_insert_radio_item_at(d.toNative(), C.int(index), C.int(command_id), (*C.cef_string_t)(label_), C.int(group_id), d.insert_radio_item_at) != 0
 }
 
 // InsertSubMenuAt (insert_sub_menu_at)
 // Insert a sub-menu in the menu at the specified |index|. The new sub-menu is
 // returned.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *MenuModel) InsertSubMenuAt(index, command_id int32, label string) *MenuModel {
 	label_ := C.cef_string_userfree_alloc()
 	setCEFStr(label, label_)
@@ -208,14 +210,14 @@ func (d *MenuModel) InsertSubMenuAt(index, command_id int32, label string) *Menu
 // Remove (remove)
 // Removes the item with the specified |command_id|. Returns true (1) on
 // success.
-func (d *MenuModel) Remove(command_id int32) int32 {
-	return int32(C.gocef_menu_model_remove(d.toNative(), C.int(command_id), d.remove))
+func (d *MenuModel) Remove(command_id int32) bool {
+	return C.gocef_menu_model_remove(d.toNative(), C.int(command_id), d.remove) != 0
 }
 
 // RemoveAt (remove_at)
 // Removes the item at the specified |index|. Returns true (1) on success.
-func (d *MenuModel) RemoveAt(index int32) int32 {
-	return int32(C.gocef_menu_model_remove_at(d.toNative(), C.int(index), d.remove_at))
+func (d *MenuModel) RemoveAt(index int32) bool {
+	return C.gocef_menu_model_remove_at(d.toNative(), C.int(index), d.remove_at) != 0
 }
 
 // GetIndexOf (get_index_of)
@@ -234,8 +236,8 @@ func (d *MenuModel) GetCommandIdAt(index int32) int32 {
 
 // SetCommandIdAt (set_command_id_at)
 // Sets the command id at the specified |index|. Returns true (1) on success.
-func (d *MenuModel) SetCommandIdAt(index, command_id int32) int32 {
-	return int32(C.gocef_menu_model_set_command_id_at(d.toNative(), C.int(index), C.int(command_id), d.set_command_id_at))
+func (d *MenuModel) SetCommandIdAt(index, command_id int32) bool {
+	return C.gocef_menu_model_set_command_id_at(d.toNative(), C.int(index), C.int(command_id), d.set_command_id_at) != 0
 }
 
 // GetLabel (get_label)
@@ -255,24 +257,24 @@ func (d *MenuModel) GetLabelAt(index int32) string {
 
 // SetLabel (set_label)
 // Sets the label for the specified |command_id|. Returns true (1) on success.
-func (d *MenuModel) SetLabel(command_id int32, label string) int32 {
+func (d *MenuModel) SetLabel(command_id int32, label string) bool {
 	label_ := C.cef_string_userfree_alloc()
 	setCEFStr(label, label_)
 	defer func() {
 		C.cef_string_userfree_free(label_)
 	}()
-	return int32(C.gocef_menu_model_set_label(d.toNative(), C.int(command_id), (*C.cef_string_t)(label_), d.set_label))
+	return C.gocef_menu_model_set_label(d.toNative(), C.int(command_id), (*C.cef_string_t)(label_), d.set_label) != 0
 }
 
 // SetLabelAt (set_label_at)
 // Set the label at the specified |index|. Returns true (1) on success.
-func (d *MenuModel) SetLabelAt(index int32, label string) int32 {
+func (d *MenuModel) SetLabelAt(index int32, label string) bool {
 	label_ := C.cef_string_userfree_alloc()
 	setCEFStr(label, label_)
 	defer func() {
 		C.cef_string_userfree_free(label_)
 	}()
-	return int32(C.gocef_menu_model_set_label_at(d.toNative(), C.int(index), (*C.cef_string_t)(label_), d.set_label_at))
+	return C.gocef_menu_model_set_label_at(d.toNative(), C.int(index), (*C.cef_string_t)(label_), d.set_label_at) != 0
 }
 
 // GetType (get_type)
@@ -302,162 +304,188 @@ func (d *MenuModel) GetGroupIdAt(index int32) int32 {
 // SetGroupId (set_group_id)
 // Sets the group id for the specified |command_id|. Returns true (1) on
 // success.
-func (d *MenuModel) SetGroupId(command_id, group_id int32) int32 {
-	return int32(C.gocef_menu_model_set_group_id(d.toNative(), C.int(command_id), C.int(group_id), d.set_group_id))
+func (d *MenuModel) SetGroupId(command_id, group_id int32) bool {
+	return C.gocef_menu_model_set_group_id(d.toNative(), C.int(command_id), C.int(group_id), d.set_group_id) != 0
 }
 
 // SetGroupIdAt (set_group_id_at)
 // Sets the group id at the specified |index|. Returns true (1) on success.
-func (d *MenuModel) SetGroupIdAt(index, group_id int32) int32 {
-	return int32(C.gocef_menu_model_set_group_id_at(d.toNative(), C.int(index), C.int(group_id), d.set_group_id_at))
+func (d *MenuModel) SetGroupIdAt(index, group_id int32) bool {
+	return C.gocef_menu_model_set_group_id_at(d.toNative(), C.int(index), C.int(group_id), d.set_group_id_at) != 0
 }
 
 // GetSubMenu (get_sub_menu)
 // Returns the submenu for the specified |command_id| or NULL if invalid.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *MenuModel) GetSubMenu(command_id int32) *MenuModel {
 	return (*MenuModel)(C.gocef_menu_model_get_sub_menu(d.toNative(), C.int(command_id), d.get_sub_menu))
 }
 
 // GetSubMenuAt (get_sub_menu_at)
 // Returns the submenu at the specified |index| or NULL if invalid.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *MenuModel) GetSubMenuAt(index int32) *MenuModel {
 	return (*MenuModel)(C.gocef_menu_model_get_sub_menu_at(d.toNative(), C.int(index), d.get_sub_menu_at))
 }
 
 // IsVisible (is_visible)
 // Returns true (1) if the specified |command_id| is visible.
-func (d *MenuModel) IsVisible(command_id int32) int32 {
-	return int32(C.gocef_menu_model_is_visible(d.toNative(), C.int(command_id), d.is_visible))
+func (d *MenuModel) IsVisible(command_id int32) bool {
+	return C.gocef_menu_model_is_visible(d.toNative(), C.int(command_id), d.is_visible) != 0
 }
 
 // IsVisibleAt (is_visible_at)
 // Returns true (1) if the specified |index| is visible.
-func (d *MenuModel) IsVisibleAt(index int32) int32 {
-	return int32(C.gocef_menu_model_is_visible_at(d.toNative(), C.int(index), d.is_visible_at))
+func (d *MenuModel) IsVisibleAt(index int32) bool {
+	return C.gocef_menu_model_is_visible_at(d.toNative(), C.int(index), d.is_visible_at) != 0
 }
 
 // SetVisible (set_visible)
 // Change the visibility of the specified |command_id|. Returns true (1) on
 // success.
-func (d *MenuModel) SetVisible(command_id, visible int32) int32 {
-	return int32(C.gocef_menu_model_set_visible(d.toNative(), C.int(command_id), C.int(visible), d.set_visible))
+func (d *MenuModel) SetVisible(command_id int32, visible bool) bool {
+	return C.gocef_menu_model_set_visible(d.toNative(), C.int(command_id), cefBool(visible), d.set_visible) != 0
 }
 
 // SetVisibleAt (set_visible_at)
 // Change the visibility at the specified |index|. Returns true (1) on
 // success.
-func (d *MenuModel) SetVisibleAt(index, visible int32) int32 {
-	return int32(C.gocef_menu_model_set_visible_at(d.toNative(), C.int(index), C.int(visible), d.set_visible_at))
+func (d *MenuModel) SetVisibleAt(index int32, visible bool) bool {
+	return C.gocef_menu_model_set_visible_at(d.toNative(), C.int(index), cefBool(visible), d.set_visible_at) != 0
 }
 
 // IsEnabled (is_enabled)
 // Returns true (1) if the specified |command_id| is enabled.
-func (d *MenuModel) IsEnabled(command_id int32) int32 {
-	return int32(C.gocef_menu_model_is_enabled(d.toNative(), C.int(command_id), d.is_enabled))
+func (d *MenuModel) IsEnabled(command_id int32) bool {
+	return C.gocef_menu_model_is_enabled(d.toNative(), C.int(command_id), d.is_enabled) != 0
 }
 
 // IsEnabledAt (is_enabled_at)
 // Returns true (1) if the specified |index| is enabled.
-func (d *MenuModel) IsEnabledAt(index int32) int32 {
-	return int32(C.gocef_menu_model_is_enabled_at(d.toNative(), C.int(index), d.is_enabled_at))
+func (d *MenuModel) IsEnabledAt(index int32) bool {
+	return C.gocef_menu_model_is_enabled_at(d.toNative(), C.int(index), d.is_enabled_at) != 0
 }
 
 // SetEnabled (set_enabled)
 // Change the enabled status of the specified |command_id|. Returns true (1)
 // on success.
-func (d *MenuModel) SetEnabled(command_id, enabled int32) int32 {
-	return int32(C.gocef_menu_model_set_enabled(d.toNative(), C.int(command_id), C.int(enabled), d.set_enabled))
+func (d *MenuModel) SetEnabled(command_id int32, enabled bool) bool {
+	return C.gocef_menu_model_set_enabled(d.toNative(), C.int(command_id), cefBool(enabled), d.set_enabled) != 0
 }
 
 // SetEnabledAt (set_enabled_at)
 // Change the enabled status at the specified |index|. Returns true (1) on
 // success.
-func (d *MenuModel) SetEnabledAt(index, enabled int32) int32 {
-	return int32(C.gocef_menu_model_set_enabled_at(d.toNative(), C.int(index), C.int(enabled), d.set_enabled_at))
+func (d *MenuModel) SetEnabledAt(index int32, enabled bool) bool {
+	return C.gocef_menu_model_set_enabled_at(d.toNative(), C.int(index), cefBool(enabled), d.set_enabled_at) != 0
 }
 
 // IsChecked (is_checked)
 // Returns true (1) if the specified |command_id| is checked. Only applies to
 // check and radio items.
-func (d *MenuModel) IsChecked(command_id int32) int32 {
-	return int32(C.gocef_menu_model_is_checked(d.toNative(), C.int(command_id), d.is_checked))
+func (d *MenuModel) IsChecked(command_id int32) bool {
+	return C.gocef_menu_model_is_checked(d.toNative(), C.int(command_id), d.is_checked) != 0
 }
 
 // IsCheckedAt (is_checked_at)
 // Returns true (1) if the specified |index| is checked. Only applies to check
 // and radio items.
-func (d *MenuModel) IsCheckedAt(index int32) int32 {
-	return int32(C.gocef_menu_model_is_checked_at(d.toNative(), C.int(index), d.is_checked_at))
+func (d *MenuModel) IsCheckedAt(index int32) bool {
+	return C.gocef_menu_model_is_checked_at(d.toNative(), C.int(index), d.is_checked_at) != 0
 }
 
 // SetChecked (set_checked)
 // Check the specified |command_id|. Only applies to check and radio items.
 // Returns true (1) on success.
-func (d *MenuModel) SetChecked(command_id, checked int32) int32 {
-	return int32(C.gocef_menu_model_set_checked(d.toNative(), C.int(command_id), C.int(checked), d.set_checked))
+func (d *MenuModel) SetChecked(command_id int32, checked bool) bool {
+	return C.gocef_menu_model_set_checked(d.toNative(), C.int(command_id), cefBool(checked), d.set_checked) != 0
 }
 
 // SetCheckedAt (set_checked_at)
 // Check the specified |index|. Only applies to check and radio items. Returns
 // true (1) on success.
-func (d *MenuModel) SetCheckedAt(index, checked int32) int32 {
-	return int32(C.gocef_menu_model_set_checked_at(d.toNative(), C.int(index), C.int(checked), d.set_checked_at))
+func (d *MenuModel) SetCheckedAt(index int32, checked bool) bool {
+	return C.gocef_menu_model_set_checked_at(d.toNative(), C.int(index), cefBool(checked), d.set_checked_at) != 0
 }
 
 // HasAccelerator (has_accelerator)
 // Returns true (1) if the specified |command_id| has a keyboard accelerator
 // assigned.
-func (d *MenuModel) HasAccelerator(command_id int32) int32 {
-	return int32(C.gocef_menu_model_has_accelerator(d.toNative(), C.int(command_id), d.has_accelerator))
+func (d *MenuModel) HasAccelerator(command_id int32) bool {
+	return C.gocef_menu_model_has_accelerator(d.toNative(), C.int(command_id), d.has_accelerator) != 0
 }
 
 // HasAcceleratorAt (has_accelerator_at)
 // Returns true (1) if the specified |index| has a keyboard accelerator
 // assigned.
-func (d *MenuModel) HasAcceleratorAt(index int32) int32 {
-	return int32(C.gocef_menu_model_has_accelerator_at(d.toNative(), C.int(index), d.has_accelerator_at))
+func (d *MenuModel) HasAcceleratorAt(index int32) bool {
+	return C.gocef_menu_model_has_accelerator_at(d.toNative(), C.int(index), d.has_accelerator_at) != 0
 }
 
 // SetAccelerator (set_accelerator)
 // Set the keyboard accelerator for the specified |command_id|. |key_code| can
 // be any virtual key or character value. Returns true (1) on success.
-func (d *MenuModel) SetAccelerator(command_id, key_code, shift_pressed, ctrl_pressed, alt_pressed int32) int32 {
-	return int32(C.gocef_menu_model_set_accelerator(d.toNative(), C.int(command_id), C.int(key_code), C.int(shift_pressed), C.int(ctrl_pressed), C.int(alt_pressed), d.set_accelerator))
+func (d *MenuModel) SetAccelerator(command_id, key_code int32, shift_pressed, ctrl_pressed, alt_pressed bool) bool {
+	return C.gocef_menu_model_set_accelerator(d.toNative(), C.int(command_id), C.int(key_code), cefBool(shift_pressed), cefBool(ctrl_pressed), cefBool(alt_pressed), d.set_accelerator) != 0
 }
 
 // SetAcceleratorAt (set_accelerator_at)
 // Set the keyboard accelerator at the specified |index|. |key_code| can be
 // any virtual key or character value. Returns true (1) on success.
-func (d *MenuModel) SetAcceleratorAt(index, key_code, shift_pressed, ctrl_pressed, alt_pressed int32) int32 {
-	return int32(C.gocef_menu_model_set_accelerator_at(d.toNative(), C.int(index), C.int(key_code), C.int(shift_pressed), C.int(ctrl_pressed), C.int(alt_pressed), d.set_accelerator_at))
+func (d *MenuModel) SetAcceleratorAt(index, key_code int32, shift_pressed, ctrl_pressed, alt_pressed bool) bool {
+	return C.gocef_menu_model_set_accelerator_at(d.toNative(), C.int(index), C.int(key_code), cefBool(shift_pressed), cefBool(ctrl_pressed), cefBool(alt_pressed), d.set_accelerator_at) != 0
 }
 
 // RemoveAccelerator (remove_accelerator)
 // Remove the keyboard accelerator for the specified |command_id|. Returns
 // true (1) on success.
-func (d *MenuModel) RemoveAccelerator(command_id int32) int32 {
-	return int32(C.gocef_menu_model_remove_accelerator(d.toNative(), C.int(command_id), d.remove_accelerator))
+func (d *MenuModel) RemoveAccelerator(command_id int32) bool {
+	return C.gocef_menu_model_remove_accelerator(d.toNative(), C.int(command_id), d.remove_accelerator) != 0
 }
 
 // RemoveAcceleratorAt (remove_accelerator_at)
 // Remove the keyboard accelerator at the specified |index|. Returns true (1)
 // on success.
-func (d *MenuModel) RemoveAcceleratorAt(index int32) int32 {
-	return int32(C.gocef_menu_model_remove_accelerator_at(d.toNative(), C.int(index), d.remove_accelerator_at))
+func (d *MenuModel) RemoveAcceleratorAt(index int32) bool {
+	return C.gocef_menu_model_remove_accelerator_at(d.toNative(), C.int(index), d.remove_accelerator_at) != 0
 }
 
 // GetAccelerator (get_accelerator)
 // Retrieves the keyboard accelerator for the specified |command_id|. Returns
 // true (1) on success.
-func (d *MenuModel) GetAccelerator(command_id int32, key_code, shift_pressed, ctrl_pressed, alt_pressed *int32) int32 {
-	return int32(C.gocef_menu_model_get_accelerator(d.toNative(), C.int(command_id), (*C.int)(key_code), (*C.int)(shift_pressed), (*C.int)(ctrl_pressed), (*C.int)(alt_pressed), d.get_accelerator))
+func (d *MenuModel) GetAccelerator(command_id int32, key_code *int32, shift_pressed, ctrl_pressed, alt_pressed *bool) bool {
+	shift_pressed_ := cefBool(*shift_pressed)
+	defer func() {
+		*shift_pressed = shift_pressed_ != 0
+	}()
+	ctrl_pressed_ := cefBool(*ctrl_pressed)
+	defer func() {
+		*ctrl_pressed = ctrl_pressed_ != 0
+	}()
+	alt_pressed_ := cefBool(*alt_pressed)
+	defer func() {
+		*alt_pressed = alt_pressed_ != 0
+	}()
+	return C.gocef_menu_model_get_accelerator(d.toNative(), C.int(command_id), (*C.int)(key_code), &shift_pressed_, &ctrl_pressed_, &alt_pressed_, d.get_accelerator) != 0
 }
 
 // GetAcceleratorAt (get_accelerator_at)
 // Retrieves the keyboard accelerator for the specified |index|. Returns true
 // (1) on success.
-func (d *MenuModel) GetAcceleratorAt(index int32, key_code, shift_pressed, ctrl_pressed, alt_pressed *int32) int32 {
-	return int32(C.gocef_menu_model_get_accelerator_at(d.toNative(), C.int(index), (*C.int)(key_code), (*C.int)(shift_pressed), (*C.int)(ctrl_pressed), (*C.int)(alt_pressed), d.get_accelerator_at))
+func (d *MenuModel) GetAcceleratorAt(index int32, key_code *int32, shift_pressed, ctrl_pressed, alt_pressed *bool) bool {
+	shift_pressed_ := cefBool(*shift_pressed)
+	defer func() {
+		*shift_pressed = shift_pressed_ != 0
+	}()
+	ctrl_pressed_ := cefBool(*ctrl_pressed)
+	defer func() {
+		*ctrl_pressed = ctrl_pressed_ != 0
+	}()
+	alt_pressed_ := cefBool(*alt_pressed)
+	defer func() {
+		*alt_pressed = alt_pressed_ != 0
+	}()
+	return C.gocef_menu_model_get_accelerator_at(d.toNative(), C.int(index), (*C.int)(key_code), &shift_pressed_, &ctrl_pressed_, &alt_pressed_, d.get_accelerator_at) != 0
 }
 
 // SetColor (set_color)
@@ -465,8 +493,8 @@ func (d *MenuModel) GetAcceleratorAt(index int32, key_code, shift_pressed, ctrl_
 // Specify a |color| value of 0 to remove the explicit color. If no explicit
 // color or default color is set for |color_type| then the system color will
 // be used. Returns true (1) on success.
-func (d *MenuModel) SetColor(command_id int32, color_type MenuColorType, color Color) int32 {
-	return int32(C.gocef_menu_model_set_color(d.toNative(), C.int(command_id), C.cef_menu_color_type_t(color_type), C.cef_color_t(color), d.set_color))
+func (d *MenuModel) SetColor(command_id int32, color_type MenuColorType, color Color) bool {
+	return C.gocef_menu_model_set_color(d.toNative(), C.int(command_id), C.cef_menu_color_type_t(color_type), C.cef_color_t(color), d.set_color) != 0
 }
 
 // SetColorAt (set_color_at)
@@ -475,16 +503,16 @@ func (d *MenuModel) SetColor(command_id int32, color_type MenuColorType, color C
 // of -1 to set the default color for items that do not have an explicit color
 // set. If no explicit color or default color is set for |color_type| then the
 // system color will be used. Returns true (1) on success.
-func (d *MenuModel) SetColorAt(index int32, color_type MenuColorType, color Color) int32 {
-	return int32(C.gocef_menu_model_set_color_at(d.toNative(), C.int(index), C.cef_menu_color_type_t(color_type), C.cef_color_t(color), d.set_color_at))
+func (d *MenuModel) SetColorAt(index int32, color_type MenuColorType, color Color) bool {
+	return C.gocef_menu_model_set_color_at(d.toNative(), C.int(index), C.cef_menu_color_type_t(color_type), C.cef_color_t(color), d.set_color_at) != 0
 }
 
 // GetColor (get_color)
 // Returns in |color| the color that was explicitly set for |command_id| and
 // |color_type|. If a color was not set then 0 will be returned in |color|.
 // Returns true (1) on success.
-func (d *MenuModel) GetColor(command_id int32, color_type MenuColorType, color *Color) int32 {
-	return int32(C.gocef_menu_model_get_color(d.toNative(), C.int(command_id), C.cef_menu_color_type_t(color_type), (*C.cef_color_t)(color), d.get_color))
+func (d *MenuModel) GetColor(command_id int32, color_type MenuColorType, color *Color) bool {
+	return C.gocef_menu_model_get_color(d.toNative(), C.int(command_id), C.cef_menu_color_type_t(color_type), (*C.cef_color_t)(color), d.get_color) != 0
 }
 
 // GetColorAt (get_color_at)
@@ -492,8 +520,8 @@ func (d *MenuModel) GetColor(command_id int32, color_type MenuColorType, color *
 // |color_type|. Specify an |index| value of -1 to return the default color in
 // |color|. If a color was not set then 0 will be returned in |color|. Returns
 // true (1) on success.
-func (d *MenuModel) GetColorAt(index int32, color_type MenuColorType, color *Color) int32 {
-	return int32(C.gocef_menu_model_get_color_at(d.toNative(), C.int(index), C.cef_menu_color_type_t(color_type), (*C.cef_color_t)(color), d.get_color_at))
+func (d *MenuModel) GetColorAt(index int32, color_type MenuColorType, color *Color) bool {
+	return C.gocef_menu_model_get_color_at(d.toNative(), C.int(index), C.cef_menu_color_type_t(color_type), (*C.cef_color_t)(color), d.get_color_at) != 0
 }
 
 // SetFontList (set_font_list)
@@ -507,13 +535,13 @@ func (d *MenuModel) GetColorAt(index int32, color_type MenuColorType, color *Col
 //
 // Here are examples of valid font description strings: - "Arial, Helvetica,
 // Bold Italic 14px" - "Arial, 14px"
-func (d *MenuModel) SetFontList(command_id int32, font_list string) int32 {
+func (d *MenuModel) SetFontList(command_id int32, font_list string) bool {
 	font_list_ := C.cef_string_userfree_alloc()
 	setCEFStr(font_list, font_list_)
 	defer func() {
 		C.cef_string_userfree_free(font_list_)
 	}()
-	return int32(C.gocef_menu_model_set_font_list(d.toNative(), C.int(command_id), (*C.cef_string_t)(font_list_), d.set_font_list))
+	return C.gocef_menu_model_set_font_list(d.toNative(), C.int(command_id), (*C.cef_string_t)(font_list_), d.set_font_list) != 0
 }
 
 // SetFontListAt (set_font_list_at)
@@ -528,11 +556,11 @@ func (d *MenuModel) SetFontList(command_id int32, font_list string) int32 {
 //
 // Here are examples of valid font description strings: - "Arial, Helvetica,
 // Bold Italic 14px" - "Arial, 14px"
-func (d *MenuModel) SetFontListAt(index int32, font_list string) int32 {
+func (d *MenuModel) SetFontListAt(index int32, font_list string) bool {
 	font_list_ := C.cef_string_userfree_alloc()
 	setCEFStr(font_list, font_list_)
 	defer func() {
 		C.cef_string_userfree_free(font_list_)
 	}()
-	return int32(C.gocef_menu_model_set_font_list_at(d.toNative(), C.int(index), (*C.cef_string_t)(font_list_), d.set_font_list_at))
+	return C.gocef_menu_model_set_font_list_at(d.toNative(), C.int(index), (*C.cef_string_t)(font_list_), d.set_font_list_at) != 0
 }
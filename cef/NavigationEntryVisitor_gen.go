@@ -6,14 +6,24 @@ import (
 	// #include "NavigationEntryVisitor_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// NavigationEntryVisitorProxy defines methods required for using NavigationEntryVisitor.
+// NavigationEntryVisitorProxy is implemented by the proxies used with NavigationEntryVisitor. Embed
+// DefaultNavigationEntryVisitor to satisfy it, then implement any of the optional
+// NavigationEntryVisitor*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type NavigationEntryVisitorProxy interface {
-	Visit(self *NavigationEntryVisitor, entry *NavigationEntry, current, index, total int32) int32
+	isNavigationEntryVisitorProxy()
+}
+
+// DefaultNavigationEntryVisitor may be embedded to satisfy NavigationEntryVisitorProxy.
+type DefaultNavigationEntryVisitor struct{}
+
+func (DefaultNavigationEntryVisitor) isNavigationEntryVisitorProxy() {}
+
+// NavigationEntryVisitorVisitProxy may be implemented by a NavigationEntryVisitorProxy to handle Visit.
+type NavigationEntryVisitorVisitProxy interface {
+	Visit(self *NavigationEntryVisitor, entry *NavigationEntry, current bool, index, total int32) bool
 }
 
 // NavigationEntryVisitor (cef_navigation_entry_visitor_t from include/capi/cef_browser_capi.h)
@@ -26,7 +36,8 @@ type NavigationEntryVisitor C.cef_navigation_entry_visitor_t
 func NewNavigationEntryVisitor(proxy NavigationEntryVisitorProxy) *NavigationEntryVisitor {
 	result := (*NavigationEntryVisitor)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_navigation_entry_visitor_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_navigation_entry_visitor_proxy(result.toNative())
+		_, visit := proxy.(NavigationEntryVisitorVisitProxy)
+		C.gocef_set_navigation_entry_visitor_proxy(result.toNative(), cefBool(visit))
 	}
 	return result
 }
@@ -35,14 +46,15 @@ func (d *NavigationEntryVisitor) toNative() *C.cef_navigation_entry_visitor_t {
 	return (*C.cef_navigation_entry_visitor_t)(d)
 }
 
-func lookupNavigationEntryVisitorProxy(obj *BaseRefCounted) NavigationEntryVisitorProxy {
+func lookupNavigationEntryVisitorProxy(obj *BaseRefCounted, callback string) NavigationEntryVisitorProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("NavigationEntryVisitor", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(NavigationEntryVisitorProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type NavigationEntryVisitorProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("NavigationEntryVisitor", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -59,13 +71,20 @@ func (d *NavigationEntryVisitor) Base() *BaseRefCounted {
 // stop. |current| is true (1) if this entry is the currently loaded
 // navigation entry. |index| is the 0-based index of this entry and |total| is
 // the total number of entries.
-func (d *NavigationEntryVisitor) Visit(entry *NavigationEntry, current, index, total int32) int32 {
-	return lookupNavigationEntryVisitorProxy(d.Base()).Visit(d, entry, current, index, total)
+func (d *NavigationEntryVisitor) Visit(entry *NavigationEntry, current bool, index, total int32) bool {
+	if proxy__, ok__ := lookupNavigationEntryVisitorProxy(d.Base(), "Visit").(NavigationEntryVisitorVisitProxy); ok__ {
+		return proxy__.Visit(d, entry, current, index, total)
+	}
+	return false
 }
 
 //export gocef_navigation_entry_visitor_visit
 func gocef_navigation_entry_visitor_visit(self *C.cef_navigation_entry_visitor_t, entry *C.cef_navigation_entry_t, current C.int, index C.int, total C.int) C.int {
+	defer recoverProxyPanic("NavigationEntryVisitor", "Visit")
 	me__ := (*NavigationEntryVisitor)(self)
-	proxy__ := lookupNavigationEntryVisitorProxy(me__.Base())
-	return C.int(proxy__.Visit(me__, (*NavigationEntry)(entry), int32(current), int32(index), int32(total)))
+	proxy__, ok__ := lookupNavigationEntryVisitorProxy(me__.Base(), "Visit").(NavigationEntryVisitorVisitProxy)
+	if !ok__ {
+		return 0
+	}
+	return cefBool(proxy__.Visit(me__, (*NavigationEntry)(entry), current != 0, int32(index), int32(total)))
 }
@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_navigation_entry_visitor_proxy(cef_navigation_entry_visitor_t *self);
+void gocef_set_navigation_entry_visitor_proxy(cef_navigation_entry_visitor_t *self, int visit);
 
 #endif // GOCEF_NavigationEntryVisitor_H_
@@ -34,8 +34,8 @@ func (d *NavigationEntry) Base() *BaseRefCounted {
 // IsValid (is_valid)
 // Returns true (1) if this object is valid. Do not call any other functions
 // if this function returns false (0).
-func (d *NavigationEntry) IsValid() int32 {
-	return int32(C.gocef_navigation_entry_is_valid(d.toNative(), d.is_valid))
+func (d *NavigationEntry) IsValid() bool {
+	return C.gocef_navigation_entry_is_valid(d.toNative(), d.is_valid) != 0
 }
 
 // GetUrl (get_url)
@@ -76,8 +76,8 @@ func (d *NavigationEntry) GetTransitionType() TransitionType {
 
 // HasPostData (has_post_data)
 // Returns true (1) if this navigation includes post data.
-func (d *NavigationEntry) HasPostData() int32 {
-	return int32(C.gocef_navigation_entry_has_post_data(d.toNative(), d.has_post_data))
+func (d *NavigationEntry) HasPostData() bool {
+	return C.gocef_navigation_entry_has_post_data(d.toNative(), d.has_post_data) != 0
 }
 
 // GetCompletionTime (get_completion_time)
@@ -101,6 +101,7 @@ func (d *NavigationEntry) GetHttpStatusCode() int32 {
 
 // GetSslstatus (get_sslstatus)
 // Returns the SSL information for this navigation entry.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *NavigationEntry) GetSslstatus() *Sslstatus {
 	return (*Sslstatus)(C.gocef_navigation_entry_get_sslstatus(d.toNative(), d.get_sslstatus))
 }
@@ -6,14 +6,24 @@ import (
 	// #include "PDFPrintCallback_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// PDFPrintCallbackProxy defines methods required for using PDFPrintCallback.
+// PDFPrintCallbackProxy is implemented by the proxies used with PDFPrintCallback. Embed
+// DefaultPDFPrintCallback to satisfy it, then implement any of the optional
+// PDFPrintCallback*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type PDFPrintCallbackProxy interface {
-	OnPdfPrintFinished(self *PDFPrintCallback, path string, ok int32)
+	isPDFPrintCallbackProxy()
+}
+
+// DefaultPDFPrintCallback may be embedded to satisfy PDFPrintCallbackProxy.
+type DefaultPDFPrintCallback struct{}
+
+func (DefaultPDFPrintCallback) isPDFPrintCallbackProxy() {}
+
+// PDFPrintCallbackOnPdfPrintFinishedProxy may be implemented by a PDFPrintCallbackProxy to handle OnPdfPrintFinished.
+type PDFPrintCallbackOnPdfPrintFinishedProxy interface {
+	OnPdfPrintFinished(self *PDFPrintCallback, path string, ok bool)
 }
 
 // PDFPrintCallback (cef_pdf_print_callback_t from include/capi/cef_browser_capi.h)
@@ -26,7 +36,8 @@ type PDFPrintCallback C.cef_pdf_print_callback_t
 func NewPDFPrintCallback(proxy PDFPrintCallbackProxy) *PDFPrintCallback {
 	result := (*PDFPrintCallback)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_pdf_print_callback_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_pdf_print_callback_proxy(result.toNative())
+		_, on_pdf_print_finished := proxy.(PDFPrintCallbackOnPdfPrintFinishedProxy)
+		C.gocef_set_pdf_print_callback_proxy(result.toNative(), cefBool(on_pdf_print_finished))
 	}
 	return result
 }
@@ -35,14 +46,15 @@ func (d *PDFPrintCallback) toNative() *C.cef_pdf_print_callback_t {
 	return (*C.cef_pdf_print_callback_t)(d)
 }
 
-func lookupPDFPrintCallbackProxy(obj *BaseRefCounted) PDFPrintCallbackProxy {
+func lookupPDFPrintCallbackProxy(obj *BaseRefCounted, callback string) PDFPrintCallbackProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("PDFPrintCallback", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(PDFPrintCallbackProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type PDFPrintCallbackProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("PDFPrintCallback", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -57,14 +69,20 @@ func (d *PDFPrintCallback) Base() *BaseRefCounted {
 // Method that will be executed when the PDF printing has completed. |path| is
 // the output path. |ok| will be true (1) if the printing completed
 // successfully or false (0) otherwise.
-func (d *PDFPrintCallback) OnPdfPrintFinished(path string, ok int32) {
-	lookupPDFPrintCallbackProxy(d.Base()).OnPdfPrintFinished(d, path, ok)
+func (d *PDFPrintCallback) OnPdfPrintFinished(path string, ok bool) {
+	if proxy__, ok__ := lookupPDFPrintCallbackProxy(d.Base(), "OnPdfPrintFinished").(PDFPrintCallbackOnPdfPrintFinishedProxy); ok__ {
+		proxy__.OnPdfPrintFinished(d, path, ok)
+	}
 }
 
 //export gocef_pdf_print_callback_on_pdf_print_finished
 func gocef_pdf_print_callback_on_pdf_print_finished(self *C.cef_pdf_print_callback_t, path *C.cef_string_t, ok C.int) {
+	defer recoverProxyPanic("PDFPrintCallback", "OnPdfPrintFinished")
 	me__ := (*PDFPrintCallback)(self)
-	proxy__ := lookupPDFPrintCallbackProxy(me__.Base())
+	proxy__, ok__ := lookupPDFPrintCallbackProxy(me__.Base(), "OnPdfPrintFinished").(PDFPrintCallbackOnPdfPrintFinishedProxy)
+	if !ok__ {
+		return
+	}
 	path_ := cefstrToString(path)
-	proxy__.OnPdfPrintFinished(me__, path_, int32(ok))
+	proxy__.OnPdfPrintFinished(me__, path_, ok != 0)
 }
@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_pdf_print_callback_proxy(cef_pdf_print_callback_t *self);
+void gocef_set_pdf_print_callback_proxy(cef_pdf_print_callback_t *self, int on_pdf_print_finished);
 
 #endif // GOCEF_PDFPrintCallback_H_
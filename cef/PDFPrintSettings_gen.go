@@ -45,17 +45,17 @@ type PDFPrintSettings struct {
 	// HeaderFooterEnabled (header_footer_enabled)
 	// Set to true (1) to print headers and footers or false (0) to not print
 	// headers and footers.
-	HeaderFooterEnabled int32
+	HeaderFooterEnabled bool
 	// SelectionOnly (selection_only)
 	// Set to true (1) to print the selection only or false (0) to print all.
-	SelectionOnly int32
+	SelectionOnly bool
 	// Landscape (landscape)
 	// Set to true (1) for landscape mode or false (0) for portrait mode.
-	Landscape int32
+	Landscape bool
 	// BackgroundsEnabled (backgrounds_enabled)
 	// Set to true (1) to print background graphics or false (0) to not print
 	// background graphics.
-	BackgroundsEnabled int32
+	BackgroundsEnabled bool
 }
 
 // NewPDFPrintSettings creates a new PDFPrintSettings.
@@ -77,10 +77,10 @@ func (d *PDFPrintSettings) toNative(native *C.cef_pdf_print_settings_t) *C.cef_p
 	native.margin_bottom = C.double(d.MarginBottom)
 	native.margin_left = C.double(d.MarginLeft)
 	native.margin_type = C.cef_pdf_print_margin_type_t(d.MarginType)
-	native.header_footer_enabled = C.int(d.HeaderFooterEnabled)
-	native.selection_only = C.int(d.SelectionOnly)
-	native.landscape = C.int(d.Landscape)
-	native.backgrounds_enabled = C.int(d.BackgroundsEnabled)
+	native.header_footer_enabled = cefBool(d.HeaderFooterEnabled)
+	native.selection_only = cefBool(d.SelectionOnly)
+	native.landscape = cefBool(d.Landscape)
+	native.backgrounds_enabled = cefBool(d.BackgroundsEnabled)
 	return native
 }
 
@@ -104,8 +104,8 @@ func (n *C.cef_pdf_print_settings_t) intoGo(d *PDFPrintSettings) {
 	d.MarginBottom = float64(n.margin_bottom)
 	d.MarginLeft = float64(n.margin_left)
 	d.MarginType = PDFPrintMarginType(n.margin_type)
-	d.HeaderFooterEnabled = int32(n.header_footer_enabled)
-	d.SelectionOnly = int32(n.selection_only)
-	d.Landscape = int32(n.landscape)
-	d.BackgroundsEnabled = int32(n.backgrounds_enabled)
+	d.HeaderFooterEnabled = n.header_footer_enabled != 0
+	d.SelectionOnly = n.selection_only != 0
+	d.Landscape = n.landscape != 0
+	d.BackgroundsEnabled = n.backgrounds_enabled != 0
 }
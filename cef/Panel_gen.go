@@ -37,6 +37,7 @@ func (d *Panel) Base() *View {
 
 // AsWindow (as_window)
 // Returns this Panel as a Window or NULL if this is not a Window.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *Panel) AsWindow() *Window {
 	return (*Window)(C.gocef_panel_as_window(d.toNative(), d.as_window))
 }
@@ -49,12 +50,14 @@ func (d *Panel) SetToFillLayout() *FillLayout {
 
 // SetToBoxLayout (set_to_box_layout)
 // Set this Panel's Layout to BoxLayout and return the BoxLayout object.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *Panel) SetToBoxLayout(settings *BoxLayoutSettings) *BoxLayout {
 	return (*BoxLayout)(C.gocef_panel_set_to_box_layout(d.toNative(), settings.toNative(&C.cef_box_layout_settings_t{}), d.set_to_box_layout))
 }
 
 // GetLayout (get_layout)
 // Get the Layout.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *Panel) GetLayout() *Layout {
 	return (*Layout)(C.gocef_panel_get_layout(d.toNative(), d.get_layout))
 }
@@ -107,6 +110,7 @@ func (d *Panel) GetChildViewCount() uint64 {
 
 // GetChildViewAt (get_child_view_at)
 // Returns the child View at the specified |index|.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *Panel) GetChildViewAt(index int32) *View {
 	return (*View)(C.gocef_panel_get_child_view_at(d.toNative(), C.int(index), d.get_child_view_at))
 }
@@ -13,27 +13,27 @@ type PopupFeatures struct {
 	// X (x)
 	X int32
 	// XSet (xSet)
-	XSet int32
+	XSet bool
 	// Y (y)
 	Y int32
 	// YSet (ySet)
-	YSet int32
+	YSet bool
 	// Width (width)
 	Width int32
 	// WidthSet (widthSet)
-	WidthSet int32
+	WidthSet bool
 	// Height (height)
 	Height int32
 	// HeightSet (heightSet)
-	HeightSet int32
+	HeightSet bool
 	// MenuBarVisible (menuBarVisible)
-	MenuBarVisible int32
+	MenuBarVisible bool
 	// StatusBarVisible (statusBarVisible)
-	StatusBarVisible int32
+	StatusBarVisible bool
 	// ToolBarVisible (toolBarVisible)
-	ToolBarVisible int32
+	ToolBarVisible bool
 	// ScrollbarsVisible (scrollbarsVisible)
-	ScrollbarsVisible int32
+	ScrollbarsVisible bool
 }
 
 // NewPopupFeatures creates a new PopupFeatures.
@@ -46,17 +46,17 @@ func (d *PopupFeatures) toNative(native *C.cef_popup_features_t) *C.cef_popup_fe
 		return nil
 	}
 	native.x = C.int(d.X)
-	native.xSet = C.int(d.XSet)
+	native.xSet = cefBool(d.XSet)
 	native.y = C.int(d.Y)
-	native.ySet = C.int(d.YSet)
+	native.ySet = cefBool(d.YSet)
 	native.width = C.int(d.Width)
-	native.widthSet = C.int(d.WidthSet)
+	native.widthSet = cefBool(d.WidthSet)
 	native.height = C.int(d.Height)
-	native.heightSet = C.int(d.HeightSet)
-	native.menuBarVisible = C.int(d.MenuBarVisible)
-	native.statusBarVisible = C.int(d.StatusBarVisible)
-	native.toolBarVisible = C.int(d.ToolBarVisible)
-	native.scrollbarsVisible = C.int(d.ScrollbarsVisible)
+	native.heightSet = cefBool(d.HeightSet)
+	native.menuBarVisible = cefBool(d.MenuBarVisible)
+	native.statusBarVisible = cefBool(d.StatusBarVisible)
+	native.toolBarVisible = cefBool(d.ToolBarVisible)
+	native.scrollbarsVisible = cefBool(d.ScrollbarsVisible)
 	return native
 }
 
@@ -71,15 +71,15 @@ func (n *C.cef_popup_features_t) toGo() *PopupFeatures {
 
 func (n *C.cef_popup_features_t) intoGo(d *PopupFeatures) {
 	d.X = int32(n.x)
-	d.XSet = int32(n.xSet)
+	d.XSet = n.xSet != 0
 	d.Y = int32(n.y)
-	d.YSet = int32(n.ySet)
+	d.YSet = n.ySet != 0
 	d.Width = int32(n.width)
-	d.WidthSet = int32(n.widthSet)
+	d.WidthSet = n.widthSet != 0
 	d.Height = int32(n.height)
-	d.HeightSet = int32(n.heightSet)
-	d.MenuBarVisible = int32(n.menuBarVisible)
-	d.StatusBarVisible = int32(n.statusBarVisible)
-	d.ToolBarVisible = int32(n.toolBarVisible)
-	d.ScrollbarsVisible = int32(n.scrollbarsVisible)
+	d.HeightSet = n.heightSet != 0
+	d.MenuBarVisible = n.menuBarVisible != 0
+	d.StatusBarVisible = n.statusBarVisible != 0
+	d.ToolBarVisible = n.toolBarVisible != 0
+	d.ScrollbarsVisible = n.scrollbarsVisible != 0
 }
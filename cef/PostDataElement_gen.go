@@ -33,8 +33,8 @@ func (d *PostDataElement) Base() *BaseRefCounted {
 
 // IsReadOnly (is_read_only)
 // Returns true (1) if this object is read-only.
-func (d *PostDataElement) IsReadOnly() int32 {
-	return int32(C.gocef_post_data_element_is_read_only(d.toNative(), d.is_read_only))
+func (d *PostDataElement) IsReadOnly() bool {
+	return C.gocef_post_data_element_is_read_only(d.toNative(), d.is_read_only) != 0
 }
 
 // SetToEmpty (set_to_empty)
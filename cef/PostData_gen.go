@@ -31,8 +31,8 @@ func (d *PostData) Base() *BaseRefCounted {
 
 // IsReadOnly (is_read_only)
 // Returns true (1) if this object is read-only.
-func (d *PostData) IsReadOnly() int32 {
-	return int32(C.gocef_post_data_is_read_only(d.toNative(), d.is_read_only))
+func (d *PostData) IsReadOnly() bool {
+	return C.gocef_post_data_is_read_only(d.toNative(), d.is_read_only) != 0
 }
 
 // HasExcludedElements (has_excluded_elements)
@@ -40,8 +40,8 @@ func (d *PostData) IsReadOnly() int32 {
 // represented by this cef_post_data_t object (for example, multi-part file
 // upload data). Modifying cef_post_data_t objects with excluded elements may
 // result in the request failing.
-func (d *PostData) HasExcludedElements() int32 {
-	return int32(C.gocef_post_data_has_excluded_elements(d.toNative(), d.has_excluded_elements))
+func (d *PostData) HasExcludedElements() bool {
+	return C.gocef_post_data_has_excluded_elements(d.toNative(), d.has_excluded_elements) != 0
 }
 
 // GetElementCount (get_element_count)
@@ -60,14 +60,14 @@ func (d *PostData) GetElements(elementsCount *uint64, elements **PostDataElement
 // RemoveElement (remove_element)
 // Remove the specified post data element.  Returns true (1) if the removal
 // succeeds.
-func (d *PostData) RemoveElement(element *PostDataElement) int32 {
-	return int32(C.gocef_post_data_remove_element(d.toNative(), element.toNative(), d.remove_element))
+func (d *PostData) RemoveElement(element *PostDataElement) bool {
+	return C.gocef_post_data_remove_element(d.toNative(), element.toNative(), d.remove_element) != 0
 }
 
 // AddElement (add_element)
 // Add the specified post data element.  Returns true (1) if the add succeeds.
-func (d *PostData) AddElement(element *PostDataElement) int32 {
-	return int32(C.gocef_post_data_add_element(d.toNative(), element.toNative(), d.add_element))
+func (d *PostData) AddElement(element *PostDataElement) bool {
+	return C.gocef_post_data_add_element(d.toNative(), element.toNative(), d.add_element) != 0
 }
 
 // RemoveElements (remove_elements)
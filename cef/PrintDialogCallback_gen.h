@@ -1,11 +0,0 @@
-// Code generated - DO NOT EDIT.
-
-#ifndef GOCEF_PrintDialogCallback_H_
-#define GOCEF_PrintDialogCallback_H_
-#pragma once
-
-#include "capi_gen.h"
-
-void gocef_set_print_dialog_callback_proxy(cef_print_dialog_callback_t *self);
-
-#endif // GOCEF_PrintDialogCallback_H_
@@ -3,13 +3,25 @@
 #include "PrintHandler_gen.h"
 #include "_cgo_export.h"
 
-void gocef_set_print_handler_proxy(cef_print_handler_t *self) {
+void gocef_set_print_handler_proxy(cef_print_handler_t *self, int on_print_start, int on_print_settings, int on_print_dialog, int on_print_job, int on_print_reset, int get_pdf_paper_size) {
 	// Casts to (void *) added to avoid warnings since Go callbacks can't have
 	// some modifiers, such as 'const' applied to their parameter signatures.
-	self->on_print_start = (void *)&gocef_print_handler_on_print_start;
-	self->on_print_settings = (void *)&gocef_print_handler_on_print_settings;
-	self->on_print_dialog = (void *)&gocef_print_handler_on_print_dialog;
-	self->on_print_job = (void *)&gocef_print_handler_on_print_job;
-	self->on_print_reset = (void *)&gocef_print_handler_on_print_reset;
-	self->get_pdf_paper_size = (void *)&gocef_print_handler_get_pdf_paper_size;
+	if (on_print_start) {
+		self->on_print_start = (void *)&gocef_print_handler_on_print_start;
+	}
+	if (on_print_settings) {
+		self->on_print_settings = (void *)&gocef_print_handler_on_print_settings;
+	}
+	if (on_print_dialog) {
+		self->on_print_dialog = (void *)&gocef_print_handler_on_print_dialog;
+	}
+	if (on_print_job) {
+		self->on_print_job = (void *)&gocef_print_handler_on_print_job;
+	}
+	if (on_print_reset) {
+		self->on_print_reset = (void *)&gocef_print_handler_on_print_reset;
+	}
+	if (get_pdf_paper_size) {
+		self->get_pdf_paper_size = (void *)&gocef_print_handler_get_pdf_paper_size;
+	}
 }
@@ -6,18 +6,48 @@ import (
 	// #include "PrintHandler_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// PrintHandlerProxy defines methods required for using PrintHandler.
+// PrintHandlerProxy is implemented by the proxies used with PrintHandler. Embed
+// DefaultPrintHandler to satisfy it, then implement any of the optional
+// PrintHandler*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type PrintHandlerProxy interface {
+	isPrintHandlerProxy()
+}
+
+// DefaultPrintHandler may be embedded to satisfy PrintHandlerProxy.
+type DefaultPrintHandler struct{}
+
+func (DefaultPrintHandler) isPrintHandlerProxy() {}
+
+// PrintHandlerOnPrintStartProxy may be implemented by a PrintHandlerProxy to handle OnPrintStart.
+type PrintHandlerOnPrintStartProxy interface {
 	OnPrintStart(self *PrintHandler, browser *Browser)
-	OnPrintSettings(self *PrintHandler, browser *Browser, settings *PrintSettings, get_defaults int32)
-	OnPrintDialog(self *PrintHandler, browser *Browser, has_selection int32, callback *PrintDialogCallback) int32
-	OnPrintJob(self *PrintHandler, browser *Browser, document_name, pdf_file_path string, callback *PrintJobCallback) int32
+}
+
+// PrintHandlerOnPrintSettingsProxy may be implemented by a PrintHandlerProxy to handle OnPrintSettings.
+type PrintHandlerOnPrintSettingsProxy interface {
+	OnPrintSettings(self *PrintHandler, browser *Browser, settings *PrintSettings, get_defaults bool)
+}
+
+// PrintHandlerOnPrintDialogProxy may be implemented by a PrintHandlerProxy to handle OnPrintDialog.
+type PrintHandlerOnPrintDialogProxy interface {
+	OnPrintDialog(self *PrintHandler, browser *Browser, has_selection bool, callback *PrintDialogCallback) bool
+}
+
+// PrintHandlerOnPrintJobProxy may be implemented by a PrintHandlerProxy to handle OnPrintJob.
+type PrintHandlerOnPrintJobProxy interface {
+	OnPrintJob(self *PrintHandler, browser *Browser, document_name, pdf_file_path string, callback *PrintJobCallback) bool
+}
+
+// PrintHandlerOnPrintResetProxy may be implemented by a PrintHandlerProxy to handle OnPrintReset.
+type PrintHandlerOnPrintResetProxy interface {
 	OnPrintReset(self *PrintHandler, browser *Browser)
+}
+
+// PrintHandlerGetPdfPaperSizeProxy may be implemented by a PrintHandlerProxy to handle GetPdfPaperSize.
+type PrintHandlerGetPdfPaperSizeProxy interface {
 	GetPdfPaperSize(self *PrintHandler, device_units_per_inch int32) Size
 }
 
@@ -32,7 +62,13 @@ type PrintHandler C.cef_print_handler_t
 func NewPrintHandler(proxy PrintHandlerProxy) *PrintHandler {
 	result := (*PrintHandler)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_print_handler_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_print_handler_proxy(result.toNative())
+		_, on_print_start := proxy.(PrintHandlerOnPrintStartProxy)
+		_, on_print_settings := proxy.(PrintHandlerOnPrintSettingsProxy)
+		_, on_print_dialog := proxy.(PrintHandlerOnPrintDialogProxy)
+		_, on_print_job := proxy.(PrintHandlerOnPrintJobProxy)
+		_, on_print_reset := proxy.(PrintHandlerOnPrintResetProxy)
+		_, get_pdf_paper_size := proxy.(PrintHandlerGetPdfPaperSizeProxy)
+		C.gocef_set_print_handler_proxy(result.toNative(), cefBool(on_print_start), cefBool(on_print_settings), cefBool(on_print_dialog), cefBool(on_print_job), cefBool(on_print_reset), cefBool(get_pdf_paper_size))
 	}
 	return result
 }
@@ -41,14 +77,15 @@ func (d *PrintHandler) toNative() *C.cef_print_handler_t {
 	return (*C.cef_print_handler_t)(d)
 }
 
-func lookupPrintHandlerProxy(obj *BaseRefCounted) PrintHandlerProxy {
+func lookupPrintHandlerProxy(obj *BaseRefCounted, callback string) PrintHandlerProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("PrintHandler", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(PrintHandlerProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type PrintHandlerProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("PrintHandler", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -65,13 +102,19 @@ func (d *PrintHandler) Base() *BaseRefCounted {
 // how printing was initiated (e.g. cef_browser_host_t::print(), JavaScript
 // window.print() or PDF extension print button).
 func (d *PrintHandler) OnPrintStart(browser *Browser) {
-	lookupPrintHandlerProxy(d.Base()).OnPrintStart(d, browser)
+	if proxy__, ok__ := lookupPrintHandlerProxy(d.Base(), "OnPrintStart").(PrintHandlerOnPrintStartProxy); ok__ {
+		proxy__.OnPrintStart(d, browser)
+	}
 }
 
 //export gocef_print_handler_on_print_start
 func gocef_print_handler_on_print_start(self *C.cef_print_handler_t, browser *C.cef_browser_t) {
+	defer recoverProxyPanic("PrintHandler", "OnPrintStart")
 	me__ := (*PrintHandler)(self)
-	proxy__ := lookupPrintHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupPrintHandlerProxy(me__.Base(), "OnPrintStart").(PrintHandlerOnPrintStartProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnPrintStart(me__, (*Browser)(browser))
 }
 
@@ -79,59 +122,85 @@ func gocef_print_handler_on_print_start(self *C.cef_print_handler_t, browser *C.
 // Synchronize |settings| with client state. If |get_defaults| is true (1)
 // then populate |settings| with the default print settings. Do not keep a
 // reference to |settings| outside of this callback.
-func (d *PrintHandler) OnPrintSettings(browser *Browser, settings *PrintSettings, get_defaults int32) {
-	lookupPrintHandlerProxy(d.Base()).OnPrintSettings(d, browser, settings, get_defaults)
+func (d *PrintHandler) OnPrintSettings(browser *Browser, settings *PrintSettings, get_defaults bool) {
+	if proxy__, ok__ := lookupPrintHandlerProxy(d.Base(), "OnPrintSettings").(PrintHandlerOnPrintSettingsProxy); ok__ {
+		proxy__.OnPrintSettings(d, browser, settings, get_defaults)
+	}
 }
 
 //export gocef_print_handler_on_print_settings
 func gocef_print_handler_on_print_settings(self *C.cef_print_handler_t, browser *C.cef_browser_t, settings *C.cef_print_settings_t, get_defaults C.int) {
+	defer recoverProxyPanic("PrintHandler", "OnPrintSettings")
 	me__ := (*PrintHandler)(self)
-	proxy__ := lookupPrintHandlerProxy(me__.Base())
-	proxy__.OnPrintSettings(me__, (*Browser)(browser), (*PrintSettings)(settings), int32(get_defaults))
+	proxy__, ok__ := lookupPrintHandlerProxy(me__.Base(), "OnPrintSettings").(PrintHandlerOnPrintSettingsProxy)
+	if !ok__ {
+		return
+	}
+	proxy__.OnPrintSettings(me__, (*Browser)(browser), (*PrintSettings)(settings), get_defaults != 0)
 }
 
 // OnPrintDialog (on_print_dialog)
 // Show the print dialog. Execute |callback| once the dialog is dismissed.
 // Return true (1) if the dialog will be displayed or false (0) to cancel the
 // printing immediately.
-func (d *PrintHandler) OnPrintDialog(browser *Browser, has_selection int32, callback *PrintDialogCallback) int32 {
-	return lookupPrintHandlerProxy(d.Base()).OnPrintDialog(d, browser, has_selection, callback)
+func (d *PrintHandler) OnPrintDialog(browser *Browser, has_selection bool, callback *PrintDialogCallback) bool {
+	if proxy__, ok__ := lookupPrintHandlerProxy(d.Base(), "OnPrintDialog").(PrintHandlerOnPrintDialogProxy); ok__ {
+		return proxy__.OnPrintDialog(d, browser, has_selection, callback)
+	}
+	return false
 }
 
 //export gocef_print_handler_on_print_dialog
 func gocef_print_handler_on_print_dialog(self *C.cef_print_handler_t, browser *C.cef_browser_t, has_selection C.int, callback *C.cef_print_dialog_callback_t) C.int {
+	defer recoverProxyPanic("PrintHandler", "OnPrintDialog")
 	me__ := (*PrintHandler)(self)
-	proxy__ := lookupPrintHandlerProxy(me__.Base())
-	return C.int(proxy__.OnPrintDialog(me__, (*Browser)(browser), int32(has_selection), (*PrintDialogCallback)(callback)))
+	proxy__, ok__ := lookupPrintHandlerProxy(me__.Base(), "OnPrintDialog").(PrintHandlerOnPrintDialogProxy)
+	if !ok__ {
+		return 0
+	}
+	return cefBool(proxy__.OnPrintDialog(me__, (*Browser)(browser), has_selection != 0, (*PrintDialogCallback)(callback)))
 }
 
 // OnPrintJob (on_print_job)
 // Send the print job to the printer. Execute |callback| once the job is
 // completed. Return true (1) if the job will proceed or false (0) to cancel
 // the job immediately.
-func (d *PrintHandler) OnPrintJob(browser *Browser, document_name, pdf_file_path string, callback *PrintJobCallback) int32 {
-	return lookupPrintHandlerProxy(d.Base()).OnPrintJob(d, browser, document_name, pdf_file_path, callback)
+func (d *PrintHandler) OnPrintJob(browser *Browser, document_name, pdf_file_path string, callback *PrintJobCallback) bool {
+	if proxy__, ok__ := lookupPrintHandlerProxy(d.Base(), "OnPrintJob").(PrintHandlerOnPrintJobProxy); ok__ {
+		return proxy__.OnPrintJob(d, browser, document_name, pdf_file_path, callback)
+	}
+	return false
 }
 
 //export gocef_print_handler_on_print_job
 func gocef_print_handler_on_print_job(self *C.cef_print_handler_t, browser *C.cef_browser_t, document_name *C.cef_string_t, pdf_file_path *C.cef_string_t, callback *C.cef_print_job_callback_t) C.int {
+	defer recoverProxyPanic("PrintHandler", "OnPrintJob")
 	me__ := (*PrintHandler)(self)
-	proxy__ := lookupPrintHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupPrintHandlerProxy(me__.Base(), "OnPrintJob").(PrintHandlerOnPrintJobProxy)
+	if !ok__ {
+		return 0
+	}
 	document_name_ := cefstrToString(document_name)
 	pdf_file_path_ := cefstrToString(pdf_file_path)
-	return C.int(proxy__.OnPrintJob(me__, (*Browser)(browser), document_name_, pdf_file_path_, (*PrintJobCallback)(callback)))
+	return cefBool(proxy__.OnPrintJob(me__, (*Browser)(browser), document_name_, pdf_file_path_, (*PrintJobCallback)(callback)))
 }
 
 // OnPrintReset (on_print_reset)
 // Reset client state related to printing.
 func (d *PrintHandler) OnPrintReset(browser *Browser) {
-	lookupPrintHandlerProxy(d.Base()).OnPrintReset(d, browser)
+	if proxy__, ok__ := lookupPrintHandlerProxy(d.Base(), "OnPrintReset").(PrintHandlerOnPrintResetProxy); ok__ {
+		proxy__.OnPrintReset(d, browser)
+	}
 }
 
 //export gocef_print_handler_on_print_reset
 func gocef_print_handler_on_print_reset(self *C.cef_print_handler_t, browser *C.cef_browser_t) {
+	defer recoverProxyPanic("PrintHandler", "OnPrintReset")
 	me__ := (*PrintHandler)(self)
-	proxy__ := lookupPrintHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupPrintHandlerProxy(me__.Base(), "OnPrintReset").(PrintHandlerOnPrintResetProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnPrintReset(me__, (*Browser)(browser))
 }
 
@@ -139,13 +208,20 @@ func gocef_print_handler_on_print_reset(self *C.cef_print_handler_t, browser *C.
 // Return the PDF paper size in device units. Used in combination with
 // cef_browser_host_t::print_to_pdf().
 func (d *PrintHandler) GetPdfPaperSize(device_units_per_inch int32) Size {
-	return lookupPrintHandlerProxy(d.Base()).GetPdfPaperSize(d, device_units_per_inch)
+	if proxy__, ok__ := lookupPrintHandlerProxy(d.Base(), "GetPdfPaperSize").(PrintHandlerGetPdfPaperSizeProxy); ok__ {
+		return proxy__.GetPdfPaperSize(d, device_units_per_inch)
+	}
+	return Size{}
 }
 
 //export gocef_print_handler_get_pdf_paper_size
 func gocef_print_handler_get_pdf_paper_size(self *C.cef_print_handler_t, device_units_per_inch C.int) C.cef_size_t {
+	defer recoverProxyPanic("PrintHandler", "GetPdfPaperSize")
 	me__ := (*PrintHandler)(self)
-	proxy__ := lookupPrintHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupPrintHandlerProxy(me__.Base(), "GetPdfPaperSize").(PrintHandlerGetPdfPaperSizeProxy)
+	if !ok__ {
+		return C.cef_size_t{}
+	}
 	call__ := proxy__.GetPdfPaperSize(me__, int32(device_units_per_inch))
 	var result__ C.cef_size_t
 	call__.toNative(&result__)
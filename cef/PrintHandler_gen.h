@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_print_handler_proxy(cef_print_handler_t *self);
+void gocef_set_print_handler_proxy(cef_print_handler_t *self, int on_print_start, int on_print_settings, int on_print_dialog, int on_print_job, int on_print_reset, int get_pdf_paper_size);
 
 #endif // GOCEF_PrintHandler_H_
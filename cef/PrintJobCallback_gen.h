@@ -1,11 +0,0 @@
-// Code generated - DO NOT EDIT.
-
-#ifndef GOCEF_PrintJobCallback_H_
-#define GOCEF_PrintJobCallback_H_
-#pragma once
-
-#include "capi_gen.h"
-
-void gocef_set_print_job_callback_proxy(cef_print_job_callback_t *self);
-
-#endif // GOCEF_PrintJobCallback_H_
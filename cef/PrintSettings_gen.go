@@ -47,41 +47,42 @@ func (d *PrintSettings) Base() *BaseRefCounted {
 // IsValid (is_valid)
 // Returns true (1) if this object is valid. Do not call any other functions
 // if this function returns false (0).
-func (d *PrintSettings) IsValid() int32 {
-	return int32(C.gocef_print_settings_is_valid(d.toNative(), d.is_valid))
+func (d *PrintSettings) IsValid() bool {
+	return C.gocef_print_settings_is_valid(d.toNative(), d.is_valid) != 0
 }
 
 // IsReadOnly (is_read_only)
 // Returns true (1) if the values of this object are read-only. Some APIs may
 // expose read-only objects.
-func (d *PrintSettings) IsReadOnly() int32 {
-	return int32(C.gocef_print_settings_is_read_only(d.toNative(), d.is_read_only))
+func (d *PrintSettings) IsReadOnly() bool {
+	return C.gocef_print_settings_is_read_only(d.toNative(), d.is_read_only) != 0
 }
 
 // Copy (copy)
 // Returns a writable copy of this object.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *PrintSettings) Copy() *PrintSettings {
 	return (*PrintSettings)(C.gocef_print_settings_copy(d.toNative(), d.copy))
 }
 
 // SetOrientation (set_orientation)
 // Set the page orientation.
-func (d *PrintSettings) SetOrientation(landscape int32) {
-	C.gocef_print_settings_set_orientation(d.toNative(), C.int(landscape), d.set_orientation)
+func (d *PrintSettings) SetOrientation(landscape bool) {
+	C.gocef_print_settings_set_orientation(d.toNative(), cefBool(landscape), d.set_orientation)
 }
 
 // IsLandscape (is_landscape)
 // Returns true (1) if the orientation is landscape.
-func (d *PrintSettings) IsLandscape() int32 {
-	return int32(C.gocef_print_settings_is_landscape(d.toNative(), d.is_landscape))
+func (d *PrintSettings) IsLandscape() bool {
+	return C.gocef_print_settings_is_landscape(d.toNative(), d.is_landscape) != 0
 }
 
 // SetPrinterPrintableArea (set_printer_printable_area)
 // Set the printer printable area in device units. Some platforms already
 // provide flipped area. Set |landscape_needs_flip| to false (0) on those
 // platforms to avoid double flipping.
-func (d *PrintSettings) SetPrinterPrintableArea(physical_size_device_units *Size, printable_area_device_units *Rect, landscape_needs_flip int32) {
-	C.gocef_print_settings_set_printer_printable_area(d.toNative(), physical_size_device_units.toNative(&C.cef_size_t{}), printable_area_device_units.toNative(&C.cef_rect_t{}), C.int(landscape_needs_flip), d.set_printer_printable_area)
+func (d *PrintSettings) SetPrinterPrintableArea(physical_size_device_units *Size, printable_area_device_units *Rect, landscape_needs_flip bool) {
+	C.gocef_print_settings_set_printer_printable_area(d.toNative(), physical_size_device_units.toNative(&C.cef_size_t{}), printable_area_device_units.toNative(&C.cef_rect_t{}), cefBool(landscape_needs_flip), d.set_printer_printable_area)
 }
 
 // SetDeviceName (set_device_name)
@@ -134,26 +135,26 @@ func (d *PrintSettings) GetPageRanges(rangesCount *uint64, ranges *Range) {
 
 // SetSelectionOnly (set_selection_only)
 // Set whether only the selection will be printed.
-func (d *PrintSettings) SetSelectionOnly(selection_only int32) {
-	C.gocef_print_settings_set_selection_only(d.toNative(), C.int(selection_only), d.set_selection_only)
+func (d *PrintSettings) SetSelectionOnly(selection_only bool) {
+	C.gocef_print_settings_set_selection_only(d.toNative(), cefBool(selection_only), d.set_selection_only)
 }
 
 // IsSelectionOnly (is_selection_only)
 // Returns true (1) if only the selection will be printed.
-func (d *PrintSettings) IsSelectionOnly() int32 {
-	return int32(C.gocef_print_settings_is_selection_only(d.toNative(), d.is_selection_only))
+func (d *PrintSettings) IsSelectionOnly() bool {
+	return C.gocef_print_settings_is_selection_only(d.toNative(), d.is_selection_only) != 0
 }
 
 // SetCollate (set_collate)
 // Set whether pages will be collated.
-func (d *PrintSettings) SetCollate(collate int32) {
-	C.gocef_print_settings_set_collate(d.toNative(), C.int(collate), d.set_collate)
+func (d *PrintSettings) SetCollate(collate bool) {
+	C.gocef_print_settings_set_collate(d.toNative(), cefBool(collate), d.set_collate)
 }
 
 // WillCollate (will_collate)
 // Returns true (1) if pages will be collated.
-func (d *PrintSettings) WillCollate() int32 {
-	return int32(C.gocef_print_settings_will_collate(d.toNative(), d.will_collate))
+func (d *PrintSettings) WillCollate() bool {
+	return C.gocef_print_settings_will_collate(d.toNative(), d.will_collate) != 0
 }
 
 // SetColorModel (set_color_model)
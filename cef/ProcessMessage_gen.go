@@ -29,19 +29,20 @@ func (d *ProcessMessage) Base() *BaseRefCounted {
 // IsValid (is_valid)
 // Returns true (1) if this object is valid. Do not call any other functions
 // if this function returns false (0).
-func (d *ProcessMessage) IsValid() int32 {
-	return int32(C.gocef_process_message_is_valid(d.toNative(), d.is_valid))
+func (d *ProcessMessage) IsValid() bool {
+	return C.gocef_process_message_is_valid(d.toNative(), d.is_valid) != 0
 }
 
 // IsReadOnly (is_read_only)
 // Returns true (1) if the values of this object are read-only. Some APIs may
 // expose read-only objects.
-func (d *ProcessMessage) IsReadOnly() int32 {
-	return int32(C.gocef_process_message_is_read_only(d.toNative(), d.is_read_only))
+func (d *ProcessMessage) IsReadOnly() bool {
+	return C.gocef_process_message_is_read_only(d.toNative(), d.is_read_only) != 0
 }
 
 // Copy (copy)
 // Returns a writable copy of this object.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *ProcessMessage) Copy() *ProcessMessage {
 	return (*ProcessMessage)(C.gocef_process_message_copy(d.toNative(), d.copy))
 }
@@ -55,6 +56,7 @@ func (d *ProcessMessage) GetName() string {
 
 // GetArgumentList (get_argument_list)
 // Returns the list of arguments.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *ProcessMessage) GetArgumentList() *ListValue {
 	return (*ListValue)(C.gocef_process_message_get_argument_list(d.toNative(), d.get_argument_list))
 }
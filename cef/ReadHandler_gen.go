@@ -6,18 +6,44 @@ import (
 	// #include "ReadHandler_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// ReadHandlerProxy defines methods required for using ReadHandler.
+// ReadHandlerProxy is implemented by the proxies used with ReadHandler. Embed
+// DefaultReadHandler to satisfy it, then implement any of the optional
+// ReadHandler*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type ReadHandlerProxy interface {
+	isReadHandlerProxy()
+}
+
+// DefaultReadHandler may be embedded to satisfy ReadHandlerProxy.
+type DefaultReadHandler struct{}
+
+func (DefaultReadHandler) isReadHandlerProxy() {}
+
+// ReadHandlerReadProxy may be implemented by a ReadHandlerProxy to handle Read.
+type ReadHandlerReadProxy interface {
 	Read(self *ReadHandler, ptr unsafe.Pointer, size, n uint64) uint64
+}
+
+// ReadHandlerSeekProxy may be implemented by a ReadHandlerProxy to handle Seek.
+type ReadHandlerSeekProxy interface {
 	Seek(self *ReadHandler, offset int64, whence int32) int32
+}
+
+// ReadHandlerTellProxy may be implemented by a ReadHandlerProxy to handle Tell.
+type ReadHandlerTellProxy interface {
 	Tell(self *ReadHandler) int64
+}
+
+// ReadHandlerEofProxy may be implemented by a ReadHandlerProxy to handle Eof.
+type ReadHandlerEofProxy interface {
 	Eof(self *ReadHandler) int32
-	MayBlock(self *ReadHandler) int32
+}
+
+// ReadHandlerMayBlockProxy may be implemented by a ReadHandlerProxy to handle MayBlock.
+type ReadHandlerMayBlockProxy interface {
+	MayBlock(self *ReadHandler) bool
 }
 
 // ReadHandler (cef_read_handler_t from include/capi/cef_stream_capi.h)
@@ -30,7 +56,12 @@ type ReadHandler C.cef_read_handler_t
 func NewReadHandler(proxy ReadHandlerProxy) *ReadHandler {
 	result := (*ReadHandler)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_read_handler_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_read_handler_proxy(result.toNative())
+		_, read := proxy.(ReadHandlerReadProxy)
+		_, seek := proxy.(ReadHandlerSeekProxy)
+		_, tell := proxy.(ReadHandlerTellProxy)
+		_, eof := proxy.(ReadHandlerEofProxy)
+		_, may_block := proxy.(ReadHandlerMayBlockProxy)
+		C.gocef_set_read_handler_proxy(result.toNative(), cefBool(read), cefBool(seek), cefBool(tell), cefBool(eof), cefBool(may_block))
 	}
 	return result
 }
@@ -39,14 +70,15 @@ func (d *ReadHandler) toNative() *C.cef_read_handler_t {
 	return (*C.cef_read_handler_t)(d)
 }
 
-func lookupReadHandlerProxy(obj *BaseRefCounted) ReadHandlerProxy {
+func lookupReadHandlerProxy(obj *BaseRefCounted, callback string) ReadHandlerProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("ReadHandler", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(ReadHandlerProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type ReadHandlerProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("ReadHandler", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -60,13 +92,20 @@ func (d *ReadHandler) Base() *BaseRefCounted {
 // Read (read)
 // Read raw binary data.
 func (d *ReadHandler) Read(ptr unsafe.Pointer, size, n uint64) uint64 {
-	return lookupReadHandlerProxy(d.Base()).Read(d, ptr, size, n)
+	if proxy__, ok__ := lookupReadHandlerProxy(d.Base(), "Read").(ReadHandlerReadProxy); ok__ {
+		return proxy__.Read(d, ptr, size, n)
+	}
+	return 0
 }
 
 //export gocef_read_handler_read
 func gocef_read_handler_read(self *C.cef_read_handler_t, ptr unsafe.Pointer, size C.size_t, n C.size_t) C.size_t {
+	defer recoverProxyPanic("ReadHandler", "Read")
 	me__ := (*ReadHandler)(self)
-	proxy__ := lookupReadHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupReadHandlerProxy(me__.Base(), "Read").(ReadHandlerReadProxy)
+	if !ok__ {
+		return 0
+	}
 	return C.size_t(proxy__.Read(me__, ptr, uint64(size), uint64(n)))
 }
 
@@ -74,39 +113,60 @@ func gocef_read_handler_read(self *C.cef_read_handler_t, ptr unsafe.Pointer, siz
 // Seek to the specified offset position. |whence| may be any one of SEEK_CUR,
 // SEEK_END or SEEK_SET. Return zero on success and non-zero on failure.
 func (d *ReadHandler) Seek(offset int64, whence int32) int32 {
-	return lookupReadHandlerProxy(d.Base()).Seek(d, offset, whence)
+	if proxy__, ok__ := lookupReadHandlerProxy(d.Base(), "Seek").(ReadHandlerSeekProxy); ok__ {
+		return proxy__.Seek(d, offset, whence)
+	}
+	return 0
 }
 
 //export gocef_read_handler_seek
 func gocef_read_handler_seek(self *C.cef_read_handler_t, offset C.int64, whence C.int) C.int {
+	defer recoverProxyPanic("ReadHandler", "Seek")
 	me__ := (*ReadHandler)(self)
-	proxy__ := lookupReadHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupReadHandlerProxy(me__.Base(), "Seek").(ReadHandlerSeekProxy)
+	if !ok__ {
+		return 0
+	}
 	return C.int(proxy__.Seek(me__, int64(offset), int32(whence)))
 }
 
 // Tell (tell)
 // Return the current offset position.
 func (d *ReadHandler) Tell() int64 {
-	return lookupReadHandlerProxy(d.Base()).Tell(d)
+	if proxy__, ok__ := lookupReadHandlerProxy(d.Base(), "Tell").(ReadHandlerTellProxy); ok__ {
+		return proxy__.Tell(d)
+	}
+	return 0
 }
 
 //export gocef_read_handler_tell
 func gocef_read_handler_tell(self *C.cef_read_handler_t) C.int64 {
+	defer recoverProxyPanic("ReadHandler", "Tell")
 	me__ := (*ReadHandler)(self)
-	proxy__ := lookupReadHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupReadHandlerProxy(me__.Base(), "Tell").(ReadHandlerTellProxy)
+	if !ok__ {
+		return 0
+	}
 	return C.int64(proxy__.Tell(me__))
 }
 
 // Eof (eof)
 // Return non-zero if at end of file.
 func (d *ReadHandler) Eof() int32 {
-	return lookupReadHandlerProxy(d.Base()).Eof(d)
+	if proxy__, ok__ := lookupReadHandlerProxy(d.Base(), "Eof").(ReadHandlerEofProxy); ok__ {
+		return proxy__.Eof(d)
+	}
+	return 0
 }
 
 //export gocef_read_handler_eof
 func gocef_read_handler_eof(self *C.cef_read_handler_t) C.int {
+	defer recoverProxyPanic("ReadHandler", "Eof")
 	me__ := (*ReadHandler)(self)
-	proxy__ := lookupReadHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupReadHandlerProxy(me__.Base(), "Eof").(ReadHandlerEofProxy)
+	if !ok__ {
+		return 0
+	}
 	return C.int(proxy__.Eof(me__))
 }
 
@@ -114,13 +174,20 @@ func gocef_read_handler_eof(self *C.cef_read_handler_t) C.int {
 // Return true (1) if this handler performs work like accessing the file
 // system which may block. Used as a hint for determining the thread to access
 // the handler from.
-func (d *ReadHandler) MayBlock() int32 {
-	return lookupReadHandlerProxy(d.Base()).MayBlock(d)
+func (d *ReadHandler) MayBlock() bool {
+	if proxy__, ok__ := lookupReadHandlerProxy(d.Base(), "MayBlock").(ReadHandlerMayBlockProxy); ok__ {
+		return proxy__.MayBlock(d)
+	}
+	return false
 }
 
 //export gocef_read_handler_may_block
 func gocef_read_handler_may_block(self *C.cef_read_handler_t) C.int {
+	defer recoverProxyPanic("ReadHandler", "MayBlock")
 	me__ := (*ReadHandler)(self)
-	proxy__ := lookupReadHandlerProxy(me__.Base())
-	return C.int(proxy__.MayBlock(me__))
+	proxy__, ok__ := lookupReadHandlerProxy(me__.Base(), "MayBlock").(ReadHandlerMayBlockProxy)
+	if !ok__ {
+		return 0
+	}
+	return cefBool(proxy__.MayBlock(me__))
 }
@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_read_handler_proxy(cef_read_handler_t *self);
+void gocef_set_read_handler_proxy(cef_read_handler_t *self, int read, int seek, int tell, int eof, int may_block);
 
 #endif // GOCEF_ReadHandler_H_
@@ -6,13 +6,23 @@ import (
 	// #include "RegisterCdmCallback_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// RegisterCdmCallbackProxy defines methods required for using RegisterCdmCallback.
+// RegisterCdmCallbackProxy is implemented by the proxies used with RegisterCdmCallback. Embed
+// DefaultRegisterCdmCallback to satisfy it, then implement any of the optional
+// RegisterCdmCallback*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type RegisterCdmCallbackProxy interface {
+	isRegisterCdmCallbackProxy()
+}
+
+// DefaultRegisterCdmCallback may be embedded to satisfy RegisterCdmCallbackProxy.
+type DefaultRegisterCdmCallback struct{}
+
+func (DefaultRegisterCdmCallback) isRegisterCdmCallbackProxy() {}
+
+// RegisterCdmCallbackOnCdmRegistrationCompleteProxy may be implemented by a RegisterCdmCallbackProxy to handle OnCdmRegistrationComplete.
+type RegisterCdmCallbackOnCdmRegistrationCompleteProxy interface {
 	OnCdmRegistrationComplete(self *RegisterCdmCallback, result CdmRegistrationError, error_message string)
 }
 
@@ -27,7 +37,8 @@ type RegisterCdmCallback C.cef_register_cdm_callback_t
 func NewRegisterCdmCallback(proxy RegisterCdmCallbackProxy) *RegisterCdmCallback {
 	result := (*RegisterCdmCallback)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_register_cdm_callback_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_register_cdm_callback_proxy(result.toNative())
+		_, on_cdm_registration_complete := proxy.(RegisterCdmCallbackOnCdmRegistrationCompleteProxy)
+		C.gocef_set_register_cdm_callback_proxy(result.toNative(), cefBool(on_cdm_registration_complete))
 	}
 	return result
 }
@@ -36,14 +47,15 @@ func (d *RegisterCdmCallback) toNative() *C.cef_register_cdm_callback_t {
 	return (*C.cef_register_cdm_callback_t)(d)
 }
 
-func lookupRegisterCdmCallbackProxy(obj *BaseRefCounted) RegisterCdmCallbackProxy {
+func lookupRegisterCdmCallbackProxy(obj *BaseRefCounted, callback string) RegisterCdmCallbackProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("RegisterCdmCallback", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(RegisterCdmCallbackProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type RegisterCdmCallbackProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("RegisterCdmCallback", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -60,13 +72,19 @@ func (d *RegisterCdmCallback) Base() *BaseRefCounted {
 // Otherwise, |result| and |error_message| will contain additional information
 // about why registration failed.
 func (d *RegisterCdmCallback) OnCdmRegistrationComplete(result CdmRegistrationError, error_message string) {
-	lookupRegisterCdmCallbackProxy(d.Base()).OnCdmRegistrationComplete(d, result, error_message)
+	if proxy__, ok__ := lookupRegisterCdmCallbackProxy(d.Base(), "OnCdmRegistrationComplete").(RegisterCdmCallbackOnCdmRegistrationCompleteProxy); ok__ {
+		proxy__.OnCdmRegistrationComplete(d, result, error_message)
+	}
 }
 
 //export gocef_register_cdm_callback_on_cdm_registration_complete
 func gocef_register_cdm_callback_on_cdm_registration_complete(self *C.cef_register_cdm_callback_t, result C.cef_cdm_registration_error_t, error_message *C.cef_string_t) {
+	defer recoverProxyPanic("RegisterCdmCallback", "OnCdmRegistrationComplete")
 	me__ := (*RegisterCdmCallback)(self)
-	proxy__ := lookupRegisterCdmCallbackProxy(me__.Base())
+	proxy__, ok__ := lookupRegisterCdmCallbackProxy(me__.Base(), "OnCdmRegistrationComplete").(RegisterCdmCallbackOnCdmRegistrationCompleteProxy)
+	if !ok__ {
+		return
+	}
 	error_message_ := cefstrToString(error_message)
 	proxy__.OnCdmRegistrationComplete(me__, CdmRegistrationError(result), error_message_)
 }
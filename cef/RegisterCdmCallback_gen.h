@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_register_cdm_callback_proxy(cef_register_cdm_callback_t *self);
+void gocef_set_register_cdm_callback_proxy(cef_register_cdm_callback_t *self, int on_cdm_registration_complete);
 
 #endif // GOCEF_RegisterCdmCallback_H_
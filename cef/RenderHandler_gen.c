@@ -3,23 +3,55 @@
 #include "RenderHandler_gen.h"
 #include "_cgo_export.h"
 
-void gocef_set_render_handler_proxy(cef_render_handler_t *self) {
+void gocef_set_render_handler_proxy(cef_render_handler_t *self, int get_accessibility_handler, int get_root_screen_rect, int get_view_rect, int get_screen_point, int get_screen_info, int on_popup_show, int on_popup_size, int on_paint, int on_accelerated_paint, int on_cursor_change, int start_dragging, int update_drag_cursor, int on_scroll_offset_changed, int on_ime_composition_range_changed, int on_text_selection_changed, int on_virtual_keyboard_requested) {
 	// Casts to (void *) added to avoid warnings since Go callbacks can't have
 	// some modifiers, such as 'const' applied to their parameter signatures.
-	self->get_accessibility_handler = (void *)&gocef_render_handler_get_accessibility_handler;
-	self->get_root_screen_rect = (void *)&gocef_render_handler_get_root_screen_rect;
-	self->get_view_rect = (void *)&gocef_render_handler_get_view_rect;
-	self->get_screen_point = (void *)&gocef_render_handler_get_screen_point;
-	self->get_screen_info = (void *)&gocef_render_handler_get_screen_info;
-	self->on_popup_show = (void *)&gocef_render_handler_on_popup_show;
-	self->on_popup_size = (void *)&gocef_render_handler_on_popup_size;
-	self->on_paint = (void *)&gocef_render_handler_on_paint;
-	self->on_accelerated_paint = (void *)&gocef_render_handler_on_accelerated_paint;
-	self->on_cursor_change = (void *)&gocef_render_handler_on_cursor_change;
-	self->start_dragging = (void *)&gocef_render_handler_start_dragging;
-	self->update_drag_cursor = (void *)&gocef_render_handler_update_drag_cursor;
-	self->on_scroll_offset_changed = (void *)&gocef_render_handler_on_scroll_offset_changed;
-	self->on_ime_composition_range_changed = (void *)&gocef_render_handler_on_ime_composition_range_changed;
-	self->on_text_selection_changed = (void *)&gocef_render_handler_on_text_selection_changed;
-	self->on_virtual_keyboard_requested = (void *)&gocef_render_handler_on_virtual_keyboard_requested;
+	if (get_accessibility_handler) {
+		self->get_accessibility_handler = (void *)&gocef_render_handler_get_accessibility_handler;
+	}
+	if (get_root_screen_rect) {
+		self->get_root_screen_rect = (void *)&gocef_render_handler_get_root_screen_rect;
+	}
+	if (get_view_rect) {
+		self->get_view_rect = (void *)&gocef_render_handler_get_view_rect;
+	}
+	if (get_screen_point) {
+		self->get_screen_point = (void *)&gocef_render_handler_get_screen_point;
+	}
+	if (get_screen_info) {
+		self->get_screen_info = (void *)&gocef_render_handler_get_screen_info;
+	}
+	if (on_popup_show) {
+		self->on_popup_show = (void *)&gocef_render_handler_on_popup_show;
+	}
+	if (on_popup_size) {
+		self->on_popup_size = (void *)&gocef_render_handler_on_popup_size;
+	}
+	if (on_paint) {
+		self->on_paint = (void *)&gocef_render_handler_on_paint;
+	}
+	if (on_accelerated_paint) {
+		self->on_accelerated_paint = (void *)&gocef_render_handler_on_accelerated_paint;
+	}
+	if (on_cursor_change) {
+		self->on_cursor_change = (void *)&gocef_render_handler_on_cursor_change;
+	}
+	if (start_dragging) {
+		self->start_dragging = (void *)&gocef_render_handler_start_dragging;
+	}
+	if (update_drag_cursor) {
+		self->update_drag_cursor = (void *)&gocef_render_handler_update_drag_cursor;
+	}
+	if (on_scroll_offset_changed) {
+		self->on_scroll_offset_changed = (void *)&gocef_render_handler_on_scroll_offset_changed;
+	}
+	if (on_ime_composition_range_changed) {
+		self->on_ime_composition_range_changed = (void *)&gocef_render_handler_on_ime_composition_range_changed;
+	}
+	if (on_text_selection_changed) {
+		self->on_text_selection_changed = (void *)&gocef_render_handler_on_text_selection_changed;
+	}
+	if (on_virtual_keyboard_requested) {
+		self->on_virtual_keyboard_requested = (void *)&gocef_render_handler_on_virtual_keyboard_requested;
+	}
 }
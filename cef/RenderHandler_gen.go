@@ -6,28 +6,98 @@ import (
 	// #include "RenderHandler_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// RenderHandlerProxy defines methods required for using RenderHandler.
+// RenderHandlerProxy is implemented by the proxies used with RenderHandler. Embed
+// DefaultRenderHandler to satisfy it, then implement any of the optional
+// RenderHandler*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type RenderHandlerProxy interface {
+	isRenderHandlerProxy()
+}
+
+// DefaultRenderHandler may be embedded to satisfy RenderHandlerProxy.
+type DefaultRenderHandler struct{}
+
+func (DefaultRenderHandler) isRenderHandlerProxy() {}
+
+// RenderHandlerGetAccessibilityHandlerProxy may be implemented by a RenderHandlerProxy to handle GetAccessibilityHandler.
+type RenderHandlerGetAccessibilityHandlerProxy interface {
 	GetAccessibilityHandler(self *RenderHandler) *AccessibilityHandler
-	GetRootScreenRect(self *RenderHandler, browser *Browser, rect *Rect) int32
+}
+
+// RenderHandlerGetRootScreenRectProxy may be implemented by a RenderHandlerProxy to handle GetRootScreenRect.
+type RenderHandlerGetRootScreenRectProxy interface {
+	GetRootScreenRect(self *RenderHandler, browser *Browser, rect *Rect) bool
+}
+
+// RenderHandlerGetViewRectProxy may be implemented by a RenderHandlerProxy to handle GetViewRect.
+type RenderHandlerGetViewRectProxy interface {
 	GetViewRect(self *RenderHandler, browser *Browser, rect *Rect)
-	GetScreenPoint(self *RenderHandler, browser *Browser, viewX, viewY int32, screenX, screenY *int32) int32
-	GetScreenInfo(self *RenderHandler, browser *Browser, screen_info *ScreenInfo) int32
-	OnPopupShow(self *RenderHandler, browser *Browser, show int32)
+}
+
+// RenderHandlerGetScreenPointProxy may be implemented by a RenderHandlerProxy to handle GetScreenPoint.
+type RenderHandlerGetScreenPointProxy interface {
+	GetScreenPoint(self *RenderHandler, browser *Browser, viewX, viewY int32, screenX, screenY *int32) bool
+}
+
+// RenderHandlerGetScreenInfoProxy may be implemented by a RenderHandlerProxy to handle GetScreenInfo.
+type RenderHandlerGetScreenInfoProxy interface {
+	GetScreenInfo(self *RenderHandler, browser *Browser, screen_info *ScreenInfo) bool
+}
+
+// RenderHandlerOnPopupShowProxy may be implemented by a RenderHandlerProxy to handle OnPopupShow.
+type RenderHandlerOnPopupShowProxy interface {
+	OnPopupShow(self *RenderHandler, browser *Browser, show bool)
+}
+
+// RenderHandlerOnPopupSizeProxy may be implemented by a RenderHandlerProxy to handle OnPopupSize.
+type RenderHandlerOnPopupSizeProxy interface {
 	OnPopupSize(self *RenderHandler, browser *Browser, rect *Rect)
-	OnPaint(self *RenderHandler, browser *Browser, type_r PaintElementType, dirtyRectsCount uint64, dirtyRects *Rect, buffer unsafe.Pointer, width, height int32)
-	OnAcceleratedPaint(self *RenderHandler, browser *Browser, type_r PaintElementType, dirtyRectsCount uint64, dirtyRects *Rect, shared_handle unsafe.Pointer)
+}
+
+// RenderHandlerOnPaintProxy may be implemented by a RenderHandlerProxy to handle OnPaint.
+type RenderHandlerOnPaintProxy interface {
+	OnPaint(self *RenderHandler, browser *Browser, type_r PaintElementType, dirtyRects []Rect, buffer unsafe.Pointer, width, height int32)
+}
+
+// RenderHandlerOnAcceleratedPaintProxy may be implemented by a RenderHandlerProxy to handle OnAcceleratedPaint.
+type RenderHandlerOnAcceleratedPaintProxy interface {
+	OnAcceleratedPaint(self *RenderHandler, browser *Browser, type_r PaintElementType, dirtyRects []Rect, shared_handle unsafe.Pointer)
+}
+
+// RenderHandlerOnCursorChangeProxy may be implemented by a RenderHandlerProxy to handle OnCursorChange.
+type RenderHandlerOnCursorChangeProxy interface {
 	OnCursorChange(self *RenderHandler, browser *Browser, cursor unsafe.Pointer, type_r CursorType, custom_cursor_info *CursorInfo)
-	StartDragging(self *RenderHandler, browser *Browser, drag_data *DragData, allowed_ops DragOperationsMask, x, y int32) int32
+}
+
+// RenderHandlerStartDraggingProxy may be implemented by a RenderHandlerProxy to handle StartDragging.
+type RenderHandlerStartDraggingProxy interface {
+	StartDragging(self *RenderHandler, browser *Browser, drag_data *DragData, allowed_ops DragOperationsMask, x, y int32) bool
+}
+
+// RenderHandlerUpdateDragCursorProxy may be implemented by a RenderHandlerProxy to handle UpdateDragCursor.
+type RenderHandlerUpdateDragCursorProxy interface {
 	UpdateDragCursor(self *RenderHandler, browser *Browser, operation DragOperationsMask)
+}
+
+// RenderHandlerOnScrollOffsetChangedProxy may be implemented by a RenderHandlerProxy to handle OnScrollOffsetChanged.
+type RenderHandlerOnScrollOffsetChangedProxy interface {
 	OnScrollOffsetChanged(self *RenderHandler, browser *Browser, x, y float64)
-	OnImeCompositionRangeChanged(self *RenderHandler, browser *Browser, selected_range *Range, character_boundsCount uint64, character_bounds *Rect)
+}
+
+// RenderHandlerOnImeCompositionRangeChangedProxy may be implemented by a RenderHandlerProxy to handle OnImeCompositionRangeChanged.
+type RenderHandlerOnImeCompositionRangeChangedProxy interface {
+	OnImeCompositionRangeChanged(self *RenderHandler, browser *Browser, selected_range *Range, character_bounds []Rect)
+}
+
+// RenderHandlerOnTextSelectionChangedProxy may be implemented by a RenderHandlerProxy to handle OnTextSelectionChanged.
+type RenderHandlerOnTextSelectionChangedProxy interface {
 	OnTextSelectionChanged(self *RenderHandler, browser *Browser, selected_text string, selected_range *Range)
+}
+
+// RenderHandlerOnVirtualKeyboardRequestedProxy may be implemented by a RenderHandlerProxy to handle OnVirtualKeyboardRequested.
+type RenderHandlerOnVirtualKeyboardRequestedProxy interface {
 	OnVirtualKeyboardRequested(self *RenderHandler, browser *Browser, input_mode TextInputMode)
 }
 
@@ -41,7 +111,23 @@ type RenderHandler C.cef_render_handler_t
 func NewRenderHandler(proxy RenderHandlerProxy) *RenderHandler {
 	result := (*RenderHandler)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_render_handler_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_render_handler_proxy(result.toNative())
+		_, get_accessibility_handler := proxy.(RenderHandlerGetAccessibilityHandlerProxy)
+		_, get_root_screen_rect := proxy.(RenderHandlerGetRootScreenRectProxy)
+		_, get_view_rect := proxy.(RenderHandlerGetViewRectProxy)
+		_, get_screen_point := proxy.(RenderHandlerGetScreenPointProxy)
+		_, get_screen_info := proxy.(RenderHandlerGetScreenInfoProxy)
+		_, on_popup_show := proxy.(RenderHandlerOnPopupShowProxy)
+		_, on_popup_size := proxy.(RenderHandlerOnPopupSizeProxy)
+		_, on_paint := proxy.(RenderHandlerOnPaintProxy)
+		_, on_accelerated_paint := proxy.(RenderHandlerOnAcceleratedPaintProxy)
+		_, on_cursor_change := proxy.(RenderHandlerOnCursorChangeProxy)
+		_, start_dragging := proxy.(RenderHandlerStartDraggingProxy)
+		_, update_drag_cursor := proxy.(RenderHandlerUpdateDragCursorProxy)
+		_, on_scroll_offset_changed := proxy.(RenderHandlerOnScrollOffsetChangedProxy)
+		_, on_ime_composition_range_changed := proxy.(RenderHandlerOnImeCompositionRangeChangedProxy)
+		_, on_text_selection_changed := proxy.(RenderHandlerOnTextSelectionChangedProxy)
+		_, on_virtual_keyboard_requested := proxy.(RenderHandlerOnVirtualKeyboardRequestedProxy)
+		C.gocef_set_render_handler_proxy(result.toNative(), cefBool(get_accessibility_handler), cefBool(get_root_screen_rect), cefBool(get_view_rect), cefBool(get_screen_point), cefBool(get_screen_info), cefBool(on_popup_show), cefBool(on_popup_size), cefBool(on_paint), cefBool(on_accelerated_paint), cefBool(on_cursor_change), cefBool(start_dragging), cefBool(update_drag_cursor), cefBool(on_scroll_offset_changed), cefBool(on_ime_composition_range_changed), cefBool(on_text_selection_changed), cefBool(on_virtual_keyboard_requested))
 	}
 	return result
 }
@@ -50,14 +136,15 @@ func (d *RenderHandler) toNative() *C.cef_render_handler_t {
 	return (*C.cef_render_handler_t)(d)
 }
 
-func lookupRenderHandlerProxy(obj *BaseRefCounted) RenderHandlerProxy {
+func lookupRenderHandlerProxy(obj *BaseRefCounted, callback string) RenderHandlerProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("RenderHandler", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(RenderHandlerProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type RenderHandlerProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("RenderHandler", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -72,13 +159,20 @@ func (d *RenderHandler) Base() *BaseRefCounted {
 // Return the handler for accessibility notifications. If no handler is
 // provided the default implementation will be used.
 func (d *RenderHandler) GetAccessibilityHandler() *AccessibilityHandler {
-	return lookupRenderHandlerProxy(d.Base()).GetAccessibilityHandler(d)
+	if proxy__, ok__ := lookupRenderHandlerProxy(d.Base(), "GetAccessibilityHandler").(RenderHandlerGetAccessibilityHandlerProxy); ok__ {
+		return proxy__.GetAccessibilityHandler(d)
+	}
+	return nil
 }
 
 //export gocef_render_handler_get_accessibility_handler
 func gocef_render_handler_get_accessibility_handler(self *C.cef_render_handler_t) *C.cef_accessibility_handler_t {
+	defer recoverProxyPanic("RenderHandler", "GetAccessibilityHandler")
 	me__ := (*RenderHandler)(self)
-	proxy__ := lookupRenderHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupRenderHandlerProxy(me__.Base(), "GetAccessibilityHandler").(RenderHandlerGetAccessibilityHandlerProxy)
+	if !ok__ {
+		return nil
+	}
 	return (proxy__.GetAccessibilityHandler(me__)).toNative()
 }
 
@@ -86,45 +180,71 @@ func gocef_render_handler_get_accessibility_handler(self *C.cef_render_handler_t
 // Called to retrieve the root window rectangle in screen coordinates. Return
 // true (1) if the rectangle was provided. If this function returns false (0)
 // the rectangle from GetViewRect will be used.
-func (d *RenderHandler) GetRootScreenRect(browser *Browser, rect *Rect) int32 {
-	return lookupRenderHandlerProxy(d.Base()).GetRootScreenRect(d, browser, rect)
+func (d *RenderHandler) GetRootScreenRect(browser *Browser, rect *Rect) bool {
+	if proxy__, ok__ := lookupRenderHandlerProxy(d.Base(), "GetRootScreenRect").(RenderHandlerGetRootScreenRectProxy); ok__ {
+		return proxy__.GetRootScreenRect(d, browser, rect)
+	}
+	return false
 }
 
 //export gocef_render_handler_get_root_screen_rect
 func gocef_render_handler_get_root_screen_rect(self *C.cef_render_handler_t, browser *C.cef_browser_t, rect *C.cef_rect_t) C.int {
+	defer recoverProxyPanic("RenderHandler", "GetRootScreenRect")
 	me__ := (*RenderHandler)(self)
-	proxy__ := lookupRenderHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupRenderHandlerProxy(me__.Base(), "GetRootScreenRect").(RenderHandlerGetRootScreenRectProxy)
+	if !ok__ {
+		return 0
+	}
 	rect_ := rect.toGo()
-	return C.int(proxy__.GetRootScreenRect(me__, (*Browser)(browser), rect_))
+	defer func() {
+		rect_.toNative(rect)
+	}()
+	return cefBool(proxy__.GetRootScreenRect(me__, (*Browser)(browser), rect_))
 }
 
 // GetViewRect (get_view_rect)
 // Called to retrieve the view rectangle which is relative to screen
 // coordinates. This function must always provide a non-NULL rectangle.
 func (d *RenderHandler) GetViewRect(browser *Browser, rect *Rect) {
-	lookupRenderHandlerProxy(d.Base()).GetViewRect(d, browser, rect)
+	if proxy__, ok__ := lookupRenderHandlerProxy(d.Base(), "GetViewRect").(RenderHandlerGetViewRectProxy); ok__ {
+		proxy__.GetViewRect(d, browser, rect)
+	}
 }
 
 //export gocef_render_handler_get_view_rect
 func gocef_render_handler_get_view_rect(self *C.cef_render_handler_t, browser *C.cef_browser_t, rect *C.cef_rect_t) {
+	defer recoverProxyPanic("RenderHandler", "GetViewRect")
 	me__ := (*RenderHandler)(self)
-	proxy__ := lookupRenderHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupRenderHandlerProxy(me__.Base(), "GetViewRect").(RenderHandlerGetViewRectProxy)
+	if !ok__ {
+		return
+	}
 	rect_ := rect.toGo()
+	defer func() {
+		rect_.toNative(rect)
+	}()
 	proxy__.GetViewRect(me__, (*Browser)(browser), rect_)
 }
 
 // GetScreenPoint (get_screen_point)
 // Called to retrieve the translation from view coordinates to actual screen
 // coordinates. Return true (1) if the screen coordinates were provided.
-func (d *RenderHandler) GetScreenPoint(browser *Browser, viewX, viewY int32, screenX, screenY *int32) int32 {
-	return lookupRenderHandlerProxy(d.Base()).GetScreenPoint(d, browser, viewX, viewY, screenX, screenY)
+func (d *RenderHandler) GetScreenPoint(browser *Browser, viewX, viewY int32, screenX, screenY *int32) bool {
+	if proxy__, ok__ := lookupRenderHandlerProxy(d.Base(), "GetScreenPoint").(RenderHandlerGetScreenPointProxy); ok__ {
+		return proxy__.GetScreenPoint(d, browser, viewX, viewY, screenX, screenY)
+	}
+	return false
 }
 
 //export gocef_render_handler_get_screen_point
 func gocef_render_handler_get_screen_point(self *C.cef_render_handler_t, browser *C.cef_browser_t, viewX C.int, viewY C.int, screenX *C.int, screenY *C.int) C.int {
+	defer recoverProxyPanic("RenderHandler", "GetScreenPoint")
 	me__ := (*RenderHandler)(self)
-	proxy__ := lookupRenderHandlerProxy(me__.Base())
-	return C.int(proxy__.GetScreenPoint(me__, (*Browser)(browser), int32(viewX), int32(viewY), (*int32)(screenX), (*int32)(screenY)))
+	proxy__, ok__ := lookupRenderHandlerProxy(me__.Base(), "GetScreenPoint").(RenderHandlerGetScreenPointProxy)
+	if !ok__ {
+		return 0
+	}
+	return cefBool(proxy__.GetScreenPoint(me__, (*Browser)(browser), int32(viewX), int32(viewY), (*int32)(screenX), (*int32)(screenY)))
 }
 
 // GetScreenInfo (get_screen_info)
@@ -135,43 +255,65 @@ func gocef_render_handler_get_screen_point(self *C.cef_render_handler_t, browser
 // If the screen info rectangle is left NULL the rectangle from GetViewRect
 // will be used. If the rectangle is still NULL or invalid popups may not be
 // drawn correctly.
-func (d *RenderHandler) GetScreenInfo(browser *Browser, screen_info *ScreenInfo) int32 {
-	return lookupRenderHandlerProxy(d.Base()).GetScreenInfo(d, browser, screen_info)
+func (d *RenderHandler) GetScreenInfo(browser *Browser, screen_info *ScreenInfo) bool {
+	if proxy__, ok__ := lookupRenderHandlerProxy(d.Base(), "GetScreenInfo").(RenderHandlerGetScreenInfoProxy); ok__ {
+		return proxy__.GetScreenInfo(d, browser, screen_info)
+	}
+	return false
 }
 
 //export gocef_render_handler_get_screen_info
 func gocef_render_handler_get_screen_info(self *C.cef_render_handler_t, browser *C.cef_browser_t, screen_info *C.cef_screen_info_t) C.int {
+	defer recoverProxyPanic("RenderHandler", "GetScreenInfo")
 	me__ := (*RenderHandler)(self)
-	proxy__ := lookupRenderHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupRenderHandlerProxy(me__.Base(), "GetScreenInfo").(RenderHandlerGetScreenInfoProxy)
+	if !ok__ {
+		return 0
+	}
 	screen_info_ := screen_info.toGo()
-	return C.int(proxy__.GetScreenInfo(me__, (*Browser)(browser), screen_info_))
+	defer func() {
+		screen_info_.toNative(screen_info)
+	}()
+	return cefBool(proxy__.GetScreenInfo(me__, (*Browser)(browser), screen_info_))
 }
 
 // OnPopupShow (on_popup_show)
 // Called when the browser wants to show or hide the popup widget. The popup
 // should be shown if |show| is true (1) and hidden if |show| is false (0).
-func (d *RenderHandler) OnPopupShow(browser *Browser, show int32) {
-	lookupRenderHandlerProxy(d.Base()).OnPopupShow(d, browser, show)
+func (d *RenderHandler) OnPopupShow(browser *Browser, show bool) {
+	if proxy__, ok__ := lookupRenderHandlerProxy(d.Base(), "OnPopupShow").(RenderHandlerOnPopupShowProxy); ok__ {
+		proxy__.OnPopupShow(d, browser, show)
+	}
 }
 
 //export gocef_render_handler_on_popup_show
 func gocef_render_handler_on_popup_show(self *C.cef_render_handler_t, browser *C.cef_browser_t, show C.int) {
+	defer recoverProxyPanic("RenderHandler", "OnPopupShow")
 	me__ := (*RenderHandler)(self)
-	proxy__ := lookupRenderHandlerProxy(me__.Base())
-	proxy__.OnPopupShow(me__, (*Browser)(browser), int32(show))
+	proxy__, ok__ := lookupRenderHandlerProxy(me__.Base(), "OnPopupShow").(RenderHandlerOnPopupShowProxy)
+	if !ok__ {
+		return
+	}
+	proxy__.OnPopupShow(me__, (*Browser)(browser), show != 0)
 }
 
 // OnPopupSize (on_popup_size)
 // Called when the browser wants to move or resize the popup widget. |rect|
 // contains the new location and size in view coordinates.
 func (d *RenderHandler) OnPopupSize(browser *Browser, rect *Rect) {
-	lookupRenderHandlerProxy(d.Base()).OnPopupSize(d, browser, rect)
+	if proxy__, ok__ := lookupRenderHandlerProxy(d.Base(), "OnPopupSize").(RenderHandlerOnPopupSizeProxy); ok__ {
+		proxy__.OnPopupSize(d, browser, rect)
+	}
 }
 
 //export gocef_render_handler_on_popup_size
 func gocef_render_handler_on_popup_size(self *C.cef_render_handler_t, browser *C.cef_browser_t, rect *C.cef_rect_t) {
+	defer recoverProxyPanic("RenderHandler", "OnPopupSize")
 	me__ := (*RenderHandler)(self)
-	proxy__ := lookupRenderHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupRenderHandlerProxy(me__.Base(), "OnPopupSize").(RenderHandlerOnPopupSizeProxy)
+	if !ok__ {
+		return
+	}
 	rect_ := rect.toGo()
 	proxy__.OnPopupSize(me__, (*Browser)(browser), rect_)
 }
@@ -186,16 +328,28 @@ func gocef_render_handler_on_popup_size(self *C.cef_render_handler_t, browser *C
 // be |width|*|height|*4 bytes in size and represents a BGRA image with an
 // upper-left origin. This function is only called when
 // cef_window_tInfo::shared_texture_enabled is set to false (0).
-func (d *RenderHandler) OnPaint(browser *Browser, type_r PaintElementType, dirtyRectsCount uint64, dirtyRects *Rect, buffer unsafe.Pointer, width, height int32) {
-	lookupRenderHandlerProxy(d.Base()).OnPaint(d, browser, type_r, dirtyRectsCount, dirtyRects, buffer, width, height)
+func (d *RenderHandler) OnPaint(browser *Browser, type_r PaintElementType, dirtyRects []Rect, buffer unsafe.Pointer, width, height int32) {
+	if proxy__, ok__ := lookupRenderHandlerProxy(d.Base(), "OnPaint").(RenderHandlerOnPaintProxy); ok__ {
+		proxy__.OnPaint(d, browser, type_r, dirtyRects, buffer, width, height)
+	}
 }
 
 //export gocef_render_handler_on_paint
 func gocef_render_handler_on_paint(self *C.cef_render_handler_t, browser *C.cef_browser_t, type_r C.cef_paint_element_type_t, dirtyRectsCount C.size_t, dirtyRects *C.cef_rect_t, buffer unsafe.Pointer, width C.int, height C.int) {
+	defer recoverProxyPanic("RenderHandler", "OnPaint")
 	me__ := (*RenderHandler)(self)
-	proxy__ := lookupRenderHandlerProxy(me__.Base())
-	dirtyRects_ := dirtyRects.toGo()
-	proxy__.OnPaint(me__, (*Browser)(browser), PaintElementType(type_r), uint64(dirtyRectsCount), dirtyRects_, buffer, int32(width), int32(height))
+	proxy__, ok__ := lookupRenderHandlerProxy(me__.Base(), "OnPaint").(RenderHandlerOnPaintProxy)
+	if !ok__ {
+		return
+	}
+	dirtyRects_ := make([]Rect, dirtyRectsCount)
+	if dirtyRectsCount > 0 {
+		dirtyRects__p := (*[1<<30 - 1]C.cef_rect_t)(unsafe.Pointer(dirtyRects))
+		for i := range dirtyRects_ {
+			dirtyRects__p[i].intoGo(&dirtyRects_[i])
+		}
+	}
+	proxy__.OnPaint(me__, (*Browser)(browser), PaintElementType(type_r), dirtyRects_, buffer, int32(width), int32(height))
 }
 
 // OnAcceleratedPaint (on_accelerated_paint)
@@ -206,29 +360,47 @@ func gocef_render_handler_on_paint(self *C.cef_render_handler_t, browser *C.cef_
 // can be accessed via ID3D11Device using the OpenSharedResource function.
 // This function is only called when cef_window_tInfo::shared_texture_enabled
 // is set to true (1), and is currently only supported on Windows.
-func (d *RenderHandler) OnAcceleratedPaint(browser *Browser, type_r PaintElementType, dirtyRectsCount uint64, dirtyRects *Rect, shared_handle unsafe.Pointer) {
-	lookupRenderHandlerProxy(d.Base()).OnAcceleratedPaint(d, browser, type_r, dirtyRectsCount, dirtyRects, shared_handle)
+func (d *RenderHandler) OnAcceleratedPaint(browser *Browser, type_r PaintElementType, dirtyRects []Rect, shared_handle unsafe.Pointer) {
+	if proxy__, ok__ := lookupRenderHandlerProxy(d.Base(), "OnAcceleratedPaint").(RenderHandlerOnAcceleratedPaintProxy); ok__ {
+		proxy__.OnAcceleratedPaint(d, browser, type_r, dirtyRects, shared_handle)
+	}
 }
 
 //export gocef_render_handler_on_accelerated_paint
 func gocef_render_handler_on_accelerated_paint(self *C.cef_render_handler_t, browser *C.cef_browser_t, type_r C.cef_paint_element_type_t, dirtyRectsCount C.size_t, dirtyRects *C.cef_rect_t, shared_handle unsafe.Pointer) {
+	defer recoverProxyPanic("RenderHandler", "OnAcceleratedPaint")
 	me__ := (*RenderHandler)(self)
-	proxy__ := lookupRenderHandlerProxy(me__.Base())
-	dirtyRects_ := dirtyRects.toGo()
-	proxy__.OnAcceleratedPaint(me__, (*Browser)(browser), PaintElementType(type_r), uint64(dirtyRectsCount), dirtyRects_, shared_handle)
+	proxy__, ok__ := lookupRenderHandlerProxy(me__.Base(), "OnAcceleratedPaint").(RenderHandlerOnAcceleratedPaintProxy)
+	if !ok__ {
+		return
+	}
+	dirtyRects_ := make([]Rect, dirtyRectsCount)
+	if dirtyRectsCount > 0 {
+		dirtyRects__p := (*[1<<30 - 1]C.cef_rect_t)(unsafe.Pointer(dirtyRects))
+		for i := range dirtyRects_ {
+			dirtyRects__p[i].intoGo(&dirtyRects_[i])
+		}
+	}
+	proxy__.OnAcceleratedPaint(me__, (*Browser)(browser), PaintElementType(type_r), dirtyRects_, shared_handle)
 }
 
 // OnCursorChange (on_cursor_change)
 // Called when the browser's cursor has changed. If |type| is CT_CUSTOM then
 // |custom_cursor_info| will be populated with the custom cursor information.
 func (d *RenderHandler) OnCursorChange(browser *Browser, cursor unsafe.Pointer, type_r CursorType, custom_cursor_info *CursorInfo) {
-	lookupRenderHandlerProxy(d.Base()).OnCursorChange(d, browser, cursor, type_r, custom_cursor_info)
+	if proxy__, ok__ := lookupRenderHandlerProxy(d.Base(), "OnCursorChange").(RenderHandlerOnCursorChangeProxy); ok__ {
+		proxy__.OnCursorChange(d, browser, cursor, type_r, custom_cursor_info)
+	}
 }
 
 //export gocef_render_handler_on_cursor_change
 func gocef_render_handler_on_cursor_change(self *C.cef_render_handler_t, browser *C.cef_browser_t, cursor unsafe.Pointer, type_r C.cef_cursor_type_t, custom_cursor_info *C.cef_cursor_info_t) {
+	defer recoverProxyPanic("RenderHandler", "OnCursorChange")
 	me__ := (*RenderHandler)(self)
-	proxy__ := lookupRenderHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupRenderHandlerProxy(me__.Base(), "OnCursorChange").(RenderHandlerOnCursorChangeProxy)
+	if !ok__ {
+		return
+	}
 	custom_cursor_info_ := custom_cursor_info.toGo()
 	proxy__.OnCursorChange(me__, (*Browser)(browser), cursor, CursorType(type_r), custom_cursor_info_)
 }
@@ -246,15 +418,22 @@ func gocef_render_handler_on_cursor_change(self *C.cef_render_handler_t, browser
 // cef_browser_host_t::DragSourceEndedAt and DragSourceSystemDragEnded either
 // synchronously or asynchronously to inform the web view that the drag
 // operation has ended.
-func (d *RenderHandler) StartDragging(browser *Browser, drag_data *DragData, allowed_ops DragOperationsMask, x, y int32) int32 {
-	return lookupRenderHandlerProxy(d.Base()).StartDragging(d, browser, drag_data, allowed_ops, x, y)
+func (d *RenderHandler) StartDragging(browser *Browser, drag_data *DragData, allowed_ops DragOperationsMask, x, y int32) bool {
+	if proxy__, ok__ := lookupRenderHandlerProxy(d.Base(), "StartDragging").(RenderHandlerStartDraggingProxy); ok__ {
+		return proxy__.StartDragging(d, browser, drag_data, allowed_ops, x, y)
+	}
+	return false
 }
 
 //export gocef_render_handler_start_dragging
 func gocef_render_handler_start_dragging(self *C.cef_render_handler_t, browser *C.cef_browser_t, drag_data *C.cef_drag_data_t, allowed_ops C.cef_drag_operations_mask_t, x C.int, y C.int) C.int {
+	defer recoverProxyPanic("RenderHandler", "StartDragging")
 	me__ := (*RenderHandler)(self)
-	proxy__ := lookupRenderHandlerProxy(me__.Base())
-	return C.int(proxy__.StartDragging(me__, (*Browser)(browser), (*DragData)(drag_data), DragOperationsMask(allowed_ops), int32(x), int32(y)))
+	proxy__, ok__ := lookupRenderHandlerProxy(me__.Base(), "StartDragging").(RenderHandlerStartDraggingProxy)
+	if !ok__ {
+		return 0
+	}
+	return cefBool(proxy__.StartDragging(me__, (*Browser)(browser), (*DragData)(drag_data), DragOperationsMask(allowed_ops), int32(x), int32(y)))
 }
 
 // UpdateDragCursor (update_drag_cursor)
@@ -262,26 +441,38 @@ func gocef_render_handler_start_dragging(self *C.cef_render_handler_t, browser *
 // drop operation. |operation| describes the allowed operation (none, move,
 // copy, link).
 func (d *RenderHandler) UpdateDragCursor(browser *Browser, operation DragOperationsMask) {
-	lookupRenderHandlerProxy(d.Base()).UpdateDragCursor(d, browser, operation)
+	if proxy__, ok__ := lookupRenderHandlerProxy(d.Base(), "UpdateDragCursor").(RenderHandlerUpdateDragCursorProxy); ok__ {
+		proxy__.UpdateDragCursor(d, browser, operation)
+	}
 }
 
 //export gocef_render_handler_update_drag_cursor
 func gocef_render_handler_update_drag_cursor(self *C.cef_render_handler_t, browser *C.cef_browser_t, operation C.cef_drag_operations_mask_t) {
+	defer recoverProxyPanic("RenderHandler", "UpdateDragCursor")
 	me__ := (*RenderHandler)(self)
-	proxy__ := lookupRenderHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupRenderHandlerProxy(me__.Base(), "UpdateDragCursor").(RenderHandlerUpdateDragCursorProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.UpdateDragCursor(me__, (*Browser)(browser), DragOperationsMask(operation))
 }
 
 // OnScrollOffsetChanged (on_scroll_offset_changed)
 // Called when the scroll offset has changed.
 func (d *RenderHandler) OnScrollOffsetChanged(browser *Browser, x, y float64) {
-	lookupRenderHandlerProxy(d.Base()).OnScrollOffsetChanged(d, browser, x, y)
+	if proxy__, ok__ := lookupRenderHandlerProxy(d.Base(), "OnScrollOffsetChanged").(RenderHandlerOnScrollOffsetChangedProxy); ok__ {
+		proxy__.OnScrollOffsetChanged(d, browser, x, y)
+	}
 }
 
 //export gocef_render_handler_on_scroll_offset_changed
 func gocef_render_handler_on_scroll_offset_changed(self *C.cef_render_handler_t, browser *C.cef_browser_t, x C.double, y C.double) {
+	defer recoverProxyPanic("RenderHandler", "OnScrollOffsetChanged")
 	me__ := (*RenderHandler)(self)
-	proxy__ := lookupRenderHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupRenderHandlerProxy(me__.Base(), "OnScrollOffsetChanged").(RenderHandlerOnScrollOffsetChangedProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnScrollOffsetChanged(me__, (*Browser)(browser), float64(x), float64(y))
 }
 
@@ -289,17 +480,29 @@ func gocef_render_handler_on_scroll_offset_changed(self *C.cef_render_handler_t,
 // Called when the IME composition range has changed. |selected_range| is the
 // range of characters that have been selected. |character_bounds| is the
 // bounds of each character in view coordinates.
-func (d *RenderHandler) OnImeCompositionRangeChanged(browser *Browser, selected_range *Range, character_boundsCount uint64, character_bounds *Rect) {
-	lookupRenderHandlerProxy(d.Base()).OnImeCompositionRangeChanged(d, browser, selected_range, character_boundsCount, character_bounds)
+func (d *RenderHandler) OnImeCompositionRangeChanged(browser *Browser, selected_range *Range, character_bounds []Rect) {
+	if proxy__, ok__ := lookupRenderHandlerProxy(d.Base(), "OnImeCompositionRangeChanged").(RenderHandlerOnImeCompositionRangeChangedProxy); ok__ {
+		proxy__.OnImeCompositionRangeChanged(d, browser, selected_range, character_bounds)
+	}
 }
 
 //export gocef_render_handler_on_ime_composition_range_changed
 func gocef_render_handler_on_ime_composition_range_changed(self *C.cef_render_handler_t, browser *C.cef_browser_t, selected_range *C.cef_range_t, character_boundsCount C.size_t, character_bounds *C.cef_rect_t) {
+	defer recoverProxyPanic("RenderHandler", "OnImeCompositionRangeChanged")
 	me__ := (*RenderHandler)(self)
-	proxy__ := lookupRenderHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupRenderHandlerProxy(me__.Base(), "OnImeCompositionRangeChanged").(RenderHandlerOnImeCompositionRangeChangedProxy)
+	if !ok__ {
+		return
+	}
 	selected_range_ := selected_range.toGo()
-	character_bounds_ := character_bounds.toGo()
-	proxy__.OnImeCompositionRangeChanged(me__, (*Browser)(browser), selected_range_, uint64(character_boundsCount), character_bounds_)
+	character_bounds_ := make([]Rect, character_boundsCount)
+	if character_boundsCount > 0 {
+		character_bounds__p := (*[1<<30 - 1]C.cef_rect_t)(unsafe.Pointer(character_bounds))
+		for i := range character_bounds_ {
+			character_bounds__p[i].intoGo(&character_bounds_[i])
+		}
+	}
+	proxy__.OnImeCompositionRangeChanged(me__, (*Browser)(browser), selected_range_, character_bounds_)
 }
 
 // OnTextSelectionChanged (on_text_selection_changed)
@@ -307,13 +510,19 @@ func gocef_render_handler_on_ime_composition_range_changed(self *C.cef_render_ha
 // |selected_text| is the currently selected text and |selected_range| is the
 // character range.
 func (d *RenderHandler) OnTextSelectionChanged(browser *Browser, selected_text string, selected_range *Range) {
-	lookupRenderHandlerProxy(d.Base()).OnTextSelectionChanged(d, browser, selected_text, selected_range)
+	if proxy__, ok__ := lookupRenderHandlerProxy(d.Base(), "OnTextSelectionChanged").(RenderHandlerOnTextSelectionChangedProxy); ok__ {
+		proxy__.OnTextSelectionChanged(d, browser, selected_text, selected_range)
+	}
 }
 
 //export gocef_render_handler_on_text_selection_changed
 func gocef_render_handler_on_text_selection_changed(self *C.cef_render_handler_t, browser *C.cef_browser_t, selected_text *C.cef_string_t, selected_range *C.cef_range_t) {
+	defer recoverProxyPanic("RenderHandler", "OnTextSelectionChanged")
 	me__ := (*RenderHandler)(self)
-	proxy__ := lookupRenderHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupRenderHandlerProxy(me__.Base(), "OnTextSelectionChanged").(RenderHandlerOnTextSelectionChangedProxy)
+	if !ok__ {
+		return
+	}
 	selected_text_ := cefstrToString(selected_text)
 	selected_range_ := selected_range.toGo()
 	proxy__.OnTextSelectionChanged(me__, (*Browser)(browser), selected_text_, selected_range_)
@@ -325,12 +534,18 @@ func gocef_render_handler_on_text_selection_changed(self *C.cef_render_handler_t
 // opened. If |input_mode| is CEF_TEXT_INPUT_MODE_NONE, any existing keyboard
 // for this browser should be hidden.
 func (d *RenderHandler) OnVirtualKeyboardRequested(browser *Browser, input_mode TextInputMode) {
-	lookupRenderHandlerProxy(d.Base()).OnVirtualKeyboardRequested(d, browser, input_mode)
+	if proxy__, ok__ := lookupRenderHandlerProxy(d.Base(), "OnVirtualKeyboardRequested").(RenderHandlerOnVirtualKeyboardRequestedProxy); ok__ {
+		proxy__.OnVirtualKeyboardRequested(d, browser, input_mode)
+	}
 }
 
 //export gocef_render_handler_on_virtual_keyboard_requested
 func gocef_render_handler_on_virtual_keyboard_requested(self *C.cef_render_handler_t, browser *C.cef_browser_t, input_mode C.cef_text_input_mode_t) {
+	defer recoverProxyPanic("RenderHandler", "OnVirtualKeyboardRequested")
 	me__ := (*RenderHandler)(self)
-	proxy__ := lookupRenderHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupRenderHandlerProxy(me__.Base(), "OnVirtualKeyboardRequested").(RenderHandlerOnVirtualKeyboardRequestedProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnVirtualKeyboardRequested(me__, (*Browser)(browser), TextInputMode(input_mode))
 }
@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_render_handler_proxy(cef_render_handler_t *self);
+void gocef_set_render_handler_proxy(cef_render_handler_t *self, int get_accessibility_handler, int get_root_screen_rect, int get_view_rect, int get_screen_point, int get_screen_info, int on_popup_show, int on_popup_size, int on_paint, int on_accelerated_paint, int on_cursor_change, int start_dragging, int update_drag_cursor, int on_scroll_offset_changed, int on_ime_composition_range_changed, int on_text_selection_changed, int on_virtual_keyboard_requested);
 
 #endif // GOCEF_RenderHandler_H_
@@ -6,23 +6,69 @@ import (
 	// #include "RenderProcessHandler_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// RenderProcessHandlerProxy defines methods required for using RenderProcessHandler.
+// RenderProcessHandlerProxy is implemented by the proxies used with RenderProcessHandler. Embed
+// DefaultRenderProcessHandler to satisfy it, then implement any of the optional
+// RenderProcessHandler*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type RenderProcessHandlerProxy interface {
+	isRenderProcessHandlerProxy()
+}
+
+// DefaultRenderProcessHandler may be embedded to satisfy RenderProcessHandlerProxy.
+type DefaultRenderProcessHandler struct{}
+
+func (DefaultRenderProcessHandler) isRenderProcessHandlerProxy() {}
+
+// RenderProcessHandlerOnRenderThreadCreatedProxy may be implemented by a RenderProcessHandlerProxy to handle OnRenderThreadCreated.
+type RenderProcessHandlerOnRenderThreadCreatedProxy interface {
 	OnRenderThreadCreated(self *RenderProcessHandler, extra_info *ListValue)
+}
+
+// RenderProcessHandlerOnWebKitInitializedProxy may be implemented by a RenderProcessHandlerProxy to handle OnWebKitInitialized.
+type RenderProcessHandlerOnWebKitInitializedProxy interface {
 	OnWebKitInitialized(self *RenderProcessHandler)
+}
+
+// RenderProcessHandlerOnBrowserCreatedProxy may be implemented by a RenderProcessHandlerProxy to handle OnBrowserCreated.
+type RenderProcessHandlerOnBrowserCreatedProxy interface {
 	OnBrowserCreated(self *RenderProcessHandler, browser *Browser)
+}
+
+// RenderProcessHandlerOnBrowserDestroyedProxy may be implemented by a RenderProcessHandlerProxy to handle OnBrowserDestroyed.
+type RenderProcessHandlerOnBrowserDestroyedProxy interface {
 	OnBrowserDestroyed(self *RenderProcessHandler, browser *Browser)
+}
+
+// RenderProcessHandlerGetLoadHandlerProxy may be implemented by a RenderProcessHandlerProxy to handle GetLoadHandler.
+type RenderProcessHandlerGetLoadHandlerProxy interface {
 	GetLoadHandler(self *RenderProcessHandler) *LoadHandler
+}
+
+// RenderProcessHandlerOnContextCreatedProxy may be implemented by a RenderProcessHandlerProxy to handle OnContextCreated.
+type RenderProcessHandlerOnContextCreatedProxy interface {
 	OnContextCreated(self *RenderProcessHandler, browser *Browser, frame *Frame, context *V8context)
+}
+
+// RenderProcessHandlerOnContextReleasedProxy may be implemented by a RenderProcessHandlerProxy to handle OnContextReleased.
+type RenderProcessHandlerOnContextReleasedProxy interface {
 	OnContextReleased(self *RenderProcessHandler, browser *Browser, frame *Frame, context *V8context)
+}
+
+// RenderProcessHandlerOnUncaughtExceptionProxy may be implemented by a RenderProcessHandlerProxy to handle OnUncaughtException.
+type RenderProcessHandlerOnUncaughtExceptionProxy interface {
 	OnUncaughtException(self *RenderProcessHandler, browser *Browser, frame *Frame, context *V8context, exception *V8exception, stackTrace *V8stackTrace)
+}
+
+// RenderProcessHandlerOnFocusedNodeChangedProxy may be implemented by a RenderProcessHandlerProxy to handle OnFocusedNodeChanged.
+type RenderProcessHandlerOnFocusedNodeChangedProxy interface {
 	OnFocusedNodeChanged(self *RenderProcessHandler, browser *Browser, frame *Frame, node *Domnode)
-	OnProcessMessageReceived(self *RenderProcessHandler, browser *Browser, source_process ProcessID, message *ProcessMessage) int32
+}
+
+// RenderProcessHandlerOnProcessMessageReceivedProxy may be implemented by a RenderProcessHandlerProxy to handle OnProcessMessageReceived.
+type RenderProcessHandlerOnProcessMessageReceivedProxy interface {
+	OnProcessMessageReceived(self *RenderProcessHandler, browser *Browser, source_process ProcessID, message *ProcessMessage) bool
 }
 
 // RenderProcessHandler (cef_render_process_handler_t from include/capi/cef_render_process_handler_capi.h)
@@ -36,7 +82,17 @@ type RenderProcessHandler C.cef_render_process_handler_t
 func NewRenderProcessHandler(proxy RenderProcessHandlerProxy) *RenderProcessHandler {
 	result := (*RenderProcessHandler)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_render_process_handler_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_render_process_handler_proxy(result.toNative())
+		_, on_render_thread_created := proxy.(RenderProcessHandlerOnRenderThreadCreatedProxy)
+		_, on_web_kit_initialized := proxy.(RenderProcessHandlerOnWebKitInitializedProxy)
+		_, on_browser_created := proxy.(RenderProcessHandlerOnBrowserCreatedProxy)
+		_, on_browser_destroyed := proxy.(RenderProcessHandlerOnBrowserDestroyedProxy)
+		_, get_load_handler := proxy.(RenderProcessHandlerGetLoadHandlerProxy)
+		_, on_context_created := proxy.(RenderProcessHandlerOnContextCreatedProxy)
+		_, on_context_released := proxy.(RenderProcessHandlerOnContextReleasedProxy)
+		_, on_uncaught_exception := proxy.(RenderProcessHandlerOnUncaughtExceptionProxy)
+		_, on_focused_node_changed := proxy.(RenderProcessHandlerOnFocusedNodeChangedProxy)
+		_, on_process_message_received := proxy.(RenderProcessHandlerOnProcessMessageReceivedProxy)
+		C.gocef_set_render_process_handler_proxy(result.toNative(), cefBool(on_render_thread_created), cefBool(on_web_kit_initialized), cefBool(on_browser_created), cefBool(on_browser_destroyed), cefBool(get_load_handler), cefBool(on_context_created), cefBool(on_context_released), cefBool(on_uncaught_exception), cefBool(on_focused_node_changed), cefBool(on_process_message_received))
 	}
 	return result
 }
@@ -45,14 +101,15 @@ func (d *RenderProcessHandler) toNative() *C.cef_render_process_handler_t {
 	return (*C.cef_render_process_handler_t)(d)
 }
 
-func lookupRenderProcessHandlerProxy(obj *BaseRefCounted) RenderProcessHandlerProxy {
+func lookupRenderProcessHandlerProxy(obj *BaseRefCounted, callback string) RenderProcessHandlerProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("RenderProcessHandler", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(RenderProcessHandlerProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type RenderProcessHandlerProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("RenderProcessHandler", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -69,26 +126,38 @@ func (d *RenderProcessHandler) Base() *BaseRefCounted {
 // cef_browser_process_handler_t::on_render_process_thread_created(). Do not
 // keep a reference to |extra_info| outside of this function.
 func (d *RenderProcessHandler) OnRenderThreadCreated(extra_info *ListValue) {
-	lookupRenderProcessHandlerProxy(d.Base()).OnRenderThreadCreated(d, extra_info)
+	if proxy__, ok__ := lookupRenderProcessHandlerProxy(d.Base(), "OnRenderThreadCreated").(RenderProcessHandlerOnRenderThreadCreatedProxy); ok__ {
+		proxy__.OnRenderThreadCreated(d, extra_info)
+	}
 }
 
 //export gocef_render_process_handler_on_render_thread_created
 func gocef_render_process_handler_on_render_thread_created(self *C.cef_render_process_handler_t, extra_info *C.cef_list_value_t) {
+	defer recoverProxyPanic("RenderProcessHandler", "OnRenderThreadCreated")
 	me__ := (*RenderProcessHandler)(self)
-	proxy__ := lookupRenderProcessHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupRenderProcessHandlerProxy(me__.Base(), "OnRenderThreadCreated").(RenderProcessHandlerOnRenderThreadCreatedProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnRenderThreadCreated(me__, (*ListValue)(extra_info))
 }
 
 // OnWebKitInitialized (on_web_kit_initialized)
 // Called after WebKit has been initialized.
 func (d *RenderProcessHandler) OnWebKitInitialized() {
-	lookupRenderProcessHandlerProxy(d.Base()).OnWebKitInitialized(d)
+	if proxy__, ok__ := lookupRenderProcessHandlerProxy(d.Base(), "OnWebKitInitialized").(RenderProcessHandlerOnWebKitInitializedProxy); ok__ {
+		proxy__.OnWebKitInitialized(d)
+	}
 }
 
 //export gocef_render_process_handler_on_web_kit_initialized
 func gocef_render_process_handler_on_web_kit_initialized(self *C.cef_render_process_handler_t) {
+	defer recoverProxyPanic("RenderProcessHandler", "OnWebKitInitialized")
 	me__ := (*RenderProcessHandler)(self)
-	proxy__ := lookupRenderProcessHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupRenderProcessHandlerProxy(me__.Base(), "OnWebKitInitialized").(RenderProcessHandlerOnWebKitInitializedProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnWebKitInitialized(me__)
 }
 
@@ -97,39 +166,58 @@ func gocef_render_process_handler_on_web_kit_initialized(self *C.cef_render_proc
 // browser will be created before the old browser with the same identifier is
 // destroyed.
 func (d *RenderProcessHandler) OnBrowserCreated(browser *Browser) {
-	lookupRenderProcessHandlerProxy(d.Base()).OnBrowserCreated(d, browser)
+	if proxy__, ok__ := lookupRenderProcessHandlerProxy(d.Base(), "OnBrowserCreated").(RenderProcessHandlerOnBrowserCreatedProxy); ok__ {
+		proxy__.OnBrowserCreated(d, browser)
+	}
 }
 
 //export gocef_render_process_handler_on_browser_created
 func gocef_render_process_handler_on_browser_created(self *C.cef_render_process_handler_t, browser *C.cef_browser_t) {
+	defer recoverProxyPanic("RenderProcessHandler", "OnBrowserCreated")
 	me__ := (*RenderProcessHandler)(self)
-	proxy__ := lookupRenderProcessHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupRenderProcessHandlerProxy(me__.Base(), "OnBrowserCreated").(RenderProcessHandlerOnBrowserCreatedProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnBrowserCreated(me__, (*Browser)(browser))
 }
 
 // OnBrowserDestroyed (on_browser_destroyed)
 // Called before a browser is destroyed.
 func (d *RenderProcessHandler) OnBrowserDestroyed(browser *Browser) {
-	lookupRenderProcessHandlerProxy(d.Base()).OnBrowserDestroyed(d, browser)
+	if proxy__, ok__ := lookupRenderProcessHandlerProxy(d.Base(), "OnBrowserDestroyed").(RenderProcessHandlerOnBrowserDestroyedProxy); ok__ {
+		proxy__.OnBrowserDestroyed(d, browser)
+	}
 }
 
 //export gocef_render_process_handler_on_browser_destroyed
 func gocef_render_process_handler_on_browser_destroyed(self *C.cef_render_process_handler_t, browser *C.cef_browser_t) {
+	defer recoverProxyPanic("RenderProcessHandler", "OnBrowserDestroyed")
 	me__ := (*RenderProcessHandler)(self)
-	proxy__ := lookupRenderProcessHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupRenderProcessHandlerProxy(me__.Base(), "OnBrowserDestroyed").(RenderProcessHandlerOnBrowserDestroyedProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnBrowserDestroyed(me__, (*Browser)(browser))
 }
 
 // GetLoadHandler (get_load_handler)
 // Return the handler for browser load status events.
 func (d *RenderProcessHandler) GetLoadHandler() *LoadHandler {
-	return lookupRenderProcessHandlerProxy(d.Base()).GetLoadHandler(d)
+	if proxy__, ok__ := lookupRenderProcessHandlerProxy(d.Base(), "GetLoadHandler").(RenderProcessHandlerGetLoadHandlerProxy); ok__ {
+		return proxy__.GetLoadHandler(d)
+	}
+	return nil
 }
 
 //export gocef_render_process_handler_get_load_handler
 func gocef_render_process_handler_get_load_handler(self *C.cef_render_process_handler_t) *C.cef_load_handler_t {
+	defer recoverProxyPanic("RenderProcessHandler", "GetLoadHandler")
 	me__ := (*RenderProcessHandler)(self)
-	proxy__ := lookupRenderProcessHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupRenderProcessHandlerProxy(me__.Base(), "GetLoadHandler").(RenderProcessHandlerGetLoadHandlerProxy)
+	if !ok__ {
+		return nil
+	}
 	return (proxy__.GetLoadHandler(me__)).toNative()
 }
 
@@ -141,13 +229,19 @@ func gocef_render_process_handler_get_load_handler(self *C.cef_render_process_ha
 // on the associated thread can be retrieved via the
 // cef_v8context_t::get_task_runner() function.
 func (d *RenderProcessHandler) OnContextCreated(browser *Browser, frame *Frame, context *V8context) {
-	lookupRenderProcessHandlerProxy(d.Base()).OnContextCreated(d, browser, frame, context)
+	if proxy__, ok__ := lookupRenderProcessHandlerProxy(d.Base(), "OnContextCreated").(RenderProcessHandlerOnContextCreatedProxy); ok__ {
+		proxy__.OnContextCreated(d, browser, frame, context)
+	}
 }
 
 //export gocef_render_process_handler_on_context_created
 func gocef_render_process_handler_on_context_created(self *C.cef_render_process_handler_t, browser *C.cef_browser_t, frame *C.cef_frame_t, context *C.cef_v8context_t) {
+	defer recoverProxyPanic("RenderProcessHandler", "OnContextCreated")
 	me__ := (*RenderProcessHandler)(self)
-	proxy__ := lookupRenderProcessHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupRenderProcessHandlerProxy(me__.Base(), "OnContextCreated").(RenderProcessHandlerOnContextCreatedProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnContextCreated(me__, (*Browser)(browser), (*Frame)(frame), (*V8context)(context))
 }
 
@@ -155,13 +249,19 @@ func gocef_render_process_handler_on_context_created(self *C.cef_render_process_
 // Called immediately before the V8 context for a frame is released. No
 // references to the context should be kept after this function is called.
 func (d *RenderProcessHandler) OnContextReleased(browser *Browser, frame *Frame, context *V8context) {
-	lookupRenderProcessHandlerProxy(d.Base()).OnContextReleased(d, browser, frame, context)
+	if proxy__, ok__ := lookupRenderProcessHandlerProxy(d.Base(), "OnContextReleased").(RenderProcessHandlerOnContextReleasedProxy); ok__ {
+		proxy__.OnContextReleased(d, browser, frame, context)
+	}
 }
 
 //export gocef_render_process_handler_on_context_released
 func gocef_render_process_handler_on_context_released(self *C.cef_render_process_handler_t, browser *C.cef_browser_t, frame *C.cef_frame_t, context *C.cef_v8context_t) {
+	defer recoverProxyPanic("RenderProcessHandler", "OnContextReleased")
 	me__ := (*RenderProcessHandler)(self)
-	proxy__ := lookupRenderProcessHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupRenderProcessHandlerProxy(me__.Base(), "OnContextReleased").(RenderProcessHandlerOnContextReleasedProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnContextReleased(me__, (*Browser)(browser), (*Frame)(frame), (*V8context)(context))
 }
 
@@ -170,13 +270,19 @@ func gocef_render_process_handler_on_context_released(self *C.cef_render_process
 // callback is disabled by default. To enable set
 // CefSettings.uncaught_exception_stack_size > 0.
 func (d *RenderProcessHandler) OnUncaughtException(browser *Browser, frame *Frame, context *V8context, exception *V8exception, stackTrace *V8stackTrace) {
-	lookupRenderProcessHandlerProxy(d.Base()).OnUncaughtException(d, browser, frame, context, exception, stackTrace)
+	if proxy__, ok__ := lookupRenderProcessHandlerProxy(d.Base(), "OnUncaughtException").(RenderProcessHandlerOnUncaughtExceptionProxy); ok__ {
+		proxy__.OnUncaughtException(d, browser, frame, context, exception, stackTrace)
+	}
 }
 
 //export gocef_render_process_handler_on_uncaught_exception
 func gocef_render_process_handler_on_uncaught_exception(self *C.cef_render_process_handler_t, browser *C.cef_browser_t, frame *C.cef_frame_t, context *C.cef_v8context_t, exception *C.cef_v8exception_t, stackTrace *C.cef_v8stack_trace_t) {
+	defer recoverProxyPanic("RenderProcessHandler", "OnUncaughtException")
 	me__ := (*RenderProcessHandler)(self)
-	proxy__ := lookupRenderProcessHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupRenderProcessHandlerProxy(me__.Base(), "OnUncaughtException").(RenderProcessHandlerOnUncaughtExceptionProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnUncaughtException(me__, (*Browser)(browser), (*Frame)(frame), (*V8context)(context), (*V8exception)(exception), (*V8stackTrace)(stackTrace))
 }
 
@@ -188,13 +294,19 @@ func gocef_render_process_handler_on_uncaught_exception(self *C.cef_render_proce
 // keep references to or attempt to access any DOM objects outside the scope
 // of this function.
 func (d *RenderProcessHandler) OnFocusedNodeChanged(browser *Browser, frame *Frame, node *Domnode) {
-	lookupRenderProcessHandlerProxy(d.Base()).OnFocusedNodeChanged(d, browser, frame, node)
+	if proxy__, ok__ := lookupRenderProcessHandlerProxy(d.Base(), "OnFocusedNodeChanged").(RenderProcessHandlerOnFocusedNodeChangedProxy); ok__ {
+		proxy__.OnFocusedNodeChanged(d, browser, frame, node)
+	}
 }
 
 //export gocef_render_process_handler_on_focused_node_changed
 func gocef_render_process_handler_on_focused_node_changed(self *C.cef_render_process_handler_t, browser *C.cef_browser_t, frame *C.cef_frame_t, node *C.cef_domnode_t) {
+	defer recoverProxyPanic("RenderProcessHandler", "OnFocusedNodeChanged")
 	me__ := (*RenderProcessHandler)(self)
-	proxy__ := lookupRenderProcessHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupRenderProcessHandlerProxy(me__.Base(), "OnFocusedNodeChanged").(RenderProcessHandlerOnFocusedNodeChangedProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnFocusedNodeChanged(me__, (*Browser)(browser), (*Frame)(frame), (*Domnode)(node))
 }
 
@@ -202,13 +314,20 @@ func gocef_render_process_handler_on_focused_node_changed(self *C.cef_render_pro
 // Called when a new message is received from a different process. Return true
 // (1) if the message was handled or false (0) otherwise. Do not keep a
 // reference to or attempt to access the message outside of this callback.
-func (d *RenderProcessHandler) OnProcessMessageReceived(browser *Browser, source_process ProcessID, message *ProcessMessage) int32 {
-	return lookupRenderProcessHandlerProxy(d.Base()).OnProcessMessageReceived(d, browser, source_process, message)
+func (d *RenderProcessHandler) OnProcessMessageReceived(browser *Browser, source_process ProcessID, message *ProcessMessage) bool {
+	if proxy__, ok__ := lookupRenderProcessHandlerProxy(d.Base(), "OnProcessMessageReceived").(RenderProcessHandlerOnProcessMessageReceivedProxy); ok__ {
+		return proxy__.OnProcessMessageReceived(d, browser, source_process, message)
+	}
+	return false
 }
 
 //export gocef_render_process_handler_on_process_message_received
 func gocef_render_process_handler_on_process_message_received(self *C.cef_render_process_handler_t, browser *C.cef_browser_t, source_process C.cef_process_id_t, message *C.cef_process_message_t) C.int {
+	defer recoverProxyPanic("RenderProcessHandler", "OnProcessMessageReceived")
 	me__ := (*RenderProcessHandler)(self)
-	proxy__ := lookupRenderProcessHandlerProxy(me__.Base())
-	return C.int(proxy__.OnProcessMessageReceived(me__, (*Browser)(browser), ProcessID(source_process), (*ProcessMessage)(message)))
+	proxy__, ok__ := lookupRenderProcessHandlerProxy(me__.Base(), "OnProcessMessageReceived").(RenderProcessHandlerOnProcessMessageReceivedProxy)
+	if !ok__ {
+		return 0
+	}
+	return cefBool(proxy__.OnProcessMessageReceived(me__, (*Browser)(browser), ProcessID(source_process), (*ProcessMessage)(message)))
 }
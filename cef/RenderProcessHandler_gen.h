@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_render_process_handler_proxy(cef_render_process_handler_t *self);
+void gocef_set_render_process_handler_proxy(cef_render_process_handler_t *self, int on_render_thread_created, int on_web_kit_initialized, int on_browser_created, int on_browser_destroyed, int get_load_handler, int on_context_created, int on_context_released, int on_uncaught_exception, int on_focused_node_changed, int on_process_message_received);
 
 #endif // GOCEF_RenderProcessHandler_H_
@@ -1,11 +0,0 @@
-// Code generated - DO NOT EDIT.
-
-#ifndef GOCEF_RequestCallback_H_
-#define GOCEF_RequestCallback_H_
-#pragma once
-
-#include "capi_gen.h"
-
-void gocef_set_request_callback_proxy(cef_request_callback_t *self);
-
-#endif // GOCEF_RequestCallback_H_
@@ -6,16 +6,34 @@ import (
 	// #include "RequestContextHandler_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// RequestContextHandlerProxy defines methods required for using RequestContextHandler.
+// RequestContextHandlerProxy is implemented by the proxies used with RequestContextHandler. Embed
+// DefaultRequestContextHandler to satisfy it, then implement any of the optional
+// RequestContextHandler*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type RequestContextHandlerProxy interface {
+	isRequestContextHandlerProxy()
+}
+
+// DefaultRequestContextHandler may be embedded to satisfy RequestContextHandlerProxy.
+type DefaultRequestContextHandler struct{}
+
+func (DefaultRequestContextHandler) isRequestContextHandlerProxy() {}
+
+// RequestContextHandlerOnRequestContextInitializedProxy may be implemented by a RequestContextHandlerProxy to handle OnRequestContextInitialized.
+type RequestContextHandlerOnRequestContextInitializedProxy interface {
 	OnRequestContextInitialized(self *RequestContextHandler, request_context *RequestContext)
+}
+
+// RequestContextHandlerGetCookieManagerProxy may be implemented by a RequestContextHandlerProxy to handle GetCookieManager.
+type RequestContextHandlerGetCookieManagerProxy interface {
 	GetCookieManager(self *RequestContextHandler) *CookieManager
-	OnBeforePluginLoad(self *RequestContextHandler, mime_type, plugin_url string, is_main_frame int32, top_origin_url string, plugin_info *WebPluginInfo, plugin_policy *PluginPolicy) int32
+}
+
+// RequestContextHandlerOnBeforePluginLoadProxy may be implemented by a RequestContextHandlerProxy to handle OnBeforePluginLoad.
+type RequestContextHandlerOnBeforePluginLoadProxy interface {
+	OnBeforePluginLoad(self *RequestContextHandler, mime_type, plugin_url string, is_main_frame bool, top_origin_url string, plugin_info *WebPluginInfo, plugin_policy *PluginPolicy) bool
 }
 
 // RequestContextHandler (cef_request_context_handler_t from include/capi/cef_request_context_handler_capi.h)
@@ -29,7 +47,10 @@ type RequestContextHandler C.cef_request_context_handler_t
 func NewRequestContextHandler(proxy RequestContextHandlerProxy) *RequestContextHandler {
 	result := (*RequestContextHandler)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_request_context_handler_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_request_context_handler_proxy(result.toNative())
+		_, on_request_context_initialized := proxy.(RequestContextHandlerOnRequestContextInitializedProxy)
+		_, get_cookie_manager := proxy.(RequestContextHandlerGetCookieManagerProxy)
+		_, on_before_plugin_load := proxy.(RequestContextHandlerOnBeforePluginLoadProxy)
+		C.gocef_set_request_context_handler_proxy(result.toNative(), cefBool(on_request_context_initialized), cefBool(get_cookie_manager), cefBool(on_before_plugin_load))
 	}
 	return result
 }
@@ -38,14 +59,15 @@ func (d *RequestContextHandler) toNative() *C.cef_request_context_handler_t {
 	return (*C.cef_request_context_handler_t)(d)
 }
 
-func lookupRequestContextHandlerProxy(obj *BaseRefCounted) RequestContextHandlerProxy {
+func lookupRequestContextHandlerProxy(obj *BaseRefCounted, callback string) RequestContextHandlerProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("RequestContextHandler", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(RequestContextHandlerProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type RequestContextHandlerProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("RequestContextHandler", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -60,13 +82,19 @@ func (d *RequestContextHandler) Base() *BaseRefCounted {
 // Called on the browser process UI thread immediately after the request
 // context has been initialized.
 func (d *RequestContextHandler) OnRequestContextInitialized(request_context *RequestContext) {
-	lookupRequestContextHandlerProxy(d.Base()).OnRequestContextInitialized(d, request_context)
+	if proxy__, ok__ := lookupRequestContextHandlerProxy(d.Base(), "OnRequestContextInitialized").(RequestContextHandlerOnRequestContextInitializedProxy); ok__ {
+		proxy__.OnRequestContextInitialized(d, request_context)
+	}
 }
 
 //export gocef_request_context_handler_on_request_context_initialized
 func gocef_request_context_handler_on_request_context_initialized(self *C.cef_request_context_handler_t, request_context *C.cef_request_context_t) {
+	defer recoverProxyPanic("RequestContextHandler", "OnRequestContextInitialized")
 	me__ := (*RequestContextHandler)(self)
-	proxy__ := lookupRequestContextHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupRequestContextHandlerProxy(me__.Base(), "OnRequestContextInitialized").(RequestContextHandlerOnRequestContextInitializedProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnRequestContextInitialized(me__, (*RequestContext)(request_context))
 }
 
@@ -75,13 +103,20 @@ func gocef_request_context_handler_on_request_context_initialized(self *C.cef_re
 // this function returns NULL the default cookie manager retrievable via
 // cef_request_tContext::get_default_cookie_manager() will be used.
 func (d *RequestContextHandler) GetCookieManager() *CookieManager {
-	return lookupRequestContextHandlerProxy(d.Base()).GetCookieManager(d)
+	if proxy__, ok__ := lookupRequestContextHandlerProxy(d.Base(), "GetCookieManager").(RequestContextHandlerGetCookieManagerProxy); ok__ {
+		return proxy__.GetCookieManager(d)
+	}
+	return nil
 }
 
 //export gocef_request_context_handler_get_cookie_manager
 func gocef_request_context_handler_get_cookie_manager(self *C.cef_request_context_handler_t) *C.cef_cookie_manager_t {
+	defer recoverProxyPanic("RequestContextHandler", "GetCookieManager")
 	me__ := (*RequestContextHandler)(self)
-	proxy__ := lookupRequestContextHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupRequestContextHandlerProxy(me__.Base(), "GetCookieManager").(RequestContextHandlerGetCookieManagerProxy)
+	if !ok__ {
+		return nil
+	}
 	return (proxy__.GetCookieManager(me__)).toNative()
 }
 
@@ -103,17 +138,24 @@ func gocef_request_context_handler_get_cookie_manager(self *C.cef_request_contex
 // |top_origin_url| is NULL. To purge the plugin list cache and potentially
 // trigger new calls to this function call
 // cef_request_tContext::PurgePluginListCache.
-func (d *RequestContextHandler) OnBeforePluginLoad(mime_type, plugin_url string, is_main_frame int32, top_origin_url string, plugin_info *WebPluginInfo, plugin_policy *PluginPolicy) int32 {
-	return lookupRequestContextHandlerProxy(d.Base()).OnBeforePluginLoad(d, mime_type, plugin_url, is_main_frame, top_origin_url, plugin_info, plugin_policy)
+func (d *RequestContextHandler) OnBeforePluginLoad(mime_type, plugin_url string, is_main_frame bool, top_origin_url string, plugin_info *WebPluginInfo, plugin_policy *PluginPolicy) bool {
+	if proxy__, ok__ := lookupRequestContextHandlerProxy(d.Base(), "OnBeforePluginLoad").(RequestContextHandlerOnBeforePluginLoadProxy); ok__ {
+		return proxy__.OnBeforePluginLoad(d, mime_type, plugin_url, is_main_frame, top_origin_url, plugin_info, plugin_policy)
+	}
+	return false
 }
 
 //export gocef_request_context_handler_on_before_plugin_load
 func gocef_request_context_handler_on_before_plugin_load(self *C.cef_request_context_handler_t, mime_type *C.cef_string_t, plugin_url *C.cef_string_t, is_main_frame C.int, top_origin_url *C.cef_string_t, plugin_info *C.cef_web_plugin_info_t, plugin_policy *C.cef_plugin_policy_t) C.int {
+	defer recoverProxyPanic("RequestContextHandler", "OnBeforePluginLoad")
 	me__ := (*RequestContextHandler)(self)
-	proxy__ := lookupRequestContextHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupRequestContextHandlerProxy(me__.Base(), "OnBeforePluginLoad").(RequestContextHandlerOnBeforePluginLoadProxy)
+	if !ok__ {
+		return 0
+	}
 	mime_type_ := cefstrToString(mime_type)
 	plugin_url_ := cefstrToString(plugin_url)
 	top_origin_url_ := cefstrToString(top_origin_url)
 	plugin_policy_ := PluginPolicy(*plugin_policy)
-	return C.int(proxy__.OnBeforePluginLoad(me__, mime_type_, plugin_url_, int32(is_main_frame), top_origin_url_, (*WebPluginInfo)(plugin_info), &plugin_policy_))
+	return cefBool(proxy__.OnBeforePluginLoad(me__, mime_type_, plugin_url_, is_main_frame != 0, top_origin_url_, (*WebPluginInfo)(plugin_info), &plugin_policy_))
 }
@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_request_context_handler_proxy(cef_request_context_handler_t *self);
+void gocef_set_request_context_handler_proxy(cef_request_context_handler_t *self, int on_request_context_initialized, int get_cookie_manager, int on_before_plugin_load);
 
 #endif // GOCEF_RequestContextHandler_H_
@@ -29,13 +29,13 @@ type RequestContextSettings struct {
 	// Web browsers do not persist them. Can be set globally using the
 	// CefSettings.persist_session_cookies value. This value will be ignored if
 	// |cache_path| is empty or if it matches the CefSettings.cache_path value.
-	PersistSessionCookies int32
+	PersistSessionCookies bool
 	// PersistUserPreferences (persist_user_preferences)
 	// To persist user preferences as a JSON file in the cache path directory set
 	// this value to true (1). Can be set globally using the
 	// CefSettings.persist_user_preferences value. This value will be ignored if
 	// |cache_path| is empty or if it matches the CefSettings.cache_path value.
-	PersistUserPreferences int32
+	PersistUserPreferences bool
 	// IgnoreCertificateErrors (ignore_certificate_errors)
 	// Set to true (1) to ignore errors related to invalid SSL certificates.
 	// Enabling this setting can lead to potential security vulnerabilities like
@@ -43,7 +43,7 @@ type RequestContextSettings struct {
 	// internet should not enable this setting. Can be set globally using the
 	// CefSettings.ignore_certificate_errors value. This value will be ignored if
 	// |cache_path| matches the CefSettings.cache_path value.
-	IgnoreCertificateErrors int32
+	IgnoreCertificateErrors bool
 	// EnableNetSecurityExpiration (enable_net_security_expiration)
 	// Set to true (1) to enable date-based expiration of built in network
 	// security information (i.e. certificate transparency logs, HSTS preloading
@@ -52,7 +52,7 @@ type RequestContextSettings struct {
 	// 10 weeks in the past. See https://www.certificate-transparency.org/ and
 	// https://www.chromium.org/hsts for details. Can be set globally using the
 	// CefSettings.enable_net_security_expiration value.
-	EnableNetSecurityExpiration int32
+	EnableNetSecurityExpiration bool
 	// AcceptLanguageList (accept_language_list)
 	// Comma delimited ordered list of language codes without any whitespace that
 	// will be used in the "Accept-Language" HTTP header. Can be set globally
@@ -76,10 +76,10 @@ func (d *RequestContextSettings) toNative(native *C.cef_request_context_settings
 	}
 	native.size = C.size_t(d.Size)
 	setCEFStr(d.CachePath, &native.cache_path)
-	native.persist_session_cookies = C.int(d.PersistSessionCookies)
-	native.persist_user_preferences = C.int(d.PersistUserPreferences)
-	native.ignore_certificate_errors = C.int(d.IgnoreCertificateErrors)
-	native.enable_net_security_expiration = C.int(d.EnableNetSecurityExpiration)
+	native.persist_session_cookies = cefBool(d.PersistSessionCookies)
+	native.persist_user_preferences = cefBool(d.PersistUserPreferences)
+	native.ignore_certificate_errors = cefBool(d.IgnoreCertificateErrors)
+	native.enable_net_security_expiration = cefBool(d.EnableNetSecurityExpiration)
 	setCEFStr(d.AcceptLanguageList, &native.accept_language_list)
 	return native
 }
@@ -96,9 +96,9 @@ func (n *C.cef_request_context_settings_t) toGo() *RequestContextSettings {
 func (n *C.cef_request_context_settings_t) intoGo(d *RequestContextSettings) {
 	d.Size = uint64(n.size)
 	d.CachePath = cefstrToString(&n.cache_path)
-	d.PersistSessionCookies = int32(n.persist_session_cookies)
-	d.PersistUserPreferences = int32(n.persist_user_preferences)
-	d.IgnoreCertificateErrors = int32(n.ignore_certificate_errors)
-	d.EnableNetSecurityExpiration = int32(n.enable_net_security_expiration)
+	d.PersistSessionCookies = n.persist_session_cookies != 0
+	d.PersistUserPreferences = n.persist_user_preferences != 0
+	d.IgnoreCertificateErrors = n.ignore_certificate_errors != 0
+	d.EnableNetSecurityExpiration = n.enable_net_security_expiration != 0
 	d.AcceptLanguageList = cefstrToString(&n.accept_language_list)
 }
@@ -60,27 +60,28 @@ func (d *RequestContext) Base() *BaseRefCounted {
 // IsSame (is_same)
 // Returns true (1) if this object is pointing to the same context as |that|
 // object.
-func (d *RequestContext) IsSame(other *RequestContext) int32 {
-	return int32(C.gocef_request_context_is_same(d.toNative(), other.toNative(), d.is_same))
+func (d *RequestContext) IsSame(other *RequestContext) bool {
+	return C.gocef_request_context_is_same(d.toNative(), other.toNative(), d.is_same) != 0
 }
 
 // IsSharingWith (is_sharing_with)
 // Returns true (1) if this object is sharing the same storage as |that|
 // object.
-func (d *RequestContext) IsSharingWith(other *RequestContext) int32 {
-	return int32(C.gocef_request_context_is_sharing_with(d.toNative(), other.toNative(), d.is_sharing_with))
+func (d *RequestContext) IsSharingWith(other *RequestContext) bool {
+	return C.gocef_request_context_is_sharing_with(d.toNative(), other.toNative(), d.is_sharing_with) != 0
 }
 
 // IsGlobal (is_global)
 // Returns true (1) if this object is the global context. The global context
 // is used by default when creating a browser or URL request with a NULL
 // context argument.
-func (d *RequestContext) IsGlobal() int32 {
-	return int32(C.gocef_request_context_is_global(d.toNative(), d.is_global))
+func (d *RequestContext) IsGlobal() bool {
+	return C.gocef_request_context_is_global(d.toNative(), d.is_global) != 0
 }
 
 // GetHandler (get_handler)
 // Returns the handler for this context if any.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *RequestContext) GetHandler() *RequestContextHandler {
 	return (*RequestContextHandler)(C.gocef_request_context_get_handler(d.toNative(), d.get_handler))
 }
@@ -100,6 +101,7 @@ func (d *RequestContext) GetCachePath() string {
 // not receive a value via cef_request_tContextHandler::get_cookie_manager().
 // If |callback| is non-NULL it will be executed asnychronously on the IO
 // thread after the manager's storage has been initialized.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *RequestContext) GetDefaultCookieManager(callback *CompletionCallback) *CookieManager {
 	return (*CookieManager)(C.gocef_request_context_get_default_cookie_manager(d.toNative(), callback.toNative(), d.get_default_cookie_manager))
 }
@@ -116,7 +118,7 @@ func (d *RequestContext) GetDefaultCookieManager(callback *CompletionCallback) *
 // change or remove the factory that matches the specified |scheme_name| and
 // optional |domain_name|. Returns false (0) if an error occurs. This function
 // may be called on any thread in the browser process.
-func (d *RequestContext) RegisterSchemeHandlerFactory(scheme_name, domain_name string, factory *SchemeHandlerFactory) int32 {
+func (d *RequestContext) RegisterSchemeHandlerFactory(scheme_name, domain_name string, factory *SchemeHandlerFactory) bool {
 	scheme_name_ := C.cef_string_userfree_alloc()
 	setCEFStr(scheme_name, scheme_name_)
 	defer func() {
@@ -127,14 +129,14 @@ func (d *RequestContext) RegisterSchemeHandlerFactory(scheme_name, domain_name s
 	defer func() {
 		C.cef_string_userfree_free(domain_name_)
 	}()
-	return int32(C.gocef_request_context_register_scheme_handler_factory(d.toNative(), (*C.cef_string_t)(scheme_name_), (*C.cef_string_t)(domain_name_), factory.toNative(), d.register_scheme_handler_factory))
+	return C.gocef_request_context_register_scheme_handler_factory(d.toNative(), (*C.cef_string_t)(scheme_name_), (*C.cef_string_t)(domain_name_), factory.toNative(), d.register_scheme_handler_factory) != 0
 }
 
 // ClearSchemeHandlerFactories (clear_scheme_handler_factories)
 // Clear all registered scheme handler factories. Returns false (0) on error.
 // This function may be called on any thread in the browser process.
-func (d *RequestContext) ClearSchemeHandlerFactories() int32 {
-	return int32(C.gocef_request_context_clear_scheme_handler_factories(d.toNative(), d.clear_scheme_handler_factories))
+func (d *RequestContext) ClearSchemeHandlerFactories() bool {
+	return C.gocef_request_context_clear_scheme_handler_factories(d.toNative(), d.clear_scheme_handler_factories) != 0
 }
 
 // PurgePluginListCache (purge_plugin_list_cache)
@@ -143,20 +145,20 @@ func (d *RequestContext) ClearSchemeHandlerFactories() int32 {
 // reload all pages with plugins.
 // cef_request_tContextHandler::OnBeforePluginLoad may be called to rebuild
 // the plugin list cache.
-func (d *RequestContext) PurgePluginListCache(reload_pages int32) {
-	C.gocef_request_context_purge_plugin_list_cache(d.toNative(), C.int(reload_pages), d.purge_plugin_list_cache)
+func (d *RequestContext) PurgePluginListCache(reload_pages bool) {
+	C.gocef_request_context_purge_plugin_list_cache(d.toNative(), cefBool(reload_pages), d.purge_plugin_list_cache)
 }
 
 // HasPreference (has_preference)
 // Returns true (1) if a preference with the specified |name| exists. This
 // function must be called on the browser process UI thread.
-func (d *RequestContext) HasPreference(name string) int32 {
+func (d *RequestContext) HasPreference(name string) bool {
 	name_ := C.cef_string_userfree_alloc()
 	setCEFStr(name, name_)
 	defer func() {
 		C.cef_string_userfree_free(name_)
 	}()
-	return int32(C.gocef_request_context_has_preference(d.toNative(), (*C.cef_string_t)(name_), d.has_preference))
+	return C.gocef_request_context_has_preference(d.toNative(), (*C.cef_string_t)(name_), d.has_preference) != 0
 }
 
 // GetPreference (get_preference)
@@ -165,6 +167,7 @@ func (d *RequestContext) HasPreference(name string) int32 {
 // of the underlying preference value and modifications to the returned object
 // will not modify the underlying preference value. This function must be
 // called on the browser process UI thread.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *RequestContext) GetPreference(name string) *Value {
 	name_ := C.cef_string_userfree_alloc()
 	setCEFStr(name, name_)
@@ -181,8 +184,9 @@ func (d *RequestContext) GetPreference(name string) *Value {
 // modifications to the returned object will not modify the underlying
 // preference values. This function must be called on the browser process UI
 // thread.
-func (d *RequestContext) GetAllPreferences(include_defaults int32) *DictionaryValue {
-	return (*DictionaryValue)(C.gocef_request_context_get_all_preferences(d.toNative(), C.int(include_defaults), d.get_all_preferences))
+// The caller owns a reference to the returned value; see Adopt().
+func (d *RequestContext) GetAllPreferences(include_defaults bool) *DictionaryValue {
+	return (*DictionaryValue)(C.gocef_request_context_get_all_preferences(d.toNative(), cefBool(include_defaults), d.get_all_preferences))
 }
 
 // CanSetPreference (can_set_preference)
@@ -190,13 +194,13 @@ func (d *RequestContext) GetAllPreferences(include_defaults int32) *DictionaryVa
 // modified using SetPreference. As one example preferences set via the
 // command-line usually cannot be modified. This function must be called on
 // the browser process UI thread.
-func (d *RequestContext) CanSetPreference(name string) int32 {
+func (d *RequestContext) CanSetPreference(name string) bool {
 	name_ := C.cef_string_userfree_alloc()
 	setCEFStr(name, name_)
 	defer func() {
 		C.cef_string_userfree_free(name_)
 	}()
-	return int32(C.gocef_request_context_can_set_preference(d.toNative(), (*C.cef_string_t)(name_), d.can_set_preference))
+	return C.gocef_request_context_can_set_preference(d.toNative(), (*C.cef_string_t)(name_), d.can_set_preference) != 0
 }
 
 // SetPreference (set_preference)
@@ -205,7 +209,7 @@ func (d *RequestContext) CanSetPreference(name string) int32 {
 // preference will be restored to its default value. If setting the preference
 // fails then |error| will be populated with a detailed description of the
 // problem. This function must be called on the browser process UI thread.
-func (d *RequestContext) SetPreference(name string, value *Value, error_r *string) int32 {
+func (d *RequestContext) SetPreference(name string, value *Value, error_r *string) bool {
 	name_ := C.cef_string_userfree_alloc()
 	setCEFStr(name, name_)
 	defer func() {
@@ -217,7 +221,7 @@ func (d *RequestContext) SetPreference(name string, value *Value, error_r *strin
 		*error_r = cefstrToString(error_r_)
 		C.cef_string_userfree_free(error_r_)
 	}()
-	return int32(C.gocef_request_context_set_preference(d.toNative(), (*C.cef_string_t)(name_), value.toNative(), (*C.cef_string_t)(error_r_), d.set_preference))
+	return C.gocef_request_context_set_preference(d.toNative(), (*C.cef_string_t)(name_), value.toNative(), (*C.cef_string_t)(error_r_), d.set_preference) != 0
 }
 
 // ClearCertificateExceptions (clear_certificate_exceptions)
@@ -257,13 +261,16 @@ func (d *RequestContext) ResolveHost(origin string, callback *ResolveCallback) {
 // cached data. |resolved_ips| will be populated with the list of resolved IP
 // addresses or NULL if no cached data is available. Returns ERR_NONE on
 // success. This function must be called on the browser process IO thread.
-func (d *RequestContext) ResolveHostCached(origin string, resolved_ips StringList) Errorcode {
+func (d *RequestContext) ResolveHostCached(origin string) ([]string, Errorcode) {
 	origin_ := C.cef_string_userfree_alloc()
 	setCEFStr(origin, origin_)
 	defer func() {
 		C.cef_string_userfree_free(origin_)
 	}()
-	return Errorcode(C.gocef_request_context_resolve_host_cached(d.toNative(), (*C.cef_string_t)(origin_), C.cef_string_list_t(resolved_ips), d.resolve_host_cached))
+	resolved_ips_ := C.cef_string_list_alloc()
+	defer C.cef_string_list_free(resolved_ips_)
+	result__ := Errorcode(C.gocef_request_context_resolve_host_cached(d.toNative(), (*C.cef_string_t)(origin_), resolved_ips_, d.resolve_host_cached))
+	return cefStringListToGo(resolved_ips_), result__
 }
 
 // LoadExtension (load_extension)
@@ -327,13 +334,13 @@ func (d *RequestContext) LoadExtension(root_directory string, manifest *Dictiona
 // by |extension_id|. Other contexts sharing the same storage will also have
 // access to the extension (see HasExtension). This function must be called on
 // the browser process UI thread.
-func (d *RequestContext) DidLoadExtension(extension_id string) int32 {
+func (d *RequestContext) DidLoadExtension(extension_id string) bool {
 	extension_id_ := C.cef_string_userfree_alloc()
 	setCEFStr(extension_id, extension_id_)
 	defer func() {
 		C.cef_string_userfree_free(extension_id_)
 	}()
-	return int32(C.gocef_request_context_did_load_extension(d.toNative(), (*C.cef_string_t)(extension_id_), d.did_load_extension))
+	return C.gocef_request_context_did_load_extension(d.toNative(), (*C.cef_string_t)(extension_id_), d.did_load_extension) != 0
 }
 
 // HasExtension (has_extension)
@@ -341,13 +348,13 @@ func (d *RequestContext) DidLoadExtension(extension_id string) int32 {
 // |extension_id|. This may not be the context that was used to load the
 // extension (see DidLoadExtension). This function must be called on the
 // browser process UI thread.
-func (d *RequestContext) HasExtension(extension_id string) int32 {
+func (d *RequestContext) HasExtension(extension_id string) bool {
 	extension_id_ := C.cef_string_userfree_alloc()
 	setCEFStr(extension_id, extension_id_)
 	defer func() {
 		C.cef_string_userfree_free(extension_id_)
 	}()
-	return int32(C.gocef_request_context_has_extension(d.toNative(), (*C.cef_string_t)(extension_id_), d.has_extension))
+	return C.gocef_request_context_has_extension(d.toNative(), (*C.cef_string_t)(extension_id_), d.has_extension) != 0
 }
 
 // GetExtensions (get_extensions)
@@ -355,14 +362,18 @@ func (d *RequestContext) HasExtension(extension_id string) int32 {
 // HasExtension). |extension_ids| will be populated with the list of extension
 // ID values. Returns true (1) on success. This function must be called on the
 // browser process UI thread.
-func (d *RequestContext) GetExtensions(extension_ids StringList) int32 {
-	return int32(C.gocef_request_context_get_extensions(d.toNative(), C.cef_string_list_t(extension_ids), d.get_extensions))
+func (d *RequestContext) GetExtensions() ([]string, bool) {
+	extension_ids_ := C.cef_string_list_alloc()
+	defer C.cef_string_list_free(extension_ids_)
+	result__ := C.gocef_request_context_get_extensions(d.toNative(), extension_ids_, d.get_extensions) != 0
+	return cefStringListToGo(extension_ids_), result__
 }
 
 // GetExtension (get_extension)
 // Returns the extension matching |extension_id| or NULL if no matching
 // extension is accessible in this context (see HasExtension). This function
 // must be called on the browser process UI thread.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *RequestContext) GetExtension(extension_id string) *Extension {
 	extension_id_ := C.cef_string_userfree_alloc()
 	setCEFStr(extension_id, extension_id_)
@@ -6,30 +6,108 @@ import (
 	// #include "RequestHandler_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// RequestHandlerProxy defines methods required for using RequestHandler.
+// RequestHandlerProxy is implemented by the proxies used with RequestHandler. Embed
+// DefaultRequestHandler to satisfy it, then implement any of the optional
+// RequestHandler*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type RequestHandlerProxy interface {
-	OnBeforeBrowse(self *RequestHandler, browser *Browser, frame *Frame, request *Request, user_gesture, is_redirect int32) int32
-	OnOpenUrlfromTab(self *RequestHandler, browser *Browser, frame *Frame, target_url string, target_disposition WindowOpenDisposition, user_gesture int32) int32
+	isRequestHandlerProxy()
+}
+
+// DefaultRequestHandler may be embedded to satisfy RequestHandlerProxy.
+type DefaultRequestHandler struct{}
+
+func (DefaultRequestHandler) isRequestHandlerProxy() {}
+
+// RequestHandlerOnBeforeBrowseProxy may be implemented by a RequestHandlerProxy to handle OnBeforeBrowse.
+type RequestHandlerOnBeforeBrowseProxy interface {
+	OnBeforeBrowse(self *RequestHandler, browser *Browser, frame *Frame, request *Request, user_gesture, is_redirect bool) bool
+}
+
+// RequestHandlerOnOpenUrlfromTabProxy may be implemented by a RequestHandlerProxy to handle OnOpenUrlfromTab.
+type RequestHandlerOnOpenUrlfromTabProxy interface {
+	OnOpenUrlfromTab(self *RequestHandler, browser *Browser, frame *Frame, target_url string, target_disposition WindowOpenDisposition, user_gesture bool) bool
+}
+
+// RequestHandlerOnBeforeResourceLoadProxy may be implemented by a RequestHandlerProxy to handle OnBeforeResourceLoad.
+type RequestHandlerOnBeforeResourceLoadProxy interface {
 	OnBeforeResourceLoad(self *RequestHandler, browser *Browser, frame *Frame, request *Request, callback *RequestCallback) ReturnValue
+}
+
+// RequestHandlerGetResourceHandlerProxy may be implemented by a RequestHandlerProxy to handle GetResourceHandler.
+type RequestHandlerGetResourceHandlerProxy interface {
 	GetResourceHandler(self *RequestHandler, browser *Browser, frame *Frame, request *Request) *ResourceHandler
+}
+
+// RequestHandlerOnResourceRedirectProxy may be implemented by a RequestHandlerProxy to handle OnResourceRedirect.
+type RequestHandlerOnResourceRedirectProxy interface {
 	OnResourceRedirect(self *RequestHandler, browser *Browser, frame *Frame, request *Request, response *Response, new_url *string)
-	OnResourceResponse(self *RequestHandler, browser *Browser, frame *Frame, request *Request, response *Response) int32
+}
+
+// RequestHandlerOnResourceResponseProxy may be implemented by a RequestHandlerProxy to handle OnResourceResponse.
+type RequestHandlerOnResourceResponseProxy interface {
+	OnResourceResponse(self *RequestHandler, browser *Browser, frame *Frame, request *Request, response *Response) bool
+}
+
+// RequestHandlerGetResourceResponseFilterProxy may be implemented by a RequestHandlerProxy to handle GetResourceResponseFilter.
+type RequestHandlerGetResourceResponseFilterProxy interface {
 	GetResourceResponseFilter(self *RequestHandler, browser *Browser, frame *Frame, request *Request, response *Response) *ResponseFilter
+}
+
+// RequestHandlerOnResourceLoadCompleteProxy may be implemented by a RequestHandlerProxy to handle OnResourceLoadComplete.
+type RequestHandlerOnResourceLoadCompleteProxy interface {
 	OnResourceLoadComplete(self *RequestHandler, browser *Browser, frame *Frame, request *Request, response *Response, status UrlrequestStatus, received_content_length int64)
-	GetAuthCredentials(self *RequestHandler, browser *Browser, frame *Frame, isProxy int32, host string, port int32, realm, scheme string, callback *AuthCallback) int32
-	CanGetCookies(self *RequestHandler, browser *Browser, frame *Frame, request *Request) int32
-	CanSetCookie(self *RequestHandler, browser *Browser, frame *Frame, request *Request, cookie *Cookie) int32
-	OnQuotaRequest(self *RequestHandler, browser *Browser, origin_url string, new_size int64, callback *RequestCallback) int32
-	OnProtocolExecution(self *RequestHandler, browser *Browser, url string, allow_os_execution *int32)
-	OnCertificateError(self *RequestHandler, browser *Browser, cert_error Errorcode, request_url string, ssl_info *Sslinfo, callback *RequestCallback) int32
-	OnSelectClientCertificate(self *RequestHandler, browser *Browser, isProxy int32, host string, port int32, certificatesCount uint64, certificates **X509certificate, callback *SelectClientCertificateCallback) int32
+}
+
+// RequestHandlerGetAuthCredentialsProxy may be implemented by a RequestHandlerProxy to handle GetAuthCredentials.
+type RequestHandlerGetAuthCredentialsProxy interface {
+	GetAuthCredentials(self *RequestHandler, browser *Browser, frame *Frame, isProxy bool, host string, port int32, realm, scheme string, callback *AuthCallback) bool
+}
+
+// RequestHandlerCanGetCookiesProxy may be implemented by a RequestHandlerProxy to handle CanGetCookies.
+type RequestHandlerCanGetCookiesProxy interface {
+	CanGetCookies(self *RequestHandler, browser *Browser, frame *Frame, request *Request) bool
+}
+
+// RequestHandlerCanSetCookieProxy may be implemented by a RequestHandlerProxy to handle CanSetCookie.
+type RequestHandlerCanSetCookieProxy interface {
+	CanSetCookie(self *RequestHandler, browser *Browser, frame *Frame, request *Request, cookie *Cookie) bool
+}
+
+// RequestHandlerOnQuotaRequestProxy may be implemented by a RequestHandlerProxy to handle OnQuotaRequest.
+type RequestHandlerOnQuotaRequestProxy interface {
+	OnQuotaRequest(self *RequestHandler, browser *Browser, origin_url string, new_size int64, callback *RequestCallback) bool
+}
+
+// RequestHandlerOnProtocolExecutionProxy may be implemented by a RequestHandlerProxy to handle OnProtocolExecution.
+type RequestHandlerOnProtocolExecutionProxy interface {
+	OnProtocolExecution(self *RequestHandler, browser *Browser, url string, allow_os_execution *bool)
+}
+
+// RequestHandlerOnCertificateErrorProxy may be implemented by a RequestHandlerProxy to handle OnCertificateError.
+type RequestHandlerOnCertificateErrorProxy interface {
+	OnCertificateError(self *RequestHandler, browser *Browser, cert_error Errorcode, request_url string, ssl_info *Sslinfo, callback *RequestCallback) bool
+}
+
+// RequestHandlerOnSelectClientCertificateProxy may be implemented by a RequestHandlerProxy to handle OnSelectClientCertificate.
+type RequestHandlerOnSelectClientCertificateProxy interface {
+	OnSelectClientCertificate(self *RequestHandler, browser *Browser, isProxy bool, host string, port int32, certificatesCount uint64, certificates **X509certificate, callback *SelectClientCertificateCallback) bool
+}
+
+// RequestHandlerOnPluginCrashedProxy may be implemented by a RequestHandlerProxy to handle OnPluginCrashed.
+type RequestHandlerOnPluginCrashedProxy interface {
 	OnPluginCrashed(self *RequestHandler, browser *Browser, plugin_path string)
+}
+
+// RequestHandlerOnRenderViewReadyProxy may be implemented by a RequestHandlerProxy to handle OnRenderViewReady.
+type RequestHandlerOnRenderViewReadyProxy interface {
 	OnRenderViewReady(self *RequestHandler, browser *Browser)
+}
+
+// RequestHandlerOnRenderProcessTerminatedProxy may be implemented by a RequestHandlerProxy to handle OnRenderProcessTerminated.
+type RequestHandlerOnRenderProcessTerminatedProxy interface {
 	OnRenderProcessTerminated(self *RequestHandler, browser *Browser, status TerminationStatus)
 }
 
@@ -43,7 +121,25 @@ type RequestHandler C.cef_request_handler_t
 func NewRequestHandler(proxy RequestHandlerProxy) *RequestHandler {
 	result := (*RequestHandler)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_request_handler_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_request_handler_proxy(result.toNative())
+		_, on_before_browse := proxy.(RequestHandlerOnBeforeBrowseProxy)
+		_, on_open_urlfrom_tab := proxy.(RequestHandlerOnOpenUrlfromTabProxy)
+		_, on_before_resource_load := proxy.(RequestHandlerOnBeforeResourceLoadProxy)
+		_, get_resource_handler := proxy.(RequestHandlerGetResourceHandlerProxy)
+		_, on_resource_redirect := proxy.(RequestHandlerOnResourceRedirectProxy)
+		_, on_resource_response := proxy.(RequestHandlerOnResourceResponseProxy)
+		_, get_resource_response_filter := proxy.(RequestHandlerGetResourceResponseFilterProxy)
+		_, on_resource_load_complete := proxy.(RequestHandlerOnResourceLoadCompleteProxy)
+		_, get_auth_credentials := proxy.(RequestHandlerGetAuthCredentialsProxy)
+		_, can_get_cookies := proxy.(RequestHandlerCanGetCookiesProxy)
+		_, can_set_cookie := proxy.(RequestHandlerCanSetCookieProxy)
+		_, on_quota_request := proxy.(RequestHandlerOnQuotaRequestProxy)
+		_, on_protocol_execution := proxy.(RequestHandlerOnProtocolExecutionProxy)
+		_, on_certificate_error := proxy.(RequestHandlerOnCertificateErrorProxy)
+		_, on_select_client_certificate := proxy.(RequestHandlerOnSelectClientCertificateProxy)
+		_, on_plugin_crashed := proxy.(RequestHandlerOnPluginCrashedProxy)
+		_, on_render_view_ready := proxy.(RequestHandlerOnRenderViewReadyProxy)
+		_, on_render_process_terminated := proxy.(RequestHandlerOnRenderProcessTerminatedProxy)
+		C.gocef_set_request_handler_proxy(result.toNative(), cefBool(on_before_browse), cefBool(on_open_urlfrom_tab), cefBool(on_before_resource_load), cefBool(get_resource_handler), cefBool(on_resource_redirect), cefBool(on_resource_response), cefBool(get_resource_response_filter), cefBool(on_resource_load_complete), cefBool(get_auth_credentials), cefBool(can_get_cookies), cefBool(can_set_cookie), cefBool(on_quota_request), cefBool(on_protocol_execution), cefBool(on_certificate_error), cefBool(on_select_client_certificate), cefBool(on_plugin_crashed), cefBool(on_render_view_ready), cefBool(on_render_process_terminated))
 	}
 	return result
 }
@@ -52,14 +148,15 @@ func (d *RequestHandler) toNative() *C.cef_request_handler_t {
 	return (*C.cef_request_handler_t)(d)
 }
 
-func lookupRequestHandlerProxy(obj *BaseRefCounted) RequestHandlerProxy {
+func lookupRequestHandlerProxy(obj *BaseRefCounted, callback string) RequestHandlerProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("RequestHandler", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(RequestHandlerProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type RequestHandlerProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("RequestHandler", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -81,15 +178,22 @@ func (d *RequestHandler) Base() *BaseRefCounted {
 // ERR_ABORTED. The |user_gesture| value will be true (1) if the browser
 // navigated via explicit user gesture (e.g. clicking a link) or false (0) if
 // it navigated automatically (e.g. via the DomContentLoaded event).
-func (d *RequestHandler) OnBeforeBrowse(browser *Browser, frame *Frame, request *Request, user_gesture, is_redirect int32) int32 {
-	return lookupRequestHandlerProxy(d.Base()).OnBeforeBrowse(d, browser, frame, request, user_gesture, is_redirect)
+func (d *RequestHandler) OnBeforeBrowse(browser *Browser, frame *Frame, request *Request, user_gesture, is_redirect bool) bool {
+	if proxy__, ok__ := lookupRequestHandlerProxy(d.Base(), "OnBeforeBrowse").(RequestHandlerOnBeforeBrowseProxy); ok__ {
+		return proxy__.OnBeforeBrowse(d, browser, frame, request, user_gesture, is_redirect)
+	}
+	return false
 }
 
 //export gocef_request_handler_on_before_browse
 func gocef_request_handler_on_before_browse(self *C.cef_request_handler_t, browser *C.cef_browser_t, frame *C.cef_frame_t, request *C.cef_request_t, user_gesture C.int, is_redirect C.int) C.int {
+	defer recoverProxyPanic("RequestHandler", "OnBeforeBrowse")
 	me__ := (*RequestHandler)(self)
-	proxy__ := lookupRequestHandlerProxy(me__.Base())
-	return C.int(proxy__.OnBeforeBrowse(me__, (*Browser)(browser), (*Frame)(frame), (*Request)(request), int32(user_gesture), int32(is_redirect)))
+	proxy__, ok__ := lookupRequestHandlerProxy(me__.Base(), "OnBeforeBrowse").(RequestHandlerOnBeforeBrowseProxy)
+	if !ok__ {
+		return 0
+	}
+	return cefBool(proxy__.OnBeforeBrowse(me__, (*Browser)(browser), (*Frame)(frame), (*Request)(request), user_gesture != 0, is_redirect != 0))
 }
 
 // OnOpenUrlfromTab (on_open_urlfrom_tab)
@@ -107,16 +211,23 @@ func gocef_request_handler_on_before_browse(self *C.cef_request_handler_t, brows
 // it navigated automatically (e.g. via the DomContentLoaded event). Return
 // true (1) to cancel the navigation or false (0) to allow the navigation to
 // proceed in the source browser's top-level frame.
-func (d *RequestHandler) OnOpenUrlfromTab(browser *Browser, frame *Frame, target_url string, target_disposition WindowOpenDisposition, user_gesture int32) int32 {
-	return lookupRequestHandlerProxy(d.Base()).OnOpenUrlfromTab(d, browser, frame, target_url, target_disposition, user_gesture)
+func (d *RequestHandler) OnOpenUrlfromTab(browser *Browser, frame *Frame, target_url string, target_disposition WindowOpenDisposition, user_gesture bool) bool {
+	if proxy__, ok__ := lookupRequestHandlerProxy(d.Base(), "OnOpenUrlfromTab").(RequestHandlerOnOpenUrlfromTabProxy); ok__ {
+		return proxy__.OnOpenUrlfromTab(d, browser, frame, target_url, target_disposition, user_gesture)
+	}
+	return false
 }
 
 //export gocef_request_handler_on_open_urlfrom_tab
 func gocef_request_handler_on_open_urlfrom_tab(self *C.cef_request_handler_t, browser *C.cef_browser_t, frame *C.cef_frame_t, target_url *C.cef_string_t, target_disposition C.cef_window_open_disposition_t, user_gesture C.int) C.int {
+	defer recoverProxyPanic("RequestHandler", "OnOpenUrlfromTab")
 	me__ := (*RequestHandler)(self)
-	proxy__ := lookupRequestHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupRequestHandlerProxy(me__.Base(), "OnOpenUrlfromTab").(RequestHandlerOnOpenUrlfromTabProxy)
+	if !ok__ {
+		return 0
+	}
 	target_url_ := cefstrToString(target_url)
-	return C.int(proxy__.OnOpenUrlfromTab(me__, (*Browser)(browser), (*Frame)(frame), target_url_, WindowOpenDisposition(target_disposition), int32(user_gesture)))
+	return cefBool(proxy__.OnOpenUrlfromTab(me__, (*Browser)(browser), (*Frame)(frame), target_url_, WindowOpenDisposition(target_disposition), user_gesture != 0))
 }
 
 // OnBeforeResourceLoad (on_before_resource_load)
@@ -127,13 +238,20 @@ func gocef_request_handler_on_open_urlfrom_tab(self *C.cef_request_handler_t, br
 // Return RV_CANCEL to cancel the request immediately.
 //
 func (d *RequestHandler) OnBeforeResourceLoad(browser *Browser, frame *Frame, request *Request, callback *RequestCallback) ReturnValue {
-	return lookupRequestHandlerProxy(d.Base()).OnBeforeResourceLoad(d, browser, frame, request, callback)
+	if proxy__, ok__ := lookupRequestHandlerProxy(d.Base(), "OnBeforeResourceLoad").(RequestHandlerOnBeforeResourceLoadProxy); ok__ {
+		return proxy__.OnBeforeResourceLoad(d, browser, frame, request, callback)
+	}
+	return 0
 }
 
 //export gocef_request_handler_on_before_resource_load
 func gocef_request_handler_on_before_resource_load(self *C.cef_request_handler_t, browser *C.cef_browser_t, frame *C.cef_frame_t, request *C.cef_request_t, callback *C.cef_request_callback_t) C.cef_return_value_t {
+	defer recoverProxyPanic("RequestHandler", "OnBeforeResourceLoad")
 	me__ := (*RequestHandler)(self)
-	proxy__ := lookupRequestHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupRequestHandlerProxy(me__.Base(), "OnBeforeResourceLoad").(RequestHandlerOnBeforeResourceLoadProxy)
+	if !ok__ {
+		return 0
+	}
 	return C.cef_return_value_t(proxy__.OnBeforeResourceLoad(me__, (*Browser)(browser), (*Frame)(frame), (*Request)(request), (*RequestCallback)(callback)))
 }
 
@@ -143,13 +261,20 @@ func gocef_request_handler_on_before_resource_load(self *C.cef_request_handler_t
 // a cef_resource_handler_t object. The |request| object should not be
 // modified in this callback.
 func (d *RequestHandler) GetResourceHandler(browser *Browser, frame *Frame, request *Request) *ResourceHandler {
-	return lookupRequestHandlerProxy(d.Base()).GetResourceHandler(d, browser, frame, request)
+	if proxy__, ok__ := lookupRequestHandlerProxy(d.Base(), "GetResourceHandler").(RequestHandlerGetResourceHandlerProxy); ok__ {
+		return proxy__.GetResourceHandler(d, browser, frame, request)
+	}
+	return nil
 }
 
 //export gocef_request_handler_get_resource_handler
 func gocef_request_handler_get_resource_handler(self *C.cef_request_handler_t, browser *C.cef_browser_t, frame *C.cef_frame_t, request *C.cef_request_t) *C.cef_resource_handler_t {
+	defer recoverProxyPanic("RequestHandler", "GetResourceHandler")
 	me__ := (*RequestHandler)(self)
-	proxy__ := lookupRequestHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupRequestHandlerProxy(me__.Base(), "GetResourceHandler").(RequestHandlerGetResourceHandlerProxy)
+	if !ok__ {
+		return nil
+	}
 	return (proxy__.GetResourceHandler(me__, (*Browser)(browser), (*Frame)(frame), (*Request)(request))).toNative()
 }
 
@@ -161,14 +286,23 @@ func gocef_request_handler_get_resource_handler(self *C.cef_request_handler_t, b
 // changed if desired. The |request| object cannot be modified in this
 // callback.
 func (d *RequestHandler) OnResourceRedirect(browser *Browser, frame *Frame, request *Request, response *Response, new_url *string) {
-	lookupRequestHandlerProxy(d.Base()).OnResourceRedirect(d, browser, frame, request, response, new_url)
+	if proxy__, ok__ := lookupRequestHandlerProxy(d.Base(), "OnResourceRedirect").(RequestHandlerOnResourceRedirectProxy); ok__ {
+		proxy__.OnResourceRedirect(d, browser, frame, request, response, new_url)
+	}
 }
 
 //export gocef_request_handler_on_resource_redirect
 func gocef_request_handler_on_resource_redirect(self *C.cef_request_handler_t, browser *C.cef_browser_t, frame *C.cef_frame_t, request *C.cef_request_t, response *C.cef_response_t, new_url *C.cef_string_t) {
+	defer recoverProxyPanic("RequestHandler", "OnResourceRedirect")
 	me__ := (*RequestHandler)(self)
-	proxy__ := lookupRequestHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupRequestHandlerProxy(me__.Base(), "OnResourceRedirect").(RequestHandlerOnResourceRedirectProxy)
+	if !ok__ {
+		return
+	}
 	new_url_ := cefstrToString(new_url)
+	defer func() {
+		setCEFStr(new_url_, new_url)
+	}()
 	proxy__.OnResourceRedirect(me__, (*Browser)(browser), (*Frame)(frame), (*Request)(request), (*Response)(response), &new_url_)
 }
 
@@ -177,15 +311,22 @@ func gocef_request_handler_on_resource_redirect(self *C.cef_request_handler_t, b
 // resource to load normally return false (0). To redirect or retry the
 // resource modify |request| (url, headers or post body) and return true (1).
 // The |response| object cannot be modified in this callback.
-func (d *RequestHandler) OnResourceResponse(browser *Browser, frame *Frame, request *Request, response *Response) int32 {
-	return lookupRequestHandlerProxy(d.Base()).OnResourceResponse(d, browser, frame, request, response)
+func (d *RequestHandler) OnResourceResponse(browser *Browser, frame *Frame, request *Request, response *Response) bool {
+	if proxy__, ok__ := lookupRequestHandlerProxy(d.Base(), "OnResourceResponse").(RequestHandlerOnResourceResponseProxy); ok__ {
+		return proxy__.OnResourceResponse(d, browser, frame, request, response)
+	}
+	return false
 }
 
 //export gocef_request_handler_on_resource_response
 func gocef_request_handler_on_resource_response(self *C.cef_request_handler_t, browser *C.cef_browser_t, frame *C.cef_frame_t, request *C.cef_request_t, response *C.cef_response_t) C.int {
+	defer recoverProxyPanic("RequestHandler", "OnResourceResponse")
 	me__ := (*RequestHandler)(self)
-	proxy__ := lookupRequestHandlerProxy(me__.Base())
-	return C.int(proxy__.OnResourceResponse(me__, (*Browser)(browser), (*Frame)(frame), (*Request)(request), (*Response)(response)))
+	proxy__, ok__ := lookupRequestHandlerProxy(me__.Base(), "OnResourceResponse").(RequestHandlerOnResourceResponseProxy)
+	if !ok__ {
+		return 0
+	}
+	return cefBool(proxy__.OnResourceResponse(me__, (*Browser)(browser), (*Frame)(frame), (*Request)(request), (*Response)(response)))
 }
 
 // GetResourceResponseFilter (get_resource_response_filter)
@@ -193,13 +334,20 @@ func gocef_request_handler_on_resource_response(self *C.cef_request_handler_t, b
 // |request| and |response| represent the request and response respectively
 // and cannot be modified in this callback.
 func (d *RequestHandler) GetResourceResponseFilter(browser *Browser, frame *Frame, request *Request, response *Response) *ResponseFilter {
-	return lookupRequestHandlerProxy(d.Base()).GetResourceResponseFilter(d, browser, frame, request, response)
+	if proxy__, ok__ := lookupRequestHandlerProxy(d.Base(), "GetResourceResponseFilter").(RequestHandlerGetResourceResponseFilterProxy); ok__ {
+		return proxy__.GetResourceResponseFilter(d, browser, frame, request, response)
+	}
+	return nil
 }
 
 //export gocef_request_handler_get_resource_response_filter
 func gocef_request_handler_get_resource_response_filter(self *C.cef_request_handler_t, browser *C.cef_browser_t, frame *C.cef_frame_t, request *C.cef_request_t, response *C.cef_response_t) *C.cef_response_filter_t {
+	defer recoverProxyPanic("RequestHandler", "GetResourceResponseFilter")
 	me__ := (*RequestHandler)(self)
-	proxy__ := lookupRequestHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupRequestHandlerProxy(me__.Base(), "GetResourceResponseFilter").(RequestHandlerGetResourceResponseFilterProxy)
+	if !ok__ {
+		return nil
+	}
 	return (proxy__.GetResourceResponseFilter(me__, (*Browser)(browser), (*Frame)(frame), (*Request)(request), (*Response)(response))).toNative()
 }
 
@@ -209,13 +357,19 @@ func gocef_request_handler_get_resource_response_filter(self *C.cef_request_hand
 // modified in this callback. |status| indicates the load completion status.
 // |received_content_length| is the number of response bytes actually read.
 func (d *RequestHandler) OnResourceLoadComplete(browser *Browser, frame *Frame, request *Request, response *Response, status UrlrequestStatus, received_content_length int64) {
-	lookupRequestHandlerProxy(d.Base()).OnResourceLoadComplete(d, browser, frame, request, response, status, received_content_length)
+	if proxy__, ok__ := lookupRequestHandlerProxy(d.Base(), "OnResourceLoadComplete").(RequestHandlerOnResourceLoadCompleteProxy); ok__ {
+		proxy__.OnResourceLoadComplete(d, browser, frame, request, response, status, received_content_length)
+	}
 }
 
 //export gocef_request_handler_on_resource_load_complete
 func gocef_request_handler_on_resource_load_complete(self *C.cef_request_handler_t, browser *C.cef_browser_t, frame *C.cef_frame_t, request *C.cef_request_t, response *C.cef_response_t, status C.cef_urlrequest_status_t, received_content_length C.int64) {
+	defer recoverProxyPanic("RequestHandler", "OnResourceLoadComplete")
 	me__ := (*RequestHandler)(self)
-	proxy__ := lookupRequestHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupRequestHandlerProxy(me__.Base(), "OnResourceLoadComplete").(RequestHandlerOnResourceLoadCompleteProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnResourceLoadComplete(me__, (*Browser)(browser), (*Frame)(frame), (*Request)(request), (*Response)(response), UrlrequestStatus(status), int64(received_content_length))
 }
 
@@ -229,18 +383,25 @@ func gocef_request_handler_on_resource_load_complete(self *C.cef_request_handler
 // cef_auth_callback_t::cont() either in this function or at a later time when
 // the authentication information is available. Return false (0) to cancel the
 // request immediately.
-func (d *RequestHandler) GetAuthCredentials(browser *Browser, frame *Frame, isProxy int32, host string, port int32, realm, scheme string, callback *AuthCallback) int32 {
-	return lookupRequestHandlerProxy(d.Base()).GetAuthCredentials(d, browser, frame, isProxy, host, port, realm, scheme, callback)
+func (d *RequestHandler) GetAuthCredentials(browser *Browser, frame *Frame, isProxy bool, host string, port int32, realm, scheme string, callback *AuthCallback) bool {
+	if proxy__, ok__ := lookupRequestHandlerProxy(d.Base(), "GetAuthCredentials").(RequestHandlerGetAuthCredentialsProxy); ok__ {
+		return proxy__.GetAuthCredentials(d, browser, frame, isProxy, host, port, realm, scheme, callback)
+	}
+	return false
 }
 
 //export gocef_request_handler_get_auth_credentials
 func gocef_request_handler_get_auth_credentials(self *C.cef_request_handler_t, browser *C.cef_browser_t, frame *C.cef_frame_t, isProxy C.int, host *C.cef_string_t, port C.int, realm *C.cef_string_t, scheme *C.cef_string_t, callback *C.cef_auth_callback_t) C.int {
+	defer recoverProxyPanic("RequestHandler", "GetAuthCredentials")
 	me__ := (*RequestHandler)(self)
-	proxy__ := lookupRequestHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupRequestHandlerProxy(me__.Base(), "GetAuthCredentials").(RequestHandlerGetAuthCredentialsProxy)
+	if !ok__ {
+		return 0
+	}
 	host_ := cefstrToString(host)
 	realm_ := cefstrToString(realm)
 	scheme_ := cefstrToString(scheme)
-	return C.int(proxy__.GetAuthCredentials(me__, (*Browser)(browser), (*Frame)(frame), int32(isProxy), host_, int32(port), realm_, scheme_, (*AuthCallback)(callback)))
+	return cefBool(proxy__.GetAuthCredentials(me__, (*Browser)(browser), (*Frame)(frame), isProxy != 0, host_, int32(port), realm_, scheme_, (*AuthCallback)(callback)))
 }
 
 // CanGetCookies (can_get_cookies)
@@ -248,15 +409,22 @@ func gocef_request_handler_get_auth_credentials(self *C.cef_request_handler_t, b
 // request header. Return true (1) to allow cookies to be included in the
 // network request or false (0) to block cookies. The |request| object should
 // not be modified in this callback.
-func (d *RequestHandler) CanGetCookies(browser *Browser, frame *Frame, request *Request) int32 {
-	return lookupRequestHandlerProxy(d.Base()).CanGetCookies(d, browser, frame, request)
+func (d *RequestHandler) CanGetCookies(browser *Browser, frame *Frame, request *Request) bool {
+	if proxy__, ok__ := lookupRequestHandlerProxy(d.Base(), "CanGetCookies").(RequestHandlerCanGetCookiesProxy); ok__ {
+		return proxy__.CanGetCookies(d, browser, frame, request)
+	}
+	return false
 }
 
 //export gocef_request_handler_can_get_cookies
 func gocef_request_handler_can_get_cookies(self *C.cef_request_handler_t, browser *C.cef_browser_t, frame *C.cef_frame_t, request *C.cef_request_t) C.int {
+	defer recoverProxyPanic("RequestHandler", "CanGetCookies")
 	me__ := (*RequestHandler)(self)
-	proxy__ := lookupRequestHandlerProxy(me__.Base())
-	return C.int(proxy__.CanGetCookies(me__, (*Browser)(browser), (*Frame)(frame), (*Request)(request)))
+	proxy__, ok__ := lookupRequestHandlerProxy(me__.Base(), "CanGetCookies").(RequestHandlerCanGetCookiesProxy)
+	if !ok__ {
+		return 0
+	}
+	return cefBool(proxy__.CanGetCookies(me__, (*Browser)(browser), (*Frame)(frame), (*Request)(request)))
 }
 
 // CanSetCookie (can_set_cookie)
@@ -264,16 +432,23 @@ func gocef_request_handler_can_get_cookies(self *C.cef_request_handler_t, browse
 // Cookie" response header value represented by |cookie|. Return true (1) to
 // allow the cookie to be stored or false (0) to block the cookie. The
 // |request| object should not be modified in this callback.
-func (d *RequestHandler) CanSetCookie(browser *Browser, frame *Frame, request *Request, cookie *Cookie) int32 {
-	return lookupRequestHandlerProxy(d.Base()).CanSetCookie(d, browser, frame, request, cookie)
+func (d *RequestHandler) CanSetCookie(browser *Browser, frame *Frame, request *Request, cookie *Cookie) bool {
+	if proxy__, ok__ := lookupRequestHandlerProxy(d.Base(), "CanSetCookie").(RequestHandlerCanSetCookieProxy); ok__ {
+		return proxy__.CanSetCookie(d, browser, frame, request, cookie)
+	}
+	return false
 }
 
 //export gocef_request_handler_can_set_cookie
 func gocef_request_handler_can_set_cookie(self *C.cef_request_handler_t, browser *C.cef_browser_t, frame *C.cef_frame_t, request *C.cef_request_t, cookie *C.cef_cookie_t) C.int {
+	defer recoverProxyPanic("RequestHandler", "CanSetCookie")
 	me__ := (*RequestHandler)(self)
-	proxy__ := lookupRequestHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupRequestHandlerProxy(me__.Base(), "CanSetCookie").(RequestHandlerCanSetCookieProxy)
+	if !ok__ {
+		return 0
+	}
 	cookie_ := cookie.toGo()
-	return C.int(proxy__.CanSetCookie(me__, (*Browser)(browser), (*Frame)(frame), (*Request)(request), cookie_))
+	return cefBool(proxy__.CanSetCookie(me__, (*Browser)(browser), (*Frame)(frame), (*Request)(request), cookie_))
 }
 
 // OnQuotaRequest (on_quota_request)
@@ -284,16 +459,23 @@ func gocef_request_handler_can_set_cookie(self *C.cef_request_handler_t, browser
 // cef_request_tCallback::cont() either in this function or at a later time to
 // grant or deny the request. Return false (0) to cancel the request
 // immediately.
-func (d *RequestHandler) OnQuotaRequest(browser *Browser, origin_url string, new_size int64, callback *RequestCallback) int32 {
-	return lookupRequestHandlerProxy(d.Base()).OnQuotaRequest(d, browser, origin_url, new_size, callback)
+func (d *RequestHandler) OnQuotaRequest(browser *Browser, origin_url string, new_size int64, callback *RequestCallback) bool {
+	if proxy__, ok__ := lookupRequestHandlerProxy(d.Base(), "OnQuotaRequest").(RequestHandlerOnQuotaRequestProxy); ok__ {
+		return proxy__.OnQuotaRequest(d, browser, origin_url, new_size, callback)
+	}
+	return false
 }
 
 //export gocef_request_handler_on_quota_request
 func gocef_request_handler_on_quota_request(self *C.cef_request_handler_t, browser *C.cef_browser_t, origin_url *C.cef_string_t, new_size C.int64, callback *C.cef_request_callback_t) C.int {
+	defer recoverProxyPanic("RequestHandler", "OnQuotaRequest")
 	me__ := (*RequestHandler)(self)
-	proxy__ := lookupRequestHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupRequestHandlerProxy(me__.Base(), "OnQuotaRequest").(RequestHandlerOnQuotaRequestProxy)
+	if !ok__ {
+		return 0
+	}
 	origin_url_ := cefstrToString(origin_url)
-	return C.int(proxy__.OnQuotaRequest(me__, (*Browser)(browser), origin_url_, int64(new_size), (*RequestCallback)(callback)))
+	return cefBool(proxy__.OnQuotaRequest(me__, (*Browser)(browser), origin_url_, int64(new_size), (*RequestCallback)(callback)))
 }
 
 // OnProtocolExecution (on_protocol_execution)
@@ -302,16 +484,26 @@ func gocef_request_handler_on_quota_request(self *C.cef_request_handler_t, brows
 // execution via the registered OS protocol handler, if any. SECURITY WARNING:
 // YOU SHOULD USE THIS METHOD TO ENFORCE RESTRICTIONS BASED ON SCHEME, HOST OR
 // OTHER URL ANALYSIS BEFORE ALLOWING OS EXECUTION.
-func (d *RequestHandler) OnProtocolExecution(browser *Browser, url string, allow_os_execution *int32) {
-	lookupRequestHandlerProxy(d.Base()).OnProtocolExecution(d, browser, url, allow_os_execution)
+func (d *RequestHandler) OnProtocolExecution(browser *Browser, url string, allow_os_execution *bool) {
+	if proxy__, ok__ := lookupRequestHandlerProxy(d.Base(), "OnProtocolExecution").(RequestHandlerOnProtocolExecutionProxy); ok__ {
+		proxy__.OnProtocolExecution(d, browser, url, allow_os_execution)
+	}
 }
 
 //export gocef_request_handler_on_protocol_execution
 func gocef_request_handler_on_protocol_execution(self *C.cef_request_handler_t, browser *C.cef_browser_t, url *C.cef_string_t, allow_os_execution *C.int) {
+	defer recoverProxyPanic("RequestHandler", "OnProtocolExecution")
 	me__ := (*RequestHandler)(self)
-	proxy__ := lookupRequestHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupRequestHandlerProxy(me__.Base(), "OnProtocolExecution").(RequestHandlerOnProtocolExecutionProxy)
+	if !ok__ {
+		return
+	}
 	url_ := cefstrToString(url)
-	proxy__.OnProtocolExecution(me__, (*Browser)(browser), url_, (*int32)(allow_os_execution))
+	allow_os_execution_ := *allow_os_execution != 0
+	defer func() {
+		*allow_os_execution = cefBool(allow_os_execution_)
+	}()
+	proxy__.OnProtocolExecution(me__, (*Browser)(browser), url_, &allow_os_execution_)
 }
 
 // OnCertificateError (on_certificate_error)
@@ -321,16 +513,23 @@ func gocef_request_handler_on_protocol_execution(self *C.cef_request_handler_t,
 // Return false (0) to cancel the request immediately. If
 // CefSettings.ignore_certificate_errors is set all invalid certificates will
 // be accepted without calling this function.
-func (d *RequestHandler) OnCertificateError(browser *Browser, cert_error Errorcode, request_url string, ssl_info *Sslinfo, callback *RequestCallback) int32 {
-	return lookupRequestHandlerProxy(d.Base()).OnCertificateError(d, browser, cert_error, request_url, ssl_info, callback)
+func (d *RequestHandler) OnCertificateError(browser *Browser, cert_error Errorcode, request_url string, ssl_info *Sslinfo, callback *RequestCallback) bool {
+	if proxy__, ok__ := lookupRequestHandlerProxy(d.Base(), "OnCertificateError").(RequestHandlerOnCertificateErrorProxy); ok__ {
+		return proxy__.OnCertificateError(d, browser, cert_error, request_url, ssl_info, callback)
+	}
+	return false
 }
 
 //export gocef_request_handler_on_certificate_error
 func gocef_request_handler_on_certificate_error(self *C.cef_request_handler_t, browser *C.cef_browser_t, cert_error C.cef_errorcode_t, request_url *C.cef_string_t, ssl_info *C.cef_sslinfo_t, callback *C.cef_request_callback_t) C.int {
+	defer recoverProxyPanic("RequestHandler", "OnCertificateError")
 	me__ := (*RequestHandler)(self)
-	proxy__ := lookupRequestHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupRequestHandlerProxy(me__.Base(), "OnCertificateError").(RequestHandlerOnCertificateErrorProxy)
+	if !ok__ {
+		return 0
+	}
 	request_url_ := cefstrToString(request_url)
-	return C.int(proxy__.OnCertificateError(me__, (*Browser)(browser), Errorcode(cert_error), request_url_, (*Sslinfo)(ssl_info), (*RequestCallback)(callback)))
+	return cefBool(proxy__.OnCertificateError(me__, (*Browser)(browser), Errorcode(cert_error), request_url_, (*Sslinfo)(ssl_info), (*RequestCallback)(callback)))
 }
 
 // OnSelectClientCertificate (on_select_client_certificate)
@@ -345,31 +544,44 @@ func gocef_request_handler_on_certificate_error(self *C.cef_request_handler_t, b
 // is the list of certificates to choose from; this list has already been
 // pruned by Chromium so that it only contains certificates from issuers that
 // the server trusts.
-func (d *RequestHandler) OnSelectClientCertificate(browser *Browser, isProxy int32, host string, port int32, certificatesCount uint64, certificates **X509certificate, callback *SelectClientCertificateCallback) int32 {
-	return lookupRequestHandlerProxy(d.Base()).OnSelectClientCertificate(d, browser, isProxy, host, port, certificatesCount, certificates, callback)
+func (d *RequestHandler) OnSelectClientCertificate(browser *Browser, isProxy bool, host string, port int32, certificatesCount uint64, certificates **X509certificate, callback *SelectClientCertificateCallback) bool {
+	if proxy__, ok__ := lookupRequestHandlerProxy(d.Base(), "OnSelectClientCertificate").(RequestHandlerOnSelectClientCertificateProxy); ok__ {
+		return proxy__.OnSelectClientCertificate(d, browser, isProxy, host, port, certificatesCount, certificates, callback)
+	}
+	return false
 }
 
 //export gocef_request_handler_on_select_client_certificate
 func gocef_request_handler_on_select_client_certificate(self *C.cef_request_handler_t, browser *C.cef_browser_t, isProxy C.int, host *C.cef_string_t, port C.int, certificatesCount C.size_t, certificates **C.cef_x509certificate_t, callback *C.cef_select_client_certificate_callback_t) C.int {
+	defer recoverProxyPanic("RequestHandler", "OnSelectClientCertificate")
 	me__ := (*RequestHandler)(self)
-	proxy__ := lookupRequestHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupRequestHandlerProxy(me__.Base(), "OnSelectClientCertificate").(RequestHandlerOnSelectClientCertificateProxy)
+	if !ok__ {
+		return 0
+	}
 	host_ := cefstrToString(host)
 	certificates_ := (*X509certificate)(*certificates)
 	certificates__p := &certificates_
-	return C.int(proxy__.OnSelectClientCertificate(me__, (*Browser)(browser), int32(isProxy), host_, int32(port), uint64(certificatesCount), certificates__p, (*SelectClientCertificateCallback)(callback)))
+	return cefBool(proxy__.OnSelectClientCertificate(me__, (*Browser)(browser), isProxy != 0, host_, int32(port), uint64(certificatesCount), certificates__p, (*SelectClientCertificateCallback)(callback)))
 }
 
 // OnPluginCrashed (on_plugin_crashed)
 // Called on the browser process UI thread when a plugin has crashed.
 // |plugin_path| is the path of the plugin that crashed.
 func (d *RequestHandler) OnPluginCrashed(browser *Browser, plugin_path string) {
-	lookupRequestHandlerProxy(d.Base()).OnPluginCrashed(d, browser, plugin_path)
+	if proxy__, ok__ := lookupRequestHandlerProxy(d.Base(), "OnPluginCrashed").(RequestHandlerOnPluginCrashedProxy); ok__ {
+		proxy__.OnPluginCrashed(d, browser, plugin_path)
+	}
 }
 
 //export gocef_request_handler_on_plugin_crashed
 func gocef_request_handler_on_plugin_crashed(self *C.cef_request_handler_t, browser *C.cef_browser_t, plugin_path *C.cef_string_t) {
+	defer recoverProxyPanic("RequestHandler", "OnPluginCrashed")
 	me__ := (*RequestHandler)(self)
-	proxy__ := lookupRequestHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupRequestHandlerProxy(me__.Base(), "OnPluginCrashed").(RequestHandlerOnPluginCrashedProxy)
+	if !ok__ {
+		return
+	}
 	plugin_path_ := cefstrToString(plugin_path)
 	proxy__.OnPluginCrashed(me__, (*Browser)(browser), plugin_path_)
 }
@@ -379,13 +591,19 @@ func gocef_request_handler_on_plugin_crashed(self *C.cef_request_handler_t, brow
 // with |browser| is ready to receive/handle IPC messages in the render
 // process.
 func (d *RequestHandler) OnRenderViewReady(browser *Browser) {
-	lookupRequestHandlerProxy(d.Base()).OnRenderViewReady(d, browser)
+	if proxy__, ok__ := lookupRequestHandlerProxy(d.Base(), "OnRenderViewReady").(RequestHandlerOnRenderViewReadyProxy); ok__ {
+		proxy__.OnRenderViewReady(d, browser)
+	}
 }
 
 //export gocef_request_handler_on_render_view_ready
 func gocef_request_handler_on_render_view_ready(self *C.cef_request_handler_t, browser *C.cef_browser_t) {
+	defer recoverProxyPanic("RequestHandler", "OnRenderViewReady")
 	me__ := (*RequestHandler)(self)
-	proxy__ := lookupRequestHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupRequestHandlerProxy(me__.Base(), "OnRenderViewReady").(RequestHandlerOnRenderViewReadyProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnRenderViewReady(me__, (*Browser)(browser))
 }
 
@@ -393,12 +611,18 @@ func gocef_request_handler_on_render_view_ready(self *C.cef_request_handler_t, b
 // Called on the browser process UI thread when the render process terminates
 // unexpectedly. |status| indicates how the process terminated.
 func (d *RequestHandler) OnRenderProcessTerminated(browser *Browser, status TerminationStatus) {
-	lookupRequestHandlerProxy(d.Base()).OnRenderProcessTerminated(d, browser, status)
+	if proxy__, ok__ := lookupRequestHandlerProxy(d.Base(), "OnRenderProcessTerminated").(RequestHandlerOnRenderProcessTerminatedProxy); ok__ {
+		proxy__.OnRenderProcessTerminated(d, browser, status)
+	}
 }
 
 //export gocef_request_handler_on_render_process_terminated
 func gocef_request_handler_on_render_process_terminated(self *C.cef_request_handler_t, browser *C.cef_browser_t, status C.cef_termination_status_t) {
+	defer recoverProxyPanic("RequestHandler", "OnRenderProcessTerminated")
 	me__ := (*RequestHandler)(self)
-	proxy__ := lookupRequestHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupRequestHandlerProxy(me__.Base(), "OnRenderProcessTerminated").(RequestHandlerOnRenderProcessTerminatedProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnRenderProcessTerminated(me__, (*Browser)(browser), TerminationStatus(status))
 }
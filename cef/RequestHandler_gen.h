@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_request_handler_proxy(cef_request_handler_t *self);
+void gocef_set_request_handler_proxy(cef_request_handler_t *self, int on_before_browse, int on_open_urlfrom_tab, int on_before_resource_load, int get_resource_handler, int on_resource_redirect, int on_resource_response, int get_resource_response_filter, int on_resource_load_complete, int get_auth_credentials, int can_get_cookies, int can_set_cookie, int on_quota_request, int on_protocol_execution, int on_certificate_error, int on_select_client_certificate, int on_plugin_crashed, int on_render_view_ready, int on_render_process_terminated);
 
 #endif // GOCEF_RequestHandler_H_
@@ -44,8 +44,8 @@ func (d *Request) Base() *BaseRefCounted {
 
 // IsReadOnly (is_read_only)
 // Returns true (1) if this object is read-only.
-func (d *Request) IsReadOnly() int32 {
-	return int32(C.gocef_request_is_read_only(d.toNative(), d.is_read_only))
+func (d *Request) IsReadOnly() bool {
+	return C.gocef_request_is_read_only(d.toNative(), d.is_read_only) != 0
 }
 
 // GetUrl (get_url)
@@ -113,6 +113,7 @@ func (d *Request) GetReferrerPolicy() ReferrerPolicy {
 
 // GetPostData (get_post_data)
 // Get the post data.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *Request) GetPostData() *PostData {
 	return (*PostData)(C.gocef_request_get_post_data(d.toNative(), d.get_post_data))
 }
@@ -125,20 +126,25 @@ func (d *Request) SetPostData(postData *PostData) {
 
 // GetHeaderMap (get_header_map)
 // Get the header values. Will not include the Referer value if any.
-func (d *Request) GetHeaderMap(headerMap StringMultimap) {
-	C.gocef_request_get_header_map(d.toNative(), C.cef_string_multimap_t(headerMap), d.get_header_map)
+func (d *Request) GetHeaderMap() map[string][]string {
+	headerMap_ := C.cef_string_multimap_alloc()
+	defer C.cef_string_multimap_free(headerMap_)
+	C.gocef_request_get_header_map(d.toNative(), headerMap_, d.get_header_map)
+	return cefStringMultimapToGo(headerMap_)
 }
 
 // SetHeaderMap (set_header_map)
 // Set the header values. If a Referer value exists in the header map it will
 // be removed and ignored.
-func (d *Request) SetHeaderMap(headerMap StringMultimap) {
-	C.gocef_request_set_header_map(d.toNative(), C.cef_string_multimap_t(headerMap), d.set_header_map)
+func (d *Request) SetHeaderMap(headerMap map[string][]string) {
+	headerMap_ := newCEFStringMultimap(headerMap)
+	defer C.cef_string_multimap_free(headerMap_)
+	C.gocef_request_set_header_map(d.toNative(), headerMap_, d.set_header_map)
 }
 
 // Set (set)
 // Set all values at one time.
-func (d *Request) Set(url, method string, postData *PostData, headerMap StringMultimap) {
+func (d *Request) Set(url, method string, postData *PostData, headerMap map[string][]string) {
 	url_ := C.cef_string_userfree_alloc()
 	setCEFStr(url, url_)
 	defer func() {
@@ -149,7 +155,9 @@ func (d *Request) Set(url, method string, postData *PostData, headerMap StringMu
 	defer func() {
 		C.cef_string_userfree_free(method_)
 	}()
-	C.gocef_request_set(d.toNative(), (*C.cef_string_t)(url_), (*C.cef_string_t)(method_), postData.toNative(), C.cef_string_multimap_t(headerMap), d.set)
+	headerMap_ := newCEFStringMultimap(headerMap)
+	defer C.cef_string_multimap_free(headerMap_)
+	C.gocef_request_set(d.toNative(), (*C.cef_string_t)(url_), (*C.cef_string_t)(method_), postData.toNative(), headerMap_, d.set)
 }
 
 // GetFlags (get_flags)
@@ -3,8 +3,10 @@
 #include "ResolveCallback_gen.h"
 #include "_cgo_export.h"
 
-void gocef_set_resolve_callback_proxy(cef_resolve_callback_t *self) {
+void gocef_set_resolve_callback_proxy(cef_resolve_callback_t *self, int on_resolve_completed) {
 	// Casts to (void *) added to avoid warnings since Go callbacks can't have
 	// some modifiers, such as 'const' applied to their parameter signatures.
-	self->on_resolve_completed = (void *)&gocef_resolve_callback_on_resolve_completed;
+	if (on_resolve_completed) {
+		self->on_resolve_completed = (void *)&gocef_resolve_callback_on_resolve_completed;
+	}
 }
@@ -6,14 +6,24 @@ import (
 	// #include "ResolveCallback_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// ResolveCallbackProxy defines methods required for using ResolveCallback.
+// ResolveCallbackProxy is implemented by the proxies used with ResolveCallback. Embed
+// DefaultResolveCallback to satisfy it, then implement any of the optional
+// ResolveCallback*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type ResolveCallbackProxy interface {
-	OnResolveCompleted(self *ResolveCallback, result Errorcode, resolved_ips StringList)
+	isResolveCallbackProxy()
+}
+
+// DefaultResolveCallback may be embedded to satisfy ResolveCallbackProxy.
+type DefaultResolveCallback struct{}
+
+func (DefaultResolveCallback) isResolveCallbackProxy() {}
+
+// ResolveCallbackOnResolveCompletedProxy may be implemented by a ResolveCallbackProxy to handle OnResolveCompleted.
+type ResolveCallbackOnResolveCompletedProxy interface {
+	OnResolveCompleted(self *ResolveCallback, result Errorcode, resolved_ips []string)
 }
 
 // ResolveCallback (cef_resolve_callback_t from include/capi/cef_request_context_capi.h)
@@ -25,7 +35,8 @@ type ResolveCallback C.cef_resolve_callback_t
 func NewResolveCallback(proxy ResolveCallbackProxy) *ResolveCallback {
 	result := (*ResolveCallback)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_resolve_callback_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_resolve_callback_proxy(result.toNative())
+		_, on_resolve_completed := proxy.(ResolveCallbackOnResolveCompletedProxy)
+		C.gocef_set_resolve_callback_proxy(result.toNative(), cefBool(on_resolve_completed))
 	}
 	return result
 }
@@ -34,14 +45,15 @@ func (d *ResolveCallback) toNative() *C.cef_resolve_callback_t {
 	return (*C.cef_resolve_callback_t)(d)
 }
 
-func lookupResolveCallbackProxy(obj *BaseRefCounted) ResolveCallbackProxy {
+func lookupResolveCallbackProxy(obj *BaseRefCounted, callback string) ResolveCallbackProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("ResolveCallback", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(ResolveCallbackProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type ResolveCallbackProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("ResolveCallback", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -56,13 +68,20 @@ func (d *ResolveCallback) Base() *BaseRefCounted {
 // Called on the UI thread after the ResolveHost request has completed.
 // |result| will be the result code. |resolved_ips| will be the list of
 // resolved IP addresses or NULL if the resolution failed.
-func (d *ResolveCallback) OnResolveCompleted(result Errorcode, resolved_ips StringList) {
-	lookupResolveCallbackProxy(d.Base()).OnResolveCompleted(d, result, resolved_ips)
+func (d *ResolveCallback) OnResolveCompleted(result Errorcode, resolved_ips []string) {
+	if proxy__, ok__ := lookupResolveCallbackProxy(d.Base(), "OnResolveCompleted").(ResolveCallbackOnResolveCompletedProxy); ok__ {
+		proxy__.OnResolveCompleted(d, result, resolved_ips)
+	}
 }
 
 //export gocef_resolve_callback_on_resolve_completed
 func gocef_resolve_callback_on_resolve_completed(self *C.cef_resolve_callback_t, result C.cef_errorcode_t, resolved_ips C.cef_string_list_t) {
+	defer recoverProxyPanic("ResolveCallback", "OnResolveCompleted")
 	me__ := (*ResolveCallback)(self)
-	proxy__ := lookupResolveCallbackProxy(me__.Base())
-	proxy__.OnResolveCompleted(me__, Errorcode(result), StringList(resolved_ips))
+	proxy__, ok__ := lookupResolveCallbackProxy(me__.Base(), "OnResolveCompleted").(ResolveCallbackOnResolveCompletedProxy)
+	if !ok__ {
+		return
+	}
+	resolved_ips_ := cefStringListToGo(resolved_ips)
+	proxy__.OnResolveCompleted(me__, Errorcode(result), resolved_ips_)
 }
@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_resolve_callback_proxy(cef_resolve_callback_t *self);
+void gocef_set_resolve_callback_proxy(cef_resolve_callback_t *self, int on_resolve_completed);
 
 #endif // GOCEF_ResolveCallback_H_
@@ -6,16 +6,34 @@ import (
 	// #include "ResourceBundleHandler_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// ResourceBundleHandlerProxy defines methods required for using ResourceBundleHandler.
+// ResourceBundleHandlerProxy is implemented by the proxies used with ResourceBundleHandler. Embed
+// DefaultResourceBundleHandler to satisfy it, then implement any of the optional
+// ResourceBundleHandler*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type ResourceBundleHandlerProxy interface {
-	GetLocalizedString(self *ResourceBundleHandler, string_id int32, string_r *string) int32
-	GetDataResource(self *ResourceBundleHandler, resource_id int32, data *unsafe.Pointer, data_size *uint64) int32
-	GetDataResourceForScale(self *ResourceBundleHandler, resource_id int32, scale_factor ScaleFactor, data *unsafe.Pointer, data_size *uint64) int32
+	isResourceBundleHandlerProxy()
+}
+
+// DefaultResourceBundleHandler may be embedded to satisfy ResourceBundleHandlerProxy.
+type DefaultResourceBundleHandler struct{}
+
+func (DefaultResourceBundleHandler) isResourceBundleHandlerProxy() {}
+
+// ResourceBundleHandlerGetLocalizedStringProxy may be implemented by a ResourceBundleHandlerProxy to handle GetLocalizedString.
+type ResourceBundleHandlerGetLocalizedStringProxy interface {
+	GetLocalizedString(self *ResourceBundleHandler, string_id int32, string_r *string) bool
+}
+
+// ResourceBundleHandlerGetDataResourceProxy may be implemented by a ResourceBundleHandlerProxy to handle GetDataResource.
+type ResourceBundleHandlerGetDataResourceProxy interface {
+	GetDataResource(self *ResourceBundleHandler, resource_id int32, data *unsafe.Pointer, data_size *uint64) bool
+}
+
+// ResourceBundleHandlerGetDataResourceForScaleProxy may be implemented by a ResourceBundleHandlerProxy to handle GetDataResourceForScale.
+type ResourceBundleHandlerGetDataResourceForScaleProxy interface {
+	GetDataResourceForScale(self *ResourceBundleHandler, resource_id int32, scale_factor ScaleFactor, data *unsafe.Pointer, data_size *uint64) bool
 }
 
 // ResourceBundleHandler (cef_resource_bundle_handler_t from include/capi/cef_resource_bundle_handler_capi.h)
@@ -29,7 +47,10 @@ type ResourceBundleHandler C.cef_resource_bundle_handler_t
 func NewResourceBundleHandler(proxy ResourceBundleHandlerProxy) *ResourceBundleHandler {
 	result := (*ResourceBundleHandler)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_resource_bundle_handler_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_resource_bundle_handler_proxy(result.toNative())
+		_, get_localized_string := proxy.(ResourceBundleHandlerGetLocalizedStringProxy)
+		_, get_data_resource := proxy.(ResourceBundleHandlerGetDataResourceProxy)
+		_, get_data_resource_for_scale := proxy.(ResourceBundleHandlerGetDataResourceForScaleProxy)
+		C.gocef_set_resource_bundle_handler_proxy(result.toNative(), cefBool(get_localized_string), cefBool(get_data_resource), cefBool(get_data_resource_for_scale))
 	}
 	return result
 }
@@ -38,14 +59,15 @@ func (d *ResourceBundleHandler) toNative() *C.cef_resource_bundle_handler_t {
 	return (*C.cef_resource_bundle_handler_t)(d)
 }
 
-func lookupResourceBundleHandlerProxy(obj *BaseRefCounted) ResourceBundleHandlerProxy {
+func lookupResourceBundleHandlerProxy(obj *BaseRefCounted, callback string) ResourceBundleHandlerProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("ResourceBundleHandler", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(ResourceBundleHandlerProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type ResourceBundleHandlerProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("ResourceBundleHandler", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -61,16 +83,26 @@ func (d *ResourceBundleHandler) Base() *BaseRefCounted {
 // To provide the translation set |string| to the translation string and
 // return true (1). To use the default translation return false (0). Include
 // cef_pack_strings.h for a listing of valid string ID values.
-func (d *ResourceBundleHandler) GetLocalizedString(string_id int32, string_r *string) int32 {
-	return lookupResourceBundleHandlerProxy(d.Base()).GetLocalizedString(d, string_id, string_r)
+func (d *ResourceBundleHandler) GetLocalizedString(string_id int32, string_r *string) bool {
+	if proxy__, ok__ := lookupResourceBundleHandlerProxy(d.Base(), "GetLocalizedString").(ResourceBundleHandlerGetLocalizedStringProxy); ok__ {
+		return proxy__.GetLocalizedString(d, string_id, string_r)
+	}
+	return false
 }
 
 //export gocef_resource_bundle_handler_get_localized_string
 func gocef_resource_bundle_handler_get_localized_string(self *C.cef_resource_bundle_handler_t, string_id C.int, string_r *C.cef_string_t) C.int {
+	defer recoverProxyPanic("ResourceBundleHandler", "GetLocalizedString")
 	me__ := (*ResourceBundleHandler)(self)
-	proxy__ := lookupResourceBundleHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupResourceBundleHandlerProxy(me__.Base(), "GetLocalizedString").(ResourceBundleHandlerGetLocalizedStringProxy)
+	if !ok__ {
+		return 0
+	}
 	string_r_ := cefstrToString(string_r)
-	return C.int(proxy__.GetLocalizedString(me__, int32(string_id), &string_r_))
+	defer func() {
+		setCEFStr(string_r_, string_r)
+	}()
+	return cefBool(proxy__.GetLocalizedString(me__, int32(string_id), &string_r_))
 }
 
 // GetDataResource (get_data_resource)
@@ -80,15 +112,22 @@ func gocef_resource_bundle_handler_get_localized_string(self *C.cef_resource_bun
 // return false (0). The resource data will not be copied and must remain
 // resident in memory. Include cef_pack_resources.h for a listing of valid
 // resource ID values.
-func (d *ResourceBundleHandler) GetDataResource(resource_id int32, data *unsafe.Pointer, data_size *uint64) int32 {
-	return lookupResourceBundleHandlerProxy(d.Base()).GetDataResource(d, resource_id, data, data_size)
+func (d *ResourceBundleHandler) GetDataResource(resource_id int32, data *unsafe.Pointer, data_size *uint64) bool {
+	if proxy__, ok__ := lookupResourceBundleHandlerProxy(d.Base(), "GetDataResource").(ResourceBundleHandlerGetDataResourceProxy); ok__ {
+		return proxy__.GetDataResource(d, resource_id, data, data_size)
+	}
+	return false
 }
 
 //export gocef_resource_bundle_handler_get_data_resource
 func gocef_resource_bundle_handler_get_data_resource(self *C.cef_resource_bundle_handler_t, resource_id C.int, data *unsafe.Pointer, data_size *C.size_t) C.int {
+	defer recoverProxyPanic("ResourceBundleHandler", "GetDataResource")
 	me__ := (*ResourceBundleHandler)(self)
-	proxy__ := lookupResourceBundleHandlerProxy(me__.Base())
-	return C.int(proxy__.GetDataResource(me__, int32(resource_id), data, (*uint64)(data_size)))
+	proxy__, ok__ := lookupResourceBundleHandlerProxy(me__.Base(), "GetDataResource").(ResourceBundleHandlerGetDataResourceProxy)
+	if !ok__ {
+		return 0
+	}
+	return cefBool(proxy__.GetDataResource(me__, int32(resource_id), data, (*uint64)(data_size)))
 }
 
 // GetDataResourceForScale (get_data_resource_for_scale)
@@ -98,13 +137,20 @@ func gocef_resource_bundle_handler_get_data_resource(self *C.cef_resource_bundle
 // To use the default resource data return false (0). The resource data will
 // not be copied and must remain resident in memory. Include
 // cef_pack_resources.h for a listing of valid resource ID values.
-func (d *ResourceBundleHandler) GetDataResourceForScale(resource_id int32, scale_factor ScaleFactor, data *unsafe.Pointer, data_size *uint64) int32 {
-	return lookupResourceBundleHandlerProxy(d.Base()).GetDataResourceForScale(d, resource_id, scale_factor, data, data_size)
+func (d *ResourceBundleHandler) GetDataResourceForScale(resource_id int32, scale_factor ScaleFactor, data *unsafe.Pointer, data_size *uint64) bool {
+	if proxy__, ok__ := lookupResourceBundleHandlerProxy(d.Base(), "GetDataResourceForScale").(ResourceBundleHandlerGetDataResourceForScaleProxy); ok__ {
+		return proxy__.GetDataResourceForScale(d, resource_id, scale_factor, data, data_size)
+	}
+	return false
 }
 
 //export gocef_resource_bundle_handler_get_data_resource_for_scale
 func gocef_resource_bundle_handler_get_data_resource_for_scale(self *C.cef_resource_bundle_handler_t, resource_id C.int, scale_factor C.cef_scale_factor_t, data *unsafe.Pointer, data_size *C.size_t) C.int {
+	defer recoverProxyPanic("ResourceBundleHandler", "GetDataResourceForScale")
 	me__ := (*ResourceBundleHandler)(self)
-	proxy__ := lookupResourceBundleHandlerProxy(me__.Base())
-	return C.int(proxy__.GetDataResourceForScale(me__, int32(resource_id), ScaleFactor(scale_factor), data, (*uint64)(data_size)))
+	proxy__, ok__ := lookupResourceBundleHandlerProxy(me__.Base(), "GetDataResourceForScale").(ResourceBundleHandlerGetDataResourceForScaleProxy)
+	if !ok__ {
+		return 0
+	}
+	return cefBool(proxy__.GetDataResourceForScale(me__, int32(resource_id), ScaleFactor(scale_factor), data, (*uint64)(data_size)))
 }
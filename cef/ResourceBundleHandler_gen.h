@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_resource_bundle_handler_proxy(cef_resource_bundle_handler_t *self);
+void gocef_set_resource_bundle_handler_proxy(cef_resource_bundle_handler_t *self, int get_localized_string, int get_data_resource, int get_data_resource_for_scale);
 
 #endif // GOCEF_ResourceBundleHandler_H_
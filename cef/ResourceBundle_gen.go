@@ -45,8 +45,8 @@ func (d *ResourceBundle) GetLocalizedString(string_id int32) string {
 // will return false (0). The returned |data| pointer will remain resident in
 // memory and should not be freed. Include cef_pack_resources.h for a listing
 // of valid resource ID values.
-func (d *ResourceBundle) GetDataResource(resource_id int32, data *unsafe.Pointer, data_size *uint64) int32 {
-	return int32(C.gocef_resource_bundle_get_data_resource(d.toNative(), C.int(resource_id), data, (*C.size_t)(data_size), d.get_data_resource))
+func (d *ResourceBundle) GetDataResource(resource_id int32, data *unsafe.Pointer, data_size *uint64) bool {
+	return C.gocef_resource_bundle_get_data_resource(d.toNative(), C.int(resource_id), data, (*C.size_t)(data_size), d.get_data_resource) != 0
 }
 
 // GetDataResourceForScale (get_data_resource_for_scale)
@@ -58,6 +58,6 @@ func (d *ResourceBundle) GetDataResource(resource_id int32, data *unsafe.Pointer
 // return false (0). The returned |data| pointer will remain resident in
 // memory and should not be freed. Include cef_pack_resources.h for a listing
 // of valid resource ID values.
-func (d *ResourceBundle) GetDataResourceForScale(resource_id int32, scale_factor ScaleFactor, data *unsafe.Pointer, data_size *uint64) int32 {
-	return int32(C.gocef_resource_bundle_get_data_resource_for_scale(d.toNative(), C.int(resource_id), C.cef_scale_factor_t(scale_factor), data, (*C.size_t)(data_size), d.get_data_resource_for_scale))
+func (d *ResourceBundle) GetDataResourceForScale(resource_id int32, scale_factor ScaleFactor, data *unsafe.Pointer, data_size *uint64) bool {
+	return C.gocef_resource_bundle_get_data_resource_for_scale(d.toNative(), C.int(resource_id), C.cef_scale_factor_t(scale_factor), data, (*C.size_t)(data_size), d.get_data_resource_for_scale) != 0
 }
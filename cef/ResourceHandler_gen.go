@@ -6,18 +6,48 @@ import (
 	// #include "ResourceHandler_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// ResourceHandlerProxy defines methods required for using ResourceHandler.
+// ResourceHandlerProxy is implemented by the proxies used with ResourceHandler. Embed
+// DefaultResourceHandler to satisfy it, then implement any of the optional
+// ResourceHandler*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type ResourceHandlerProxy interface {
-	ProcessRequest(self *ResourceHandler, request *Request, callback *Callback) int32
+	isResourceHandlerProxy()
+}
+
+// DefaultResourceHandler may be embedded to satisfy ResourceHandlerProxy.
+type DefaultResourceHandler struct{}
+
+func (DefaultResourceHandler) isResourceHandlerProxy() {}
+
+// ResourceHandlerProcessRequestProxy may be implemented by a ResourceHandlerProxy to handle ProcessRequest.
+type ResourceHandlerProcessRequestProxy interface {
+	ProcessRequest(self *ResourceHandler, request *Request, callback *Callback) bool
+}
+
+// ResourceHandlerGetResponseHeadersProxy may be implemented by a ResourceHandlerProxy to handle GetResponseHeaders.
+type ResourceHandlerGetResponseHeadersProxy interface {
 	GetResponseHeaders(self *ResourceHandler, response *Response, response_length *int64, redirectUrl *string)
-	ReadResponse(self *ResourceHandler, data_out unsafe.Pointer, bytes_to_read int32, bytes_read *int32, callback *Callback) int32
-	CanGetCookie(self *ResourceHandler, cookie *Cookie) int32
-	CanSetCookie(self *ResourceHandler, cookie *Cookie) int32
+}
+
+// ResourceHandlerReadResponseProxy may be implemented by a ResourceHandlerProxy to handle ReadResponse.
+type ResourceHandlerReadResponseProxy interface {
+	ReadResponse(self *ResourceHandler, data_out unsafe.Pointer, bytes_to_read int32, bytes_read *int32, callback *Callback) bool
+}
+
+// ResourceHandlerCanGetCookieProxy may be implemented by a ResourceHandlerProxy to handle CanGetCookie.
+type ResourceHandlerCanGetCookieProxy interface {
+	CanGetCookie(self *ResourceHandler, cookie *Cookie) bool
+}
+
+// ResourceHandlerCanSetCookieProxy may be implemented by a ResourceHandlerProxy to handle CanSetCookie.
+type ResourceHandlerCanSetCookieProxy interface {
+	CanSetCookie(self *ResourceHandler, cookie *Cookie) bool
+}
+
+// ResourceHandlerCancelProxy may be implemented by a ResourceHandlerProxy to handle Cancel.
+type ResourceHandlerCancelProxy interface {
 	Cancel(self *ResourceHandler)
 }
 
@@ -31,7 +61,13 @@ type ResourceHandler C.cef_resource_handler_t
 func NewResourceHandler(proxy ResourceHandlerProxy) *ResourceHandler {
 	result := (*ResourceHandler)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_resource_handler_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_resource_handler_proxy(result.toNative())
+		_, process_request := proxy.(ResourceHandlerProcessRequestProxy)
+		_, get_response_headers := proxy.(ResourceHandlerGetResponseHeadersProxy)
+		_, read_response := proxy.(ResourceHandlerReadResponseProxy)
+		_, can_get_cookie := proxy.(ResourceHandlerCanGetCookieProxy)
+		_, can_set_cookie := proxy.(ResourceHandlerCanSetCookieProxy)
+		_, cancel := proxy.(ResourceHandlerCancelProxy)
+		C.gocef_set_resource_handler_proxy(result.toNative(), cefBool(process_request), cefBool(get_response_headers), cefBool(read_response), cefBool(can_get_cookie), cefBool(can_set_cookie), cefBool(cancel))
 	}
 	return result
 }
@@ -40,14 +76,15 @@ func (d *ResourceHandler) toNative() *C.cef_resource_handler_t {
 	return (*C.cef_resource_handler_t)(d)
 }
 
-func lookupResourceHandlerProxy(obj *BaseRefCounted) ResourceHandlerProxy {
+func lookupResourceHandlerProxy(obj *BaseRefCounted, callback string) ResourceHandlerProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("ResourceHandler", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(ResourceHandlerProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type ResourceHandlerProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("ResourceHandler", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -64,15 +101,22 @@ func (d *ResourceHandler) Base() *BaseRefCounted {
 // available (cef_callback_t::cont() can also be called from inside this
 // function if header information is available immediately). To cancel the
 // request return false (0).
-func (d *ResourceHandler) ProcessRequest(request *Request, callback *Callback) int32 {
-	return lookupResourceHandlerProxy(d.Base()).ProcessRequest(d, request, callback)
+func (d *ResourceHandler) ProcessRequest(request *Request, callback *Callback) bool {
+	if proxy__, ok__ := lookupResourceHandlerProxy(d.Base(), "ProcessRequest").(ResourceHandlerProcessRequestProxy); ok__ {
+		return proxy__.ProcessRequest(d, request, callback)
+	}
+	return false
 }
 
 //export gocef_resource_handler_process_request
 func gocef_resource_handler_process_request(self *C.cef_resource_handler_t, request *C.cef_request_t, callback *C.cef_callback_t) C.int {
+	defer recoverProxyPanic("ResourceHandler", "ProcessRequest")
 	me__ := (*ResourceHandler)(self)
-	proxy__ := lookupResourceHandlerProxy(me__.Base())
-	return C.int(proxy__.ProcessRequest(me__, (*Request)(request), (*Callback)(callback)))
+	proxy__, ok__ := lookupResourceHandlerProxy(me__.Base(), "ProcessRequest").(ResourceHandlerProcessRequestProxy)
+	if !ok__ {
+		return 0
+	}
+	return cefBool(proxy__.ProcessRequest(me__, (*Request)(request), (*Callback)(callback)))
 }
 
 // GetResponseHeaders (get_response_headers)
@@ -90,14 +134,23 @@ func gocef_resource_handler_process_request(self *C.cef_resource_handler_t, requ
 // error occured while setting up the request you can call set_error() on
 // |response| to indicate the error condition.
 func (d *ResourceHandler) GetResponseHeaders(response *Response, response_length *int64, redirectUrl *string) {
-	lookupResourceHandlerProxy(d.Base()).GetResponseHeaders(d, response, response_length, redirectUrl)
+	if proxy__, ok__ := lookupResourceHandlerProxy(d.Base(), "GetResponseHeaders").(ResourceHandlerGetResponseHeadersProxy); ok__ {
+		proxy__.GetResponseHeaders(d, response, response_length, redirectUrl)
+	}
 }
 
 //export gocef_resource_handler_get_response_headers
 func gocef_resource_handler_get_response_headers(self *C.cef_resource_handler_t, response *C.cef_response_t, response_length *C.int64, redirectUrl *C.cef_string_t) {
+	defer recoverProxyPanic("ResourceHandler", "GetResponseHeaders")
 	me__ := (*ResourceHandler)(self)
-	proxy__ := lookupResourceHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupResourceHandlerProxy(me__.Base(), "GetResponseHeaders").(ResourceHandlerGetResponseHeadersProxy)
+	if !ok__ {
+		return
+	}
 	redirectUrl_ := cefstrToString(redirectUrl)
+	defer func() {
+		setCEFStr(redirectUrl_, redirectUrl)
+	}()
 	proxy__.GetResponseHeaders(me__, (*Response)(response), (*int64)(response_length), &redirectUrl_)
 }
 
@@ -107,57 +160,84 @@ func gocef_resource_handler_get_response_headers(self *C.cef_resource_handler_t,
 // bytes copied, and return true (1). To read the data at a later time set
 // |bytes_read| to 0, return true (1) and call cef_callback_t::cont() when the
 // data is available. To indicate response completion return false (0).
-func (d *ResourceHandler) ReadResponse(data_out unsafe.Pointer, bytes_to_read int32, bytes_read *int32, callback *Callback) int32 {
-	return lookupResourceHandlerProxy(d.Base()).ReadResponse(d, data_out, bytes_to_read, bytes_read, callback)
+func (d *ResourceHandler) ReadResponse(data_out unsafe.Pointer, bytes_to_read int32, bytes_read *int32, callback *Callback) bool {
+	if proxy__, ok__ := lookupResourceHandlerProxy(d.Base(), "ReadResponse").(ResourceHandlerReadResponseProxy); ok__ {
+		return proxy__.ReadResponse(d, data_out, bytes_to_read, bytes_read, callback)
+	}
+	return false
 }
 
 //export gocef_resource_handler_read_response
 func gocef_resource_handler_read_response(self *C.cef_resource_handler_t, data_out unsafe.Pointer, bytes_to_read C.int, bytes_read *C.int, callback *C.cef_callback_t) C.int {
+	defer recoverProxyPanic("ResourceHandler", "ReadResponse")
 	me__ := (*ResourceHandler)(self)
-	proxy__ := lookupResourceHandlerProxy(me__.Base())
-	return C.int(proxy__.ReadResponse(me__, data_out, int32(bytes_to_read), (*int32)(bytes_read), (*Callback)(callback)))
+	proxy__, ok__ := lookupResourceHandlerProxy(me__.Base(), "ReadResponse").(ResourceHandlerReadResponseProxy)
+	if !ok__ {
+		return 0
+	}
+	return cefBool(proxy__.ReadResponse(me__, data_out, int32(bytes_to_read), (*int32)(bytes_read), (*Callback)(callback)))
 }
 
 // CanGetCookie (can_get_cookie)
 // Return true (1) if the specified cookie can be sent with the request or
 // false (0) otherwise. If false (0) is returned for any cookie then no
 // cookies will be sent with the request.
-func (d *ResourceHandler) CanGetCookie(cookie *Cookie) int32 {
-	return lookupResourceHandlerProxy(d.Base()).CanGetCookie(d, cookie)
+func (d *ResourceHandler) CanGetCookie(cookie *Cookie) bool {
+	if proxy__, ok__ := lookupResourceHandlerProxy(d.Base(), "CanGetCookie").(ResourceHandlerCanGetCookieProxy); ok__ {
+		return proxy__.CanGetCookie(d, cookie)
+	}
+	return false
 }
 
 //export gocef_resource_handler_can_get_cookie
 func gocef_resource_handler_can_get_cookie(self *C.cef_resource_handler_t, cookie *C.cef_cookie_t) C.int {
+	defer recoverProxyPanic("ResourceHandler", "CanGetCookie")
 	me__ := (*ResourceHandler)(self)
-	proxy__ := lookupResourceHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupResourceHandlerProxy(me__.Base(), "CanGetCookie").(ResourceHandlerCanGetCookieProxy)
+	if !ok__ {
+		return 0
+	}
 	cookie_ := cookie.toGo()
-	return C.int(proxy__.CanGetCookie(me__, cookie_))
+	return cefBool(proxy__.CanGetCookie(me__, cookie_))
 }
 
 // CanSetCookie (can_set_cookie)
 // Return true (1) if the specified cookie returned with the response can be
 // set or false (0) otherwise.
-func (d *ResourceHandler) CanSetCookie(cookie *Cookie) int32 {
-	return lookupResourceHandlerProxy(d.Base()).CanSetCookie(d, cookie)
+func (d *ResourceHandler) CanSetCookie(cookie *Cookie) bool {
+	if proxy__, ok__ := lookupResourceHandlerProxy(d.Base(), "CanSetCookie").(ResourceHandlerCanSetCookieProxy); ok__ {
+		return proxy__.CanSetCookie(d, cookie)
+	}
+	return false
 }
 
 //export gocef_resource_handler_can_set_cookie
 func gocef_resource_handler_can_set_cookie(self *C.cef_resource_handler_t, cookie *C.cef_cookie_t) C.int {
+	defer recoverProxyPanic("ResourceHandler", "CanSetCookie")
 	me__ := (*ResourceHandler)(self)
-	proxy__ := lookupResourceHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupResourceHandlerProxy(me__.Base(), "CanSetCookie").(ResourceHandlerCanSetCookieProxy)
+	if !ok__ {
+		return 0
+	}
 	cookie_ := cookie.toGo()
-	return C.int(proxy__.CanSetCookie(me__, cookie_))
+	return cefBool(proxy__.CanSetCookie(me__, cookie_))
 }
 
 // Cancel (cancel)
 // Request processing has been canceled.
 func (d *ResourceHandler) Cancel() {
-	lookupResourceHandlerProxy(d.Base()).Cancel(d)
+	if proxy__, ok__ := lookupResourceHandlerProxy(d.Base(), "Cancel").(ResourceHandlerCancelProxy); ok__ {
+		proxy__.Cancel(d)
+	}
 }
 
 //export gocef_resource_handler_cancel
 func gocef_resource_handler_cancel(self *C.cef_resource_handler_t) {
+	defer recoverProxyPanic("ResourceHandler", "Cancel")
 	me__ := (*ResourceHandler)(self)
-	proxy__ := lookupResourceHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupResourceHandlerProxy(me__.Base(), "Cancel").(ResourceHandlerCancelProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.Cancel(me__)
 }
@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_resource_handler_proxy(cef_resource_handler_t *self);
+void gocef_set_resource_handler_proxy(cef_resource_handler_t *self, int process_request, int get_response_headers, int read_response, int can_get_cookie, int can_set_cookie, int cancel);
 
 #endif // GOCEF_ResourceHandler_H_
@@ -6,14 +6,28 @@ import (
 	// #include "ResponseFilter_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// ResponseFilterProxy defines methods required for using ResponseFilter.
+// ResponseFilterProxy is implemented by the proxies used with ResponseFilter. Embed
+// DefaultResponseFilter to satisfy it, then implement any of the optional
+// ResponseFilter*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type ResponseFilterProxy interface {
-	InitFilter(self *ResponseFilter) int32
+	isResponseFilterProxy()
+}
+
+// DefaultResponseFilter may be embedded to satisfy ResponseFilterProxy.
+type DefaultResponseFilter struct{}
+
+func (DefaultResponseFilter) isResponseFilterProxy() {}
+
+// ResponseFilterInitFilterProxy may be implemented by a ResponseFilterProxy to handle InitFilter.
+type ResponseFilterInitFilterProxy interface {
+	InitFilter(self *ResponseFilter) bool
+}
+
+// ResponseFilterFilterProxy may be implemented by a ResponseFilterProxy to handle Filter.
+type ResponseFilterFilterProxy interface {
 	Filter(self *ResponseFilter, data_in unsafe.Pointer, data_in_size uint64, data_in_read *uint64, data_out unsafe.Pointer, data_out_size uint64, data_out_written *uint64) ResponseFilterStatus
 }
 
@@ -27,7 +41,9 @@ type ResponseFilter C.cef_response_filter_t
 func NewResponseFilter(proxy ResponseFilterProxy) *ResponseFilter {
 	result := (*ResponseFilter)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_response_filter_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_response_filter_proxy(result.toNative())
+		_, init_filter := proxy.(ResponseFilterInitFilterProxy)
+		_, filter := proxy.(ResponseFilterFilterProxy)
+		C.gocef_set_response_filter_proxy(result.toNative(), cefBool(init_filter), cefBool(filter))
 	}
 	return result
 }
@@ -36,14 +52,15 @@ func (d *ResponseFilter) toNative() *C.cef_response_filter_t {
 	return (*C.cef_response_filter_t)(d)
 }
 
-func lookupResponseFilterProxy(obj *BaseRefCounted) ResponseFilterProxy {
+func lookupResponseFilterProxy(obj *BaseRefCounted, callback string) ResponseFilterProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("ResponseFilter", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(ResponseFilterProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type ResponseFilterProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("ResponseFilter", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -57,15 +74,22 @@ func (d *ResponseFilter) Base() *BaseRefCounted {
 // InitFilter (init_filter)
 // Initialize the response filter. Will only be called a single time. The
 // filter will not be installed if this function returns false (0).
-func (d *ResponseFilter) InitFilter() int32 {
-	return lookupResponseFilterProxy(d.Base()).InitFilter(d)
+func (d *ResponseFilter) InitFilter() bool {
+	if proxy__, ok__ := lookupResponseFilterProxy(d.Base(), "InitFilter").(ResponseFilterInitFilterProxy); ok__ {
+		return proxy__.InitFilter(d)
+	}
+	return false
 }
 
 //export gocef_response_filter_init_filter
 func gocef_response_filter_init_filter(self *C.cef_response_filter_t) C.int {
+	defer recoverProxyPanic("ResponseFilter", "InitFilter")
 	me__ := (*ResponseFilter)(self)
-	proxy__ := lookupResponseFilterProxy(me__.Base())
-	return C.int(proxy__.InitFilter(me__))
+	proxy__, ok__ := lookupResponseFilterProxy(me__.Base(), "InitFilter").(ResponseFilterInitFilterProxy)
+	if !ok__ {
+		return 0
+	}
+	return cefBool(proxy__.InitFilter(me__))
 }
 
 // Filter (filter)
@@ -99,12 +123,19 @@ func gocef_response_filter_init_filter(self *C.cef_response_filter_t) C.int {
 //
 // Do not keep a reference to the buffers passed to this function.
 func (d *ResponseFilter) Filter(data_in unsafe.Pointer, data_in_size uint64, data_in_read *uint64, data_out unsafe.Pointer, data_out_size uint64, data_out_written *uint64) ResponseFilterStatus {
-	return lookupResponseFilterProxy(d.Base()).Filter(d, data_in, data_in_size, data_in_read, data_out, data_out_size, data_out_written)
+	if proxy__, ok__ := lookupResponseFilterProxy(d.Base(), "Filter").(ResponseFilterFilterProxy); ok__ {
+		return proxy__.Filter(d, data_in, data_in_size, data_in_read, data_out, data_out_size, data_out_written)
+	}
+	return 0
 }
 
 //export gocef_response_filter_filter
 func gocef_response_filter_filter(self *C.cef_response_filter_t, data_in unsafe.Pointer, data_in_size C.size_t, data_in_read *C.size_t, data_out unsafe.Pointer, data_out_size C.size_t, data_out_written *C.size_t) C.cef_response_filter_status_t {
+	defer recoverProxyPanic("ResponseFilter", "Filter")
 	me__ := (*ResponseFilter)(self)
-	proxy__ := lookupResponseFilterProxy(me__.Base())
+	proxy__, ok__ := lookupResponseFilterProxy(me__.Base(), "Filter").(ResponseFilterFilterProxy)
+	if !ok__ {
+		return 0
+	}
 	return C.cef_response_filter_status_t(proxy__.Filter(me__, data_in, uint64(data_in_size), (*uint64)(data_in_read), data_out, uint64(data_out_size), (*uint64)(data_out_written)))
 }
@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_response_filter_proxy(cef_response_filter_t *self);
+void gocef_set_response_filter_proxy(cef_response_filter_t *self, int init_filter, int filter);
 
 #endif // GOCEF_ResponseFilter_H_
@@ -38,8 +38,8 @@ func (d *Response) Base() *BaseRefCounted {
 
 // IsReadOnly (is_read_only)
 // Returns true (1) if this object is read-only.
-func (d *Response) IsReadOnly() int32 {
-	return int32(C.gocef_response_is_read_only(d.toNative(), d.is_read_only))
+func (d *Response) IsReadOnly() bool {
+	return C.gocef_response_is_read_only(d.toNative(), d.is_read_only) != 0
 }
 
 // GetError (get_error)
@@ -117,14 +117,19 @@ func (d *Response) GetHeader(name string) string {
 
 // GetHeaderMap (get_header_map)
 // Get all response header fields.
-func (d *Response) GetHeaderMap(headerMap StringMultimap) {
-	C.gocef_response_get_header_map(d.toNative(), C.cef_string_multimap_t(headerMap), d.get_header_map)
+func (d *Response) GetHeaderMap() map[string][]string {
+	headerMap_ := C.cef_string_multimap_alloc()
+	defer C.cef_string_multimap_free(headerMap_)
+	C.gocef_response_get_header_map(d.toNative(), headerMap_, d.get_header_map)
+	return cefStringMultimapToGo(headerMap_)
 }
 
 // SetHeaderMap (set_header_map)
 // Set all response header fields.
-func (d *Response) SetHeaderMap(headerMap StringMultimap) {
-	C.gocef_response_set_header_map(d.toNative(), C.cef_string_multimap_t(headerMap), d.set_header_map)
+func (d *Response) SetHeaderMap(headerMap map[string][]string) {
+	headerMap_ := newCEFStringMultimap(headerMap)
+	defer C.cef_string_multimap_free(headerMap_)
+	C.gocef_response_set_header_map(d.toNative(), headerMap_, d.set_header_map)
 }
 
 // GetUrl (get_url)
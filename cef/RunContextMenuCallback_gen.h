@@ -1,11 +0,0 @@
-// Code generated - DO NOT EDIT.
-
-#ifndef GOCEF_RunContextMenuCallback_H_
-#define GOCEF_RunContextMenuCallback_H_
-#pragma once
-
-#include "capi_gen.h"
-
-void gocef_set_run_context_menu_callback_proxy(cef_run_context_menu_callback_t *self);
-
-#endif // GOCEF_RunContextMenuCallback_H_
@@ -6,14 +6,24 @@ import (
 	// #include "RunFileDialogCallback_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// RunFileDialogCallbackProxy defines methods required for using RunFileDialogCallback.
+// RunFileDialogCallbackProxy is implemented by the proxies used with RunFileDialogCallback. Embed
+// DefaultRunFileDialogCallback to satisfy it, then implement any of the optional
+// RunFileDialogCallback*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type RunFileDialogCallbackProxy interface {
-	OnFileDialogDismissed(self *RunFileDialogCallback, selected_accept_filter int32, file_paths StringList)
+	isRunFileDialogCallbackProxy()
+}
+
+// DefaultRunFileDialogCallback may be embedded to satisfy RunFileDialogCallbackProxy.
+type DefaultRunFileDialogCallback struct{}
+
+func (DefaultRunFileDialogCallback) isRunFileDialogCallbackProxy() {}
+
+// RunFileDialogCallbackOnFileDialogDismissedProxy may be implemented by a RunFileDialogCallbackProxy to handle OnFileDialogDismissed.
+type RunFileDialogCallbackOnFileDialogDismissedProxy interface {
+	OnFileDialogDismissed(self *RunFileDialogCallback, selected_accept_filter int32, file_paths []string)
 }
 
 // RunFileDialogCallback (cef_run_file_dialog_callback_t from include/capi/cef_browser_capi.h)
@@ -26,7 +36,8 @@ type RunFileDialogCallback C.cef_run_file_dialog_callback_t
 func NewRunFileDialogCallback(proxy RunFileDialogCallbackProxy) *RunFileDialogCallback {
 	result := (*RunFileDialogCallback)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_run_file_dialog_callback_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_run_file_dialog_callback_proxy(result.toNative())
+		_, on_file_dialog_dismissed := proxy.(RunFileDialogCallbackOnFileDialogDismissedProxy)
+		C.gocef_set_run_file_dialog_callback_proxy(result.toNative(), cefBool(on_file_dialog_dismissed))
 	}
 	return result
 }
@@ -35,14 +46,15 @@ func (d *RunFileDialogCallback) toNative() *C.cef_run_file_dialog_callback_t {
 	return (*C.cef_run_file_dialog_callback_t)(d)
 }
 
-func lookupRunFileDialogCallbackProxy(obj *BaseRefCounted) RunFileDialogCallbackProxy {
+func lookupRunFileDialogCallbackProxy(obj *BaseRefCounted, callback string) RunFileDialogCallbackProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("RunFileDialogCallback", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(RunFileDialogCallbackProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type RunFileDialogCallbackProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("RunFileDialogCallback", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -59,13 +71,20 @@ func (d *RunFileDialogCallback) Base() *BaseRefCounted {
 // the accept filters array passed to cef_browser_host_t::RunFileDialog.
 // |file_paths| will be a single value or a list of values depending on the
 // dialog mode. If the selection was cancelled |file_paths| will be NULL.
-func (d *RunFileDialogCallback) OnFileDialogDismissed(selected_accept_filter int32, file_paths StringList) {
-	lookupRunFileDialogCallbackProxy(d.Base()).OnFileDialogDismissed(d, selected_accept_filter, file_paths)
+func (d *RunFileDialogCallback) OnFileDialogDismissed(selected_accept_filter int32, file_paths []string) {
+	if proxy__, ok__ := lookupRunFileDialogCallbackProxy(d.Base(), "OnFileDialogDismissed").(RunFileDialogCallbackOnFileDialogDismissedProxy); ok__ {
+		proxy__.OnFileDialogDismissed(d, selected_accept_filter, file_paths)
+	}
 }
 
 //export gocef_run_file_dialog_callback_on_file_dialog_dismissed
 func gocef_run_file_dialog_callback_on_file_dialog_dismissed(self *C.cef_run_file_dialog_callback_t, selected_accept_filter C.int, file_paths C.cef_string_list_t) {
+	defer recoverProxyPanic("RunFileDialogCallback", "OnFileDialogDismissed")
 	me__ := (*RunFileDialogCallback)(self)
-	proxy__ := lookupRunFileDialogCallbackProxy(me__.Base())
-	proxy__.OnFileDialogDismissed(me__, int32(selected_accept_filter), StringList(file_paths))
+	proxy__, ok__ := lookupRunFileDialogCallbackProxy(me__.Base(), "OnFileDialogDismissed").(RunFileDialogCallbackOnFileDialogDismissedProxy)
+	if !ok__ {
+		return
+	}
+	file_paths_ := cefStringListToGo(file_paths)
+	proxy__.OnFileDialogDismissed(me__, int32(selected_accept_filter), file_paths_)
 }
@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_run_file_dialog_callback_proxy(cef_run_file_dialog_callback_t *self);
+void gocef_set_run_file_dialog_callback_proxy(cef_run_file_dialog_callback_t *self, int on_file_dialog_dismissed);
 
 #endif // GOCEF_RunFileDialogCallback_H_
@@ -1,11 +1,12 @@
 // Code generated - DO NOT EDIT.
 
-#include "AuthCallback_gen.h"
+#include "SchemeHandlerFactory_gen.h"
 #include "_cgo_export.h"
 
-void gocef_set_auth_callback_proxy(cef_auth_callback_t *self) {
+void gocef_set_scheme_handler_factory_proxy(cef_scheme_handler_factory_t *self, int create) {
 	// Casts to (void *) added to avoid warnings since Go callbacks can't have
 	// some modifiers, such as 'const' applied to their parameter signatures.
-	self->cont = (void *)&gocef_auth_callback_cont;
-	self->cancel = (void *)&gocef_auth_callback_cancel;
+	if (create) {
+		self->create = (void *)&gocef_scheme_handler_factory_create;
+	}
 }
@@ -3,21 +3,63 @@
 package cef
 
 import (
-	// #include "capi_gen.h"
-	// cef_resource_handler_t * gocef_scheme_handler_factory_create(cef_scheme_handler_factory_t * self, cef_browser_t * browser, cef_frame_t * frame, cef_string_t * scheme_name, cef_request_t * request, cef_resource_handler_t * (CEF_CALLBACK *callback__)(cef_scheme_handler_factory_t *, cef_browser_t *, cef_frame_t *, cef_string_t *, cef_request_t *)) { return callback__(self, browser, frame, scheme_name, request); }
+	// #include "SchemeHandlerFactory_gen.h"
 	"C"
+	"unsafe"
 )
 
+// SchemeHandlerFactoryProxy is implemented by the proxies used with SchemeHandlerFactory. Embed
+// DefaultSchemeHandlerFactory to satisfy it, then implement any of the optional
+// SchemeHandlerFactory*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
+type SchemeHandlerFactoryProxy interface {
+	isSchemeHandlerFactoryProxy()
+}
+
+// DefaultSchemeHandlerFactory may be embedded to satisfy SchemeHandlerFactoryProxy.
+type DefaultSchemeHandlerFactory struct{}
+
+func (DefaultSchemeHandlerFactory) isSchemeHandlerFactoryProxy() {}
+
+// SchemeHandlerFactoryCreateProxy may be implemented by a SchemeHandlerFactoryProxy to handle Create.
+type SchemeHandlerFactoryCreateProxy interface {
+	Create(self *SchemeHandlerFactory, browser *Browser, frame *Frame, scheme_name string, request *Request) *ResourceHandler
+}
+
 // SchemeHandlerFactory (cef_scheme_handler_factory_t from include/capi/cef_scheme_capi.h)
 // Structure that creates cef_resource_handler_t instances for handling scheme
 // requests. The functions of this structure will always be called on the IO
 // thread.
 type SchemeHandlerFactory C.cef_scheme_handler_factory_t
 
+// NewSchemeHandlerFactory creates a new SchemeHandlerFactory with the specified proxy. Passing
+// in nil will result in default handling, if applicable.
+func NewSchemeHandlerFactory(proxy SchemeHandlerFactoryProxy) *SchemeHandlerFactory {
+	result := (*SchemeHandlerFactory)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_scheme_handler_factory_t, proxy)))
+	if proxy != nil {
+		_, create := proxy.(SchemeHandlerFactoryCreateProxy)
+		C.gocef_set_scheme_handler_factory_proxy(result.toNative(), cefBool(create))
+	}
+	return result
+}
+
 func (d *SchemeHandlerFactory) toNative() *C.cef_scheme_handler_factory_t {
 	return (*C.cef_scheme_handler_factory_t)(d)
 }
 
+func lookupSchemeHandlerFactoryProxy(obj *BaseRefCounted, callback string) SchemeHandlerFactoryProxy {
+	proxy, exists := lookupProxy(obj)
+	if !exists {
+		reportProxyError("SchemeHandlerFactory", callback, ErrProxyNotFound, nil)
+		return nil
+	}
+	actual, ok := proxy.(SchemeHandlerFactoryProxy)
+	if !ok && proxy != nil {
+		reportProxyError("SchemeHandlerFactory", callback, ErrProxyWrongType, nil)
+	}
+	return actual
+}
+
 // Base (base)
 // Base structure.
 func (d *SchemeHandlerFactory) Base() *BaseRefCounted {
@@ -32,10 +74,20 @@ func (d *SchemeHandlerFactory) Base() *BaseRefCounted {
 // example, if the request came from cef_urlrequest_t). The |request| object
 // passed to this function will not contain cookie data.
 func (d *SchemeHandlerFactory) Create(browser *Browser, frame *Frame, scheme_name string, request *Request) *ResourceHandler {
-	scheme_name_ := C.cef_string_userfree_alloc()
-	setCEFStr(scheme_name, scheme_name_)
-	defer func() {
-		C.cef_string_userfree_free(scheme_name_)
-	}()
-	return (*ResourceHandler)(C.gocef_scheme_handler_factory_create(d.toNative(), browser.toNative(), frame.toNative(), (*C.cef_string_t)(scheme_name_), request.toNative(), d.create))
+	if proxy__, ok__ := lookupSchemeHandlerFactoryProxy(d.Base(), "Create").(SchemeHandlerFactoryCreateProxy); ok__ {
+		return proxy__.Create(d, browser, frame, scheme_name, request)
+	}
+	return nil
+}
+
+//export gocef_scheme_handler_factory_create
+func gocef_scheme_handler_factory_create(self *C.cef_scheme_handler_factory_t, browser *C.cef_browser_t, frame *C.cef_frame_t, scheme_name *C.cef_string_t, request *C.cef_request_t) *C.cef_resource_handler_t {
+	defer recoverProxyPanic("SchemeHandlerFactory", "Create")
+	me__ := (*SchemeHandlerFactory)(self)
+	proxy__, ok__ := lookupSchemeHandlerFactoryProxy(me__.Base(), "Create").(SchemeHandlerFactoryCreateProxy)
+	if !ok__ {
+		return nil
+	}
+	scheme_name_ := cefstrToString(scheme_name)
+	return (proxy__.Create(me__, (*Browser)(browser), (*Frame)(frame), scheme_name_, (*Request)(request))).toNative()
 }
@@ -31,11 +31,11 @@ func (d *SchemeRegistrar) Base() *BaseScoped {
 // This function may be called on any thread. It should only be called once
 // per unique |scheme_name| value. If |scheme_name| is already registered or
 // if an error occurs this function will return false (0).
-func (d *SchemeRegistrar) AddCustomScheme(scheme_name string, options int32) int32 {
+func (d *SchemeRegistrar) AddCustomScheme(scheme_name string, options int32) bool {
 	scheme_name_ := C.cef_string_userfree_alloc()
 	setCEFStr(scheme_name, scheme_name_)
 	defer func() {
 		C.cef_string_userfree_free(scheme_name_)
 	}()
-	return int32(C.gocef_scheme_registrar_add_custom_scheme(d.toNative(), (*C.cef_string_t)(scheme_name_), C.int(options), d.add_custom_scheme))
+	return C.gocef_scheme_registrar_add_custom_scheme(d.toNative(), (*C.cef_string_t)(scheme_name_), C.int(options), d.add_custom_scheme) != 0
 }
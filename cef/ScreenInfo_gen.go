@@ -25,7 +25,7 @@ type ScreenInfo struct {
 	DepthPerComponent int32
 	// IsMonochrome (is_monochrome)
 	// This can be true for black and white printers.
-	IsMonochrome int32
+	IsMonochrome bool
 	// Rect (rect)
 	// This is set from the rcMonitor member of MONITORINFOEX, to whit:
 	//   "A RECT structure that specifies the display monitor rectangle,
@@ -63,7 +63,7 @@ func (d *ScreenInfo) toNative(native *C.cef_screen_info_t) *C.cef_screen_info_t
 	native.device_scale_factor = C.float(d.DeviceScaleFactor)
 	native.depth = C.int(d.Depth)
 	native.depth_per_component = C.int(d.DepthPerComponent)
-	native.is_monochrome = C.int(d.IsMonochrome)
+	native.is_monochrome = cefBool(d.IsMonochrome)
 	d.Rect.toNative(&native.rect)
 	d.AvailableRect.toNative(&native.available_rect)
 	return native
@@ -82,7 +82,7 @@ func (n *C.cef_screen_info_t) intoGo(d *ScreenInfo) {
 	d.DeviceScaleFactor = float32(n.device_scale_factor)
 	d.Depth = int32(n.depth)
 	d.DepthPerComponent = int32(n.depth_per_component)
-	d.IsMonochrome = int32(n.is_monochrome)
+	d.IsMonochrome = n.is_monochrome != 0
 	n.rect.intoGo(&d.Rect)
 	n.available_rect.intoGo(&d.AvailableRect)
 }
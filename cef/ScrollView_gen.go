@@ -39,6 +39,7 @@ func (d *ScrollView) SetContentView(view *View) {
 
 // GetContentView (get_content_view)
 // Returns the content View.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *ScrollView) GetContentView() *View {
 	return (*View)(C.gocef_scroll_view_get_content_view(d.toNative(), d.get_content_view))
 }
@@ -54,8 +55,8 @@ func (d *ScrollView) GetVisibleContentRect() Rect {
 
 // HasHorizontalScrollbar (has_horizontal_scrollbar)
 // Returns true (1) if the horizontal scrollbar is currently showing.
-func (d *ScrollView) HasHorizontalScrollbar() int32 {
-	return int32(C.gocef_scroll_view_has_horizontal_scrollbar(d.toNative(), d.has_horizontal_scrollbar))
+func (d *ScrollView) HasHorizontalScrollbar() bool {
+	return C.gocef_scroll_view_has_horizontal_scrollbar(d.toNative(), d.has_horizontal_scrollbar) != 0
 }
 
 // GetHorizontalScrollbarHeight (get_horizontal_scrollbar_height)
@@ -66,8 +67,8 @@ func (d *ScrollView) GetHorizontalScrollbarHeight() int32 {
 
 // HasVerticalScrollbar (has_vertical_scrollbar)
 // Returns true (1) if the vertical scrollbar is currently showing.
-func (d *ScrollView) HasVerticalScrollbar() int32 {
-	return int32(C.gocef_scroll_view_has_vertical_scrollbar(d.toNative(), d.has_vertical_scrollbar))
+func (d *ScrollView) HasVerticalScrollbar() bool {
+	return C.gocef_scroll_view_has_vertical_scrollbar(d.toNative(), d.has_vertical_scrollbar) != 0
 }
 
 // GetVerticalScrollbarWidth (get_vertical_scrollbar_width)
@@ -1,11 +0,0 @@
-// Code generated - DO NOT EDIT.
-
-#ifndef GOCEF_SelectClientCertificateCallback_H_
-#define GOCEF_SelectClientCertificateCallback_H_
-#pragma once
-
-#include "capi_gen.h"
-
-void gocef_set_select_client_certificate_callback_proxy(cef_select_client_certificate_callback_t *self);
-
-#endif // GOCEF_SelectClientCertificateCallback_H_
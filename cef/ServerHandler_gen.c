@@ -3,15 +3,31 @@
 #include "ServerHandler_gen.h"
 #include "_cgo_export.h"
 
-void gocef_set_server_handler_proxy(cef_server_handler_t *self) {
+void gocef_set_server_handler_proxy(cef_server_handler_t *self, int on_server_created, int on_server_destroyed, int on_client_connected, int on_client_disconnected, int on_http_request, int on_web_socket_request, int on_web_socket_connected, int on_web_socket_message) {
 	// Casts to (void *) added to avoid warnings since Go callbacks can't have
 	// some modifiers, such as 'const' applied to their parameter signatures.
-	self->on_server_created = (void *)&gocef_server_handler_on_server_created;
-	self->on_server_destroyed = (void *)&gocef_server_handler_on_server_destroyed;
-	self->on_client_connected = (void *)&gocef_server_handler_on_client_connected;
-	self->on_client_disconnected = (void *)&gocef_server_handler_on_client_disconnected;
-	self->on_http_request = (void *)&gocef_server_handler_on_http_request;
-	self->on_web_socket_request = (void *)&gocef_server_handler_on_web_socket_request;
-	self->on_web_socket_connected = (void *)&gocef_server_handler_on_web_socket_connected;
-	self->on_web_socket_message = (void *)&gocef_server_handler_on_web_socket_message;
+	if (on_server_created) {
+		self->on_server_created = (void *)&gocef_server_handler_on_server_created;
+	}
+	if (on_server_destroyed) {
+		self->on_server_destroyed = (void *)&gocef_server_handler_on_server_destroyed;
+	}
+	if (on_client_connected) {
+		self->on_client_connected = (void *)&gocef_server_handler_on_client_connected;
+	}
+	if (on_client_disconnected) {
+		self->on_client_disconnected = (void *)&gocef_server_handler_on_client_disconnected;
+	}
+	if (on_http_request) {
+		self->on_http_request = (void *)&gocef_server_handler_on_http_request;
+	}
+	if (on_web_socket_request) {
+		self->on_web_socket_request = (void *)&gocef_server_handler_on_web_socket_request;
+	}
+	if (on_web_socket_connected) {
+		self->on_web_socket_connected = (void *)&gocef_server_handler_on_web_socket_connected;
+	}
+	if (on_web_socket_message) {
+		self->on_web_socket_message = (void *)&gocef_server_handler_on_web_socket_message;
+	}
 }
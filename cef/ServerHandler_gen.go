@@ -6,20 +6,58 @@ import (
 	// #include "ServerHandler_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// ServerHandlerProxy defines methods required for using ServerHandler.
+// ServerHandlerProxy is implemented by the proxies used with ServerHandler. Embed
+// DefaultServerHandler to satisfy it, then implement any of the optional
+// ServerHandler*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type ServerHandlerProxy interface {
+	isServerHandlerProxy()
+}
+
+// DefaultServerHandler may be embedded to satisfy ServerHandlerProxy.
+type DefaultServerHandler struct{}
+
+func (DefaultServerHandler) isServerHandlerProxy() {}
+
+// ServerHandlerOnServerCreatedProxy may be implemented by a ServerHandlerProxy to handle OnServerCreated.
+type ServerHandlerOnServerCreatedProxy interface {
 	OnServerCreated(self *ServerHandler, server *Server)
+}
+
+// ServerHandlerOnServerDestroyedProxy may be implemented by a ServerHandlerProxy to handle OnServerDestroyed.
+type ServerHandlerOnServerDestroyedProxy interface {
 	OnServerDestroyed(self *ServerHandler, server *Server)
+}
+
+// ServerHandlerOnClientConnectedProxy may be implemented by a ServerHandlerProxy to handle OnClientConnected.
+type ServerHandlerOnClientConnectedProxy interface {
 	OnClientConnected(self *ServerHandler, server *Server, connection_id int32)
+}
+
+// ServerHandlerOnClientDisconnectedProxy may be implemented by a ServerHandlerProxy to handle OnClientDisconnected.
+type ServerHandlerOnClientDisconnectedProxy interface {
 	OnClientDisconnected(self *ServerHandler, server *Server, connection_id int32)
+}
+
+// ServerHandlerOnHttpRequestProxy may be implemented by a ServerHandlerProxy to handle OnHttpRequest.
+type ServerHandlerOnHttpRequestProxy interface {
 	OnHttpRequest(self *ServerHandler, server *Server, connection_id int32, client_address string, request *Request)
+}
+
+// ServerHandlerOnWebSocketRequestProxy may be implemented by a ServerHandlerProxy to handle OnWebSocketRequest.
+type ServerHandlerOnWebSocketRequestProxy interface {
 	OnWebSocketRequest(self *ServerHandler, server *Server, connection_id int32, client_address string, request *Request, callback *Callback)
+}
+
+// ServerHandlerOnWebSocketConnectedProxy may be implemented by a ServerHandlerProxy to handle OnWebSocketConnected.
+type ServerHandlerOnWebSocketConnectedProxy interface {
 	OnWebSocketConnected(self *ServerHandler, server *Server, connection_id int32)
+}
+
+// ServerHandlerOnWebSocketMessageProxy may be implemented by a ServerHandlerProxy to handle OnWebSocketMessage.
+type ServerHandlerOnWebSocketMessageProxy interface {
 	OnWebSocketMessage(self *ServerHandler, server *Server, connection_id int32, data unsafe.Pointer, data_size uint64)
 }
 
@@ -37,7 +75,15 @@ type ServerHandler C.cef_server_handler_t
 func NewServerHandler(proxy ServerHandlerProxy) *ServerHandler {
 	result := (*ServerHandler)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_server_handler_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_server_handler_proxy(result.toNative())
+		_, on_server_created := proxy.(ServerHandlerOnServerCreatedProxy)
+		_, on_server_destroyed := proxy.(ServerHandlerOnServerDestroyedProxy)
+		_, on_client_connected := proxy.(ServerHandlerOnClientConnectedProxy)
+		_, on_client_disconnected := proxy.(ServerHandlerOnClientDisconnectedProxy)
+		_, on_http_request := proxy.(ServerHandlerOnHttpRequestProxy)
+		_, on_web_socket_request := proxy.(ServerHandlerOnWebSocketRequestProxy)
+		_, on_web_socket_connected := proxy.(ServerHandlerOnWebSocketConnectedProxy)
+		_, on_web_socket_message := proxy.(ServerHandlerOnWebSocketMessageProxy)
+		C.gocef_set_server_handler_proxy(result.toNative(), cefBool(on_server_created), cefBool(on_server_destroyed), cefBool(on_client_connected), cefBool(on_client_disconnected), cefBool(on_http_request), cefBool(on_web_socket_request), cefBool(on_web_socket_connected), cefBool(on_web_socket_message))
 	}
 	return result
 }
@@ -46,14 +92,15 @@ func (d *ServerHandler) toNative() *C.cef_server_handler_t {
 	return (*C.cef_server_handler_t)(d)
 }
 
-func lookupServerHandlerProxy(obj *BaseRefCounted) ServerHandlerProxy {
+func lookupServerHandlerProxy(obj *BaseRefCounted, callback string) ServerHandlerProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("ServerHandler", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(ServerHandlerProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type ServerHandlerProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("ServerHandler", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -71,13 +118,19 @@ func (d *ServerHandler) Base() *BaseRefCounted {
 // OnServerDestroyed will be called. If the server failed to start then
 // OnServerDestroyed will be called immediately after this function returns.
 func (d *ServerHandler) OnServerCreated(server *Server) {
-	lookupServerHandlerProxy(d.Base()).OnServerCreated(d, server)
+	if proxy__, ok__ := lookupServerHandlerProxy(d.Base(), "OnServerCreated").(ServerHandlerOnServerCreatedProxy); ok__ {
+		proxy__.OnServerCreated(d, server)
+	}
 }
 
 //export gocef_server_handler_on_server_created
 func gocef_server_handler_on_server_created(self *C.cef_server_handler_t, server *C.cef_server_t) {
+	defer recoverProxyPanic("ServerHandler", "OnServerCreated")
 	me__ := (*ServerHandler)(self)
-	proxy__ := lookupServerHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupServerHandlerProxy(me__.Base(), "OnServerCreated").(ServerHandlerOnServerCreatedProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnServerCreated(me__, (*Server)(server))
 }
 
@@ -87,13 +140,19 @@ func gocef_server_handler_on_server_created(self *C.cef_server_handler_t, server
 // when this function is called. See OnServerCreated documentation for a
 // description of server lifespan.
 func (d *ServerHandler) OnServerDestroyed(server *Server) {
-	lookupServerHandlerProxy(d.Base()).OnServerDestroyed(d, server)
+	if proxy__, ok__ := lookupServerHandlerProxy(d.Base(), "OnServerDestroyed").(ServerHandlerOnServerDestroyedProxy); ok__ {
+		proxy__.OnServerDestroyed(d, server)
+	}
 }
 
 //export gocef_server_handler_on_server_destroyed
 func gocef_server_handler_on_server_destroyed(self *C.cef_server_handler_t, server *C.cef_server_t) {
+	defer recoverProxyPanic("ServerHandler", "OnServerDestroyed")
 	me__ := (*ServerHandler)(self)
-	proxy__ := lookupServerHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupServerHandlerProxy(me__.Base(), "OnServerDestroyed").(ServerHandlerOnServerDestroyedProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnServerDestroyed(me__, (*Server)(server))
 }
 
@@ -102,13 +161,19 @@ func gocef_server_handler_on_server_destroyed(self *C.cef_server_handler_t, serv
 // identifies the connection. Each call to this function will have a matching
 // call to OnClientDisconnected.
 func (d *ServerHandler) OnClientConnected(server *Server, connection_id int32) {
-	lookupServerHandlerProxy(d.Base()).OnClientConnected(d, server, connection_id)
+	if proxy__, ok__ := lookupServerHandlerProxy(d.Base(), "OnClientConnected").(ServerHandlerOnClientConnectedProxy); ok__ {
+		proxy__.OnClientConnected(d, server, connection_id)
+	}
 }
 
 //export gocef_server_handler_on_client_connected
 func gocef_server_handler_on_client_connected(self *C.cef_server_handler_t, server *C.cef_server_t, connection_id C.int) {
+	defer recoverProxyPanic("ServerHandler", "OnClientConnected")
 	me__ := (*ServerHandler)(self)
-	proxy__ := lookupServerHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupServerHandlerProxy(me__.Base(), "OnClientConnected").(ServerHandlerOnClientConnectedProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnClientConnected(me__, (*Server)(server), int32(connection_id))
 }
 
@@ -121,13 +186,19 @@ func gocef_server_handler_on_client_connected(self *C.cef_server_handler_t, serv
 // will disconnect automatically after a cef_server_t::SendHttpXXXResponse
 // function is called.
 func (d *ServerHandler) OnClientDisconnected(server *Server, connection_id int32) {
-	lookupServerHandlerProxy(d.Base()).OnClientDisconnected(d, server, connection_id)
+	if proxy__, ok__ := lookupServerHandlerProxy(d.Base(), "OnClientDisconnected").(ServerHandlerOnClientDisconnectedProxy); ok__ {
+		proxy__.OnClientDisconnected(d, server, connection_id)
+	}
 }
 
 //export gocef_server_handler_on_client_disconnected
 func gocef_server_handler_on_client_disconnected(self *C.cef_server_handler_t, server *C.cef_server_t, connection_id C.int) {
+	defer recoverProxyPanic("ServerHandler", "OnClientDisconnected")
 	me__ := (*ServerHandler)(self)
-	proxy__ := lookupServerHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupServerHandlerProxy(me__.Base(), "OnClientDisconnected").(ServerHandlerOnClientDisconnectedProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnClientDisconnected(me__, (*Server)(server), int32(connection_id))
 }
 
@@ -138,13 +209,19 @@ func gocef_server_handler_on_client_disconnected(self *C.cef_server_handler_t, s
 // contents (URL, function, headers and optional POST data). Call cef_server_t
 // functions either synchronously or asynchronusly to send a response.
 func (d *ServerHandler) OnHttpRequest(server *Server, connection_id int32, client_address string, request *Request) {
-	lookupServerHandlerProxy(d.Base()).OnHttpRequest(d, server, connection_id, client_address, request)
+	if proxy__, ok__ := lookupServerHandlerProxy(d.Base(), "OnHttpRequest").(ServerHandlerOnHttpRequestProxy); ok__ {
+		proxy__.OnHttpRequest(d, server, connection_id, client_address, request)
+	}
 }
 
 //export gocef_server_handler_on_http_request
 func gocef_server_handler_on_http_request(self *C.cef_server_handler_t, server *C.cef_server_t, connection_id C.int, client_address *C.cef_string_t, request *C.cef_request_t) {
+	defer recoverProxyPanic("ServerHandler", "OnHttpRequest")
 	me__ := (*ServerHandler)(self)
-	proxy__ := lookupServerHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupServerHandlerProxy(me__.Base(), "OnHttpRequest").(ServerHandlerOnHttpRequestProxy)
+	if !ok__ {
+		return
+	}
 	client_address_ := cefstrToString(client_address)
 	proxy__.OnHttpRequest(me__, (*Server)(server), int32(connection_id), client_address_, (*Request)(request))
 }
@@ -163,13 +240,19 @@ func gocef_server_handler_on_http_request(self *C.cef_server_handler_t, server *
 // receiving the OnWebSocketConnected callback to respond with WebSocket
 // messages.
 func (d *ServerHandler) OnWebSocketRequest(server *Server, connection_id int32, client_address string, request *Request, callback *Callback) {
-	lookupServerHandlerProxy(d.Base()).OnWebSocketRequest(d, server, connection_id, client_address, request, callback)
+	if proxy__, ok__ := lookupServerHandlerProxy(d.Base(), "OnWebSocketRequest").(ServerHandlerOnWebSocketRequestProxy); ok__ {
+		proxy__.OnWebSocketRequest(d, server, connection_id, client_address, request, callback)
+	}
 }
 
 //export gocef_server_handler_on_web_socket_request
 func gocef_server_handler_on_web_socket_request(self *C.cef_server_handler_t, server *C.cef_server_t, connection_id C.int, client_address *C.cef_string_t, request *C.cef_request_t, callback *C.cef_callback_t) {
+	defer recoverProxyPanic("ServerHandler", "OnWebSocketRequest")
 	me__ := (*ServerHandler)(self)
-	proxy__ := lookupServerHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupServerHandlerProxy(me__.Base(), "OnWebSocketRequest").(ServerHandlerOnWebSocketRequestProxy)
+	if !ok__ {
+		return
+	}
 	client_address_ := cefstrToString(client_address)
 	proxy__.OnWebSocketRequest(me__, (*Server)(server), int32(connection_id), client_address_, (*Request)(request), (*Callback)(callback))
 }
@@ -179,13 +262,19 @@ func gocef_server_handler_on_web_socket_request(self *C.cef_server_handler_t, se
 // and |connection_id| via the OnWebSocketRequest callback. See
 // OnWebSocketRequest documentation for intended usage.
 func (d *ServerHandler) OnWebSocketConnected(server *Server, connection_id int32) {
-	lookupServerHandlerProxy(d.Base()).OnWebSocketConnected(d, server, connection_id)
+	if proxy__, ok__ := lookupServerHandlerProxy(d.Base(), "OnWebSocketConnected").(ServerHandlerOnWebSocketConnectedProxy); ok__ {
+		proxy__.OnWebSocketConnected(d, server, connection_id)
+	}
 }
 
 //export gocef_server_handler_on_web_socket_connected
 func gocef_server_handler_on_web_socket_connected(self *C.cef_server_handler_t, server *C.cef_server_t, connection_id C.int) {
+	defer recoverProxyPanic("ServerHandler", "OnWebSocketConnected")
 	me__ := (*ServerHandler)(self)
-	proxy__ := lookupServerHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupServerHandlerProxy(me__.Base(), "OnWebSocketConnected").(ServerHandlerOnWebSocketConnectedProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnWebSocketConnected(me__, (*Server)(server), int32(connection_id))
 }
 
@@ -196,12 +285,18 @@ func gocef_server_handler_on_web_socket_connected(self *C.cef_server_handler_t,
 // |data| outside of this function. See OnWebSocketRequest documentation for
 // intended usage.
 func (d *ServerHandler) OnWebSocketMessage(server *Server, connection_id int32, data unsafe.Pointer, data_size uint64) {
-	lookupServerHandlerProxy(d.Base()).OnWebSocketMessage(d, server, connection_id, data, data_size)
+	if proxy__, ok__ := lookupServerHandlerProxy(d.Base(), "OnWebSocketMessage").(ServerHandlerOnWebSocketMessageProxy); ok__ {
+		proxy__.OnWebSocketMessage(d, server, connection_id, data, data_size)
+	}
 }
 
 //export gocef_server_handler_on_web_socket_message
 func gocef_server_handler_on_web_socket_message(self *C.cef_server_handler_t, server *C.cef_server_t, connection_id C.int, data unsafe.Pointer, data_size C.size_t) {
+	defer recoverProxyPanic("ServerHandler", "OnWebSocketMessage")
 	me__ := (*ServerHandler)(self)
-	proxy__ := lookupServerHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupServerHandlerProxy(me__.Base(), "OnWebSocketMessage").(ServerHandlerOnWebSocketMessageProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnWebSocketMessage(me__, (*Server)(server), int32(connection_id), data, uint64(data_size))
 }
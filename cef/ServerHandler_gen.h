@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_server_handler_proxy(cef_server_handler_t *self);
+void gocef_set_server_handler_proxy(cef_server_handler_t *self, int on_server_created, int on_server_destroyed, int on_client_connected, int on_client_disconnected, int on_http_request, int on_web_socket_request, int on_web_socket_connected, int on_web_socket_message);
 
 #endif // GOCEF_ServerHandler_H_
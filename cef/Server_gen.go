@@ -41,6 +41,7 @@ func (d *Server) Base() *BaseRefCounted {
 
 // GetTaskRunner (get_task_runner)
 // Returns the task runner for the dedicated server thread.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *Server) GetTaskRunner() *TaskRunner {
 	return (*TaskRunner)(C.gocef_server_get_task_runner(d.toNative(), d.get_task_runner))
 }
@@ -58,8 +59,8 @@ func (d *Server) Shutdown() {
 // connections. See cef_server_handler_t::OnServerCreated documentation for a
 // description of server lifespan. This function must be called on the
 // dedicated server thread.
-func (d *Server) IsRunning() int32 {
-	return int32(C.gocef_server_is_running(d.toNative(), d.is_running))
+func (d *Server) IsRunning() bool {
+	return C.gocef_server_is_running(d.toNative(), d.is_running) != 0
 }
 
 // GetAddress (get_address)
@@ -72,15 +73,15 @@ func (d *Server) GetAddress() string {
 // HasConnection (has_connection)
 // Returns true (1) if the server currently has a connection. This function
 // must be called on the dedicated server thread.
-func (d *Server) HasConnection() int32 {
-	return int32(C.gocef_server_has_connection(d.toNative(), d.has_connection))
+func (d *Server) HasConnection() bool {
+	return C.gocef_server_has_connection(d.toNative(), d.has_connection) != 0
 }
 
 // IsValidConnection (is_valid_connection)
 // Returns true (1) if |connection_id| represents a valid connection. This
 // function must be called on the dedicated server thread.
-func (d *Server) IsValidConnection(connection_id int32) int32 {
-	return int32(C.gocef_server_is_valid_connection(d.toNative(), C.int(connection_id), d.is_valid_connection))
+func (d *Server) IsValidConnection(connection_id int32) bool {
+	return C.gocef_server_is_valid_connection(d.toNative(), C.int(connection_id), d.is_valid_connection) != 0
 }
 
 // SendHttp200response (send_http200response)
@@ -133,13 +134,15 @@ func (d *Server) SendHttp500response(connection_id int32, error_message string)
 // the client will continue reading until the connection is closed. Use the
 // SendRawData function to send the content, if applicable, and call
 // CloseConnection after all content has been sent.
-func (d *Server) SendHttpResponse(connection_id, response_code int32, content_type string, content_length int64, extra_headers StringMultimap) {
+func (d *Server) SendHttpResponse(connection_id, response_code int32, content_type string, content_length int64, extra_headers map[string][]string) {
 	content_type_ := C.cef_string_userfree_alloc()
 	setCEFStr(content_type, content_type_)
 	defer func() {
 		C.cef_string_userfree_free(content_type_)
 	}()
-	C.gocef_server_send_http_response(d.toNative(), C.int(connection_id), C.int(response_code), (*C.cef_string_t)(content_type_), C.int64(content_length), C.cef_string_multimap_t(extra_headers), d.send_http_response)
+	extra_headers_ := newCEFStringMultimap(extra_headers)
+	defer C.cef_string_multimap_free(extra_headers_)
+	C.gocef_server_send_http_response(d.toNative(), C.int(connection_id), C.int(response_code), (*C.cef_string_t)(content_type_), C.int64(content_length), extra_headers_, d.send_http_response)
 }
 
 // SendRawData (send_raw_data)
@@ -6,14 +6,24 @@ import (
 	// #include "SetCookieCallback_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// SetCookieCallbackProxy defines methods required for using SetCookieCallback.
+// SetCookieCallbackProxy is implemented by the proxies used with SetCookieCallback. Embed
+// DefaultSetCookieCallback to satisfy it, then implement any of the optional
+// SetCookieCallback*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type SetCookieCallbackProxy interface {
-	OnComplete(self *SetCookieCallback, success int32)
+	isSetCookieCallbackProxy()
+}
+
+// DefaultSetCookieCallback may be embedded to satisfy SetCookieCallbackProxy.
+type DefaultSetCookieCallback struct{}
+
+func (DefaultSetCookieCallback) isSetCookieCallbackProxy() {}
+
+// SetCookieCallbackOnCompleteProxy may be implemented by a SetCookieCallbackProxy to handle OnComplete.
+type SetCookieCallbackOnCompleteProxy interface {
+	OnComplete(self *SetCookieCallback, success bool)
 }
 
 // SetCookieCallback (cef_set_cookie_callback_t from include/capi/cef_cookie_capi.h)
@@ -26,7 +36,8 @@ type SetCookieCallback C.cef_set_cookie_callback_t
 func NewSetCookieCallback(proxy SetCookieCallbackProxy) *SetCookieCallback {
 	result := (*SetCookieCallback)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_set_cookie_callback_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_set_cookie_callback_proxy(result.toNative())
+		_, on_complete := proxy.(SetCookieCallbackOnCompleteProxy)
+		C.gocef_set_set_cookie_callback_proxy(result.toNative(), cefBool(on_complete))
 	}
 	return result
 }
@@ -35,14 +46,15 @@ func (d *SetCookieCallback) toNative() *C.cef_set_cookie_callback_t {
 	return (*C.cef_set_cookie_callback_t)(d)
 }
 
-func lookupSetCookieCallbackProxy(obj *BaseRefCounted) SetCookieCallbackProxy {
+func lookupSetCookieCallbackProxy(obj *BaseRefCounted, callback string) SetCookieCallbackProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("SetCookieCallback", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(SetCookieCallbackProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type SetCookieCallbackProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("SetCookieCallback", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -56,13 +68,19 @@ func (d *SetCookieCallback) Base() *BaseRefCounted {
 // OnComplete (on_complete)
 // Method that will be called upon completion. |success| will be true (1) if
 // the cookie was set successfully.
-func (d *SetCookieCallback) OnComplete(success int32) {
-	lookupSetCookieCallbackProxy(d.Base()).OnComplete(d, success)
+func (d *SetCookieCallback) OnComplete(success bool) {
+	if proxy__, ok__ := lookupSetCookieCallbackProxy(d.Base(), "OnComplete").(SetCookieCallbackOnCompleteProxy); ok__ {
+		proxy__.OnComplete(d, success)
+	}
 }
 
 //export gocef_set_cookie_callback_on_complete
 func gocef_set_cookie_callback_on_complete(self *C.cef_set_cookie_callback_t, success C.int) {
+	defer recoverProxyPanic("SetCookieCallback", "OnComplete")
 	me__ := (*SetCookieCallback)(self)
-	proxy__ := lookupSetCookieCallbackProxy(me__.Base())
-	proxy__.OnComplete(me__, int32(success))
+	proxy__, ok__ := lookupSetCookieCallbackProxy(me__.Base(), "OnComplete").(SetCookieCallbackOnCompleteProxy)
+	if !ok__ {
+		return
+	}
+	proxy__.OnComplete(me__, success != 0)
 }
@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_set_cookie_callback_proxy(cef_set_cookie_callback_t *self);
+void gocef_set_set_cookie_callback_proxy(cef_set_cookie_callback_t *self, int on_complete);
 
 #endif // GOCEF_SetCookieCallback_H_
@@ -22,7 +22,7 @@ type Settings struct {
 	// Set to true (1) to disable the sandbox for sub-processes. See
 	// cef_sandbox_win.h for requirements to enable the sandbox on Windows. Also
 	// configurable using the "no-sandbox" command-line switch.
-	NoSandbox int32
+	NoSandbox bool
 	// BrowserSubprocessPath (browser_subprocess_path)
 	// The path to a separate executable that will be launched for sub-processes.
 	// If this value is empty on Windows or Linux then the main process executable
@@ -43,7 +43,7 @@ type Settings struct {
 	// thread. If false (0) than the CefDoMessageLoopWork() function must be
 	// called from your application message loop. This option is only supported on
 	// Windows and Linux.
-	MultiThreadedMessageLoop int32
+	MultiThreadedMessageLoop bool
 	// ExternalMessagePump (external_message_pump)
 	// Set to true (1) to control browser process main (UI) thread message pump
 	// scheduling via the CefBrowserProcessHandler::OnScheduleMessagePumpWork()
@@ -53,18 +53,18 @@ type Settings struct {
 	// comments and warnings on CefDoMessageLoopWork). Enabling this option is not
 	// recommended for most users; leave this option disabled and use either the
 	// CefRunMessageLoop() function or multi_threaded_message_loop if possible.
-	ExternalMessagePump int32
+	ExternalMessagePump bool
 	// WindowlessRenderingEnabled (windowless_rendering_enabled)
 	// Set to true (1) to enable windowless (off-screen) rendering support. Do not
 	// enable this value if the application does not use windowless rendering as
 	// it may reduce rendering performance on some systems.
-	WindowlessRenderingEnabled int32
+	WindowlessRenderingEnabled bool
 	// CommandLineArgsDisabled (command_line_args_disabled)
 	// Set to true (1) to disable configuration of browser process features using
 	// standard CEF and Chromium command-line arguments. Configuration can still
 	// be specified using CEF data structures or via the
 	// CefApp::OnBeforeCommandLineProcessing() method.
-	CommandLineArgsDisabled int32
+	CommandLineArgsDisabled bool
 	// CachePath (cache_path)
 	// The location where cache data will be stored on disk. If empty then
 	// browsers will be created in "incognito mode" where in-memory caches are
@@ -90,7 +90,7 @@ type Settings struct {
 	// "persist-session-cookies" command-line switch. Can be overridden for
 	// individual CefRequestContext instances via the
 	// CefRequestContextSettings.persist_session_cookies value.
-	PersistSessionCookies int32
+	PersistSessionCookies bool
 	// PersistUserPreferences (persist_user_preferences)
 	// To persist user preferences as a JSON file in the cache path directory set
 	// this value to true (1). A |cache_path| value must also be specified
@@ -98,7 +98,7 @@ type Settings struct {
 	// "persist-user-preferences" command-line switch. Can be overridden for
 	// individual CefRequestContext instances via the
 	// CefRequestContextSettings.persist_user_preferences value.
-	PersistUserPreferences int32
+	PersistUserPreferences bool
 	// UserAgent (user_agent)
 	// Value that will be returned as the User-Agent HTTP header. If empty the
 	// default User-Agent string will be used. Also configurable using the
@@ -157,7 +157,7 @@ type Settings struct {
 	// processes via CefApp::GetResourceBundleHandler() if loading of pack files
 	// is disabled. Also configurable using the "disable-pack-loading" command-
 	// line switch.
-	PackLoadingDisabled int32
+	PackLoadingDisabled bool
 	// RemoteDebuggingPort (remote_debugging_port)
 	// Set to a value between 1024 and 65535 to enable remote debugging on the
 	// specified port. For example, if 8080 is specified the remote debugging URL
@@ -180,7 +180,7 @@ type Settings struct {
 	// "ignore-certificate-errors" command-line switch. Can be overridden for
 	// individual CefRequestContext instances via the
 	// CefRequestContextSettings.ignore_certificate_errors value.
-	IgnoreCertificateErrors int32
+	IgnoreCertificateErrors bool
 	// EnableNetSecurityExpiration (enable_net_security_expiration)
 	// Set to true (1) to enable date-based expiration of built in network
 	// security information (i.e. certificate transparency logs, HSTS preloading
@@ -191,7 +191,7 @@ type Settings struct {
 	// "enable-net-security-expiration" command-line switch. Can be overridden for
 	// individual CefRequestContext instances via the
 	// CefRequestContextSettings.enable_net_security_expiration value.
-	EnableNetSecurityExpiration int32
+	EnableNetSecurityExpiration bool
 	// BackgroundColor (background_color)
 	// Background color used for the browser before a document is loaded and when
 	// no document color is specified. The alpha component must be either fully
@@ -216,8 +216,8 @@ type Settings struct {
 func NewSettings() *Settings {
 	return &Settings{
 		Size:                    C.sizeof_struct__cef_settings_t,
-		NoSandbox:               1,
-		CommandLineArgsDisabled: 1,
+		NoSandbox:               true,
+		CommandLineArgsDisabled: true,
 		LogSeverity:             LogseverityWarning,
 		CachePath:               filepath.Join(paths.AppDataDir(), "cache"),
 		UserDataPath:            filepath.Join(paths.AppDataDir(), "data"),
@@ -230,17 +230,17 @@ func (d *Settings) toNative(native *C.cef_settings_t) *C.cef_settings_t {
 		return nil
 	}
 	native.size = C.size_t(d.Size)
-	native.no_sandbox = C.int(d.NoSandbox)
+	native.no_sandbox = cefBool(d.NoSandbox)
 	setCEFStr(d.BrowserSubprocessPath, &native.browser_subprocess_path)
 	setCEFStr(d.FrameworkDirPath, &native.framework_dir_path)
-	native.multi_threaded_message_loop = C.int(d.MultiThreadedMessageLoop)
-	native.external_message_pump = C.int(d.ExternalMessagePump)
-	native.windowless_rendering_enabled = C.int(d.WindowlessRenderingEnabled)
-	native.command_line_args_disabled = C.int(d.CommandLineArgsDisabled)
+	native.multi_threaded_message_loop = cefBool(d.MultiThreadedMessageLoop)
+	native.external_message_pump = cefBool(d.ExternalMessagePump)
+	native.windowless_rendering_enabled = cefBool(d.WindowlessRenderingEnabled)
+	native.command_line_args_disabled = cefBool(d.CommandLineArgsDisabled)
 	setCEFStr(d.CachePath, &native.cache_path)
 	setCEFStr(d.UserDataPath, &native.user_data_path)
-	native.persist_session_cookies = C.int(d.PersistSessionCookies)
-	native.persist_user_preferences = C.int(d.PersistUserPreferences)
+	native.persist_session_cookies = cefBool(d.PersistSessionCookies)
+	native.persist_user_preferences = cefBool(d.PersistUserPreferences)
 	setCEFStr(d.UserAgent, &native.user_agent)
 	setCEFStr(d.ProductVersion, &native.product_version)
 	setCEFStr(d.Locale, &native.locale)
@@ -249,11 +249,11 @@ func (d *Settings) toNative(native *C.cef_settings_t) *C.cef_settings_t {
 	setCEFStr(d.JavascriptFlags, &native.javascript_flags)
 	setCEFStr(d.ResourcesDirPath, &native.resources_dir_path)
 	setCEFStr(d.LocalesDirPath, &native.locales_dir_path)
-	native.pack_loading_disabled = C.int(d.PackLoadingDisabled)
+	native.pack_loading_disabled = cefBool(d.PackLoadingDisabled)
 	native.remote_debugging_port = C.int(d.RemoteDebuggingPort)
 	native.uncaught_exception_stack_size = C.int(d.UncaughtExceptionStackSize)
-	native.ignore_certificate_errors = C.int(d.IgnoreCertificateErrors)
-	native.enable_net_security_expiration = C.int(d.EnableNetSecurityExpiration)
+	native.ignore_certificate_errors = cefBool(d.IgnoreCertificateErrors)
+	native.enable_net_security_expiration = cefBool(d.EnableNetSecurityExpiration)
 	native.background_color = C.cef_color_t(d.BackgroundColor)
 	setCEFStr(d.AcceptLanguageList, &native.accept_language_list)
 	return native
@@ -270,17 +270,17 @@ func (n *C.cef_settings_t) toGo() *Settings {
 
 func (n *C.cef_settings_t) intoGo(d *Settings) {
 	d.Size = uint64(n.size)
-	d.NoSandbox = int32(n.no_sandbox)
+	d.NoSandbox = n.no_sandbox != 0
 	d.BrowserSubprocessPath = cefstrToString(&n.browser_subprocess_path)
 	d.FrameworkDirPath = cefstrToString(&n.framework_dir_path)
-	d.MultiThreadedMessageLoop = int32(n.multi_threaded_message_loop)
-	d.ExternalMessagePump = int32(n.external_message_pump)
-	d.WindowlessRenderingEnabled = int32(n.windowless_rendering_enabled)
-	d.CommandLineArgsDisabled = int32(n.command_line_args_disabled)
+	d.MultiThreadedMessageLoop = n.multi_threaded_message_loop != 0
+	d.ExternalMessagePump = n.external_message_pump != 0
+	d.WindowlessRenderingEnabled = n.windowless_rendering_enabled != 0
+	d.CommandLineArgsDisabled = n.command_line_args_disabled != 0
 	d.CachePath = cefstrToString(&n.cache_path)
 	d.UserDataPath = cefstrToString(&n.user_data_path)
-	d.PersistSessionCookies = int32(n.persist_session_cookies)
-	d.PersistUserPreferences = int32(n.persist_user_preferences)
+	d.PersistSessionCookies = n.persist_session_cookies != 0
+	d.PersistUserPreferences = n.persist_user_preferences != 0
 	d.UserAgent = cefstrToString(&n.user_agent)
 	d.ProductVersion = cefstrToString(&n.product_version)
 	d.Locale = cefstrToString(&n.locale)
@@ -289,11 +289,11 @@ func (n *C.cef_settings_t) intoGo(d *Settings) {
 	d.JavascriptFlags = cefstrToString(&n.javascript_flags)
 	d.ResourcesDirPath = cefstrToString(&n.resources_dir_path)
 	d.LocalesDirPath = cefstrToString(&n.locales_dir_path)
-	d.PackLoadingDisabled = int32(n.pack_loading_disabled)
+	d.PackLoadingDisabled = n.pack_loading_disabled != 0
 	d.RemoteDebuggingPort = int32(n.remote_debugging_port)
 	d.UncaughtExceptionStackSize = int32(n.uncaught_exception_stack_size)
-	d.IgnoreCertificateErrors = int32(n.ignore_certificate_errors)
-	d.EnableNetSecurityExpiration = int32(n.enable_net_security_expiration)
+	d.IgnoreCertificateErrors = n.ignore_certificate_errors != 0
+	d.EnableNetSecurityExpiration = n.enable_net_security_expiration != 0
 	d.BackgroundColor = Color(n.background_color)
 	d.AcceptLanguageList = cefstrToString(&n.accept_language_list)
 }
@@ -32,6 +32,7 @@ func (d *Sslinfo) GetCertStatus() CertStatus {
 
 // GetX509certificate (get_x509certificate)
 // Returns the X.509 certificate.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *Sslinfo) GetX509certificate() *X509certificate {
 	return (*X509certificate)(C.gocef_sslinfo_get_x509certificate(d.toNative(), d.get_x509certificate))
 }
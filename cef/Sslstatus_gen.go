@@ -28,8 +28,8 @@ func (d *Sslstatus) Base() *BaseRefCounted {
 
 // IsSecureConnection (is_secure_connection)
 // Returns true (1) if the status is related to a secure SSL/TLS connection.
-func (d *Sslstatus) IsSecureConnection() int32 {
-	return int32(C.gocef_sslstatus_is_secure_connection(d.toNative(), d.is_secure_connection))
+func (d *Sslstatus) IsSecureConnection() bool {
+	return C.gocef_sslstatus_is_secure_connection(d.toNative(), d.is_secure_connection) != 0
 }
 
 // GetCertStatus (get_cert_status)
@@ -53,6 +53,7 @@ func (d *Sslstatus) GetContentStatus() SSLContentStatus {
 
 // GetX509certificate (get_x509certificate)
 // Returns the X.509 certificate.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *Sslstatus) GetX509certificate() *X509certificate {
 	return (*X509certificate)(C.gocef_sslstatus_get_x509certificate(d.toNative(), d.get_x509certificate))
 }
@@ -57,6 +57,6 @@ func (d *StreamReader) Eof() int32 {
 // Returns true (1) if this reader performs work like accessing the file
 // system which may block. Used as a hint for determining the thread to access
 // the reader from.
-func (d *StreamReader) MayBlock() int32 {
-	return int32(C.gocef_stream_reader_may_block(d.toNative(), d.may_block))
+func (d *StreamReader) MayBlock() bool {
+	return C.gocef_stream_reader_may_block(d.toNative(), d.may_block) != 0
 }
@@ -57,6 +57,6 @@ func (d *StreamWriter) Flush() int32 {
 // Returns true (1) if this writer performs work like accessing the file
 // system which may block. Used as a hint for determining the thread to access
 // the writer from.
-func (d *StreamWriter) MayBlock() int32 {
-	return int32(C.gocef_stream_writer_may_block(d.toNative(), d.may_block))
+func (d *StreamWriter) MayBlock() bool {
+	return C.gocef_stream_writer_may_block(d.toNative(), d.may_block) != 0
 }
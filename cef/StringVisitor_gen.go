@@ -6,13 +6,23 @@ import (
 	// #include "StringVisitor_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// StringVisitorProxy defines methods required for using StringVisitor.
+// StringVisitorProxy is implemented by the proxies used with StringVisitor. Embed
+// DefaultStringVisitor to satisfy it, then implement any of the optional
+// StringVisitor*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type StringVisitorProxy interface {
+	isStringVisitorProxy()
+}
+
+// DefaultStringVisitor may be embedded to satisfy StringVisitorProxy.
+type DefaultStringVisitor struct{}
+
+func (DefaultStringVisitor) isStringVisitorProxy() {}
+
+// StringVisitorVisitProxy may be implemented by a StringVisitorProxy to handle Visit.
+type StringVisitorVisitProxy interface {
 	Visit(self *StringVisitor, string_r string)
 }
 
@@ -25,7 +35,8 @@ type StringVisitor C.cef_string_visitor_t
 func NewStringVisitor(proxy StringVisitorProxy) *StringVisitor {
 	result := (*StringVisitor)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_string_visitor_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_string_visitor_proxy(result.toNative())
+		_, visit := proxy.(StringVisitorVisitProxy)
+		C.gocef_set_string_visitor_proxy(result.toNative(), cefBool(visit))
 	}
 	return result
 }
@@ -34,14 +45,15 @@ func (d *StringVisitor) toNative() *C.cef_string_visitor_t {
 	return (*C.cef_string_visitor_t)(d)
 }
 
-func lookupStringVisitorProxy(obj *BaseRefCounted) StringVisitorProxy {
+func lookupStringVisitorProxy(obj *BaseRefCounted, callback string) StringVisitorProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("StringVisitor", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(StringVisitorProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type StringVisitorProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("StringVisitor", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -55,13 +67,19 @@ func (d *StringVisitor) Base() *BaseRefCounted {
 // Visit (visit)
 // Method that will be executed.
 func (d *StringVisitor) Visit(string_r string) {
-	lookupStringVisitorProxy(d.Base()).Visit(d, string_r)
+	if proxy__, ok__ := lookupStringVisitorProxy(d.Base(), "Visit").(StringVisitorVisitProxy); ok__ {
+		proxy__.Visit(d, string_r)
+	}
 }
 
 //export gocef_string_visitor_visit
 func gocef_string_visitor_visit(self *C.cef_string_visitor_t, string_r *C.cef_string_t) {
+	defer recoverProxyPanic("StringVisitor", "Visit")
 	me__ := (*StringVisitor)(self)
-	proxy__ := lookupStringVisitorProxy(me__.Base())
+	proxy__, ok__ := lookupStringVisitorProxy(me__.Base(), "Visit").(StringVisitorVisitProxy)
+	if !ok__ {
+		return
+	}
 	string_r_ := cefstrToString(string_r)
 	proxy__.Visit(me__, string_r_)
 }
@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_string_visitor_proxy(cef_string_visitor_t *self);
+void gocef_set_string_visitor_proxy(cef_string_visitor_t *self, int visit);
 
 #endif // GOCEF_StringVisitor_H_
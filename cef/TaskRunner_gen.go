@@ -35,27 +35,27 @@ func (d *TaskRunner) Base() *BaseRefCounted {
 // IsSame (is_same)
 // Returns true (1) if this object is pointing to the same task runner as
 // |that| object.
-func (d *TaskRunner) IsSame(that *TaskRunner) int32 {
-	return int32(C.gocef_task_runner_is_same(d.toNative(), that.toNative(), d.is_same))
+func (d *TaskRunner) IsSame(that *TaskRunner) bool {
+	return C.gocef_task_runner_is_same(d.toNative(), that.toNative(), d.is_same) != 0
 }
 
 // BelongsToCurrentThread (belongs_to_current_thread)
 // Returns true (1) if this task runner belongs to the current thread.
-func (d *TaskRunner) BelongsToCurrentThread() int32 {
-	return int32(C.gocef_task_runner_belongs_to_current_thread(d.toNative(), d.belongs_to_current_thread))
+func (d *TaskRunner) BelongsToCurrentThread() bool {
+	return C.gocef_task_runner_belongs_to_current_thread(d.toNative(), d.belongs_to_current_thread) != 0
 }
 
 // BelongsToThread (belongs_to_thread)
 // Returns true (1) if this task runner is for the specified CEF thread.
-func (d *TaskRunner) BelongsToThread(threadId ThreadID) int32 {
-	return int32(C.gocef_task_runner_belongs_to_thread(d.toNative(), C.cef_thread_id_t(threadId), d.belongs_to_thread))
+func (d *TaskRunner) BelongsToThread(threadId ThreadID) bool {
+	return C.gocef_task_runner_belongs_to_thread(d.toNative(), C.cef_thread_id_t(threadId), d.belongs_to_thread) != 0
 }
 
 // PostTask (post_task)
 // Post a task for execution on the thread associated with this task runner.
 // Execution will occur asynchronously.
-func (d *TaskRunner) PostTask(task *Task) int32 {
-	return int32(C.gocef_task_runner_post_task(d.toNative(), task.toNative(), d.post_task))
+func (d *TaskRunner) PostTask(task *Task) bool {
+	return C.gocef_task_runner_post_task(d.toNative(), task.toNative(), d.post_task) != 0
 }
 
 // PostDelayedTask (post_delayed_task)
@@ -63,6 +63,6 @@ func (d *TaskRunner) PostTask(task *Task) int32 {
 // runner. Execution will occur asynchronously. Delayed tasks are not
 // supported on V8 WebWorker threads and will be executed without the
 // specified delay.
-func (d *TaskRunner) PostDelayedTask(task *Task, delay_ms int64) int32 {
-	return int32(C.gocef_task_runner_post_delayed_task(d.toNative(), task.toNative(), C.int64(delay_ms), d.post_delayed_task))
+func (d *TaskRunner) PostDelayedTask(task *Task, delay_ms int64) bool {
+	return C.gocef_task_runner_post_delayed_task(d.toNative(), task.toNative(), C.int64(delay_ms), d.post_delayed_task) != 0
 }
@@ -6,13 +6,23 @@ import (
 	// #include "Task_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// TaskProxy defines methods required for using Task.
+// TaskProxy is implemented by the proxies used with Task. Embed
+// DefaultTask to satisfy it, then implement any of the optional
+// Task*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type TaskProxy interface {
+	isTaskProxy()
+}
+
+// DefaultTask may be embedded to satisfy TaskProxy.
+type DefaultTask struct{}
+
+func (DefaultTask) isTaskProxy() {}
+
+// TaskExecuteProxy may be implemented by a TaskProxy to handle Execute.
+type TaskExecuteProxy interface {
 	Execute(self *Task)
 }
 
@@ -30,7 +40,8 @@ type Task C.cef_task_t
 func NewTask(proxy TaskProxy) *Task {
 	result := (*Task)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_task_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_task_proxy(result.toNative())
+		_, execute := proxy.(TaskExecuteProxy)
+		C.gocef_set_task_proxy(result.toNative(), cefBool(execute))
 	}
 	return result
 }
@@ -39,14 +50,15 @@ func (d *Task) toNative() *C.cef_task_t {
 	return (*C.cef_task_t)(d)
 }
 
-func lookupTaskProxy(obj *BaseRefCounted) TaskProxy {
+func lookupTaskProxy(obj *BaseRefCounted, callback string) TaskProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("Task", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(TaskProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type TaskProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("Task", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -60,12 +72,18 @@ func (d *Task) Base() *BaseRefCounted {
 // Execute (execute)
 // Method that will be executed on the target thread.
 func (d *Task) Execute() {
-	lookupTaskProxy(d.Base()).Execute(d)
+	if proxy__, ok__ := lookupTaskProxy(d.Base(), "Execute").(TaskExecuteProxy); ok__ {
+		proxy__.Execute(d)
+	}
 }
 
 //export gocef_task_execute
 func gocef_task_execute(self *C.cef_task_t) {
+	defer recoverProxyPanic("Task", "Execute")
 	me__ := (*Task)(self)
-	proxy__ := lookupTaskProxy(me__.Base())
+	proxy__, ok__ := lookupTaskProxy(me__.Base(), "Execute").(TaskExecuteProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.Execute(me__)
 }
@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_task_proxy(cef_task_t *self);
+void gocef_set_task_proxy(cef_task_t *self, int execute);
 
 #endif // GOCEF_Task_H_
@@ -6,14 +6,28 @@ import (
 	// #include "TextfieldDelegate_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// TextfieldDelegateProxy defines methods required for using TextfieldDelegate.
+// TextfieldDelegateProxy is implemented by the proxies used with TextfieldDelegate. Embed
+// DefaultTextfieldDelegate to satisfy it, then implement any of the optional
+// TextfieldDelegate*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type TextfieldDelegateProxy interface {
-	OnKeyEvent(self *TextfieldDelegate, textfield *Textfield, event *KeyEvent) int32
+	isTextfieldDelegateProxy()
+}
+
+// DefaultTextfieldDelegate may be embedded to satisfy TextfieldDelegateProxy.
+type DefaultTextfieldDelegate struct{}
+
+func (DefaultTextfieldDelegate) isTextfieldDelegateProxy() {}
+
+// TextfieldDelegateOnKeyEventProxy may be implemented by a TextfieldDelegateProxy to handle OnKeyEvent.
+type TextfieldDelegateOnKeyEventProxy interface {
+	OnKeyEvent(self *TextfieldDelegate, textfield *Textfield, event *KeyEvent) bool
+}
+
+// TextfieldDelegateOnAfterUserActionProxy may be implemented by a TextfieldDelegateProxy to handle OnAfterUserAction.
+type TextfieldDelegateOnAfterUserActionProxy interface {
 	OnAfterUserAction(self *TextfieldDelegate, textfield *Textfield)
 }
 
@@ -28,7 +42,9 @@ type TextfieldDelegate C.cef_textfield_delegate_t
 func NewTextfieldDelegate(proxy TextfieldDelegateProxy) *TextfieldDelegate {
 	result := (*TextfieldDelegate)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_textfield_delegate_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_textfield_delegate_proxy(result.toNative())
+		_, on_key_event := proxy.(TextfieldDelegateOnKeyEventProxy)
+		_, on_after_user_action := proxy.(TextfieldDelegateOnAfterUserActionProxy)
+		C.gocef_set_textfield_delegate_proxy(result.toNative(), cefBool(on_key_event), cefBool(on_after_user_action))
 	}
 	return result
 }
@@ -37,14 +53,15 @@ func (d *TextfieldDelegate) toNative() *C.cef_textfield_delegate_t {
 	return (*C.cef_textfield_delegate_t)(d)
 }
 
-func lookupTextfieldDelegateProxy(obj *BaseRefCounted) TextfieldDelegateProxy {
+func lookupTextfieldDelegateProxy(obj *BaseRefCounted, callback string) TextfieldDelegateProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("TextfieldDelegate", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(TextfieldDelegateProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type TextfieldDelegateProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("TextfieldDelegate", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -59,27 +76,40 @@ func (d *TextfieldDelegate) Base() *ViewDelegate {
 // Called when |textfield| recieves a keyboard event. |event| contains
 // information about the keyboard event. Return true (1) if the keyboard event
 // was handled or false (0) otherwise for default handling.
-func (d *TextfieldDelegate) OnKeyEvent(textfield *Textfield, event *KeyEvent) int32 {
-	return lookupTextfieldDelegateProxy(d.Base().Base()).OnKeyEvent(d, textfield, event)
+func (d *TextfieldDelegate) OnKeyEvent(textfield *Textfield, event *KeyEvent) bool {
+	if proxy__, ok__ := lookupTextfieldDelegateProxy(d.Base().Base(), "OnKeyEvent").(TextfieldDelegateOnKeyEventProxy); ok__ {
+		return proxy__.OnKeyEvent(d, textfield, event)
+	}
+	return false
 }
 
 //export gocef_textfield_delegate_on_key_event
 func gocef_textfield_delegate_on_key_event(self *C.cef_textfield_delegate_t, textfield *C.cef_textfield_t, event *C.cef_key_event_t) C.int {
+	defer recoverProxyPanic("TextfieldDelegate", "OnKeyEvent")
 	me__ := (*TextfieldDelegate)(self)
-	proxy__ := lookupTextfieldDelegateProxy(me__.Base().Base())
+	proxy__, ok__ := lookupTextfieldDelegateProxy(me__.Base().Base(), "OnKeyEvent").(TextfieldDelegateOnKeyEventProxy)
+	if !ok__ {
+		return 0
+	}
 	event_ := event.toGo()
-	return C.int(proxy__.OnKeyEvent(me__, (*Textfield)(textfield), event_))
+	return cefBool(proxy__.OnKeyEvent(me__, (*Textfield)(textfield), event_))
 }
 
 // OnAfterUserAction (on_after_user_action)
 // Called after performing a user action that may change |textfield|.
 func (d *TextfieldDelegate) OnAfterUserAction(textfield *Textfield) {
-	lookupTextfieldDelegateProxy(d.Base().Base()).OnAfterUserAction(d, textfield)
+	if proxy__, ok__ := lookupTextfieldDelegateProxy(d.Base().Base(), "OnAfterUserAction").(TextfieldDelegateOnAfterUserActionProxy); ok__ {
+		proxy__.OnAfterUserAction(d, textfield)
+	}
 }
 
 //export gocef_textfield_delegate_on_after_user_action
 func gocef_textfield_delegate_on_after_user_action(self *C.cef_textfield_delegate_t, textfield *C.cef_textfield_t) {
+	defer recoverProxyPanic("TextfieldDelegate", "OnAfterUserAction")
 	me__ := (*TextfieldDelegate)(self)
-	proxy__ := lookupTextfieldDelegateProxy(me__.Base().Base())
+	proxy__, ok__ := lookupTextfieldDelegateProxy(me__.Base().Base(), "OnAfterUserAction").(TextfieldDelegateOnAfterUserActionProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnAfterUserAction(me__, (*Textfield)(textfield))
 }
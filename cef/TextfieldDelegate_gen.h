@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_textfield_delegate_proxy(cef_textfield_delegate_t *self);
+void gocef_set_textfield_delegate_proxy(cef_textfield_delegate_t *self, int on_key_event, int on_after_user_action);
 
 #endif // GOCEF_TextfieldDelegate_H_
@@ -56,26 +56,26 @@ func (d *Textfield) Base() *View {
 
 // SetPasswordInput (set_password_input)
 // Sets whether the text will be displayed as asterisks.
-func (d *Textfield) SetPasswordInput(password_input int32) {
-	C.gocef_textfield_set_password_input(d.toNative(), C.int(password_input), d.set_password_input)
+func (d *Textfield) SetPasswordInput(password_input bool) {
+	C.gocef_textfield_set_password_input(d.toNative(), cefBool(password_input), d.set_password_input)
 }
 
 // IsPasswordInput (is_password_input)
 // Returns true (1) if the text will be displayed as asterisks.
-func (d *Textfield) IsPasswordInput() int32 {
-	return int32(C.gocef_textfield_is_password_input(d.toNative(), d.is_password_input))
+func (d *Textfield) IsPasswordInput() bool {
+	return C.gocef_textfield_is_password_input(d.toNative(), d.is_password_input) != 0
 }
 
 // SetReadOnly (set_read_only)
 // Sets whether the text will read-only.
-func (d *Textfield) SetReadOnly(read_only int32) {
-	C.gocef_textfield_set_read_only(d.toNative(), C.int(read_only), d.set_read_only)
+func (d *Textfield) SetReadOnly(read_only bool) {
+	C.gocef_textfield_set_read_only(d.toNative(), cefBool(read_only), d.set_read_only)
 }
 
 // IsReadOnly (is_read_only)
 // Returns true (1) if the text is read-only.
-func (d *Textfield) IsReadOnly() int32 {
-	return int32(C.gocef_textfield_is_read_only(d.toNative(), d.is_read_only))
+func (d *Textfield) IsReadOnly() bool {
+	return C.gocef_textfield_is_read_only(d.toNative(), d.is_read_only) != 0
 }
 
 // GetText (get_text)
@@ -121,8 +121,8 @@ func (d *Textfield) InsertOrReplaceText(text string) {
 
 // HasSelection (has_selection)
 // Returns true (1) if there is any selected text.
-func (d *Textfield) HasSelection() int32 {
-	return int32(C.gocef_textfield_has_selection(d.toNative(), d.has_selection))
+func (d *Textfield) HasSelection() bool {
+	return C.gocef_textfield_has_selection(d.toNative(), d.has_selection) != 0
 }
 
 // GetSelectedText (get_selected_text)
@@ -136,8 +136,8 @@ func (d *Textfield) GetSelectedText() string {
 // Selects all text. If |reversed| is true (1) the range will end at the
 // logical beginning of the text; this generally shows the leading portion of
 // text that overflows its display area.
-func (d *Textfield) SelectAll(reversed int32) {
-	C.gocef_textfield_select_all(d.toNative(), C.int(reversed), d.select_all)
+func (d *Textfield) SelectAll(reversed bool) {
+	C.gocef_textfield_select_all(d.toNative(), cefBool(reversed), d.select_all)
 }
 
 // ClearSelection (clear_selection)
@@ -234,15 +234,15 @@ func (d *Textfield) ApplyTextColor(color Color, range_r *Range) {
 // style. If |add| is true (1) the style will be added, otherwise the style
 // will be removed. If |range| is NULL the style will be set on the complete
 // text contents.
-func (d *Textfield) ApplyTextStyle(style TextStyle, add int32, range_r *Range) {
-	C.gocef_textfield_apply_text_style(d.toNative(), C.cef_text_style_t(style), C.int(add), range_r.toNative(&C.cef_range_t{}), d.apply_text_style)
+func (d *Textfield) ApplyTextStyle(style TextStyle, add bool, range_r *Range) {
+	C.gocef_textfield_apply_text_style(d.toNative(), C.cef_text_style_t(style), cefBool(add), range_r.toNative(&C.cef_range_t{}), d.apply_text_style)
 }
 
 // IsCommandEnabled (is_command_enabled)
 // Returns true (1) if the action associated with the specified command id is
 // enabled. See additional comments on execute_command().
-func (d *Textfield) IsCommandEnabled(command_id int32) int32 {
-	return int32(C.gocef_textfield_is_command_enabled(d.toNative(), C.int(command_id), d.is_command_enabled))
+func (d *Textfield) IsCommandEnabled(command_id int32) bool {
+	return C.gocef_textfield_is_command_enabled(d.toNative(), C.int(command_id), d.is_command_enabled) != 0
 }
 
 // ExecuteCommand (execute_command)
@@ -6,18 +6,44 @@ import (
 	// #include "UrlrequestClient_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// UrlrequestClientProxy defines methods required for using UrlrequestClient.
+// UrlrequestClientProxy is implemented by the proxies used with UrlrequestClient. Embed
+// DefaultUrlrequestClient to satisfy it, then implement any of the optional
+// UrlrequestClient*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type UrlrequestClientProxy interface {
+	isUrlrequestClientProxy()
+}
+
+// DefaultUrlrequestClient may be embedded to satisfy UrlrequestClientProxy.
+type DefaultUrlrequestClient struct{}
+
+func (DefaultUrlrequestClient) isUrlrequestClientProxy() {}
+
+// UrlrequestClientOnRequestCompleteProxy may be implemented by a UrlrequestClientProxy to handle OnRequestComplete.
+type UrlrequestClientOnRequestCompleteProxy interface {
 	OnRequestComplete(self *UrlrequestClient, request *Urlrequest)
+}
+
+// UrlrequestClientOnUploadProgressProxy may be implemented by a UrlrequestClientProxy to handle OnUploadProgress.
+type UrlrequestClientOnUploadProgressProxy interface {
 	OnUploadProgress(self *UrlrequestClient, request *Urlrequest, current, total int64)
+}
+
+// UrlrequestClientOnDownloadProgressProxy may be implemented by a UrlrequestClientProxy to handle OnDownloadProgress.
+type UrlrequestClientOnDownloadProgressProxy interface {
 	OnDownloadProgress(self *UrlrequestClient, request *Urlrequest, current, total int64)
+}
+
+// UrlrequestClientOnDownloadDataProxy may be implemented by a UrlrequestClientProxy to handle OnDownloadData.
+type UrlrequestClientOnDownloadDataProxy interface {
 	OnDownloadData(self *UrlrequestClient, request *Urlrequest, data unsafe.Pointer, data_length uint64)
-	GetAuthCredentials(self *UrlrequestClient, isProxy int32, host string, port int32, realm, scheme string, callback *AuthCallback) int32
+}
+
+// UrlrequestClientGetAuthCredentialsProxy may be implemented by a UrlrequestClientProxy to handle GetAuthCredentials.
+type UrlrequestClientGetAuthCredentialsProxy interface {
+	GetAuthCredentials(self *UrlrequestClient, isProxy bool, host string, port int32, realm, scheme string, callback *AuthCallback) bool
 }
 
 // UrlrequestClient (cef_urlrequest_client_t from include/capi/cef_urlrequest_capi.h)
@@ -31,7 +57,12 @@ type UrlrequestClient C.cef_urlrequest_client_t
 func NewUrlrequestClient(proxy UrlrequestClientProxy) *UrlrequestClient {
 	result := (*UrlrequestClient)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_urlrequest_client_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_urlrequest_client_proxy(result.toNative())
+		_, on_request_complete := proxy.(UrlrequestClientOnRequestCompleteProxy)
+		_, on_upload_progress := proxy.(UrlrequestClientOnUploadProgressProxy)
+		_, on_download_progress := proxy.(UrlrequestClientOnDownloadProgressProxy)
+		_, on_download_data := proxy.(UrlrequestClientOnDownloadDataProxy)
+		_, get_auth_credentials := proxy.(UrlrequestClientGetAuthCredentialsProxy)
+		C.gocef_set_urlrequest_client_proxy(result.toNative(), cefBool(on_request_complete), cefBool(on_upload_progress), cefBool(on_download_progress), cefBool(on_download_data), cefBool(get_auth_credentials))
 	}
 	return result
 }
@@ -40,14 +71,15 @@ func (d *UrlrequestClient) toNative() *C.cef_urlrequest_client_t {
 	return (*C.cef_urlrequest_client_t)(d)
 }
 
-func lookupUrlrequestClientProxy(obj *BaseRefCounted) UrlrequestClientProxy {
+func lookupUrlrequestClientProxy(obj *BaseRefCounted, callback string) UrlrequestClientProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("UrlrequestClient", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(UrlrequestClientProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type UrlrequestClientProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("UrlrequestClient", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -63,13 +95,19 @@ func (d *UrlrequestClient) Base() *BaseRefCounted {
 // cef_urlrequest_t::GetRequestStatus function to determine if the request was
 // successful or not.
 func (d *UrlrequestClient) OnRequestComplete(request *Urlrequest) {
-	lookupUrlrequestClientProxy(d.Base()).OnRequestComplete(d, request)
+	if proxy__, ok__ := lookupUrlrequestClientProxy(d.Base(), "OnRequestComplete").(UrlrequestClientOnRequestCompleteProxy); ok__ {
+		proxy__.OnRequestComplete(d, request)
+	}
 }
 
 //export gocef_urlrequest_client_on_request_complete
 func gocef_urlrequest_client_on_request_complete(self *C.cef_urlrequest_client_t, request *C.cef_urlrequest_t) {
+	defer recoverProxyPanic("UrlrequestClient", "OnRequestComplete")
 	me__ := (*UrlrequestClient)(self)
-	proxy__ := lookupUrlrequestClientProxy(me__.Base())
+	proxy__, ok__ := lookupUrlrequestClientProxy(me__.Base(), "OnRequestComplete").(UrlrequestClientOnRequestCompleteProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnRequestComplete(me__, (*Urlrequest)(request))
 }
 
@@ -79,13 +117,19 @@ func gocef_urlrequest_client_on_request_complete(self *C.cef_urlrequest_client_t
 // chunked upload is enabled). This function will only be called if the
 // UR_FLAG_REPORT_UPLOAD_PROGRESS flag is set on the request.
 func (d *UrlrequestClient) OnUploadProgress(request *Urlrequest, current, total int64) {
-	lookupUrlrequestClientProxy(d.Base()).OnUploadProgress(d, request, current, total)
+	if proxy__, ok__ := lookupUrlrequestClientProxy(d.Base(), "OnUploadProgress").(UrlrequestClientOnUploadProgressProxy); ok__ {
+		proxy__.OnUploadProgress(d, request, current, total)
+	}
 }
 
 //export gocef_urlrequest_client_on_upload_progress
 func gocef_urlrequest_client_on_upload_progress(self *C.cef_urlrequest_client_t, request *C.cef_urlrequest_t, current C.int64, total C.int64) {
+	defer recoverProxyPanic("UrlrequestClient", "OnUploadProgress")
 	me__ := (*UrlrequestClient)(self)
-	proxy__ := lookupUrlrequestClientProxy(me__.Base())
+	proxy__, ok__ := lookupUrlrequestClientProxy(me__.Base(), "OnUploadProgress").(UrlrequestClientOnUploadProgressProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnUploadProgress(me__, (*Urlrequest)(request), int64(current), int64(total))
 }
 
@@ -94,13 +138,19 @@ func gocef_urlrequest_client_on_upload_progress(self *C.cef_urlrequest_client_t,
 // bytes received up to the call and |total| is the expected total size of the
 // response (or -1 if not determined).
 func (d *UrlrequestClient) OnDownloadProgress(request *Urlrequest, current, total int64) {
-	lookupUrlrequestClientProxy(d.Base()).OnDownloadProgress(d, request, current, total)
+	if proxy__, ok__ := lookupUrlrequestClientProxy(d.Base(), "OnDownloadProgress").(UrlrequestClientOnDownloadProgressProxy); ok__ {
+		proxy__.OnDownloadProgress(d, request, current, total)
+	}
 }
 
 //export gocef_urlrequest_client_on_download_progress
 func gocef_urlrequest_client_on_download_progress(self *C.cef_urlrequest_client_t, request *C.cef_urlrequest_t, current C.int64, total C.int64) {
+	defer recoverProxyPanic("UrlrequestClient", "OnDownloadProgress")
 	me__ := (*UrlrequestClient)(self)
-	proxy__ := lookupUrlrequestClientProxy(me__.Base())
+	proxy__, ok__ := lookupUrlrequestClientProxy(me__.Base(), "OnDownloadProgress").(UrlrequestClientOnDownloadProgressProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnDownloadProgress(me__, (*Urlrequest)(request), int64(current), int64(total))
 }
 
@@ -109,13 +159,19 @@ func gocef_urlrequest_client_on_download_progress(self *C.cef_urlrequest_client_
 // bytes received since the last call. This function will not be called if the
 // UR_FLAG_NO_DOWNLOAD_DATA flag is set on the request.
 func (d *UrlrequestClient) OnDownloadData(request *Urlrequest, data unsafe.Pointer, data_length uint64) {
-	lookupUrlrequestClientProxy(d.Base()).OnDownloadData(d, request, data, data_length)
+	if proxy__, ok__ := lookupUrlrequestClientProxy(d.Base(), "OnDownloadData").(UrlrequestClientOnDownloadDataProxy); ok__ {
+		proxy__.OnDownloadData(d, request, data, data_length)
+	}
 }
 
 //export gocef_urlrequest_client_on_download_data
 func gocef_urlrequest_client_on_download_data(self *C.cef_urlrequest_client_t, request *C.cef_urlrequest_t, data unsafe.Pointer, data_length C.size_t) {
+	defer recoverProxyPanic("UrlrequestClient", "OnDownloadData")
 	me__ := (*UrlrequestClient)(self)
-	proxy__ := lookupUrlrequestClientProxy(me__.Base())
+	proxy__, ok__ := lookupUrlrequestClientProxy(me__.Base(), "OnDownloadData").(UrlrequestClientOnDownloadDataProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnDownloadData(me__, (*Urlrequest)(request), data, uint64(data_length))
 }
 
@@ -127,16 +183,23 @@ func gocef_urlrequest_client_on_download_data(self *C.cef_urlrequest_client_t, r
 // information is available. Return false (0) to cancel the request. This
 // function will only be called for requests initiated from the browser
 // process.
-func (d *UrlrequestClient) GetAuthCredentials(isProxy int32, host string, port int32, realm, scheme string, callback *AuthCallback) int32 {
-	return lookupUrlrequestClientProxy(d.Base()).GetAuthCredentials(d, isProxy, host, port, realm, scheme, callback)
+func (d *UrlrequestClient) GetAuthCredentials(isProxy bool, host string, port int32, realm, scheme string, callback *AuthCallback) bool {
+	if proxy__, ok__ := lookupUrlrequestClientProxy(d.Base(), "GetAuthCredentials").(UrlrequestClientGetAuthCredentialsProxy); ok__ {
+		return proxy__.GetAuthCredentials(d, isProxy, host, port, realm, scheme, callback)
+	}
+	return false
 }
 
 //export gocef_urlrequest_client_get_auth_credentials
 func gocef_urlrequest_client_get_auth_credentials(self *C.cef_urlrequest_client_t, isProxy C.int, host *C.cef_string_t, port C.int, realm *C.cef_string_t, scheme *C.cef_string_t, callback *C.cef_auth_callback_t) C.int {
+	defer recoverProxyPanic("UrlrequestClient", "GetAuthCredentials")
 	me__ := (*UrlrequestClient)(self)
-	proxy__ := lookupUrlrequestClientProxy(me__.Base())
+	proxy__, ok__ := lookupUrlrequestClientProxy(me__.Base(), "GetAuthCredentials").(UrlrequestClientGetAuthCredentialsProxy)
+	if !ok__ {
+		return 0
+	}
 	host_ := cefstrToString(host)
 	realm_ := cefstrToString(realm)
 	scheme_ := cefstrToString(scheme)
-	return C.int(proxy__.GetAuthCredentials(me__, int32(isProxy), host_, int32(port), realm_, scheme_, (*AuthCallback)(callback)))
+	return cefBool(proxy__.GetAuthCredentials(me__, isProxy != 0, host_, int32(port), realm_, scheme_, (*AuthCallback)(callback)))
 }
@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_urlrequest_client_proxy(cef_urlrequest_client_t *self);
+void gocef_set_urlrequest_client_proxy(cef_urlrequest_client_t *self, int on_request_complete, int on_upload_progress, int on_download_progress, int on_download_data, int get_auth_credentials);
 
 #endif // GOCEF_UrlrequestClient_H_
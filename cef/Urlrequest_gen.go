@@ -35,12 +35,14 @@ func (d *Urlrequest) Base() *BaseRefCounted {
 // GetRequest (get_request)
 // Returns the request object used to create this URL request. The returned
 // object is read-only and should not be modified.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *Urlrequest) GetRequest() *Request {
 	return (*Request)(C.gocef_urlrequest_get_request(d.toNative(), d.get_request))
 }
 
 // GetClient (get_client)
 // Returns the client.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *Urlrequest) GetClient() *UrlrequestClient {
 	return (*UrlrequestClient)(C.gocef_urlrequest_get_client(d.toNative(), d.get_client))
 }
@@ -62,6 +64,7 @@ func (d *Urlrequest) GetRequestError() Errorcode {
 // Returns the response, or NULL if no response information is available.
 // Response information will only be available after the upload has completed.
 // The returned object is read-only and should not be modified.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *Urlrequest) GetResponse() *Response {
 	return (*Response)(C.gocef_urlrequest_get_response(d.toNative(), d.get_response))
 }
@@ -69,8 +72,8 @@ func (d *Urlrequest) GetResponse() *Response {
 // ResponseWasCached (response_was_cached)
 // Returns true (1) if the response body was served from the cache. This
 // includes responses for which revalidation was required.
-func (d *Urlrequest) ResponseWasCached() int32 {
-	return int32(C.gocef_urlrequest_response_was_cached(d.toNative(), d.response_was_cached))
+func (d *Urlrequest) ResponseWasCached() bool {
+	return C.gocef_urlrequest_response_was_cached(d.toNative(), d.response_was_cached) != 0
 }
 
 // Cancel (cancel)
@@ -0,0 +1,9 @@
+#include "V8accessor.h"
+#include "_cgo_export.h"
+
+void gocef_set_v8accessor_proxy(cef_v8accessor_t *self) {
+	// Casts to (void *) added to avoid warnings since Go callbacks can't have
+	// some modifiers, such as 'const' applied to their parameter signatures.
+	self->get = (void *)&gocef_v8accessor_get;
+	self->set = (void *)&gocef_v8accessor_set;
+}
@@ -0,0 +1,111 @@
+package cef
+
+import (
+	// #include "V8accessor.h"
+	"C"
+	"unsafe"
+)
+
+// V8accessorProxy defines methods required for using V8accessor.
+type V8accessorProxy interface {
+	// Get handles retrieval of the accessor value identified by name. object is
+	// the receiver ('this' object) of the accessor. Return the value on
+	// success, nil if retrieval was not handled, or an error whose text will
+	// be thrown as a JavaScript exception.
+	Get(self *V8accessor, name string, object *V8value) (*V8value, error)
+	// Set handles assignment of the accessor value identified by name. object
+	// is the receiver ('this' object) of the accessor and value is the new
+	// value being assigned. Return true if the assignment was handled, or an
+	// error whose text will be thrown as a JavaScript exception.
+	Set(self *V8accessor, name string, object, value *V8value) (bool, error)
+}
+
+// V8accessor (cef_v8accessor_t from include/capi/cef_v8_capi.h)
+// Structure that should be implemented to handle V8 accessor calls. Accessor
+// identifiers are registered by calling cef_v8value_t::set_value(). The
+// functions of this structure will be called on the thread associated with the
+// V8 accessor.
+type V8accessor C.cef_v8accessor_t
+
+// NewV8accessor creates a new V8accessor with the specified proxy. Passing in
+// nil will result in default handling, if applicable.
+func NewV8accessor(proxy V8accessorProxy) *V8accessor {
+	result := (*V8accessor)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_v8accessor_t, proxy)))
+	if proxy != nil {
+		C.gocef_set_v8accessor_proxy(result.toNative())
+	}
+	return result
+}
+
+func (d *V8accessor) toNative() *C.cef_v8accessor_t {
+	return (*C.cef_v8accessor_t)(d)
+}
+
+func lookupV8accessorProxy(obj *BaseRefCounted, callback string) V8accessorProxy {
+	proxy, exists := lookupProxy(obj)
+	if !exists {
+		reportProxyError("V8accessor", callback, ErrProxyNotFound, nil)
+		return nil
+	}
+	actual, ok := proxy.(V8accessorProxy)
+	if !ok && proxy != nil {
+		reportProxyError("V8accessor", callback, ErrProxyWrongType, nil)
+	}
+	return actual
+}
+
+// Base (base)
+// Base structure.
+func (d *V8accessor) Base() *BaseRefCounted {
+	return (*BaseRefCounted)(&d.base)
+}
+
+// Get (get)
+// Handle retrieval the accessor value identified by |name|. |object| is the
+// receiver ('this' object) of the accessor. If retrieval succeeds the value is
+// returned. If retrieval fails an error is returned whose text is the
+// exception that will be thrown. A nil value and error indicate the retrieval
+// was not handled.
+func (d *V8accessor) Get(name string, object *V8value) (*V8value, error) {
+	if proxy__ := lookupV8accessorProxy(d.Base(), "Get"); proxy__ != nil {
+		return proxy__.Get(d, name, object)
+	}
+	return nil, nil
+}
+
+//export gocef_v8accessor_get
+func gocef_v8accessor_get(self *C.cef_v8accessor_t, name *C.cef_string_t, object *C.cef_v8value_t, retval **C.cef_v8value_t, exception *C.cef_string_t) C.int {
+	defer recoverProxyPanic("V8accessor", "Get")
+	me__ := (*V8accessor)(self)
+	proxy__ := lookupV8accessorProxy(me__.Base(), "Get")
+	if proxy__ == nil {
+		return 0
+	}
+	result, err := proxy__.Get(me__, cefstrToString(name), (*V8value)(object))
+	return v8Result(result, err, retval, exception)
+}
+
+// Set (set)
+// Handle assignment of the accessor value identified by |name|. |object| is
+// the receiver ('this' object) of the accessor. |value| is the new value
+// being assigned to the accessor. If assignment fails an error is returned
+// whose text is the exception that will be thrown. Returns true if accessor
+// assignment was handled.
+func (d *V8accessor) Set(name string, object, value *V8value) (bool, error) {
+	if proxy__ := lookupV8accessorProxy(d.Base(), "Set"); proxy__ != nil {
+		return proxy__.Set(d, name, object, value)
+	}
+	return false, nil
+}
+
+//export gocef_v8accessor_set
+func gocef_v8accessor_set(self *C.cef_v8accessor_t, name *C.cef_string_t, object *C.cef_v8value_t, value *C.cef_v8value_t, exception *C.cef_string_t) C.int {
+	defer recoverProxyPanic("V8accessor", "Set")
+	me__ := (*V8accessor)(self)
+	proxy__ := lookupV8accessorProxy(me__.Base(), "Set")
+	if proxy__ == nil {
+		return 0
+	}
+	handled, err := proxy__.Set(me__, cefstrToString(name), (*V8value)(object), (*V8value)(value))
+	return v8Assigned(handled, err, exception)
+}
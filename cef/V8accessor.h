@@ -0,0 +1,9 @@
+#ifndef GOCEF_V8accessor_H_
+#define GOCEF_V8accessor_H_
+#pragma once
+
+#include "capi_gen.h"
+
+void gocef_set_v8accessor_proxy(cef_v8accessor_t *self);
+
+#endif // GOCEF_V8accessor_H_
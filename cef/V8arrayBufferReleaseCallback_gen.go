@@ -6,13 +6,23 @@ import (
 	// #include "V8arrayBufferReleaseCallback_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// V8arrayBufferReleaseCallbackProxy defines methods required for using V8arrayBufferReleaseCallback.
+// V8arrayBufferReleaseCallbackProxy is implemented by the proxies used with V8arrayBufferReleaseCallback. Embed
+// DefaultV8arrayBufferReleaseCallback to satisfy it, then implement any of the optional
+// V8arrayBufferReleaseCallback*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type V8arrayBufferReleaseCallbackProxy interface {
+	isV8arrayBufferReleaseCallbackProxy()
+}
+
+// DefaultV8arrayBufferReleaseCallback may be embedded to satisfy V8arrayBufferReleaseCallbackProxy.
+type DefaultV8arrayBufferReleaseCallback struct{}
+
+func (DefaultV8arrayBufferReleaseCallback) isV8arrayBufferReleaseCallbackProxy() {}
+
+// V8arrayBufferReleaseCallbackReleaseBufferProxy may be implemented by a V8arrayBufferReleaseCallbackProxy to handle ReleaseBuffer.
+type V8arrayBufferReleaseCallbackReleaseBufferProxy interface {
 	ReleaseBuffer(self *V8arrayBufferReleaseCallback, buffer unsafe.Pointer)
 }
 
@@ -25,7 +35,8 @@ type V8arrayBufferReleaseCallback C.cef_v8array_buffer_release_callback_t
 func NewV8arrayBufferReleaseCallback(proxy V8arrayBufferReleaseCallbackProxy) *V8arrayBufferReleaseCallback {
 	result := (*V8arrayBufferReleaseCallback)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_v8array_buffer_release_callback_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_v8array_buffer_release_callback_proxy(result.toNative())
+		_, release_buffer := proxy.(V8arrayBufferReleaseCallbackReleaseBufferProxy)
+		C.gocef_set_v8array_buffer_release_callback_proxy(result.toNative(), cefBool(release_buffer))
 	}
 	return result
 }
@@ -34,14 +45,15 @@ func (d *V8arrayBufferReleaseCallback) toNative() *C.cef_v8array_buffer_release_
 	return (*C.cef_v8array_buffer_release_callback_t)(d)
 }
 
-func lookupV8arrayBufferReleaseCallbackProxy(obj *BaseRefCounted) V8arrayBufferReleaseCallbackProxy {
+func lookupV8arrayBufferReleaseCallbackProxy(obj *BaseRefCounted, callback string) V8arrayBufferReleaseCallbackProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("V8arrayBufferReleaseCallback", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(V8arrayBufferReleaseCallbackProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type V8arrayBufferReleaseCallbackProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("V8arrayBufferReleaseCallback", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -57,12 +69,18 @@ func (d *V8arrayBufferReleaseCallback) Base() *BaseRefCounted {
 // collected. |buffer| is the value that was passed to CreateArrayBuffer along
 // with this object.
 func (d *V8arrayBufferReleaseCallback) ReleaseBuffer(buffer unsafe.Pointer) {
-	lookupV8arrayBufferReleaseCallbackProxy(d.Base()).ReleaseBuffer(d, buffer)
+	if proxy__, ok__ := lookupV8arrayBufferReleaseCallbackProxy(d.Base(), "ReleaseBuffer").(V8arrayBufferReleaseCallbackReleaseBufferProxy); ok__ {
+		proxy__.ReleaseBuffer(d, buffer)
+	}
 }
 
 //export gocef_v8array_buffer_release_callback_release_buffer
 func gocef_v8array_buffer_release_callback_release_buffer(self *C.cef_v8array_buffer_release_callback_t, buffer unsafe.Pointer) {
+	defer recoverProxyPanic("V8arrayBufferReleaseCallback", "ReleaseBuffer")
 	me__ := (*V8arrayBufferReleaseCallback)(self)
-	proxy__ := lookupV8arrayBufferReleaseCallbackProxy(me__.Base())
+	proxy__, ok__ := lookupV8arrayBufferReleaseCallbackProxy(me__.Base(), "ReleaseBuffer").(V8arrayBufferReleaseCallbackReleaseBufferProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.ReleaseBuffer(me__, buffer)
 }
@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_v8array_buffer_release_callback_proxy(cef_v8array_buffer_release_callback_t *self);
+void gocef_set_v8array_buffer_release_callback_proxy(cef_v8array_buffer_release_callback_t *self, int release_buffer);
 
 #endif // GOCEF_V8arrayBufferReleaseCallback_H_
@@ -38,6 +38,7 @@ func (d *V8context) Base() *BaseRefCounted {
 // Returns the task runner associated with this context. V8 handles can only
 // be accessed from the thread on which they are created. This function can be
 // called on any render process thread.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *V8context) GetTaskRunner() *TaskRunner {
 	return (*TaskRunner)(C.gocef_v8context_get_task_runner(d.toNative(), d.get_task_runner))
 }
@@ -46,13 +47,14 @@ func (d *V8context) GetTaskRunner() *TaskRunner {
 // Returns true (1) if the underlying handle is valid and it can be accessed
 // on the current thread. Do not call any other functions if this function
 // returns false (0).
-func (d *V8context) IsValid() int32 {
-	return int32(C.gocef_v8context_is_valid(d.toNative(), d.is_valid))
+func (d *V8context) IsValid() bool {
+	return C.gocef_v8context_is_valid(d.toNative(), d.is_valid) != 0
 }
 
 // GetBrowser (get_browser)
 // Returns the browser for this context. This function will return an NULL
 // reference for WebWorker contexts.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *V8context) GetBrowser() *Browser {
 	return (*Browser)(C.gocef_v8context_get_browser(d.toNative(), d.get_browser))
 }
@@ -60,6 +62,7 @@ func (d *V8context) GetBrowser() *Browser {
 // GetFrame (get_frame)
 // Returns the frame for this context. This function will return an NULL
 // reference for WebWorker contexts.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *V8context) GetFrame() *Frame {
 	return (*Frame)(C.gocef_v8context_get_frame(d.toNative(), d.get_frame))
 }
@@ -67,6 +70,7 @@ func (d *V8context) GetFrame() *Frame {
 // GetGlobal (get_global)
 // Returns the global object for this context. The context must be entered
 // before calling this function.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *V8context) GetGlobal() *V8value {
 	return (*V8value)(C.gocef_v8context_get_global(d.toNative(), d.get_global))
 }
@@ -77,22 +81,22 @@ func (d *V8context) GetGlobal() *V8value {
 // the same number of times as enter() before releasing this context. V8
 // objects belong to the context in which they are created. Returns true (1)
 // if the scope was entered successfully.
-func (d *V8context) Enter() int32 {
-	return int32(C.gocef_v8context_enter(d.toNative(), d.enter))
+func (d *V8context) Enter() bool {
+	return C.gocef_v8context_enter(d.toNative(), d.enter) != 0
 }
 
 // Exit (exit)
 // Exit this context. Call this function only after calling enter(). Returns
 // true (1) if the scope was exited successfully.
-func (d *V8context) Exit() int32 {
-	return int32(C.gocef_v8context_exit(d.toNative(), d.exit))
+func (d *V8context) Exit() bool {
+	return C.gocef_v8context_exit(d.toNative(), d.exit) != 0
 }
 
 // IsSame (is_same)
 // Returns true (1) if this object is pointing to the same handle as |that|
 // object.
-func (d *V8context) IsSame(that *V8context) int32 {
-	return int32(C.gocef_v8context_is_same(d.toNative(), that.toNative(), d.is_same))
+func (d *V8context) IsSame(that *V8context) bool {
+	return C.gocef_v8context_is_same(d.toNative(), that.toNative(), d.is_same) != 0
 }
 
 // Eval (eval)
@@ -102,7 +106,7 @@ func (d *V8context) IsSame(that *V8context) int32 {
 // On success |retval| will be set to the return value, if any, and the
 // function will return true (1). On failure |exception| will be set to the
 // exception, if any, and the function will return false (0).
-func (d *V8context) Eval(code, script_url string, start_line int32, retval **V8value, exception **V8exception) int32 {
+func (d *V8context) Eval(code, script_url string, start_line int32, retval **V8value, exception **V8exception) bool {
 	code_ := C.cef_string_userfree_alloc()
 	setCEFStr(code, code_)
 	defer func() {
@@ -115,5 +119,5 @@ func (d *V8context) Eval(code, script_url string, start_line int32, retval **V8v
 	}()
 	retval_ := (*retval).toNative()
 	exception_ := (*exception).toNative()
-	return int32(C.gocef_v8context_eval(d.toNative(), (*C.cef_string_t)(code_), (*C.cef_string_t)(script_url_), C.int(start_line), &retval_, &exception_, d.eval))
+	return C.gocef_v8context_eval(d.toNative(), (*C.cef_string_t)(code_), (*C.cef_string_t)(script_url_), C.int(start_line), &retval_, &exception_, d.eval) != 0
 }
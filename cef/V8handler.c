@@ -0,0 +1,8 @@
+#include "V8handler.h"
+#include "_cgo_export.h"
+
+void gocef_set_v8handler_proxy(cef_v8handler_t *self) {
+	// Cast to (void *) added to avoid warnings since Go callbacks can't have
+	// some modifiers, such as 'const' applied to their parameter signatures.
+	self->execute = (void *)&gocef_v8handler_execute;
+}
@@ -0,0 +1,93 @@
+package cef
+
+import (
+	// #include "V8handler.h"
+	"C"
+	"unsafe"
+)
+
+// V8handlerProxy defines methods required for using V8handler.
+type V8handlerProxy interface {
+	// Execute handles execution of the function identified by name. object is
+	// the receiver ('this' object) of the function. If execution succeeds,
+	// return the function's result, which may be nil to return undefined. If
+	// execution fails, return an error, whose text will be thrown as a
+	// JavaScript exception.
+	Execute(self *V8handler, name string, object *V8value, arguments []*V8value) (*V8value, error)
+}
+
+// V8handlerFunc is an adapter to allow the use of an ordinary function as a
+// V8handlerProxy.
+type V8handlerFunc func(self *V8handler, name string, object *V8value, arguments []*V8value) (*V8value, error)
+
+// Execute calls f(self, name, object, arguments).
+func (f V8handlerFunc) Execute(self *V8handler, name string, object *V8value, arguments []*V8value) (*V8value, error) {
+	return f(self, name, object, arguments)
+}
+
+// V8handler (cef_v8handler_t from include/capi/cef_v8_capi.h)
+// Structure that should be implemented to handle V8 function calls. The
+// functions of this structure will be called on the thread associated with the
+// V8 function.
+type V8handler C.cef_v8handler_t
+
+// NewV8handler creates a new V8handler with the specified proxy. Passing in
+// nil will result in default handling, if applicable.
+func NewV8handler(proxy V8handlerProxy) *V8handler {
+	result := (*V8handler)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_v8handler_t, proxy)))
+	if proxy != nil {
+		C.gocef_set_v8handler_proxy(result.toNative())
+	}
+	return result
+}
+
+func (d *V8handler) toNative() *C.cef_v8handler_t {
+	return (*C.cef_v8handler_t)(d)
+}
+
+func lookupV8handlerProxy(obj *BaseRefCounted, callback string) V8handlerProxy {
+	proxy, exists := lookupProxy(obj)
+	if !exists {
+		reportProxyError("V8handler", callback, ErrProxyNotFound, nil)
+		return nil
+	}
+	actual, ok := proxy.(V8handlerProxy)
+	if !ok && proxy != nil {
+		reportProxyError("V8handler", callback, ErrProxyWrongType, nil)
+	}
+	return actual
+}
+
+// Base (base)
+// Base structure.
+func (d *V8handler) Base() *BaseRefCounted {
+	return (*BaseRefCounted)(&d.base)
+}
+
+// Execute (execute)
+// Handle execution of the function identified by |name|. |object| is the
+// receiver ('this' object) of the function. |arguments| is the list of
+// arguments passed to the function. If execution succeeds the function return
+// value is returned. If execution fails an error is returned whose text is the
+// exception that will be thrown.
+func (d *V8handler) Execute(name string, object *V8value, arguments []*V8value) (*V8value, error) {
+	if proxy__ := lookupV8handlerProxy(d.Base(), "Execute"); proxy__ != nil {
+		return proxy__.Execute(d, name, object, arguments)
+	}
+	return nil, nil
+}
+
+//export gocef_v8handler_execute
+func gocef_v8handler_execute(self *C.cef_v8handler_t, name *C.cef_string_t, object *C.cef_v8value_t, argumentsCount C.size_t, arguments **C.cef_v8value_t, retval **C.cef_v8value_t, exception *C.cef_string_t) C.int {
+	defer recoverProxyPanic("V8handler", "Execute")
+	me__ := (*V8handler)(self)
+	proxy__ := lookupV8handlerProxy(me__.Base(), "Execute")
+	if proxy__ == nil {
+		return 0
+	}
+	result, err := proxy__.Execute(me__, cefstrToString(name), (*V8value)(object), v8Args(argumentsCount, arguments))
+	if err == nil && result == nil {
+		result = V8valueCreateUndefined()
+	}
+	return v8Result(result, err, retval, exception)
+}
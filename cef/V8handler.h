@@ -0,0 +1,9 @@
+#ifndef GOCEF_V8handler_H_
+#define GOCEF_V8handler_H_
+#pragma once
+
+#include "capi_gen.h"
+
+void gocef_set_v8handler_proxy(cef_v8handler_t *self);
+
+#endif // GOCEF_V8handler_H_
@@ -0,0 +1,11 @@
+#include "V8interceptor.h"
+#include "_cgo_export.h"
+
+void gocef_set_v8interceptor_proxy(cef_v8interceptor_t *self) {
+	// Casts to (void *) added to avoid warnings since Go callbacks can't have
+	// some modifiers, such as 'const' applied to their parameter signatures.
+	self->get_byname = (void *)&gocef_v8interceptor_get_byname;
+	self->get_byindex = (void *)&gocef_v8interceptor_get_byindex;
+	self->set_byname = (void *)&gocef_v8interceptor_set_byname;
+	self->set_byindex = (void *)&gocef_v8interceptor_set_byindex;
+}
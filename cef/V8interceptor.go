@@ -0,0 +1,171 @@
+package cef
+
+import (
+	// #include "V8interceptor.h"
+	"C"
+	"unsafe"
+)
+
+// V8interceptorProxy defines methods required for using V8interceptor.
+type V8interceptorProxy interface {
+	// GetByname handles retrieval of the interceptor value identified by name.
+	// Return the value on success, nil if the value does not exist, or an
+	// error whose text will be thrown as a JavaScript exception.
+	GetByname(self *V8interceptor, name string, object *V8value) (*V8value, error)
+	// GetByindex handles retrieval of the interceptor value identified by
+	// index. Return the value on success, nil if the value does not exist, or
+	// an error whose text will be thrown as a JavaScript exception.
+	GetByindex(self *V8interceptor, index int32, object *V8value) (*V8value, error)
+	// SetByname handles assignment of the interceptor value identified by
+	// name. Return true if the assignment was handled, or an error whose text
+	// will be thrown as a JavaScript exception.
+	SetByname(self *V8interceptor, name string, object, value *V8value) (bool, error)
+	// SetByindex handles assignment of the interceptor value identified by
+	// index. Return true if the assignment was handled, or an error whose text
+	// will be thrown as a JavaScript exception.
+	SetByindex(self *V8interceptor, index int32, object, value *V8value) (bool, error)
+}
+
+// V8interceptor (cef_v8interceptor_t from include/capi/cef_v8_capi.h)
+// Structure that should be implemented to handle V8 interceptor calls. The
+// functions of this structure will be called on the thread associated with the
+// V8 interceptor. Interceptor's named property handlers (with first argument of
+// type CefString) are called when object is indexed by string. Indexed property
+// handlers (with first argument of type int) are called when object is indexed
+// by integer.
+type V8interceptor C.cef_v8interceptor_t
+
+// NewV8interceptor creates a new V8interceptor with the specified proxy.
+// Passing in nil will result in default handling, if applicable.
+func NewV8interceptor(proxy V8interceptorProxy) *V8interceptor {
+	result := (*V8interceptor)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_v8interceptor_t, proxy)))
+	if proxy != nil {
+		C.gocef_set_v8interceptor_proxy(result.toNative())
+	}
+	return result
+}
+
+func (d *V8interceptor) toNative() *C.cef_v8interceptor_t {
+	return (*C.cef_v8interceptor_t)(d)
+}
+
+func lookupV8interceptorProxy(obj *BaseRefCounted, callback string) V8interceptorProxy {
+	proxy, exists := lookupProxy(obj)
+	if !exists {
+		reportProxyError("V8interceptor", callback, ErrProxyNotFound, nil)
+		return nil
+	}
+	actual, ok := proxy.(V8interceptorProxy)
+	if !ok && proxy != nil {
+		reportProxyError("V8interceptor", callback, ErrProxyWrongType, nil)
+	}
+	return actual
+}
+
+// Base (base)
+// Base structure.
+func (d *V8interceptor) Base() *BaseRefCounted {
+	return (*BaseRefCounted)(&d.base)
+}
+
+// GetByname (get_byname)
+// Handle retrieval of the interceptor value identified by |name|. |object| is
+// the receiver ('this' object) of the interceptor. If retrieval succeeds, the
+// value is returned. If the requested value does not exist, nil is returned
+// for both the value and the error. If retrieval fails, an error is returned
+// whose text is the exception that will be thrown. If the property has an
+// associated accessor, it will be called only if no value is returned.
+func (d *V8interceptor) GetByname(name string, object *V8value) (*V8value, error) {
+	if proxy__ := lookupV8interceptorProxy(d.Base(), "GetByname"); proxy__ != nil {
+		return proxy__.GetByname(d, name, object)
+	}
+	return nil, nil
+}
+
+//export gocef_v8interceptor_get_byname
+func gocef_v8interceptor_get_byname(self *C.cef_v8interceptor_t, name *C.cef_string_t, object *C.cef_v8value_t, retval **C.cef_v8value_t, exception *C.cef_string_t) C.int {
+	defer recoverProxyPanic("V8interceptor", "GetByname")
+	me__ := (*V8interceptor)(self)
+	proxy__ := lookupV8interceptorProxy(me__.Base(), "GetByname")
+	if proxy__ == nil {
+		return 0
+	}
+	result, err := proxy__.GetByname(me__, cefstrToString(name), (*V8value)(object))
+	return v8Result(result, err, retval, exception)
+}
+
+// GetByindex (get_byindex)
+// Handle retrieval of the interceptor value identified by |index|. |object|
+// is the receiver ('this' object) of the interceptor. If retrieval succeeds,
+// the value is returned. If the requested value does not exist, nil is
+// returned for both the value and the error. If retrieval fails, an error is
+// returned whose text is the exception that will be thrown.
+func (d *V8interceptor) GetByindex(index int32, object *V8value) (*V8value, error) {
+	if proxy__ := lookupV8interceptorProxy(d.Base(), "GetByindex"); proxy__ != nil {
+		return proxy__.GetByindex(d, index, object)
+	}
+	return nil, nil
+}
+
+//export gocef_v8interceptor_get_byindex
+func gocef_v8interceptor_get_byindex(self *C.cef_v8interceptor_t, index C.int, object *C.cef_v8value_t, retval **C.cef_v8value_t, exception *C.cef_string_t) C.int {
+	defer recoverProxyPanic("V8interceptor", "GetByindex")
+	me__ := (*V8interceptor)(self)
+	proxy__ := lookupV8interceptorProxy(me__.Base(), "GetByindex")
+	if proxy__ == nil {
+		return 0
+	}
+	result, err := proxy__.GetByindex(me__, int32(index), (*V8value)(object))
+	return v8Result(result, err, retval, exception)
+}
+
+// SetByname (set_byname)
+// Handle assignment of the interceptor value identified by |name|. |object|
+// is the receiver ('this' object) of the interceptor. |value| is the new
+// value being assigned to the interceptor. If assignment fails, an error is
+// returned whose text is the exception that will be thrown. This setter will
+// always be called, even when the property has an associated accessor.
+// Returns true if interceptor assignment was handled, false otherwise.
+func (d *V8interceptor) SetByname(name string, object, value *V8value) (bool, error) {
+	if proxy__ := lookupV8interceptorProxy(d.Base(), "SetByname"); proxy__ != nil {
+		return proxy__.SetByname(d, name, object, value)
+	}
+	return false, nil
+}
+
+//export gocef_v8interceptor_set_byname
+func gocef_v8interceptor_set_byname(self *C.cef_v8interceptor_t, name *C.cef_string_t, object *C.cef_v8value_t, value *C.cef_v8value_t, exception *C.cef_string_t) C.int {
+	defer recoverProxyPanic("V8interceptor", "SetByname")
+	me__ := (*V8interceptor)(self)
+	proxy__ := lookupV8interceptorProxy(me__.Base(), "SetByname")
+	if proxy__ == nil {
+		return 0
+	}
+	handled, err := proxy__.SetByname(me__, cefstrToString(name), (*V8value)(object), (*V8value)(value))
+	return v8Assigned(handled, err, exception)
+}
+
+// SetByindex (set_byindex)
+// Handle assignment of the interceptor value identified by |index|. |object|
+// is the receiver ('this' object) of the interceptor. |value| is the new
+// value being assigned to the interceptor. If assignment fails, an error is
+// returned whose text is the exception that will be thrown. Returns true if
+// interceptor assignment was handled, false otherwise.
+func (d *V8interceptor) SetByindex(index int32, object, value *V8value) (bool, error) {
+	if proxy__ := lookupV8interceptorProxy(d.Base(), "SetByindex"); proxy__ != nil {
+		return proxy__.SetByindex(d, index, object, value)
+	}
+	return false, nil
+}
+
+//export gocef_v8interceptor_set_byindex
+func gocef_v8interceptor_set_byindex(self *C.cef_v8interceptor_t, index C.int, object *C.cef_v8value_t, value *C.cef_v8value_t, exception *C.cef_string_t) C.int {
+	defer recoverProxyPanic("V8interceptor", "SetByindex")
+	me__ := (*V8interceptor)(self)
+	proxy__ := lookupV8interceptorProxy(me__.Base(), "SetByindex")
+	if proxy__ == nil {
+		return 0
+	}
+	handled, err := proxy__.SetByindex(me__, int32(index), (*V8value)(object), (*V8value)(value))
+	return v8Assigned(handled, err, exception)
+}
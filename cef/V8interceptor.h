@@ -0,0 +1,9 @@
+#ifndef GOCEF_V8interceptor_H_
+#define GOCEF_V8interceptor_H_
+#pragma once
+
+#include "capi_gen.h"
+
+void gocef_set_v8interceptor_proxy(cef_v8interceptor_t *self);
+
+#endif // GOCEF_V8interceptor_H_
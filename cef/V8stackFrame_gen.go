@@ -37,8 +37,8 @@ func (d *V8stackFrame) Base() *BaseRefCounted {
 // Returns true (1) if the underlying handle is valid and it can be accessed
 // on the current thread. Do not call any other functions if this function
 // returns false (0).
-func (d *V8stackFrame) IsValid() int32 {
-	return int32(C.gocef_v8stack_frame_is_valid(d.toNative(), d.is_valid))
+func (d *V8stackFrame) IsValid() bool {
+	return C.gocef_v8stack_frame_is_valid(d.toNative(), d.is_valid) != 0
 }
 
 // GetScriptName (get_script_name)
@@ -79,12 +79,12 @@ func (d *V8stackFrame) GetColumn() int32 {
 
 // IsEval (is_eval)
 // Returns true (1) if the function was compiled using eval().
-func (d *V8stackFrame) IsEval() int32 {
-	return int32(C.gocef_v8stack_frame_is_eval(d.toNative(), d.is_eval))
+func (d *V8stackFrame) IsEval() bool {
+	return C.gocef_v8stack_frame_is_eval(d.toNative(), d.is_eval) != 0
 }
 
 // IsConstructor (is_constructor)
 // Returns true (1) if the function was called as a constructor via "new".
-func (d *V8stackFrame) IsConstructor() int32 {
-	return int32(C.gocef_v8stack_frame_is_constructor(d.toNative(), d.is_constructor))
+func (d *V8stackFrame) IsConstructor() bool {
+	return C.gocef_v8stack_frame_is_constructor(d.toNative(), d.is_constructor) != 0
 }
@@ -32,8 +32,8 @@ func (d *V8stackTrace) Base() *BaseRefCounted {
 // Returns true (1) if the underlying handle is valid and it can be accessed
 // on the current thread. Do not call any other functions if this function
 // returns false (0).
-func (d *V8stackTrace) IsValid() int32 {
-	return int32(C.gocef_v8stack_trace_is_valid(d.toNative(), d.is_valid))
+func (d *V8stackTrace) IsValid() bool {
+	return C.gocef_v8stack_trace_is_valid(d.toNative(), d.is_valid) != 0
 }
 
 // GetFrameCount (get_frame_count)
@@ -44,6 +44,7 @@ func (d *V8stackTrace) GetFrameCount() int32 {
 
 // GetFrame (get_frame)
 // Returns the stack frame at the specified 0-based index.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *V8stackTrace) GetFrame(index int32) *V8stackFrame {
 	return (*V8stackFrame)(C.gocef_v8stack_trace_get_frame(d.toNative(), C.int(index), d.get_frame))
 }
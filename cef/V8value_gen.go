@@ -76,93 +76,93 @@ func (d *V8value) Base() *BaseRefCounted {
 // Returns true (1) if the underlying handle is valid and it can be accessed
 // on the current thread. Do not call any other functions if this function
 // returns false (0).
-func (d *V8value) IsValid() int32 {
-	return int32(C.gocef_v8value_is_valid(d.toNative(), d.is_valid))
+func (d *V8value) IsValid() bool {
+	return C.gocef_v8value_is_valid(d.toNative(), d.is_valid) != 0
 }
 
 // IsUndefined (is_undefined)
 // True if the value type is undefined.
-func (d *V8value) IsUndefined() int32 {
-	return int32(C.gocef_v8value_is_undefined(d.toNative(), d.is_undefined))
+func (d *V8value) IsUndefined() bool {
+	return C.gocef_v8value_is_undefined(d.toNative(), d.is_undefined) != 0
 }
 
 // IsNull (is_null)
 // True if the value type is null.
-func (d *V8value) IsNull() int32 {
-	return int32(C.gocef_v8value_is_null(d.toNative(), d.is_null))
+func (d *V8value) IsNull() bool {
+	return C.gocef_v8value_is_null(d.toNative(), d.is_null) != 0
 }
 
 // IsBool (is_bool)
 // True if the value type is bool.
-func (d *V8value) IsBool() int32 {
-	return int32(C.gocef_v8value_is_bool(d.toNative(), d.is_bool))
+func (d *V8value) IsBool() bool {
+	return C.gocef_v8value_is_bool(d.toNative(), d.is_bool) != 0
 }
 
 // IsInt (is_int)
 // True if the value type is int.
-func (d *V8value) IsInt() int32 {
-	return int32(C.gocef_v8value_is_int(d.toNative(), d.is_int))
+func (d *V8value) IsInt() bool {
+	return C.gocef_v8value_is_int(d.toNative(), d.is_int) != 0
 }
 
 // IsUint (is_uint)
 // True if the value type is unsigned int.
-func (d *V8value) IsUint() int32 {
-	return int32(C.gocef_v8value_is_uint(d.toNative(), d.is_uint))
+func (d *V8value) IsUint() bool {
+	return C.gocef_v8value_is_uint(d.toNative(), d.is_uint) != 0
 }
 
 // IsDouble (is_double)
 // True if the value type is double.
-func (d *V8value) IsDouble() int32 {
-	return int32(C.gocef_v8value_is_double(d.toNative(), d.is_double))
+func (d *V8value) IsDouble() bool {
+	return C.gocef_v8value_is_double(d.toNative(), d.is_double) != 0
 }
 
 // IsDate (is_date)
 // True if the value type is Date.
-func (d *V8value) IsDate() int32 {
-	return int32(C.gocef_v8value_is_date(d.toNative(), d.is_date))
+func (d *V8value) IsDate() bool {
+	return C.gocef_v8value_is_date(d.toNative(), d.is_date) != 0
 }
 
 // IsString (is_string)
 // True if the value type is string.
-func (d *V8value) IsString() int32 {
-	return int32(C.gocef_v8value_is_string(d.toNative(), d.is_string))
+func (d *V8value) IsString() bool {
+	return C.gocef_v8value_is_string(d.toNative(), d.is_string) != 0
 }
 
 // IsObject (is_object)
 // True if the value type is object.
-func (d *V8value) IsObject() int32 {
-	return int32(C.gocef_v8value_is_object(d.toNative(), d.is_object))
+func (d *V8value) IsObject() bool {
+	return C.gocef_v8value_is_object(d.toNative(), d.is_object) != 0
 }
 
 // IsArray (is_array)
 // True if the value type is array.
-func (d *V8value) IsArray() int32 {
-	return int32(C.gocef_v8value_is_array(d.toNative(), d.is_array))
+func (d *V8value) IsArray() bool {
+	return C.gocef_v8value_is_array(d.toNative(), d.is_array) != 0
 }
 
 // IsArrayBuffer (is_array_buffer)
 // True if the value type is an ArrayBuffer.
-func (d *V8value) IsArrayBuffer() int32 {
-	return int32(C.gocef_v8value_is_array_buffer(d.toNative(), d.is_array_buffer))
+func (d *V8value) IsArrayBuffer() bool {
+	return C.gocef_v8value_is_array_buffer(d.toNative(), d.is_array_buffer) != 0
 }
 
 // IsFunction (is_function)
 // True if the value type is function.
-func (d *V8value) IsFunction() int32 {
-	return int32(C.gocef_v8value_is_function(d.toNative(), d.is_function))
+func (d *V8value) IsFunction() bool {
+	return C.gocef_v8value_is_function(d.toNative(), d.is_function) != 0
 }
 
 // IsSame (is_same)
 // Returns true (1) if this object is pointing to the same handle as |that|
 // object.
-func (d *V8value) IsSame(that *V8value) int32 {
-	return int32(C.gocef_v8value_is_same(d.toNative(), that.toNative(), d.is_same))
+func (d *V8value) IsSame(that *V8value) bool {
+	return C.gocef_v8value_is_same(d.toNative(), that.toNative(), d.is_same) != 0
 }
 
 // GetBoolValue (get_bool_value)
 // Return a bool value.
-func (d *V8value) GetBoolValue() int32 {
-	return int32(C.gocef_v8value_get_bool_value(d.toNative(), d.get_bool_value))
+func (d *V8value) GetBoolValue() bool {
+	return C.gocef_v8value_get_bool_value(d.toNative(), d.get_bool_value) != 0
 }
 
 // GetIntValue (get_int_value)
@@ -201,35 +201,36 @@ func (d *V8value) GetStringValue() string {
 
 // IsUserCreated (is_user_created)
 // Returns true (1) if this is a user created object.
-func (d *V8value) IsUserCreated() int32 {
-	return int32(C.gocef_v8value_is_user_created(d.toNative(), d.is_user_created))
+func (d *V8value) IsUserCreated() bool {
+	return C.gocef_v8value_is_user_created(d.toNative(), d.is_user_created) != 0
 }
 
 // HasException (has_exception)
 // Returns true (1) if the last function call resulted in an exception. This
 // attribute exists only in the scope of the current CEF value object.
-func (d *V8value) HasException() int32 {
-	return int32(C.gocef_v8value_has_exception(d.toNative(), d.has_exception))
+func (d *V8value) HasException() bool {
+	return C.gocef_v8value_has_exception(d.toNative(), d.has_exception) != 0
 }
 
 // GetException (get_exception)
 // Returns the exception resulting from the last function call. This attribute
 // exists only in the scope of the current CEF value object.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *V8value) GetException() *V8exception {
 	return (*V8exception)(C.gocef_v8value_get_exception(d.toNative(), d.get_exception))
 }
 
 // ClearException (clear_exception)
 // Clears the last exception and returns true (1) on success.
-func (d *V8value) ClearException() int32 {
-	return int32(C.gocef_v8value_clear_exception(d.toNative(), d.clear_exception))
+func (d *V8value) ClearException() bool {
+	return C.gocef_v8value_clear_exception(d.toNative(), d.clear_exception) != 0
 }
 
 // WillRethrowExceptions (will_rethrow_exceptions)
 // Returns true (1) if this object will re-throw future exceptions. This
 // attribute exists only in the scope of the current CEF value object.
-func (d *V8value) WillRethrowExceptions() int32 {
-	return int32(C.gocef_v8value_will_rethrow_exceptions(d.toNative(), d.will_rethrow_exceptions))
+func (d *V8value) WillRethrowExceptions() bool {
+	return C.gocef_v8value_will_rethrow_exceptions(d.toNative(), d.will_rethrow_exceptions) != 0
 }
 
 // SetRethrowExceptions (set_rethrow_exceptions)
@@ -238,25 +239,25 @@ func (d *V8value) WillRethrowExceptions() int32 {
 // context should not be accessed again until after the exception has been
 // caught and not re-thrown. Returns true (1) on success. This attribute
 // exists only in the scope of the current CEF value object.
-func (d *V8value) SetRethrowExceptions(rethrow int32) int32 {
-	return int32(C.gocef_v8value_set_rethrow_exceptions(d.toNative(), C.int(rethrow), d.set_rethrow_exceptions))
+func (d *V8value) SetRethrowExceptions(rethrow bool) bool {
+	return C.gocef_v8value_set_rethrow_exceptions(d.toNative(), cefBool(rethrow), d.set_rethrow_exceptions) != 0
 }
 
 // HasValueBykey (has_value_bykey)
 // Returns true (1) if the object has a value with the specified identifier.
-func (d *V8value) HasValueBykey(key string) int32 {
+func (d *V8value) HasValueBykey(key string) bool {
 	key_ := C.cef_string_userfree_alloc()
 	setCEFStr(key, key_)
 	defer func() {
 		C.cef_string_userfree_free(key_)
 	}()
-	return int32(C.gocef_v8value_has_value_bykey(d.toNative(), (*C.cef_string_t)(key_), d.has_value_bykey))
+	return C.gocef_v8value_has_value_bykey(d.toNative(), (*C.cef_string_t)(key_), d.has_value_bykey) != 0
 }
 
 // HasValueByindex (has_value_byindex)
 // Returns true (1) if the object has a value with the specified identifier.
-func (d *V8value) HasValueByindex(index int32) int32 {
-	return int32(C.gocef_v8value_has_value_byindex(d.toNative(), C.int(index), d.has_value_byindex))
+func (d *V8value) HasValueByindex(index int32) bool {
+	return C.gocef_v8value_has_value_byindex(d.toNative(), C.int(index), d.has_value_byindex) != 0
 }
 
 // DeleteValueBykey (delete_value_bykey)
@@ -264,13 +265,13 @@ func (d *V8value) HasValueByindex(index int32) int32 {
 // success. Returns false (0) if this function is called incorrectly or an
 // exception is thrown. For read-only and don't-delete values this function
 // will return true (1) even though deletion failed.
-func (d *V8value) DeleteValueBykey(key string) int32 {
+func (d *V8value) DeleteValueBykey(key string) bool {
 	key_ := C.cef_string_userfree_alloc()
 	setCEFStr(key, key_)
 	defer func() {
 		C.cef_string_userfree_free(key_)
 	}()
-	return int32(C.gocef_v8value_delete_value_bykey(d.toNative(), (*C.cef_string_t)(key_), d.delete_value_bykey))
+	return C.gocef_v8value_delete_value_bykey(d.toNative(), (*C.cef_string_t)(key_), d.delete_value_bykey) != 0
 }
 
 // DeleteValueByindex (delete_value_byindex)
@@ -278,13 +279,14 @@ func (d *V8value) DeleteValueBykey(key string) int32 {
 // success. Returns false (0) if this function is called incorrectly, deletion
 // fails or an exception is thrown. For read-only and don't-delete values this
 // function will return true (1) even though deletion failed.
-func (d *V8value) DeleteValueByindex(index int32) int32 {
-	return int32(C.gocef_v8value_delete_value_byindex(d.toNative(), C.int(index), d.delete_value_byindex))
+func (d *V8value) DeleteValueByindex(index int32) bool {
+	return C.gocef_v8value_delete_value_byindex(d.toNative(), C.int(index), d.delete_value_byindex) != 0
 }
 
 // GetValueBykey (get_value_bykey)
 // Returns the value with the specified identifier on success. Returns NULL if
 // this function is called incorrectly or an exception is thrown.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *V8value) GetValueBykey(key string) *V8value {
 	key_ := C.cef_string_userfree_alloc()
 	setCEFStr(key, key_)
@@ -297,6 +299,7 @@ func (d *V8value) GetValueBykey(key string) *V8value {
 // GetValueByindex (get_value_byindex)
 // Returns the value with the specified identifier on success. Returns NULL if
 // this function is called incorrectly or an exception is thrown.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *V8value) GetValueByindex(index int32) *V8value {
 	return (*V8value)(C.gocef_v8value_get_value_byindex(d.toNative(), C.int(index), d.get_value_byindex))
 }
@@ -306,13 +309,13 @@ func (d *V8value) GetValueByindex(index int32) *V8value {
 // success. Returns false (0) if this function is called incorrectly or an
 // exception is thrown. For read-only values this function will return true
 // (1) even though assignment failed.
-func (d *V8value) SetValueBykey(key string, value *V8value, attribute V8Propertyattribute) int32 {
+func (d *V8value) SetValueBykey(key string, value *V8value, attribute V8Propertyattribute) bool {
 	key_ := C.cef_string_userfree_alloc()
 	setCEFStr(key, key_)
 	defer func() {
 		C.cef_string_userfree_free(key_)
 	}()
-	return int32(C.gocef_v8value_set_value_bykey(d.toNative(), (*C.cef_string_t)(key_), value.toNative(), C.cef_v8_propertyattribute_t(attribute), d.set_value_bykey))
+	return C.gocef_v8value_set_value_bykey(d.toNative(), (*C.cef_string_t)(key_), value.toNative(), C.cef_v8_propertyattribute_t(attribute), d.set_value_bykey) != 0
 }
 
 // SetValueByindex (set_value_byindex)
@@ -320,8 +323,8 @@ func (d *V8value) SetValueBykey(key string, value *V8value, attribute V8Property
 // success. Returns false (0) if this function is called incorrectly or an
 // exception is thrown. For read-only values this function will return true
 // (1) even though assignment failed.
-func (d *V8value) SetValueByindex(index int32, value *V8value) int32 {
-	return int32(C.gocef_v8value_set_value_byindex(d.toNative(), C.int(index), value.toNative(), d.set_value_byindex))
+func (d *V8value) SetValueByindex(index int32, value *V8value) bool {
+	return C.gocef_v8value_set_value_byindex(d.toNative(), C.int(index), value.toNative(), d.set_value_byindex) != 0
 }
 
 // SetValueByaccessor (set_value_byaccessor)
@@ -330,32 +333,36 @@ func (d *V8value) SetValueByindex(index int32, value *V8value) int32 {
 // cef_v8value_t::cef_v8value_create_object(). Returns false (0) if this
 // function is called incorrectly or an exception is thrown. For read-only
 // values this function will return true (1) even though assignment failed.
-func (d *V8value) SetValueByaccessor(key string, settings V8Accesscontrol, attribute V8Propertyattribute) int32 {
+func (d *V8value) SetValueByaccessor(key string, settings V8Accesscontrol, attribute V8Propertyattribute) bool {
 	key_ := C.cef_string_userfree_alloc()
 	setCEFStr(key, key_)
 	defer func() {
 		C.cef_string_userfree_free(key_)
 	}()
-	return int32(C.gocef_v8value_set_value_byaccessor(d.toNative(), (*C.cef_string_t)(key_), C.cef_v8_accesscontrol_t(settings), C.cef_v8_propertyattribute_t(attribute), d.set_value_byaccessor))
+	return C.gocef_v8value_set_value_byaccessor(d.toNative(), (*C.cef_string_t)(key_), C.cef_v8_accesscontrol_t(settings), C.cef_v8_propertyattribute_t(attribute), d.set_value_byaccessor) != 0
 }
 
 // GetKeys (get_keys)
 // Read the keys for the object's values into the specified vector. Integer-
 // based keys will also be returned as strings.
-func (d *V8value) GetKeys(keys StringList) int32 {
-	return int32(C.gocef_v8value_get_keys(d.toNative(), C.cef_string_list_t(keys), d.get_keys))
+func (d *V8value) GetKeys() ([]string, bool) {
+	keys_ := C.cef_string_list_alloc()
+	defer C.cef_string_list_free(keys_)
+	result__ := C.gocef_v8value_get_keys(d.toNative(), keys_, d.get_keys) != 0
+	return cefStringListToGo(keys_), result__
 }
 
 // SetUserData (set_user_data)
 // Sets the user data for this object and returns true (1) on success. Returns
 // false (0) if this function is called incorrectly. This function can only be
 // called on user created objects.
-func (d *V8value) SetUserData(user_data *BaseRefCounted) int32 {
-	return int32(C.gocef_v8value_set_user_data(d.toNative(), user_data.toNative(), d.set_user_data))
+func (d *V8value) SetUserData(user_data *BaseRefCounted) bool {
+	return C.gocef_v8value_set_user_data(d.toNative(), user_data.toNative(), d.set_user_data) != 0
 }
 
 // GetUserData (get_user_data)
 // Returns the user data, if any, assigned to this object.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *V8value) GetUserData() *BaseRefCounted {
 	return (*BaseRefCounted)(C.gocef_v8value_get_user_data(d.toNative(), d.get_user_data))
 }
@@ -390,6 +397,7 @@ func (d *V8value) GetArrayLength() int32 {
 // GetArrayBufferReleaseCallback (get_array_buffer_release_callback)
 // Returns the ReleaseCallback object associated with the ArrayBuffer or NULL
 // if the ArrayBuffer was not created with CreateArrayBuffer.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *V8value) GetArrayBufferReleaseCallback() *V8arrayBufferReleaseCallback {
 	return (*V8arrayBufferReleaseCallback)(C.gocef_v8value_get_array_buffer_release_callback(d.toNative(), d.get_array_buffer_release_callback))
 }
@@ -400,8 +408,8 @@ func (d *V8value) GetArrayBufferReleaseCallback() *V8arrayBufferReleaseCallback
 // with CreateArrayBuffer then
 // cef_v8array_buffer_release_callback_t::ReleaseBuffer will be called to
 // release the underlying buffer.
-func (d *V8value) NeuterArrayBuffer() int32 {
-	return int32(C.gocef_v8value_neuter_array_buffer(d.toNative(), d.neuter_array_buffer))
+func (d *V8value) NeuterArrayBuffer() bool {
+	return C.gocef_v8value_neuter_array_buffer(d.toNative(), d.neuter_array_buffer) != 0
 }
 
 // GetFunctionName (get_function_name)
@@ -427,6 +435,7 @@ func (d *V8value) GetFunctionHandler() *V8handler {
 // be passed to the function. Returns the function return value on success.
 // Returns NULL if this function is called incorrectly or an exception is
 // thrown.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *V8value) ExecuteFunction(object *V8value, argumentsCount uint64, arguments **V8value) *V8value {
 	arguments_ := (*arguments).toNative()
 	return (*V8value)(C.gocef_v8value_execute_function(d.toNative(), object.toNative(), C.size_t(argumentsCount), &arguments_, d.execute_function))
@@ -439,6 +448,7 @@ func (d *V8value) ExecuteFunction(object *V8value, argumentsCount uint64, argume
 // that will be passed to the function. Returns the function return value on
 // success. Returns NULL if this function is called incorrectly or an
 // exception is thrown.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *V8value) ExecuteFunctionWithContext(context *V8context, object *V8value, argumentsCount uint64, arguments **V8value) *V8value {
 	arguments_ := (*arguments).toNative()
 	return (*V8value)(C.gocef_v8value_execute_function_with_context(d.toNative(), context.toNative(), object.toNative(), C.size_t(argumentsCount), &arguments_, d.execute_function_with_context))
@@ -52,40 +52,41 @@ func (d *Value) Base() *BaseRefCounted {
 // dictionary) and that other object is then modified or destroyed. This value
 // object can be re-used by calling Set*() even if the underlying data is
 // invalid.
-func (d *Value) IsValid() int32 {
-	return int32(C.gocef_value_is_valid(d.toNative(), d.is_valid))
+func (d *Value) IsValid() bool {
+	return C.gocef_value_is_valid(d.toNative(), d.is_valid) != 0
 }
 
 // IsOwned (is_owned)
 // Returns true (1) if the underlying data is owned by another object.
-func (d *Value) IsOwned() int32 {
-	return int32(C.gocef_value_is_owned(d.toNative(), d.is_owned))
+func (d *Value) IsOwned() bool {
+	return C.gocef_value_is_owned(d.toNative(), d.is_owned) != 0
 }
 
 // IsReadOnly (is_read_only)
 // Returns true (1) if the underlying data is read-only. Some APIs may expose
 // read-only objects.
-func (d *Value) IsReadOnly() int32 {
-	return int32(C.gocef_value_is_read_only(d.toNative(), d.is_read_only))
+func (d *Value) IsReadOnly() bool {
+	return C.gocef_value_is_read_only(d.toNative(), d.is_read_only) != 0
 }
 
 // IsSame (is_same)
 // Returns true (1) if this object and |that| object have the same underlying
 // data. If true (1) modifications to this object will also affect |that|
 // object and vice-versa.
-func (d *Value) IsSame(that *Value) int32 {
-	return int32(C.gocef_value_is_same(d.toNative(), that.toNative(), d.is_same))
+func (d *Value) IsSame(that *Value) bool {
+	return C.gocef_value_is_same(d.toNative(), that.toNative(), d.is_same) != 0
 }
 
 // IsEqual (is_equal)
 // Returns true (1) if this object and |that| object have an equivalent
 // underlying value but are not necessarily the same object.
-func (d *Value) IsEqual(that *Value) int32 {
-	return int32(C.gocef_value_is_equal(d.toNative(), that.toNative(), d.is_equal))
+func (d *Value) IsEqual(that *Value) bool {
+	return C.gocef_value_is_equal(d.toNative(), that.toNative(), d.is_equal) != 0
 }
 
 // Copy (copy)
 // Returns a copy of this object. The underlying data will also be copied.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *Value) Copy() *Value {
 	return (*Value)(C.gocef_value_copy(d.toNative(), d.copy))
 }
@@ -98,8 +99,8 @@ func (d *Value) GetType() ValueType {
 
 // GetBool (get_bool)
 // Returns the underlying value as type bool.
-func (d *Value) GetBool() int32 {
-	return int32(C.gocef_value_get_bool(d.toNative(), d.get_bool))
+func (d *Value) GetBool() bool {
+	return C.gocef_value_get_bool(d.toNative(), d.get_bool) != 0
 }
 
 // GetInt (get_int)
@@ -128,6 +129,7 @@ func (d *Value) GetString() string {
 // value after assigning ownership to a dictionary or list pass this object to
 // the set_value() function instead of passing the returned reference to
 // set_binary().
+// The caller owns a reference to the returned value; see Adopt().
 func (d *Value) GetBinary() *BinaryValue {
 	return (*BinaryValue)(C.gocef_value_get_binary(d.toNative(), d.get_binary))
 }
@@ -139,6 +141,7 @@ func (d *Value) GetBinary() *BinaryValue {
 // value after assigning ownership to a dictionary or list pass this object to
 // the set_value() function instead of passing the returned reference to
 // set_dictionary().
+// The caller owns a reference to the returned value; see Adopt().
 func (d *Value) GetDictionary() *DictionaryValue {
 	return (*DictionaryValue)(C.gocef_value_get_dictionary(d.toNative(), d.get_dictionary))
 }
@@ -150,6 +153,7 @@ func (d *Value) GetDictionary() *DictionaryValue {
 // value after assigning ownership to a dictionary or list pass this object to
 // the set_value() function instead of passing the returned reference to
 // set_list().
+// The caller owns a reference to the returned value; see Adopt().
 func (d *Value) GetList() *ListValue {
 	return (*ListValue)(C.gocef_value_get_list(d.toNative(), d.get_list))
 }
@@ -157,63 +161,63 @@ func (d *Value) GetList() *ListValue {
 // SetNull (set_null)
 // Sets the underlying value as type null. Returns true (1) if the value was
 // set successfully.
-func (d *Value) SetNull() int32 {
-	return int32(C.gocef_value_set_null(d.toNative(), d.set_null))
+func (d *Value) SetNull() bool {
+	return C.gocef_value_set_null(d.toNative(), d.set_null) != 0
 }
 
 // SetBool (set_bool)
 // Sets the underlying value as type bool. Returns true (1) if the value was
 // set successfully.
-func (d *Value) SetBool(value int32) int32 {
-	return int32(C.gocef_value_set_bool(d.toNative(), C.int(value), d.set_bool))
+func (d *Value) SetBool(value bool) bool {
+	return C.gocef_value_set_bool(d.toNative(), cefBool(value), d.set_bool) != 0
 }
 
 // SetInt (set_int)
 // Sets the underlying value as type int. Returns true (1) if the value was
 // set successfully.
-func (d *Value) SetInt(value int32) int32 {
-	return int32(C.gocef_value_set_int(d.toNative(), C.int(value), d.set_int))
+func (d *Value) SetInt(value int32) bool {
+	return C.gocef_value_set_int(d.toNative(), C.int(value), d.set_int) != 0
 }
 
 // SetDouble (set_double)
 // Sets the underlying value as type double. Returns true (1) if the value was
 // set successfully.
-func (d *Value) SetDouble(value float64) int32 {
-	return int32(C.gocef_value_set_double(d.toNative(), C.double(value), d.set_double))
+func (d *Value) SetDouble(value float64) bool {
+	return C.gocef_value_set_double(d.toNative(), C.double(value), d.set_double) != 0
 }
 
 // SetString (set_string)
 // Sets the underlying value as type string. Returns true (1) if the value was
 // set successfully.
-func (d *Value) SetString(value string) int32 {
+func (d *Value) SetString(value string) bool {
 	value_ := C.cef_string_userfree_alloc()
 	setCEFStr(value, value_)
 	defer func() {
 		C.cef_string_userfree_free(value_)
 	}()
-	return int32(C.gocef_value_set_string(d.toNative(), (*C.cef_string_t)(value_), d.set_string))
+	return C.gocef_value_set_string(d.toNative(), (*C.cef_string_t)(value_), d.set_string) != 0
 }
 
 // SetBinary (set_binary)
 // Sets the underlying value as type binary. Returns true (1) if the value was
 // set successfully. This object keeps a reference to |value| and ownership of
 // the underlying data remains unchanged.
-func (d *Value) SetBinary(value *BinaryValue) int32 {
-	return int32(C.gocef_value_set_binary(d.toNative(), value.toNative(), d.set_binary))
+func (d *Value) SetBinary(value *BinaryValue) bool {
+	return C.gocef_value_set_binary(d.toNative(), value.toNative(), d.set_binary) != 0
 }
 
 // SetDictionary (set_dictionary)
 // Sets the underlying value as type dict. Returns true (1) if the value was
 // set successfully. This object keeps a reference to |value| and ownership of
 // the underlying data remains unchanged.
-func (d *Value) SetDictionary(value *DictionaryValue) int32 {
-	return int32(C.gocef_value_set_dictionary(d.toNative(), value.toNative(), d.set_dictionary))
+func (d *Value) SetDictionary(value *DictionaryValue) bool {
+	return C.gocef_value_set_dictionary(d.toNative(), value.toNative(), d.set_dictionary) != 0
 }
 
 // SetList (set_list)
 // Sets the underlying value as type list. Returns true (1) if the value was
 // set successfully. This object keeps a reference to |value| and ownership of
 // the underlying data remains unchanged.
-func (d *Value) SetList(value *ListValue) int32 {
-	return int32(C.gocef_value_set_list(d.toNative(), value.toNative(), d.set_list))
+func (d *Value) SetList(value *ListValue) bool {
+	return C.gocef_value_set_list(d.toNative(), value.toNative(), d.set_list) != 0
 }
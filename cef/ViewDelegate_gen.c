@@ -3,15 +3,31 @@
 #include "ViewDelegate_gen.h"
 #include "_cgo_export.h"
 
-void gocef_set_view_delegate_proxy(cef_view_delegate_t *self) {
+void gocef_set_view_delegate_proxy(cef_view_delegate_t *self, int get_preferred_size, int get_minimum_size, int get_maximum_size, int get_height_for_width, int on_parent_view_changed, int on_child_view_changed, int on_focus, int on_blur) {
 	// Casts to (void *) added to avoid warnings since Go callbacks can't have
 	// some modifiers, such as 'const' applied to their parameter signatures.
-	self->get_preferred_size = (void *)&gocef_view_delegate_get_preferred_size;
-	self->get_minimum_size = (void *)&gocef_view_delegate_get_minimum_size;
-	self->get_maximum_size = (void *)&gocef_view_delegate_get_maximum_size;
-	self->get_height_for_width = (void *)&gocef_view_delegate_get_height_for_width;
-	self->on_parent_view_changed = (void *)&gocef_view_delegate_on_parent_view_changed;
-	self->on_child_view_changed = (void *)&gocef_view_delegate_on_child_view_changed;
-	self->on_focus = (void *)&gocef_view_delegate_on_focus;
-	self->on_blur = (void *)&gocef_view_delegate_on_blur;
+	if (get_preferred_size) {
+		self->get_preferred_size = (void *)&gocef_view_delegate_get_preferred_size;
+	}
+	if (get_minimum_size) {
+		self->get_minimum_size = (void *)&gocef_view_delegate_get_minimum_size;
+	}
+	if (get_maximum_size) {
+		self->get_maximum_size = (void *)&gocef_view_delegate_get_maximum_size;
+	}
+	if (get_height_for_width) {
+		self->get_height_for_width = (void *)&gocef_view_delegate_get_height_for_width;
+	}
+	if (on_parent_view_changed) {
+		self->on_parent_view_changed = (void *)&gocef_view_delegate_on_parent_view_changed;
+	}
+	if (on_child_view_changed) {
+		self->on_child_view_changed = (void *)&gocef_view_delegate_on_child_view_changed;
+	}
+	if (on_focus) {
+		self->on_focus = (void *)&gocef_view_delegate_on_focus;
+	}
+	if (on_blur) {
+		self->on_blur = (void *)&gocef_view_delegate_on_blur;
+	}
 }
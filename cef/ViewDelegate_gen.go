@@ -6,20 +6,58 @@ import (
 	// #include "ViewDelegate_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// ViewDelegateProxy defines methods required for using ViewDelegate.
+// ViewDelegateProxy is implemented by the proxies used with ViewDelegate. Embed
+// DefaultViewDelegate to satisfy it, then implement any of the optional
+// ViewDelegate*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type ViewDelegateProxy interface {
+	isViewDelegateProxy()
+}
+
+// DefaultViewDelegate may be embedded to satisfy ViewDelegateProxy.
+type DefaultViewDelegate struct{}
+
+func (DefaultViewDelegate) isViewDelegateProxy() {}
+
+// ViewDelegateGetPreferredSizeProxy may be implemented by a ViewDelegateProxy to handle GetPreferredSize.
+type ViewDelegateGetPreferredSizeProxy interface {
 	GetPreferredSize(self *ViewDelegate, view *View) Size
+}
+
+// ViewDelegateGetMinimumSizeProxy may be implemented by a ViewDelegateProxy to handle GetMinimumSize.
+type ViewDelegateGetMinimumSizeProxy interface {
 	GetMinimumSize(self *ViewDelegate, view *View) Size
+}
+
+// ViewDelegateGetMaximumSizeProxy may be implemented by a ViewDelegateProxy to handle GetMaximumSize.
+type ViewDelegateGetMaximumSizeProxy interface {
 	GetMaximumSize(self *ViewDelegate, view *View) Size
+}
+
+// ViewDelegateGetHeightForWidthProxy may be implemented by a ViewDelegateProxy to handle GetHeightForWidth.
+type ViewDelegateGetHeightForWidthProxy interface {
 	GetHeightForWidth(self *ViewDelegate, view *View, width int32) int32
-	OnParentViewChanged(self *ViewDelegate, view *View, added int32, parent *View)
-	OnChildViewChanged(self *ViewDelegate, view *View, added int32, child *View)
+}
+
+// ViewDelegateOnParentViewChangedProxy may be implemented by a ViewDelegateProxy to handle OnParentViewChanged.
+type ViewDelegateOnParentViewChangedProxy interface {
+	OnParentViewChanged(self *ViewDelegate, view *View, added bool, parent *View)
+}
+
+// ViewDelegateOnChildViewChangedProxy may be implemented by a ViewDelegateProxy to handle OnChildViewChanged.
+type ViewDelegateOnChildViewChangedProxy interface {
+	OnChildViewChanged(self *ViewDelegate, view *View, added bool, child *View)
+}
+
+// ViewDelegateOnFocusProxy may be implemented by a ViewDelegateProxy to handle OnFocus.
+type ViewDelegateOnFocusProxy interface {
 	OnFocus(self *ViewDelegate, view *View)
+}
+
+// ViewDelegateOnBlurProxy may be implemented by a ViewDelegateProxy to handle OnBlur.
+type ViewDelegateOnBlurProxy interface {
 	OnBlur(self *ViewDelegate, view *View)
 }
 
@@ -34,7 +72,15 @@ type ViewDelegate C.cef_view_delegate_t
 func NewViewDelegate(proxy ViewDelegateProxy) *ViewDelegate {
 	result := (*ViewDelegate)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_view_delegate_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_view_delegate_proxy(result.toNative())
+		_, get_preferred_size := proxy.(ViewDelegateGetPreferredSizeProxy)
+		_, get_minimum_size := proxy.(ViewDelegateGetMinimumSizeProxy)
+		_, get_maximum_size := proxy.(ViewDelegateGetMaximumSizeProxy)
+		_, get_height_for_width := proxy.(ViewDelegateGetHeightForWidthProxy)
+		_, on_parent_view_changed := proxy.(ViewDelegateOnParentViewChangedProxy)
+		_, on_child_view_changed := proxy.(ViewDelegateOnChildViewChangedProxy)
+		_, on_focus := proxy.(ViewDelegateOnFocusProxy)
+		_, on_blur := proxy.(ViewDelegateOnBlurProxy)
+		C.gocef_set_view_delegate_proxy(result.toNative(), cefBool(get_preferred_size), cefBool(get_minimum_size), cefBool(get_maximum_size), cefBool(get_height_for_width), cefBool(on_parent_view_changed), cefBool(on_child_view_changed), cefBool(on_focus), cefBool(on_blur))
 	}
 	return result
 }
@@ -43,14 +89,15 @@ func (d *ViewDelegate) toNative() *C.cef_view_delegate_t {
 	return (*C.cef_view_delegate_t)(d)
 }
 
-func lookupViewDelegateProxy(obj *BaseRefCounted) ViewDelegateProxy {
+func lookupViewDelegateProxy(obj *BaseRefCounted, callback string) ViewDelegateProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("ViewDelegate", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(ViewDelegateProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type ViewDelegateProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("ViewDelegate", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -65,13 +112,20 @@ func (d *ViewDelegate) Base() *BaseRefCounted {
 // Return the preferred size for |view|. The Layout will use this information
 // to determine the display size.
 func (d *ViewDelegate) GetPreferredSize(view *View) Size {
-	return lookupViewDelegateProxy(d.Base()).GetPreferredSize(d, view)
+	if proxy__, ok__ := lookupViewDelegateProxy(d.Base(), "GetPreferredSize").(ViewDelegateGetPreferredSizeProxy); ok__ {
+		return proxy__.GetPreferredSize(d, view)
+	}
+	return Size{}
 }
 
 //export gocef_view_delegate_get_preferred_size
 func gocef_view_delegate_get_preferred_size(self *C.cef_view_delegate_t, view *C.cef_view_t) C.cef_size_t {
+	defer recoverProxyPanic("ViewDelegate", "GetPreferredSize")
 	me__ := (*ViewDelegate)(self)
-	proxy__ := lookupViewDelegateProxy(me__.Base())
+	proxy__, ok__ := lookupViewDelegateProxy(me__.Base(), "GetPreferredSize").(ViewDelegateGetPreferredSizeProxy)
+	if !ok__ {
+		return C.cef_size_t{}
+	}
 	call__ := proxy__.GetPreferredSize(me__, (*View)(view))
 	var result__ C.cef_size_t
 	call__.toNative(&result__)
@@ -81,13 +135,20 @@ func gocef_view_delegate_get_preferred_size(self *C.cef_view_delegate_t, view *C
 // GetMinimumSize (get_minimum_size)
 // Return the minimum size for |view|.
 func (d *ViewDelegate) GetMinimumSize(view *View) Size {
-	return lookupViewDelegateProxy(d.Base()).GetMinimumSize(d, view)
+	if proxy__, ok__ := lookupViewDelegateProxy(d.Base(), "GetMinimumSize").(ViewDelegateGetMinimumSizeProxy); ok__ {
+		return proxy__.GetMinimumSize(d, view)
+	}
+	return Size{}
 }
 
 //export gocef_view_delegate_get_minimum_size
 func gocef_view_delegate_get_minimum_size(self *C.cef_view_delegate_t, view *C.cef_view_t) C.cef_size_t {
+	defer recoverProxyPanic("ViewDelegate", "GetMinimumSize")
 	me__ := (*ViewDelegate)(self)
-	proxy__ := lookupViewDelegateProxy(me__.Base())
+	proxy__, ok__ := lookupViewDelegateProxy(me__.Base(), "GetMinimumSize").(ViewDelegateGetMinimumSizeProxy)
+	if !ok__ {
+		return C.cef_size_t{}
+	}
 	call__ := proxy__.GetMinimumSize(me__, (*View)(view))
 	var result__ C.cef_size_t
 	call__.toNative(&result__)
@@ -97,13 +158,20 @@ func gocef_view_delegate_get_minimum_size(self *C.cef_view_delegate_t, view *C.c
 // GetMaximumSize (get_maximum_size)
 // Return the maximum size for |view|.
 func (d *ViewDelegate) GetMaximumSize(view *View) Size {
-	return lookupViewDelegateProxy(d.Base()).GetMaximumSize(d, view)
+	if proxy__, ok__ := lookupViewDelegateProxy(d.Base(), "GetMaximumSize").(ViewDelegateGetMaximumSizeProxy); ok__ {
+		return proxy__.GetMaximumSize(d, view)
+	}
+	return Size{}
 }
 
 //export gocef_view_delegate_get_maximum_size
 func gocef_view_delegate_get_maximum_size(self *C.cef_view_delegate_t, view *C.cef_view_t) C.cef_size_t {
+	defer recoverProxyPanic("ViewDelegate", "GetMaximumSize")
 	me__ := (*ViewDelegate)(self)
-	proxy__ := lookupViewDelegateProxy(me__.Base())
+	proxy__, ok__ := lookupViewDelegateProxy(me__.Base(), "GetMaximumSize").(ViewDelegateGetMaximumSizeProxy)
+	if !ok__ {
+		return C.cef_size_t{}
+	}
 	call__ := proxy__.GetMaximumSize(me__, (*View)(view))
 	var result__ C.cef_size_t
 	call__.toNative(&result__)
@@ -116,13 +184,20 @@ func gocef_view_delegate_get_maximum_size(self *C.cef_view_delegate_t, view *C.c
 // default. Override if |view|'s preferred height depends upon the width (for
 // example, with Labels).
 func (d *ViewDelegate) GetHeightForWidth(view *View, width int32) int32 {
-	return lookupViewDelegateProxy(d.Base()).GetHeightForWidth(d, view, width)
+	if proxy__, ok__ := lookupViewDelegateProxy(d.Base(), "GetHeightForWidth").(ViewDelegateGetHeightForWidthProxy); ok__ {
+		return proxy__.GetHeightForWidth(d, view, width)
+	}
+	return 0
 }
 
 //export gocef_view_delegate_get_height_for_width
 func gocef_view_delegate_get_height_for_width(self *C.cef_view_delegate_t, view *C.cef_view_t, width C.int) C.int {
+	defer recoverProxyPanic("ViewDelegate", "GetHeightForWidth")
 	me__ := (*ViewDelegate)(self)
-	proxy__ := lookupViewDelegateProxy(me__.Base())
+	proxy__, ok__ := lookupViewDelegateProxy(me__.Base(), "GetHeightForWidth").(ViewDelegateGetHeightForWidthProxy)
+	if !ok__ {
+		return 0
+	}
 	return C.int(proxy__.GetHeightForWidth(me__, (*View)(view), int32(width)))
 }
 
@@ -132,15 +207,21 @@ func gocef_view_delegate_get_height_for_width(self *C.cef_view_delegate_t, view
 // |parent| then |added| will be false (0). If |view| is being reparented the
 // remove notification will be sent before the add notification. Do not modify
 // the view hierarchy in this callback.
-func (d *ViewDelegate) OnParentViewChanged(view *View, added int32, parent *View) {
-	lookupViewDelegateProxy(d.Base()).OnParentViewChanged(d, view, added, parent)
+func (d *ViewDelegate) OnParentViewChanged(view *View, added bool, parent *View) {
+	if proxy__, ok__ := lookupViewDelegateProxy(d.Base(), "OnParentViewChanged").(ViewDelegateOnParentViewChangedProxy); ok__ {
+		proxy__.OnParentViewChanged(d, view, added, parent)
+	}
 }
 
 //export gocef_view_delegate_on_parent_view_changed
 func gocef_view_delegate_on_parent_view_changed(self *C.cef_view_delegate_t, view *C.cef_view_t, added C.int, parent *C.cef_view_t) {
+	defer recoverProxyPanic("ViewDelegate", "OnParentViewChanged")
 	me__ := (*ViewDelegate)(self)
-	proxy__ := lookupViewDelegateProxy(me__.Base())
-	proxy__.OnParentViewChanged(me__, (*View)(view), int32(added), (*View)(parent))
+	proxy__, ok__ := lookupViewDelegateProxy(me__.Base(), "OnParentViewChanged").(ViewDelegateOnParentViewChangedProxy)
+	if !ok__ {
+		return
+	}
+	proxy__.OnParentViewChanged(me__, (*View)(view), added != 0, (*View)(parent))
 }
 
 // OnChildViewChanged (on_child_view_changed)
@@ -150,39 +231,57 @@ func gocef_view_delegate_on_parent_view_changed(self *C.cef_view_delegate_t, vie
 // remove notification will be sent to the old parent before the add
 // notification is sent to the new parent. Do not modify the view hierarchy in
 // this callback.
-func (d *ViewDelegate) OnChildViewChanged(view *View, added int32, child *View) {
-	lookupViewDelegateProxy(d.Base()).OnChildViewChanged(d, view, added, child)
+func (d *ViewDelegate) OnChildViewChanged(view *View, added bool, child *View) {
+	if proxy__, ok__ := lookupViewDelegateProxy(d.Base(), "OnChildViewChanged").(ViewDelegateOnChildViewChangedProxy); ok__ {
+		proxy__.OnChildViewChanged(d, view, added, child)
+	}
 }
 
 //export gocef_view_delegate_on_child_view_changed
 func gocef_view_delegate_on_child_view_changed(self *C.cef_view_delegate_t, view *C.cef_view_t, added C.int, child *C.cef_view_t) {
+	defer recoverProxyPanic("ViewDelegate", "OnChildViewChanged")
 	me__ := (*ViewDelegate)(self)
-	proxy__ := lookupViewDelegateProxy(me__.Base())
-	proxy__.OnChildViewChanged(me__, (*View)(view), int32(added), (*View)(child))
+	proxy__, ok__ := lookupViewDelegateProxy(me__.Base(), "OnChildViewChanged").(ViewDelegateOnChildViewChangedProxy)
+	if !ok__ {
+		return
+	}
+	proxy__.OnChildViewChanged(me__, (*View)(view), added != 0, (*View)(child))
 }
 
 // OnFocus (on_focus)
 // Called when |view| gains focus.
 func (d *ViewDelegate) OnFocus(view *View) {
-	lookupViewDelegateProxy(d.Base()).OnFocus(d, view)
+	if proxy__, ok__ := lookupViewDelegateProxy(d.Base(), "OnFocus").(ViewDelegateOnFocusProxy); ok__ {
+		proxy__.OnFocus(d, view)
+	}
 }
 
 //export gocef_view_delegate_on_focus
 func gocef_view_delegate_on_focus(self *C.cef_view_delegate_t, view *C.cef_view_t) {
+	defer recoverProxyPanic("ViewDelegate", "OnFocus")
 	me__ := (*ViewDelegate)(self)
-	proxy__ := lookupViewDelegateProxy(me__.Base())
+	proxy__, ok__ := lookupViewDelegateProxy(me__.Base(), "OnFocus").(ViewDelegateOnFocusProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnFocus(me__, (*View)(view))
 }
 
 // OnBlur (on_blur)
 // Called when |view| loses focus.
 func (d *ViewDelegate) OnBlur(view *View) {
-	lookupViewDelegateProxy(d.Base()).OnBlur(d, view)
+	if proxy__, ok__ := lookupViewDelegateProxy(d.Base(), "OnBlur").(ViewDelegateOnBlurProxy); ok__ {
+		proxy__.OnBlur(d, view)
+	}
 }
 
 //export gocef_view_delegate_on_blur
 func gocef_view_delegate_on_blur(self *C.cef_view_delegate_t, view *C.cef_view_t) {
+	defer recoverProxyPanic("ViewDelegate", "OnBlur")
 	me__ := (*ViewDelegate)(self)
-	proxy__ := lookupViewDelegateProxy(me__.Base())
+	proxy__, ok__ := lookupViewDelegateProxy(me__.Base(), "OnBlur").(ViewDelegateOnBlurProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnBlur(me__, (*View)(view))
 }
@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_view_delegate_proxy(cef_view_delegate_t *self);
+void gocef_set_view_delegate_proxy(cef_view_delegate_t *self, int get_preferred_size, int get_minimum_size, int get_maximum_size, int get_height_for_width, int on_parent_view_changed, int on_child_view_changed, int on_focus, int on_blur);
 
 #endif // GOCEF_ViewDelegate_H_
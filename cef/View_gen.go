@@ -74,30 +74,35 @@ func (d *View) Base() *BaseRefCounted {
 
 // AsBrowserView (as_browser_view)
 // Returns this View as a BrowserView or NULL if this is not a BrowserView.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *View) AsBrowserView() *BrowserView {
 	return (*BrowserView)(C.gocef_view_as_browser_view(d.toNative(), d.as_browser_view))
 }
 
 // AsButton (as_button)
 // Returns this View as a Button or NULL if this is not a Button.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *View) AsButton() *Button {
 	return (*Button)(C.gocef_view_as_button(d.toNative(), d.as_button))
 }
 
 // AsPanel (as_panel)
 // Returns this View as a Panel or NULL if this is not a Panel.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *View) AsPanel() *Panel {
 	return (*Panel)(C.gocef_view_as_panel(d.toNative(), d.as_panel))
 }
 
 // AsScrollView (as_scroll_view)
 // Returns this View as a ScrollView or NULL if this is not a ScrollView.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *View) AsScrollView() *ScrollView {
 	return (*ScrollView)(C.gocef_view_as_scroll_view(d.toNative(), d.as_scroll_view))
 }
 
 // AsTextfield (as_textfield)
 // Returns this View as a Textfield or NULL if this is not a Textfield.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *View) AsTextfield() *Textfield {
 	return (*Textfield)(C.gocef_view_as_textfield(d.toNative(), d.as_textfield))
 }
@@ -116,37 +121,39 @@ func (d *View) GetTypeString() string {
 // (1) any child Views will also be included. Used primarily for testing
 // purposes.
 // The resulting string must be freed by calling cef_string_userfree_free().
-func (d *View) ToString(include_children int32) string {
-	return cefuserfreestrToString(C.gocef_view_to_string(d.toNative(), C.int(include_children), d.to_string))
+func (d *View) ToString(include_children bool) string {
+	return cefuserfreestrToString(C.gocef_view_to_string(d.toNative(), cefBool(include_children), d.to_string))
 }
 
 // IsValid (is_valid)
 // Returns true (1) if this View is valid.
-func (d *View) IsValid() int32 {
-	return int32(C.gocef_view_is_valid(d.toNative(), d.is_valid))
+func (d *View) IsValid() bool {
+	return C.gocef_view_is_valid(d.toNative(), d.is_valid) != 0
 }
 
 // IsAttached (is_attached)
 // Returns true (1) if this View is currently attached to another View. A View
 // can only be attached to one View at a time.
-func (d *View) IsAttached() int32 {
-	return int32(C.gocef_view_is_attached(d.toNative(), d.is_attached))
+func (d *View) IsAttached() bool {
+	return C.gocef_view_is_attached(d.toNative(), d.is_attached) != 0
 }
 
 // IsSame (is_same)
 // Returns true (1) if this View is the same as |that| View.
-func (d *View) IsSame(that *View) int32 {
-	return int32(C.gocef_view_is_same(d.toNative(), that.toNative(), d.is_same))
+func (d *View) IsSame(that *View) bool {
+	return C.gocef_view_is_same(d.toNative(), that.toNative(), d.is_same) != 0
 }
 
 // GetDelegate (get_delegate)
 // Returns the delegate associated with this View, if any.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *View) GetDelegate() *ViewDelegate {
 	return (*ViewDelegate)(C.gocef_view_get_delegate(d.toNative(), d.get_delegate))
 }
 
 // GetWindow (get_window)
 // Returns the top-level Window hosting this View, if any.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *View) GetWindow() *Window {
 	return (*Window)(C.gocef_view_get_window(d.toNative(), d.get_window))
 }
@@ -180,6 +187,7 @@ func (d *View) SetGroupId(group_id int32) {
 
 // GetParentView (get_parent_view)
 // Returns the View that contains this View, if any.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *View) GetParentView() *View {
 	return (*View)(C.gocef_view_get_parent_view(d.toNative(), d.get_parent_view))
 }
@@ -188,6 +196,7 @@ func (d *View) GetParentView() *View {
 // Recursively descends the view tree starting at this View, and returns the
 // first child that it encounters with the given ID. Returns NULL if no
 // matching child view is found.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *View) GetViewForId(id int32) *View {
 	return (*View)(C.gocef_view_get_view_for_id(d.toNative(), C.int(id), d.get_view_for_id))
 }
@@ -305,8 +314,8 @@ func (d *View) InvalidateLayout() {
 // views currently have focus, then focus will also be cleared. Painting is
 // scheduled as needed. If this View is a Window then calling this function is
 // equivalent to calling the Window show() and hide() functions.
-func (d *View) SetVisible(visible int32) {
-	C.gocef_view_set_visible(d.toNative(), C.int(visible), d.set_visible)
+func (d *View) SetVisible(visible bool) {
+	C.gocef_view_set_visible(d.toNative(), cefBool(visible), d.set_visible)
 }
 
 // IsVisible (is_visible)
@@ -316,8 +325,8 @@ func (d *View) SetVisible(visible int32) {
 // visible to the user on-screen. If this View is not a Window then call
 // is_drawn() to determine whether this View and all parent views are visible
 // and will be drawn.
-func (d *View) IsVisible() int32 {
-	return int32(C.gocef_view_is_visible(d.toNative(), d.is_visible))
+func (d *View) IsVisible() bool {
+	return C.gocef_view_is_visible(d.toNative(), d.is_visible) != 0
 }
 
 // IsDrawn (is_drawn)
@@ -326,43 +335,43 @@ func (d *View) IsVisible() int32 {
 // calling this function is equivalent to calling is_visible(). Otherwise, to
 // determine if the containing Window is visible to the user on-screen call
 // is_visible() on the Window.
-func (d *View) IsDrawn() int32 {
-	return int32(C.gocef_view_is_drawn(d.toNative(), d.is_drawn))
+func (d *View) IsDrawn() bool {
+	return C.gocef_view_is_drawn(d.toNative(), d.is_drawn) != 0
 }
 
 // SetEnabled (set_enabled)
 // Set whether this View is enabled. A disabled View does not receive keyboard
 // or mouse inputs. If |enabled| differs from the current value the View will
 // be repainted. Also, clears focus if the focused View is disabled.
-func (d *View) SetEnabled(enabled int32) {
-	C.gocef_view_set_enabled(d.toNative(), C.int(enabled), d.set_enabled)
+func (d *View) SetEnabled(enabled bool) {
+	C.gocef_view_set_enabled(d.toNative(), cefBool(enabled), d.set_enabled)
 }
 
 // IsEnabled (is_enabled)
 // Returns whether this View is enabled.
-func (d *View) IsEnabled() int32 {
-	return int32(C.gocef_view_is_enabled(d.toNative(), d.is_enabled))
+func (d *View) IsEnabled() bool {
+	return C.gocef_view_is_enabled(d.toNative(), d.is_enabled) != 0
 }
 
 // SetFocusable (set_focusable)
 // Sets whether this View is capable of taking focus. It will clear focus if
 // the focused View is set to be non-focusable. This is false (0) by default
 // so that a View used as a container does not get the focus.
-func (d *View) SetFocusable(focusable int32) {
-	C.gocef_view_set_focusable(d.toNative(), C.int(focusable), d.set_focusable)
+func (d *View) SetFocusable(focusable bool) {
+	C.gocef_view_set_focusable(d.toNative(), cefBool(focusable), d.set_focusable)
 }
 
 // IsFocusable (is_focusable)
 // Returns true (1) if this View is focusable, enabled and drawn.
-func (d *View) IsFocusable() int32 {
-	return int32(C.gocef_view_is_focusable(d.toNative(), d.is_focusable))
+func (d *View) IsFocusable() bool {
+	return C.gocef_view_is_focusable(d.toNative(), d.is_focusable) != 0
 }
 
 // IsAccessibilityFocusable (is_accessibility_focusable)
 // Return whether this View is focusable when the user requires full keyboard
 // access, even though it may not be normally focusable.
-func (d *View) IsAccessibilityFocusable() int32 {
-	return int32(C.gocef_view_is_accessibility_focusable(d.toNative(), d.is_accessibility_focusable))
+func (d *View) IsAccessibilityFocusable() bool {
+	return C.gocef_view_is_accessibility_focusable(d.toNative(), d.is_accessibility_focusable) != 0
 }
 
 // RequestFocus (request_focus)
@@ -390,8 +399,8 @@ func (d *View) GetBackgroundColor() Color {
 // (1) if the conversion is successful or false (0) otherwise. Use
 // cef_display_t::convert_point_to_pixels() after calling this function if
 // further conversion to display-specific pixel coordinates is desired.
-func (d *View) ConvertPointToScreen(point *Point) int32 {
-	return int32(C.gocef_view_convert_point_to_screen(d.toNative(), point.toNative(&C.cef_point_t{}), d.convert_point_to_screen))
+func (d *View) ConvertPointToScreen(point *Point) bool {
+	return C.gocef_view_convert_point_to_screen(d.toNative(), point.toNative(&C.cef_point_t{}), d.convert_point_to_screen) != 0
 }
 
 // ConvertPointFromScreen (convert_point_from_screen)
@@ -400,24 +409,24 @@ func (d *View) ConvertPointToScreen(point *Point) int32 {
 // (1) if the conversion is successful or false (0) otherwise. Use
 // cef_display_t::convert_point_from_pixels() before calling this function if
 // conversion from display-specific pixel coordinates is necessary.
-func (d *View) ConvertPointFromScreen(point *Point) int32 {
-	return int32(C.gocef_view_convert_point_from_screen(d.toNative(), point.toNative(&C.cef_point_t{}), d.convert_point_from_screen))
+func (d *View) ConvertPointFromScreen(point *Point) bool {
+	return C.gocef_view_convert_point_from_screen(d.toNative(), point.toNative(&C.cef_point_t{}), d.convert_point_from_screen) != 0
 }
 
 // ConvertPointToWindow (convert_point_to_window)
 // Convert |point| from this View's coordinate system to that of the Window.
 // This View must belong to a Window when calling this function. Returns true
 // (1) if the conversion is successful or false (0) otherwise.
-func (d *View) ConvertPointToWindow(point *Point) int32 {
-	return int32(C.gocef_view_convert_point_to_window(d.toNative(), point.toNative(&C.cef_point_t{}), d.convert_point_to_window))
+func (d *View) ConvertPointToWindow(point *Point) bool {
+	return C.gocef_view_convert_point_to_window(d.toNative(), point.toNative(&C.cef_point_t{}), d.convert_point_to_window) != 0
 }
 
 // ConvertPointFromWindow (convert_point_from_window)
 // Convert |point| to this View's coordinate system from that of the Window.
 // This View must belong to a Window when calling this function. Returns true
 // (1) if the conversion is successful or false (0) otherwise.
-func (d *View) ConvertPointFromWindow(point *Point) int32 {
-	return int32(C.gocef_view_convert_point_from_window(d.toNative(), point.toNative(&C.cef_point_t{}), d.convert_point_from_window))
+func (d *View) ConvertPointFromWindow(point *Point) bool {
+	return C.gocef_view_convert_point_from_window(d.toNative(), point.toNative(&C.cef_point_t{}), d.convert_point_from_window) != 0
 }
 
 // ConvertPointToView (convert_point_to_view)
@@ -425,14 +434,14 @@ func (d *View) ConvertPointFromWindow(point *Point) int32 {
 // |view| needs to be in the same Window but not necessarily the same view
 // hierarchy. Returns true (1) if the conversion is successful or false (0)
 // otherwise.
-func (d *View) ConvertPointToView(view *View, point *Point) int32 {
-	return int32(C.gocef_view_convert_point_to_view(d.toNative(), view.toNative(), point.toNative(&C.cef_point_t{}), d.convert_point_to_view))
+func (d *View) ConvertPointToView(view *View, point *Point) bool {
+	return C.gocef_view_convert_point_to_view(d.toNative(), view.toNative(), point.toNative(&C.cef_point_t{}), d.convert_point_to_view) != 0
 }
 
 // ConvertPointFromView (convert_point_from_view)
 // Convert |point| to this View's coordinate system from that |view|. |view|
 // needs to be in the same Window but not necessarily the same view hierarchy.
 // Returns true (1) if the conversion is successful or false (0) otherwise.
-func (d *View) ConvertPointFromView(view *View, point *Point) int32 {
-	return int32(C.gocef_view_convert_point_from_view(d.toNative(), view.toNative(), point.toNative(&C.cef_point_t{}), d.convert_point_from_view))
+func (d *View) ConvertPointFromView(view *View, point *Point) bool {
+	return C.gocef_view_convert_point_from_view(d.toNative(), view.toNative(), point.toNative(&C.cef_point_t{}), d.convert_point_from_view) != 0
 }
@@ -51,8 +51,8 @@ func (d *WaitableEvent) Signal() {
 // Returns true (1) if the event is in the signaled state, else false (0). If
 // the event was created with |automatic_reset| set to true (1) then calling
 // this function will also cause a reset.
-func (d *WaitableEvent) IsSignaled() int32 {
-	return int32(C.gocef_waitable_event_is_signaled(d.toNative(), d.is_signaled))
+func (d *WaitableEvent) IsSignaled() bool {
+	return C.gocef_waitable_event_is_signaled(d.toNative(), d.is_signaled) != 0
 }
 
 // Wait (wait)
@@ -69,6 +69,6 @@ func (d *WaitableEvent) Wait() {
 // necessarily mean that |max_ms| was exceeded. This function will not return
 // until after the call to signal() has completed. This function cannot be
 // called on the browser process UI or IO threads.
-func (d *WaitableEvent) TimedWait(max_ms int64) int32 {
-	return int32(C.gocef_waitable_event_timed_wait(d.toNative(), C.int64(max_ms), d.timed_wait))
+func (d *WaitableEvent) TimedWait(max_ms int64) bool {
+	return C.gocef_waitable_event_timed_wait(d.toNative(), C.int64(max_ms), d.timed_wait) != 0
 }
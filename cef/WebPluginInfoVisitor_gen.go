@@ -6,14 +6,24 @@ import (
 	// #include "WebPluginInfoVisitor_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// WebPluginInfoVisitorProxy defines methods required for using WebPluginInfoVisitor.
+// WebPluginInfoVisitorProxy is implemented by the proxies used with WebPluginInfoVisitor. Embed
+// DefaultWebPluginInfoVisitor to satisfy it, then implement any of the optional
+// WebPluginInfoVisitor*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type WebPluginInfoVisitorProxy interface {
-	Visit(self *WebPluginInfoVisitor, info *WebPluginInfo, count, total int32) int32
+	isWebPluginInfoVisitorProxy()
+}
+
+// DefaultWebPluginInfoVisitor may be embedded to satisfy WebPluginInfoVisitorProxy.
+type DefaultWebPluginInfoVisitor struct{}
+
+func (DefaultWebPluginInfoVisitor) isWebPluginInfoVisitorProxy() {}
+
+// WebPluginInfoVisitorVisitProxy may be implemented by a WebPluginInfoVisitorProxy to handle Visit.
+type WebPluginInfoVisitorVisitProxy interface {
+	Visit(self *WebPluginInfoVisitor, info *WebPluginInfo, count, total int32) bool
 }
 
 // WebPluginInfoVisitor (cef_web_plugin_info_visitor_t from include/capi/cef_web_plugin_capi.h)
@@ -26,7 +36,8 @@ type WebPluginInfoVisitor C.cef_web_plugin_info_visitor_t
 func NewWebPluginInfoVisitor(proxy WebPluginInfoVisitorProxy) *WebPluginInfoVisitor {
 	result := (*WebPluginInfoVisitor)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_web_plugin_info_visitor_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_web_plugin_info_visitor_proxy(result.toNative())
+		_, visit := proxy.(WebPluginInfoVisitorVisitProxy)
+		C.gocef_set_web_plugin_info_visitor_proxy(result.toNative(), cefBool(visit))
 	}
 	return result
 }
@@ -35,14 +46,15 @@ func (d *WebPluginInfoVisitor) toNative() *C.cef_web_plugin_info_visitor_t {
 	return (*C.cef_web_plugin_info_visitor_t)(d)
 }
 
-func lookupWebPluginInfoVisitorProxy(obj *BaseRefCounted) WebPluginInfoVisitorProxy {
+func lookupWebPluginInfoVisitorProxy(obj *BaseRefCounted, callback string) WebPluginInfoVisitorProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("WebPluginInfoVisitor", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(WebPluginInfoVisitorProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type WebPluginInfoVisitorProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("WebPluginInfoVisitor", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -58,13 +70,20 @@ func (d *WebPluginInfoVisitor) Base() *BaseRefCounted {
 // index for the current plugin. |total| is the total number of plugins.
 // Return false (0) to stop visiting plugins. This function may never be
 // called if no plugins are found.
-func (d *WebPluginInfoVisitor) Visit(info *WebPluginInfo, count, total int32) int32 {
-	return lookupWebPluginInfoVisitorProxy(d.Base()).Visit(d, info, count, total)
+func (d *WebPluginInfoVisitor) Visit(info *WebPluginInfo, count, total int32) bool {
+	if proxy__, ok__ := lookupWebPluginInfoVisitorProxy(d.Base(), "Visit").(WebPluginInfoVisitorVisitProxy); ok__ {
+		return proxy__.Visit(d, info, count, total)
+	}
+	return false
 }
 
 //export gocef_web_plugin_info_visitor_visit
 func gocef_web_plugin_info_visitor_visit(self *C.cef_web_plugin_info_visitor_t, info *C.cef_web_plugin_info_t, count C.int, total C.int) C.int {
+	defer recoverProxyPanic("WebPluginInfoVisitor", "Visit")
 	me__ := (*WebPluginInfoVisitor)(self)
-	proxy__ := lookupWebPluginInfoVisitorProxy(me__.Base())
-	return C.int(proxy__.Visit(me__, (*WebPluginInfo)(info), int32(count), int32(total)))
+	proxy__, ok__ := lookupWebPluginInfoVisitorProxy(me__.Base(), "Visit").(WebPluginInfoVisitorVisitProxy)
+	if !ok__ {
+		return 0
+	}
+	return cefBool(proxy__.Visit(me__, (*WebPluginInfo)(info), int32(count), int32(total)))
 }
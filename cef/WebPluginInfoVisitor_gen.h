@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_web_plugin_info_visitor_proxy(cef_web_plugin_info_visitor_t *self);
+void gocef_set_web_plugin_info_visitor_proxy(cef_web_plugin_info_visitor_t *self, int visit);
 
 #endif // GOCEF_WebPluginInfoVisitor_H_
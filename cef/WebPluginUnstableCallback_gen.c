@@ -3,8 +3,10 @@
 #include "WebPluginUnstableCallback_gen.h"
 #include "_cgo_export.h"
 
-void gocef_set_web_plugin_unstable_callback_proxy(cef_web_plugin_unstable_callback_t *self) {
+void gocef_set_web_plugin_unstable_callback_proxy(cef_web_plugin_unstable_callback_t *self, int is_unstable) {
 	// Casts to (void *) added to avoid warnings since Go callbacks can't have
 	// some modifiers, such as 'const' applied to their parameter signatures.
-	self->is_unstable = (void *)&gocef_web_plugin_unstable_callback_is_unstable;
+	if (is_unstable) {
+		self->is_unstable = (void *)&gocef_web_plugin_unstable_callback_is_unstable;
+	}
 }
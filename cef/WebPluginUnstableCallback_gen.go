@@ -6,14 +6,24 @@ import (
 	// #include "WebPluginUnstableCallback_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// WebPluginUnstableCallbackProxy defines methods required for using WebPluginUnstableCallback.
+// WebPluginUnstableCallbackProxy is implemented by the proxies used with WebPluginUnstableCallback. Embed
+// DefaultWebPluginUnstableCallback to satisfy it, then implement any of the optional
+// WebPluginUnstableCallback*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type WebPluginUnstableCallbackProxy interface {
-	IsUnstable(self *WebPluginUnstableCallback, path string, unstable int32)
+	isWebPluginUnstableCallbackProxy()
+}
+
+// DefaultWebPluginUnstableCallback may be embedded to satisfy WebPluginUnstableCallbackProxy.
+type DefaultWebPluginUnstableCallback struct{}
+
+func (DefaultWebPluginUnstableCallback) isWebPluginUnstableCallbackProxy() {}
+
+// WebPluginUnstableCallbackIsUnstableProxy may be implemented by a WebPluginUnstableCallbackProxy to handle IsUnstable.
+type WebPluginUnstableCallbackIsUnstableProxy interface {
+	IsUnstable(self *WebPluginUnstableCallback, path string, unstable bool)
 }
 
 // WebPluginUnstableCallback (cef_web_plugin_unstable_callback_t from include/capi/cef_web_plugin_capi.h)
@@ -26,7 +36,8 @@ type WebPluginUnstableCallback C.cef_web_plugin_unstable_callback_t
 func NewWebPluginUnstableCallback(proxy WebPluginUnstableCallbackProxy) *WebPluginUnstableCallback {
 	result := (*WebPluginUnstableCallback)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_web_plugin_unstable_callback_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_web_plugin_unstable_callback_proxy(result.toNative())
+		_, is_unstable := proxy.(WebPluginUnstableCallbackIsUnstableProxy)
+		C.gocef_set_web_plugin_unstable_callback_proxy(result.toNative(), cefBool(is_unstable))
 	}
 	return result
 }
@@ -35,14 +46,15 @@ func (d *WebPluginUnstableCallback) toNative() *C.cef_web_plugin_unstable_callba
 	return (*C.cef_web_plugin_unstable_callback_t)(d)
 }
 
-func lookupWebPluginUnstableCallbackProxy(obj *BaseRefCounted) WebPluginUnstableCallbackProxy {
+func lookupWebPluginUnstableCallbackProxy(obj *BaseRefCounted, callback string) WebPluginUnstableCallbackProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("WebPluginUnstableCallback", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(WebPluginUnstableCallbackProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type WebPluginUnstableCallbackProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("WebPluginUnstableCallback", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -57,14 +69,20 @@ func (d *WebPluginUnstableCallback) Base() *BaseRefCounted {
 // Method that will be called for the requested plugin. |unstable| will be
 // true (1) if the plugin has reached the crash count threshold of 3 times in
 // 120 seconds.
-func (d *WebPluginUnstableCallback) IsUnstable(path string, unstable int32) {
-	lookupWebPluginUnstableCallbackProxy(d.Base()).IsUnstable(d, path, unstable)
+func (d *WebPluginUnstableCallback) IsUnstable(path string, unstable bool) {
+	if proxy__, ok__ := lookupWebPluginUnstableCallbackProxy(d.Base(), "IsUnstable").(WebPluginUnstableCallbackIsUnstableProxy); ok__ {
+		proxy__.IsUnstable(d, path, unstable)
+	}
 }
 
 //export gocef_web_plugin_unstable_callback_is_unstable
 func gocef_web_plugin_unstable_callback_is_unstable(self *C.cef_web_plugin_unstable_callback_t, path *C.cef_string_t, unstable C.int) {
+	defer recoverProxyPanic("WebPluginUnstableCallback", "IsUnstable")
 	me__ := (*WebPluginUnstableCallback)(self)
-	proxy__ := lookupWebPluginUnstableCallbackProxy(me__.Base())
+	proxy__, ok__ := lookupWebPluginUnstableCallbackProxy(me__.Base(), "IsUnstable").(WebPluginUnstableCallbackIsUnstableProxy)
+	if !ok__ {
+		return
+	}
 	path_ := cefstrToString(path)
-	proxy__.IsUnstable(me__, path_, int32(unstable))
+	proxy__.IsUnstable(me__, path_, unstable != 0)
 }
@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_web_plugin_unstable_callback_proxy(cef_web_plugin_unstable_callback_t *self);
+void gocef_set_web_plugin_unstable_callback_proxy(cef_web_plugin_unstable_callback_t *self, int is_unstable);
 
 #endif // GOCEF_WebPluginUnstableCallback_H_
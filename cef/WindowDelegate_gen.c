@@ -3,17 +3,37 @@
 #include "WindowDelegate_gen.h"
 #include "_cgo_export.h"
 
-void gocef_set_window_delegate_proxy(cef_window_delegate_t *self) {
+void gocef_set_window_delegate_proxy(cef_window_delegate_t *self, int on_window_created, int on_window_destroyed, int get_parent_window, int is_frameless, int can_resize, int can_maximize, int can_minimize, int can_close, int on_accelerator, int on_key_event) {
 	// Casts to (void *) added to avoid warnings since Go callbacks can't have
 	// some modifiers, such as 'const' applied to their parameter signatures.
-	self->on_window_created = (void *)&gocef_window_delegate_on_window_created;
-	self->on_window_destroyed = (void *)&gocef_window_delegate_on_window_destroyed;
-	self->get_parent_window = (void *)&gocef_window_delegate_get_parent_window;
-	self->is_frameless = (void *)&gocef_window_delegate_is_frameless;
-	self->can_resize = (void *)&gocef_window_delegate_can_resize;
-	self->can_maximize = (void *)&gocef_window_delegate_can_maximize;
-	self->can_minimize = (void *)&gocef_window_delegate_can_minimize;
-	self->can_close = (void *)&gocef_window_delegate_can_close;
-	self->on_accelerator = (void *)&gocef_window_delegate_on_accelerator;
-	self->on_key_event = (void *)&gocef_window_delegate_on_key_event;
+	if (on_window_created) {
+		self->on_window_created = (void *)&gocef_window_delegate_on_window_created;
+	}
+	if (on_window_destroyed) {
+		self->on_window_destroyed = (void *)&gocef_window_delegate_on_window_destroyed;
+	}
+	if (get_parent_window) {
+		self->get_parent_window = (void *)&gocef_window_delegate_get_parent_window;
+	}
+	if (is_frameless) {
+		self->is_frameless = (void *)&gocef_window_delegate_is_frameless;
+	}
+	if (can_resize) {
+		self->can_resize = (void *)&gocef_window_delegate_can_resize;
+	}
+	if (can_maximize) {
+		self->can_maximize = (void *)&gocef_window_delegate_can_maximize;
+	}
+	if (can_minimize) {
+		self->can_minimize = (void *)&gocef_window_delegate_can_minimize;
+	}
+	if (can_close) {
+		self->can_close = (void *)&gocef_window_delegate_can_close;
+	}
+	if (on_accelerator) {
+		self->on_accelerator = (void *)&gocef_window_delegate_on_accelerator;
+	}
+	if (on_key_event) {
+		self->on_key_event = (void *)&gocef_window_delegate_on_key_event;
+	}
 }
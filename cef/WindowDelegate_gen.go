@@ -6,23 +6,69 @@ import (
 	// #include "WindowDelegate_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// WindowDelegateProxy defines methods required for using WindowDelegate.
+// WindowDelegateProxy is implemented by the proxies used with WindowDelegate. Embed
+// DefaultWindowDelegate to satisfy it, then implement any of the optional
+// WindowDelegate*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type WindowDelegateProxy interface {
+	isWindowDelegateProxy()
+}
+
+// DefaultWindowDelegate may be embedded to satisfy WindowDelegateProxy.
+type DefaultWindowDelegate struct{}
+
+func (DefaultWindowDelegate) isWindowDelegateProxy() {}
+
+// WindowDelegateOnWindowCreatedProxy may be implemented by a WindowDelegateProxy to handle OnWindowCreated.
+type WindowDelegateOnWindowCreatedProxy interface {
 	OnWindowCreated(self *WindowDelegate, window *Window)
+}
+
+// WindowDelegateOnWindowDestroyedProxy may be implemented by a WindowDelegateProxy to handle OnWindowDestroyed.
+type WindowDelegateOnWindowDestroyedProxy interface {
 	OnWindowDestroyed(self *WindowDelegate, window *Window)
-	GetParentWindow(self *WindowDelegate, window *Window, is_menu, can_activate_menu *int32) *Window
-	IsFrameless(self *WindowDelegate, window *Window) int32
-	CanResize(self *WindowDelegate, window *Window) int32
-	CanMaximize(self *WindowDelegate, window *Window) int32
-	CanMinimize(self *WindowDelegate, window *Window) int32
-	CanClose(self *WindowDelegate, window *Window) int32
-	OnAccelerator(self *WindowDelegate, window *Window, command_id int32) int32
-	OnKeyEvent(self *WindowDelegate, window *Window, event *KeyEvent) int32
+}
+
+// WindowDelegateGetParentWindowProxy may be implemented by a WindowDelegateProxy to handle GetParentWindow.
+type WindowDelegateGetParentWindowProxy interface {
+	GetParentWindow(self *WindowDelegate, window *Window, is_menu, can_activate_menu *bool) *Window
+}
+
+// WindowDelegateIsFramelessProxy may be implemented by a WindowDelegateProxy to handle IsFrameless.
+type WindowDelegateIsFramelessProxy interface {
+	IsFrameless(self *WindowDelegate, window *Window) bool
+}
+
+// WindowDelegateCanResizeProxy may be implemented by a WindowDelegateProxy to handle CanResize.
+type WindowDelegateCanResizeProxy interface {
+	CanResize(self *WindowDelegate, window *Window) bool
+}
+
+// WindowDelegateCanMaximizeProxy may be implemented by a WindowDelegateProxy to handle CanMaximize.
+type WindowDelegateCanMaximizeProxy interface {
+	CanMaximize(self *WindowDelegate, window *Window) bool
+}
+
+// WindowDelegateCanMinimizeProxy may be implemented by a WindowDelegateProxy to handle CanMinimize.
+type WindowDelegateCanMinimizeProxy interface {
+	CanMinimize(self *WindowDelegate, window *Window) bool
+}
+
+// WindowDelegateCanCloseProxy may be implemented by a WindowDelegateProxy to handle CanClose.
+type WindowDelegateCanCloseProxy interface {
+	CanClose(self *WindowDelegate, window *Window) bool
+}
+
+// WindowDelegateOnAcceleratorProxy may be implemented by a WindowDelegateProxy to handle OnAccelerator.
+type WindowDelegateOnAcceleratorProxy interface {
+	OnAccelerator(self *WindowDelegate, window *Window, command_id int32) bool
+}
+
+// WindowDelegateOnKeyEventProxy may be implemented by a WindowDelegateProxy to handle OnKeyEvent.
+type WindowDelegateOnKeyEventProxy interface {
+	OnKeyEvent(self *WindowDelegate, window *Window, event *KeyEvent) bool
 }
 
 // WindowDelegate (cef_window_delegate_t from include/capi/views/cef_window_delegate_capi.h)
@@ -36,7 +82,17 @@ type WindowDelegate C.cef_window_delegate_t
 func NewWindowDelegate(proxy WindowDelegateProxy) *WindowDelegate {
 	result := (*WindowDelegate)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_window_delegate_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_window_delegate_proxy(result.toNative())
+		_, on_window_created := proxy.(WindowDelegateOnWindowCreatedProxy)
+		_, on_window_destroyed := proxy.(WindowDelegateOnWindowDestroyedProxy)
+		_, get_parent_window := proxy.(WindowDelegateGetParentWindowProxy)
+		_, is_frameless := proxy.(WindowDelegateIsFramelessProxy)
+		_, can_resize := proxy.(WindowDelegateCanResizeProxy)
+		_, can_maximize := proxy.(WindowDelegateCanMaximizeProxy)
+		_, can_minimize := proxy.(WindowDelegateCanMinimizeProxy)
+		_, can_close := proxy.(WindowDelegateCanCloseProxy)
+		_, on_accelerator := proxy.(WindowDelegateOnAcceleratorProxy)
+		_, on_key_event := proxy.(WindowDelegateOnKeyEventProxy)
+		C.gocef_set_window_delegate_proxy(result.toNative(), cefBool(on_window_created), cefBool(on_window_destroyed), cefBool(get_parent_window), cefBool(is_frameless), cefBool(can_resize), cefBool(can_maximize), cefBool(can_minimize), cefBool(can_close), cefBool(on_accelerator), cefBool(on_key_event))
 	}
 	return result
 }
@@ -45,14 +101,15 @@ func (d *WindowDelegate) toNative() *C.cef_window_delegate_t {
 	return (*C.cef_window_delegate_t)(d)
 }
 
-func lookupWindowDelegateProxy(obj *BaseRefCounted) WindowDelegateProxy {
+func lookupWindowDelegateProxy(obj *BaseRefCounted, callback string) WindowDelegateProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("WindowDelegate", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(WindowDelegateProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type WindowDelegateProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("WindowDelegate", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -66,13 +123,19 @@ func (d *WindowDelegate) Base() *PanelDelegate {
 // OnWindowCreated (on_window_created)
 // Called when |window| is created.
 func (d *WindowDelegate) OnWindowCreated(window *Window) {
-	lookupWindowDelegateProxy(d.Base().Base.Base()).OnWindowCreated(d, window)
+	if proxy__, ok__ := lookupWindowDelegateProxy(d.Base().Base.Base(), "OnWindowCreated").(WindowDelegateOnWindowCreatedProxy); ok__ {
+		proxy__.OnWindowCreated(d, window)
+	}
 }
 
 //export gocef_window_delegate_on_window_created
 func gocef_window_delegate_on_window_created(self *C.cef_window_delegate_t, window *C.cef_window_t) {
+	defer recoverProxyPanic("WindowDelegate", "OnWindowCreated")
 	me__ := (*WindowDelegate)(self)
-	proxy__ := lookupWindowDelegateProxy(me__.Base().Base.Base())
+	proxy__, ok__ := lookupWindowDelegateProxy(me__.Base().Base.Base(), "OnWindowCreated").(WindowDelegateOnWindowCreatedProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnWindowCreated(me__, (*Window)(window))
 }
 
@@ -81,13 +144,19 @@ func gocef_window_delegate_on_window_created(self *C.cef_window_delegate_t, wind
 // do not attempt to execute any functions on |window| after this callback
 // returns.
 func (d *WindowDelegate) OnWindowDestroyed(window *Window) {
-	lookupWindowDelegateProxy(d.Base().Base.Base()).OnWindowDestroyed(d, window)
+	if proxy__, ok__ := lookupWindowDelegateProxy(d.Base().Base.Base(), "OnWindowDestroyed").(WindowDelegateOnWindowDestroyedProxy); ok__ {
+		proxy__.OnWindowDestroyed(d, window)
+	}
 }
 
 //export gocef_window_delegate_on_window_destroyed
 func gocef_window_delegate_on_window_destroyed(self *C.cef_window_delegate_t, window *C.cef_window_t) {
+	defer recoverProxyPanic("WindowDelegate", "OnWindowDestroyed")
 	me__ := (*WindowDelegate)(self)
-	proxy__ := lookupWindowDelegateProxy(me__.Base().Base.Base())
+	proxy__, ok__ := lookupWindowDelegateProxy(me__.Base().Base.Base(), "OnWindowDestroyed").(WindowDelegateOnWindowDestroyedProxy)
+	if !ok__ {
+		return
+	}
 	proxy__.OnWindowDestroyed(me__, (*Window)(window))
 }
 
@@ -98,112 +167,176 @@ func gocef_window_delegate_on_window_destroyed(self *C.cef_window_delegate_t, wi
 // not be clipped to the parent window bounds. Set |can_activate_menu| to
 // false (0) if |is_menu| is true (1) and |window| should not be activated
 // (given keyboard focus) when displayed.
-func (d *WindowDelegate) GetParentWindow(window *Window, is_menu, can_activate_menu *int32) *Window {
-	return lookupWindowDelegateProxy(d.Base().Base.Base()).GetParentWindow(d, window, is_menu, can_activate_menu)
+func (d *WindowDelegate) GetParentWindow(window *Window, is_menu, can_activate_menu *bool) *Window {
+	if proxy__, ok__ := lookupWindowDelegateProxy(d.Base().Base.Base(), "GetParentWindow").(WindowDelegateGetParentWindowProxy); ok__ {
+		return proxy__.GetParentWindow(d, window, is_menu, can_activate_menu)
+	}
+	return nil
 }
 
 //export gocef_window_delegate_get_parent_window
 func gocef_window_delegate_get_parent_window(self *C.cef_window_delegate_t, window *C.cef_window_t, is_menu *C.int, can_activate_menu *C.int) *C.cef_window_t {
+	defer recoverProxyPanic("WindowDelegate", "GetParentWindow")
 	me__ := (*WindowDelegate)(self)
-	proxy__ := lookupWindowDelegateProxy(me__.Base().Base.Base())
-	return (proxy__.GetParentWindow(me__, (*Window)(window), (*int32)(is_menu), (*int32)(can_activate_menu))).toNative()
+	proxy__, ok__ := lookupWindowDelegateProxy(me__.Base().Base.Base(), "GetParentWindow").(WindowDelegateGetParentWindowProxy)
+	if !ok__ {
+		return nil
+	}
+	is_menu_ := *is_menu != 0
+	defer func() {
+		*is_menu = cefBool(is_menu_)
+	}()
+	can_activate_menu_ := *can_activate_menu != 0
+	defer func() {
+		*can_activate_menu = cefBool(can_activate_menu_)
+	}()
+	return (proxy__.GetParentWindow(me__, (*Window)(window), &is_menu_, &can_activate_menu_)).toNative()
 }
 
 // IsFrameless (is_frameless)
 // Return true (1) if |window| should be created without a frame or title bar.
 // The window will be resizable if can_resize() returns true (1). Use
 // cef_window_t::set_draggable_regions() to specify draggable regions.
-func (d *WindowDelegate) IsFrameless(window *Window) int32 {
-	return lookupWindowDelegateProxy(d.Base().Base.Base()).IsFrameless(d, window)
+func (d *WindowDelegate) IsFrameless(window *Window) bool {
+	if proxy__, ok__ := lookupWindowDelegateProxy(d.Base().Base.Base(), "IsFrameless").(WindowDelegateIsFramelessProxy); ok__ {
+		return proxy__.IsFrameless(d, window)
+	}
+	return false
 }
 
 //export gocef_window_delegate_is_frameless
 func gocef_window_delegate_is_frameless(self *C.cef_window_delegate_t, window *C.cef_window_t) C.int {
+	defer recoverProxyPanic("WindowDelegate", "IsFrameless")
 	me__ := (*WindowDelegate)(self)
-	proxy__ := lookupWindowDelegateProxy(me__.Base().Base.Base())
-	return C.int(proxy__.IsFrameless(me__, (*Window)(window)))
+	proxy__, ok__ := lookupWindowDelegateProxy(me__.Base().Base.Base(), "IsFrameless").(WindowDelegateIsFramelessProxy)
+	if !ok__ {
+		return 0
+	}
+	return cefBool(proxy__.IsFrameless(me__, (*Window)(window)))
 }
 
 // CanResize (can_resize)
 // Return true (1) if |window| can be resized.
-func (d *WindowDelegate) CanResize(window *Window) int32 {
-	return lookupWindowDelegateProxy(d.Base().Base.Base()).CanResize(d, window)
+func (d *WindowDelegate) CanResize(window *Window) bool {
+	if proxy__, ok__ := lookupWindowDelegateProxy(d.Base().Base.Base(), "CanResize").(WindowDelegateCanResizeProxy); ok__ {
+		return proxy__.CanResize(d, window)
+	}
+	return false
 }
 
 //export gocef_window_delegate_can_resize
 func gocef_window_delegate_can_resize(self *C.cef_window_delegate_t, window *C.cef_window_t) C.int {
+	defer recoverProxyPanic("WindowDelegate", "CanResize")
 	me__ := (*WindowDelegate)(self)
-	proxy__ := lookupWindowDelegateProxy(me__.Base().Base.Base())
-	return C.int(proxy__.CanResize(me__, (*Window)(window)))
+	proxy__, ok__ := lookupWindowDelegateProxy(me__.Base().Base.Base(), "CanResize").(WindowDelegateCanResizeProxy)
+	if !ok__ {
+		return 0
+	}
+	return cefBool(proxy__.CanResize(me__, (*Window)(window)))
 }
 
 // CanMaximize (can_maximize)
 // Return true (1) if |window| can be maximized.
-func (d *WindowDelegate) CanMaximize(window *Window) int32 {
-	return lookupWindowDelegateProxy(d.Base().Base.Base()).CanMaximize(d, window)
+func (d *WindowDelegate) CanMaximize(window *Window) bool {
+	if proxy__, ok__ := lookupWindowDelegateProxy(d.Base().Base.Base(), "CanMaximize").(WindowDelegateCanMaximizeProxy); ok__ {
+		return proxy__.CanMaximize(d, window)
+	}
+	return false
 }
 
 //export gocef_window_delegate_can_maximize
 func gocef_window_delegate_can_maximize(self *C.cef_window_delegate_t, window *C.cef_window_t) C.int {
+	defer recoverProxyPanic("WindowDelegate", "CanMaximize")
 	me__ := (*WindowDelegate)(self)
-	proxy__ := lookupWindowDelegateProxy(me__.Base().Base.Base())
-	return C.int(proxy__.CanMaximize(me__, (*Window)(window)))
+	proxy__, ok__ := lookupWindowDelegateProxy(me__.Base().Base.Base(), "CanMaximize").(WindowDelegateCanMaximizeProxy)
+	if !ok__ {
+		return 0
+	}
+	return cefBool(proxy__.CanMaximize(me__, (*Window)(window)))
 }
 
 // CanMinimize (can_minimize)
 // Return true (1) if |window| can be minimized.
-func (d *WindowDelegate) CanMinimize(window *Window) int32 {
-	return lookupWindowDelegateProxy(d.Base().Base.Base()).CanMinimize(d, window)
+func (d *WindowDelegate) CanMinimize(window *Window) bool {
+	if proxy__, ok__ := lookupWindowDelegateProxy(d.Base().Base.Base(), "CanMinimize").(WindowDelegateCanMinimizeProxy); ok__ {
+		return proxy__.CanMinimize(d, window)
+	}
+	return false
 }
 
 //export gocef_window_delegate_can_minimize
 func gocef_window_delegate_can_minimize(self *C.cef_window_delegate_t, window *C.cef_window_t) C.int {
+	defer recoverProxyPanic("WindowDelegate", "CanMinimize")
 	me__ := (*WindowDelegate)(self)
-	proxy__ := lookupWindowDelegateProxy(me__.Base().Base.Base())
-	return C.int(proxy__.CanMinimize(me__, (*Window)(window)))
+	proxy__, ok__ := lookupWindowDelegateProxy(me__.Base().Base.Base(), "CanMinimize").(WindowDelegateCanMinimizeProxy)
+	if !ok__ {
+		return 0
+	}
+	return cefBool(proxy__.CanMinimize(me__, (*Window)(window)))
 }
 
 // CanClose (can_close)
 // Return true (1) if |window| can be closed. This will be called for user-
 // initiated window close actions and when cef_window_t::close() is called.
-func (d *WindowDelegate) CanClose(window *Window) int32 {
-	return lookupWindowDelegateProxy(d.Base().Base.Base()).CanClose(d, window)
+func (d *WindowDelegate) CanClose(window *Window) bool {
+	if proxy__, ok__ := lookupWindowDelegateProxy(d.Base().Base.Base(), "CanClose").(WindowDelegateCanCloseProxy); ok__ {
+		return proxy__.CanClose(d, window)
+	}
+	return false
 }
 
 //export gocef_window_delegate_can_close
 func gocef_window_delegate_can_close(self *C.cef_window_delegate_t, window *C.cef_window_t) C.int {
+	defer recoverProxyPanic("WindowDelegate", "CanClose")
 	me__ := (*WindowDelegate)(self)
-	proxy__ := lookupWindowDelegateProxy(me__.Base().Base.Base())
-	return C.int(proxy__.CanClose(me__, (*Window)(window)))
+	proxy__, ok__ := lookupWindowDelegateProxy(me__.Base().Base.Base(), "CanClose").(WindowDelegateCanCloseProxy)
+	if !ok__ {
+		return 0
+	}
+	return cefBool(proxy__.CanClose(me__, (*Window)(window)))
 }
 
 // OnAccelerator (on_accelerator)
 // Called when a keyboard accelerator registered with
 // cef_window_t::SetAccelerator is triggered. Return true (1) if the
 // accelerator was handled or false (0) otherwise.
-func (d *WindowDelegate) OnAccelerator(window *Window, command_id int32) int32 {
-	return lookupWindowDelegateProxy(d.Base().Base.Base()).OnAccelerator(d, window, command_id)
+func (d *WindowDelegate) OnAccelerator(window *Window, command_id int32) bool {
+	if proxy__, ok__ := lookupWindowDelegateProxy(d.Base().Base.Base(), "OnAccelerator").(WindowDelegateOnAcceleratorProxy); ok__ {
+		return proxy__.OnAccelerator(d, window, command_id)
+	}
+	return false
 }
 
 //export gocef_window_delegate_on_accelerator
 func gocef_window_delegate_on_accelerator(self *C.cef_window_delegate_t, window *C.cef_window_t, command_id C.int) C.int {
+	defer recoverProxyPanic("WindowDelegate", "OnAccelerator")
 	me__ := (*WindowDelegate)(self)
-	proxy__ := lookupWindowDelegateProxy(me__.Base().Base.Base())
-	return C.int(proxy__.OnAccelerator(me__, (*Window)(window), int32(command_id)))
+	proxy__, ok__ := lookupWindowDelegateProxy(me__.Base().Base.Base(), "OnAccelerator").(WindowDelegateOnAcceleratorProxy)
+	if !ok__ {
+		return 0
+	}
+	return cefBool(proxy__.OnAccelerator(me__, (*Window)(window), int32(command_id)))
 }
 
 // OnKeyEvent (on_key_event)
 // Called after all other controls in the window have had a chance to handle
 // the event. |event| contains information about the keyboard event. Return
 // true (1) if the keyboard event was handled or false (0) otherwise.
-func (d *WindowDelegate) OnKeyEvent(window *Window, event *KeyEvent) int32 {
-	return lookupWindowDelegateProxy(d.Base().Base.Base()).OnKeyEvent(d, window, event)
+func (d *WindowDelegate) OnKeyEvent(window *Window, event *KeyEvent) bool {
+	if proxy__, ok__ := lookupWindowDelegateProxy(d.Base().Base.Base(), "OnKeyEvent").(WindowDelegateOnKeyEventProxy); ok__ {
+		return proxy__.OnKeyEvent(d, window, event)
+	}
+	return false
 }
 
 //export gocef_window_delegate_on_key_event
 func gocef_window_delegate_on_key_event(self *C.cef_window_delegate_t, window *C.cef_window_t, event *C.cef_key_event_t) C.int {
+	defer recoverProxyPanic("WindowDelegate", "OnKeyEvent")
 	me__ := (*WindowDelegate)(self)
-	proxy__ := lookupWindowDelegateProxy(me__.Base().Base.Base())
+	proxy__, ok__ := lookupWindowDelegateProxy(me__.Base().Base.Base(), "OnKeyEvent").(WindowDelegateOnKeyEventProxy)
+	if !ok__ {
+		return 0
+	}
 	event_ := event.toGo()
-	return C.int(proxy__.OnKeyEvent(me__, (*Window)(window), event_))
+	return cefBool(proxy__.OnKeyEvent(me__, (*Window)(window), event_))
 }
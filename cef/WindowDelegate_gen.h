@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_window_delegate_proxy(cef_window_delegate_t *self);
+void gocef_set_window_delegate_proxy(cef_window_delegate_t *self, int on_window_created, int on_window_destroyed, int get_parent_window, int is_frameless, int can_resize, int can_maximize, int can_minimize, int can_close, int on_accelerator, int on_key_event);
 
 #endif // GOCEF_WindowDelegate_H_
@@ -21,7 +21,7 @@ type WindowInfo struct { //nolint:maligned
 	Height int32
 	// Hidden (hidden)
 	// Set to true (1) to create the view initially hidden.
-	Hidden int32
+	Hidden bool
 	// ParentView (parent_view)
 	ParentView unsafe.Pointer
 	// WindowlessRenderingEnabled (windowless_rendering_enabled)
@@ -35,7 +35,7 @@ type WindowInfo struct { //nolint:maligned
 	// CefSettings.windowless_rendering_enabled value must be set to true.
 	// Transparent painting is enabled by default but can be disabled by setting
 	// CefBrowserSettings.background_color to an opaque value.
-	WindowlessRenderingEnabled int32
+	WindowlessRenderingEnabled bool
 	// View (view)
 	View unsafe.Pointer
 }
@@ -50,9 +50,9 @@ func (d *WindowInfo) toNative(native *C.cef_window_info_t) *C.cef_window_info_t
 	native.y = C.int(d.Y)
 	native.width = C.int(d.Width)
 	native.height = C.int(d.Height)
-	native.hidden = C.int(d.Hidden)
+	native.hidden = cefBool(d.Hidden)
 	native.parent_view = d.ParentView
-	native.windowless_rendering_enabled = C.int(d.WindowlessRenderingEnabled)
+	native.windowless_rendering_enabled = cefBool(d.WindowlessRenderingEnabled)
 	native.view = d.View
 	return native
 }
@@ -69,8 +69,8 @@ func (n *C.cef_window_info_t) intoGo(d *WindowInfo) {
 	d.Y = int32(n.y)
 	d.Width = int32(n.width)
 	d.Height = int32(n.height)
-	d.Hidden = int32(n.hidden)
+	d.Hidden = n.hidden != 0
 	d.ParentView = n.parent_view
-	d.WindowlessRenderingEnabled = int32(n.windowless_rendering_enabled)
+	d.WindowlessRenderingEnabled = n.windowless_rendering_enabled != 0
 	d.View = n.view
 }
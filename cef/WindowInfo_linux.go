@@ -30,7 +30,7 @@ type WindowInfo struct {
 	// CefSettings.windowless_rendering_enabled value must be set to true.
 	// Transparent painting is enabled by default but can be disabled by setting
 	// CefBrowserSettings.background_color to an opaque value.
-	WindowlessRenderingEnabled int32
+	WindowlessRenderingEnabled bool
 	// Window (window)
 	// Handle for the new browser window. Only used with windowed rendering.
 	Window unsafe.Pointer
@@ -46,7 +46,7 @@ func (d *WindowInfo) toNative(native *C.cef_window_info_t) *C.cef_window_info_t
 	native.width = C.uint(d.Width)
 	native.height = C.uint(d.Height)
 	native.parent_window = C.ulong(uintptr(d.ParentWindow))
-	native.windowless_rendering_enabled = C.int(d.WindowlessRenderingEnabled)
+	native.windowless_rendering_enabled = cefBool(d.WindowlessRenderingEnabled)
 	native.window = C.ulong(uintptr(d.Window))
 	return native
 }
@@ -63,6 +63,6 @@ func (n *C.cef_window_info_t) intoGo(d *WindowInfo) {
 	d.Width = int32(n.width)
 	d.Height = int32(n.height)
 	d.ParentWindow = unsafe.Pointer(uintptr(n.parent_window))
-	d.WindowlessRenderingEnabled = int32(n.windowless_rendering_enabled)
+	d.WindowlessRenderingEnabled = n.windowless_rendering_enabled != 0
 	d.Window = unsafe.Pointer(uintptr(n.window))
 }
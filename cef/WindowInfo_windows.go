@@ -39,7 +39,7 @@ type WindowInfo struct {
 	// CefSettings.windowless_rendering_enabled value must be set to true.
 	// Transparent painting is enabled by default but can be disabled by setting
 	// CefBrowserSettings.background_color to an opaque value.
-	WindowlessRenderingEnabled int32
+	WindowlessRenderingEnabled bool
 	// Window (window)
 	// Handle for the new browser window. Only used with windowed rendering.
 	Window unsafe.Pointer
@@ -60,7 +60,7 @@ func (d *WindowInfo) toNative(native *C.cef_window_info_t) *C.cef_window_info_t
 	native.height = C.int(d.Height)
 	native.parent_window = C.HWND(d.ParentWindow)
 	native.menu = C.HMENU(d.Menu)
-	native.windowless_rendering_enabled = C.int(d.WindowlessRenderingEnabled)
+	native.windowless_rendering_enabled = cefBool(d.WindowlessRenderingEnabled)
 	native.window = C.HWND(d.Window)
 	return native
 }
@@ -81,6 +81,6 @@ func (n *C.cef_window_info_t) intoGo(d *WindowInfo) {
 	d.Height = int32(n.height)
 	d.ParentWindow = unsafe.Pointer(n.parent_window)
 	d.Menu = unsafe.Pointer(n.menu)
-	d.WindowlessRenderingEnabled = int32(n.windowless_rendering_enabled)
+	d.WindowlessRenderingEnabled = n.windowless_rendering_enabled != 0
 	d.Window = unsafe.Pointer(n.window)
 }
@@ -88,8 +88,8 @@ func (d *Window) Close() {
 
 // IsClosed (is_closed)
 // Returns true (1) if the Window has been closed.
-func (d *Window) IsClosed() int32 {
-	return int32(C.gocef_window_is_closed(d.toNative(), d.is_closed))
+func (d *Window) IsClosed() bool {
+	return C.gocef_window_is_closed(d.toNative(), d.is_closed) != 0
 }
 
 // Activate (activate)
@@ -107,8 +107,8 @@ func (d *Window) Deactivate() {
 
 // IsActive (is_active)
 // Returns whether the Window is the currently active Window.
-func (d *Window) IsActive() int32 {
-	return int32(C.gocef_window_is_active(d.toNative(), d.is_active))
+func (d *Window) IsActive() bool {
+	return C.gocef_window_is_active(d.toNative(), d.is_active) != 0
 }
 
 // BringToTop (bring_to_top)
@@ -119,15 +119,15 @@ func (d *Window) BringToTop() {
 
 // SetAlwaysOnTop (set_always_on_top)
 // Set the Window to be on top of other Windows in the Windowing system.
-func (d *Window) SetAlwaysOnTop(on_top int32) {
-	C.gocef_window_set_always_on_top(d.toNative(), C.int(on_top), d.set_always_on_top)
+func (d *Window) SetAlwaysOnTop(on_top bool) {
+	C.gocef_window_set_always_on_top(d.toNative(), cefBool(on_top), d.set_always_on_top)
 }
 
 // IsAlwaysOnTop (is_always_on_top)
 // Returns whether the Window has been set to be on top of other Windows in
 // the Windowing system.
-func (d *Window) IsAlwaysOnTop() int32 {
-	return int32(C.gocef_window_is_always_on_top(d.toNative(), d.is_always_on_top))
+func (d *Window) IsAlwaysOnTop() bool {
+	return C.gocef_window_is_always_on_top(d.toNative(), d.is_always_on_top) != 0
 }
 
 // Maximize (maximize)
@@ -150,26 +150,26 @@ func (d *Window) Restore() {
 
 // SetFullscreen (set_fullscreen)
 // Set fullscreen Window state.
-func (d *Window) SetFullscreen(fullscreen int32) {
-	C.gocef_window_set_fullscreen(d.toNative(), C.int(fullscreen), d.set_fullscreen)
+func (d *Window) SetFullscreen(fullscreen bool) {
+	C.gocef_window_set_fullscreen(d.toNative(), cefBool(fullscreen), d.set_fullscreen)
 }
 
 // IsMaximized (is_maximized)
 // Returns true (1) if the Window is maximized.
-func (d *Window) IsMaximized() int32 {
-	return int32(C.gocef_window_is_maximized(d.toNative(), d.is_maximized))
+func (d *Window) IsMaximized() bool {
+	return C.gocef_window_is_maximized(d.toNative(), d.is_maximized) != 0
 }
 
 // IsMinimized (is_minimized)
 // Returns true (1) if the Window is minimized.
-func (d *Window) IsMinimized() int32 {
-	return int32(C.gocef_window_is_minimized(d.toNative(), d.is_minimized))
+func (d *Window) IsMinimized() bool {
+	return C.gocef_window_is_minimized(d.toNative(), d.is_minimized) != 0
 }
 
 // IsFullscreen (is_fullscreen)
 // Returns true (1) if the Window is fullscreen.
-func (d *Window) IsFullscreen() int32 {
-	return int32(C.gocef_window_is_fullscreen(d.toNative(), d.is_fullscreen))
+func (d *Window) IsFullscreen() bool {
+	return C.gocef_window_is_fullscreen(d.toNative(), d.is_fullscreen) != 0
 }
 
 // SetTitle (set_title)
@@ -199,6 +199,7 @@ func (d *Window) SetWindowIcon(image *Image) {
 
 // GetWindowIcon (get_window_icon)
 // Get the Window icon.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *Window) GetWindowIcon() *Image {
 	return (*Image)(C.gocef_window_get_window_icon(d.toNative(), d.get_window_icon))
 }
@@ -214,6 +215,7 @@ func (d *Window) SetWindowAppIcon(image *Image) {
 
 // GetWindowAppIcon (get_window_app_icon)
 // Get the Window App icon.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *Window) GetWindowAppIcon() *Image {
 	return (*Image)(C.gocef_window_get_window_app_icon(d.toNative(), d.get_window_app_icon))
 }
@@ -235,6 +237,7 @@ func (d *Window) CancelMenu() {
 // GetDisplay (get_display)
 // Returns the Display that most closely intersects the bounds of this Window.
 // May return NULL if this Window is not currently displayed.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *Window) GetDisplay() *Display {
 	return (*Display)(C.gocef_window_get_display(d.toNative(), d.get_display))
 }
@@ -290,8 +293,8 @@ func (d *Window) SendMouseMove(screen_x, screen_y int32) {
 // clicking the mouse button). The events will be sent using the current
 // cursor position so make sure to call send_mouse_move() first to position
 // the mouse. This function is exposed primarily for testing purposes.
-func (d *Window) SendMouseEvents(button MouseButtonType, mouse_down, mouse_up int32) {
-	C.gocef_window_send_mouse_events(d.toNative(), C.cef_mouse_button_type_t(button), C.int(mouse_down), C.int(mouse_up), d.send_mouse_events)
+func (d *Window) SendMouseEvents(button MouseButtonType, mouse_down, mouse_up bool) {
+	C.gocef_window_send_mouse_events(d.toNative(), C.cef_mouse_button_type_t(button), cefBool(mouse_down), cefBool(mouse_up), d.send_mouse_events)
 }
 
 // SetAccelerator (set_accelerator)
@@ -299,8 +302,8 @@ func (d *Window) SendMouseEvents(button MouseButtonType, mouse_down, mouse_up in
 // be any virtual key or character value. cef_window_delegate_t::OnAccelerator
 // will be called if the keyboard combination is triggered while this window
 // has focus.
-func (d *Window) SetAccelerator(command_id, key_code, shift_pressed, ctrl_pressed, alt_pressed int32) {
-	C.gocef_window_set_accelerator(d.toNative(), C.int(command_id), C.int(key_code), C.int(shift_pressed), C.int(ctrl_pressed), C.int(alt_pressed), d.set_accelerator)
+func (d *Window) SetAccelerator(command_id, key_code int32, shift_pressed, ctrl_pressed, alt_pressed bool) {
+	C.gocef_window_set_accelerator(d.toNative(), C.int(command_id), C.int(key_code), cefBool(shift_pressed), cefBool(ctrl_pressed), cefBool(alt_pressed), d.set_accelerator)
 }
 
 // RemoveAccelerator (remove_accelerator)
@@ -3,12 +3,22 @@
 #include "WriteHandler_gen.h"
 #include "_cgo_export.h"
 
-void gocef_set_write_handler_proxy(cef_write_handler_t *self) {
+void gocef_set_write_handler_proxy(cef_write_handler_t *self, int write, int seek, int tell, int flush, int may_block) {
 	// Casts to (void *) added to avoid warnings since Go callbacks can't have
 	// some modifiers, such as 'const' applied to their parameter signatures.
-	self->write = (void *)&gocef_write_handler_write;
-	self->seek = (void *)&gocef_write_handler_seek;
-	self->tell = (void *)&gocef_write_handler_tell;
-	self->flush = (void *)&gocef_write_handler_flush;
-	self->may_block = (void *)&gocef_write_handler_may_block;
+	if (write) {
+		self->write = (void *)&gocef_write_handler_write;
+	}
+	if (seek) {
+		self->seek = (void *)&gocef_write_handler_seek;
+	}
+	if (tell) {
+		self->tell = (void *)&gocef_write_handler_tell;
+	}
+	if (flush) {
+		self->flush = (void *)&gocef_write_handler_flush;
+	}
+	if (may_block) {
+		self->may_block = (void *)&gocef_write_handler_may_block;
+	}
 }
@@ -6,18 +6,44 @@ import (
 	// #include "WriteHandler_gen.h"
 	"C"
 	"unsafe"
-
-	"github.com/richardwilkes/toolbox/errs"
-	"github.com/richardwilkes/toolbox/log/jot"
 )
 
-// WriteHandlerProxy defines methods required for using WriteHandler.
+// WriteHandlerProxy is implemented by the proxies used with WriteHandler. Embed
+// DefaultWriteHandler to satisfy it, then implement any of the optional
+// WriteHandler*Proxy interfaces for the callbacks that should be handled.
+// Callbacks that aren't implemented are left to CEF's default handling.
 type WriteHandlerProxy interface {
+	isWriteHandlerProxy()
+}
+
+// DefaultWriteHandler may be embedded to satisfy WriteHandlerProxy.
+type DefaultWriteHandler struct{}
+
+func (DefaultWriteHandler) isWriteHandlerProxy() {}
+
+// WriteHandlerWriteProxy may be implemented by a WriteHandlerProxy to handle Write.
+type WriteHandlerWriteProxy interface {
 	Write(self *WriteHandler, ptr unsafe.Pointer, size, n uint64) uint64
+}
+
+// WriteHandlerSeekProxy may be implemented by a WriteHandlerProxy to handle Seek.
+type WriteHandlerSeekProxy interface {
 	Seek(self *WriteHandler, offset int64, whence int32) int32
+}
+
+// WriteHandlerTellProxy may be implemented by a WriteHandlerProxy to handle Tell.
+type WriteHandlerTellProxy interface {
 	Tell(self *WriteHandler) int64
+}
+
+// WriteHandlerFlushProxy may be implemented by a WriteHandlerProxy to handle Flush.
+type WriteHandlerFlushProxy interface {
 	Flush(self *WriteHandler) int32
-	MayBlock(self *WriteHandler) int32
+}
+
+// WriteHandlerMayBlockProxy may be implemented by a WriteHandlerProxy to handle MayBlock.
+type WriteHandlerMayBlockProxy interface {
+	MayBlock(self *WriteHandler) bool
 }
 
 // WriteHandler (cef_write_handler_t from include/capi/cef_stream_capi.h)
@@ -30,7 +56,12 @@ type WriteHandler C.cef_write_handler_t
 func NewWriteHandler(proxy WriteHandlerProxy) *WriteHandler {
 	result := (*WriteHandler)(unsafe.Pointer(newRefCntObj(C.sizeof_struct__cef_write_handler_t, proxy)))
 	if proxy != nil {
-		C.gocef_set_write_handler_proxy(result.toNative())
+		_, write := proxy.(WriteHandlerWriteProxy)
+		_, seek := proxy.(WriteHandlerSeekProxy)
+		_, tell := proxy.(WriteHandlerTellProxy)
+		_, flush := proxy.(WriteHandlerFlushProxy)
+		_, may_block := proxy.(WriteHandlerMayBlockProxy)
+		C.gocef_set_write_handler_proxy(result.toNative(), cefBool(write), cefBool(seek), cefBool(tell), cefBool(flush), cefBool(may_block))
 	}
 	return result
 }
@@ -39,14 +70,15 @@ func (d *WriteHandler) toNative() *C.cef_write_handler_t {
 	return (*C.cef_write_handler_t)(d)
 }
 
-func lookupWriteHandlerProxy(obj *BaseRefCounted) WriteHandlerProxy {
+func lookupWriteHandlerProxy(obj *BaseRefCounted, callback string) WriteHandlerProxy {
 	proxy, exists := lookupProxy(obj)
 	if !exists {
-		jot.Fatal(1, errs.New("Proxy not found for ID"))
+		reportProxyError("WriteHandler", callback, ErrProxyNotFound, nil)
+		return nil
 	}
 	actual, ok := proxy.(WriteHandlerProxy)
-	if !ok {
-		jot.Fatal(1, errs.New("Proxy was not of type WriteHandlerProxy"))
+	if !ok && proxy != nil {
+		reportProxyError("WriteHandler", callback, ErrProxyWrongType, nil)
 	}
 	return actual
 }
@@ -60,13 +92,20 @@ func (d *WriteHandler) Base() *BaseRefCounted {
 // Write (write)
 // Write raw binary data.
 func (d *WriteHandler) Write(ptr unsafe.Pointer, size, n uint64) uint64 {
-	return lookupWriteHandlerProxy(d.Base()).Write(d, ptr, size, n)
+	if proxy__, ok__ := lookupWriteHandlerProxy(d.Base(), "Write").(WriteHandlerWriteProxy); ok__ {
+		return proxy__.Write(d, ptr, size, n)
+	}
+	return 0
 }
 
 //export gocef_write_handler_write
 func gocef_write_handler_write(self *C.cef_write_handler_t, ptr unsafe.Pointer, size C.size_t, n C.size_t) C.size_t {
+	defer recoverProxyPanic("WriteHandler", "Write")
 	me__ := (*WriteHandler)(self)
-	proxy__ := lookupWriteHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupWriteHandlerProxy(me__.Base(), "Write").(WriteHandlerWriteProxy)
+	if !ok__ {
+		return 0
+	}
 	return C.size_t(proxy__.Write(me__, ptr, uint64(size), uint64(n)))
 }
 
@@ -74,39 +113,60 @@ func gocef_write_handler_write(self *C.cef_write_handler_t, ptr unsafe.Pointer,
 // Seek to the specified offset position. |whence| may be any one of SEEK_CUR,
 // SEEK_END or SEEK_SET. Return zero on success and non-zero on failure.
 func (d *WriteHandler) Seek(offset int64, whence int32) int32 {
-	return lookupWriteHandlerProxy(d.Base()).Seek(d, offset, whence)
+	if proxy__, ok__ := lookupWriteHandlerProxy(d.Base(), "Seek").(WriteHandlerSeekProxy); ok__ {
+		return proxy__.Seek(d, offset, whence)
+	}
+	return 0
 }
 
 //export gocef_write_handler_seek
 func gocef_write_handler_seek(self *C.cef_write_handler_t, offset C.int64, whence C.int) C.int {
+	defer recoverProxyPanic("WriteHandler", "Seek")
 	me__ := (*WriteHandler)(self)
-	proxy__ := lookupWriteHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupWriteHandlerProxy(me__.Base(), "Seek").(WriteHandlerSeekProxy)
+	if !ok__ {
+		return 0
+	}
 	return C.int(proxy__.Seek(me__, int64(offset), int32(whence)))
 }
 
 // Tell (tell)
 // Return the current offset position.
 func (d *WriteHandler) Tell() int64 {
-	return lookupWriteHandlerProxy(d.Base()).Tell(d)
+	if proxy__, ok__ := lookupWriteHandlerProxy(d.Base(), "Tell").(WriteHandlerTellProxy); ok__ {
+		return proxy__.Tell(d)
+	}
+	return 0
 }
 
 //export gocef_write_handler_tell
 func gocef_write_handler_tell(self *C.cef_write_handler_t) C.int64 {
+	defer recoverProxyPanic("WriteHandler", "Tell")
 	me__ := (*WriteHandler)(self)
-	proxy__ := lookupWriteHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupWriteHandlerProxy(me__.Base(), "Tell").(WriteHandlerTellProxy)
+	if !ok__ {
+		return 0
+	}
 	return C.int64(proxy__.Tell(me__))
 }
 
 // Flush (flush)
 // Flush the stream.
 func (d *WriteHandler) Flush() int32 {
-	return lookupWriteHandlerProxy(d.Base()).Flush(d)
+	if proxy__, ok__ := lookupWriteHandlerProxy(d.Base(), "Flush").(WriteHandlerFlushProxy); ok__ {
+		return proxy__.Flush(d)
+	}
+	return 0
 }
 
 //export gocef_write_handler_flush
 func gocef_write_handler_flush(self *C.cef_write_handler_t) C.int {
+	defer recoverProxyPanic("WriteHandler", "Flush")
 	me__ := (*WriteHandler)(self)
-	proxy__ := lookupWriteHandlerProxy(me__.Base())
+	proxy__, ok__ := lookupWriteHandlerProxy(me__.Base(), "Flush").(WriteHandlerFlushProxy)
+	if !ok__ {
+		return 0
+	}
 	return C.int(proxy__.Flush(me__))
 }
 
@@ -114,13 +174,20 @@ func gocef_write_handler_flush(self *C.cef_write_handler_t) C.int {
 // Return true (1) if this handler performs work like accessing the file
 // system which may block. Used as a hint for determining the thread to access
 // the handler from.
-func (d *WriteHandler) MayBlock() int32 {
-	return lookupWriteHandlerProxy(d.Base()).MayBlock(d)
+func (d *WriteHandler) MayBlock() bool {
+	if proxy__, ok__ := lookupWriteHandlerProxy(d.Base(), "MayBlock").(WriteHandlerMayBlockProxy); ok__ {
+		return proxy__.MayBlock(d)
+	}
+	return false
 }
 
 //export gocef_write_handler_may_block
 func gocef_write_handler_may_block(self *C.cef_write_handler_t) C.int {
+	defer recoverProxyPanic("WriteHandler", "MayBlock")
 	me__ := (*WriteHandler)(self)
-	proxy__ := lookupWriteHandlerProxy(me__.Base())
-	return C.int(proxy__.MayBlock(me__))
+	proxy__, ok__ := lookupWriteHandlerProxy(me__.Base(), "MayBlock").(WriteHandlerMayBlockProxy)
+	if !ok__ {
+		return 0
+	}
+	return cefBool(proxy__.MayBlock(me__))
 }
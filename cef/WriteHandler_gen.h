@@ -6,6 +6,6 @@
 
 #include "capi_gen.h"
 
-void gocef_set_write_handler_proxy(cef_write_handler_t *self);
+void gocef_set_write_handler_proxy(cef_write_handler_t *self, int write, int seek, int tell, int flush, int may_block);
 
 #endif // GOCEF_WriteHandler_H_
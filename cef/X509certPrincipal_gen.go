@@ -69,24 +69,36 @@ func (d *X509certPrincipal) GetCountryName() string {
 
 // GetStreetAddresses (get_street_addresses)
 // Retrieve the list of street addresses.
-func (d *X509certPrincipal) GetStreetAddresses(addresses StringList) {
-	C.gocef_x509cert_principal_get_street_addresses(d.toNative(), C.cef_string_list_t(addresses), d.get_street_addresses)
+func (d *X509certPrincipal) GetStreetAddresses() []string {
+	addresses_ := C.cef_string_list_alloc()
+	defer C.cef_string_list_free(addresses_)
+	C.gocef_x509cert_principal_get_street_addresses(d.toNative(), addresses_, d.get_street_addresses)
+	return cefStringListToGo(addresses_)
 }
 
 // GetOrganizationNames (get_organization_names)
 // Retrieve the list of organization names.
-func (d *X509certPrincipal) GetOrganizationNames(names StringList) {
-	C.gocef_x509cert_principal_get_organization_names(d.toNative(), C.cef_string_list_t(names), d.get_organization_names)
+func (d *X509certPrincipal) GetOrganizationNames() []string {
+	names_ := C.cef_string_list_alloc()
+	defer C.cef_string_list_free(names_)
+	C.gocef_x509cert_principal_get_organization_names(d.toNative(), names_, d.get_organization_names)
+	return cefStringListToGo(names_)
 }
 
 // GetOrganizationUnitNames (get_organization_unit_names)
 // Retrieve the list of organization unit names.
-func (d *X509certPrincipal) GetOrganizationUnitNames(names StringList) {
-	C.gocef_x509cert_principal_get_organization_unit_names(d.toNative(), C.cef_string_list_t(names), d.get_organization_unit_names)
+func (d *X509certPrincipal) GetOrganizationUnitNames() []string {
+	names_ := C.cef_string_list_alloc()
+	defer C.cef_string_list_free(names_)
+	C.gocef_x509cert_principal_get_organization_unit_names(d.toNative(), names_, d.get_organization_unit_names)
+	return cefStringListToGo(names_)
 }
 
 // GetDomainComponents (get_domain_components)
 // Retrieve the list of domain components.
-func (d *X509certPrincipal) GetDomainComponents(components StringList) {
-	C.gocef_x509cert_principal_get_domain_components(d.toNative(), C.cef_string_list_t(components), d.get_domain_components)
+func (d *X509certPrincipal) GetDomainComponents() []string {
+	components_ := C.cef_string_list_alloc()
+	defer C.cef_string_list_free(components_)
+	C.gocef_x509cert_principal_get_domain_components(d.toNative(), components_, d.get_domain_components)
+	return cefStringListToGo(components_)
 }
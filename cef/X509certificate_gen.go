@@ -35,12 +35,14 @@ func (d *X509certificate) Base() *BaseRefCounted {
 // Returns the subject of the X.509 certificate. For HTTPS server certificates
 // this represents the web server.  The common name of the subject should
 // match the host name of the web server.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *X509certificate) GetSubject() *X509certPrincipal {
 	return (*X509certPrincipal)(C.gocef_x509certificate_get_subject(d.toNative(), d.get_subject))
 }
 
 // GetIssuer (get_issuer)
 // Returns the issuer of the X.509 certificate.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *X509certificate) GetIssuer() *X509certPrincipal {
 	return (*X509certPrincipal)(C.gocef_x509certificate_get_issuer(d.toNative(), d.get_issuer))
 }
@@ -48,6 +50,7 @@ func (d *X509certificate) GetIssuer() *X509certPrincipal {
 // GetSerialNumber (get_serial_number)
 // Returns the DER encoded serial number for the X.509 certificate. The value
 // possibly includes a leading 00 byte.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *X509certificate) GetSerialNumber() *BinaryValue {
 	return (*BinaryValue)(C.gocef_x509certificate_get_serial_number(d.toNative(), d.get_serial_number))
 }
@@ -74,12 +77,14 @@ func (d *X509certificate) GetValidExpiry() Time {
 
 // GetDerencoded (get_derencoded)
 // Returns the DER encoded data for the X.509 certificate.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *X509certificate) GetDerencoded() *BinaryValue {
 	return (*BinaryValue)(C.gocef_x509certificate_get_derencoded(d.toNative(), d.get_derencoded))
 }
 
 // GetPemencoded (get_pemencoded)
 // Returns the PEM encoded data for the X.509 certificate.
+// The caller owns a reference to the returned value; see Adopt().
 func (d *X509certificate) GetPemencoded() *BinaryValue {
 	return (*BinaryValue)(C.gocef_x509certificate_get_pemencoded(d.toNative(), d.get_pemencoded))
 }
@@ -0,0 +1,325 @@
+package cef
+
+import (
+	"encoding/binary"
+	"io"
+	"math"
+	"os"
+	"sync"
+	"unsafe"
+
+	"github.com/richardwilkes/toolbox/errs"
+	"github.com/richardwilkes/toolbox/log/jot"
+)
+
+// AudioFormat describes the format of an audio stream, as reported by
+// AudioHandlerProxy.OnAudioStreamStarted.
+type AudioFormat struct {
+	Channels        int
+	ChannelLayout   ChannelLayout
+	SampleRate      int
+	FramesPerBuffer int
+}
+
+// AudioPacket holds a copy of the PCM data received for an audio stream.
+type AudioPacket struct {
+	StreamID int32
+	Format   AudioFormat
+	// PTS is the presentation timestamp, in milliseconds since the Unix
+	// epoch.
+	PTS int64
+	// Samples holds one slice per channel, each containing one sample per
+	// frame in the range -1 to 1.
+	Samples [][]float32
+}
+
+// Frames returns the number of frames in the packet.
+func (p *AudioPacket) Frames() int {
+	if len(p.Samples) == 0 {
+		return 0
+	}
+	return len(p.Samples[0])
+}
+
+// Interleaved returns the samples with the channels interleaved, i.e. the
+// samples for all channels of the first frame, followed by those of the
+// second frame, and so on.
+func (p *AudioPacket) Interleaved() []float32 {
+	frames := p.Frames()
+	channels := len(p.Samples)
+	result := make([]float32, frames*channels)
+	for c, samples := range p.Samples {
+		for f, sample := range samples {
+			result[f*channels+c] = sample
+		}
+	}
+	return result
+}
+
+// Int16 returns the samples with the channels interleaved, converted to
+// signed 16-bit values.
+func (p *AudioPacket) Int16() []int16 {
+	samples := p.Interleaved()
+	result := make([]int16, len(samples))
+	for i, sample := range samples {
+		result[i] = int16(math.Round(float64(clampSample(sample)) * math.MaxInt16))
+	}
+	return result
+}
+
+func clampSample(sample float32) float32 {
+	switch {
+	case sample < -1:
+		return -1
+	case sample > 1:
+		return 1
+	default:
+		return sample
+	}
+}
+
+// AudioSink receives the packets for a single audio stream.
+type AudioSink interface {
+	// WritePacket is called for each packet received for the stream.
+	WritePacket(packet *AudioPacket) error
+	// Close is called when the stream stops.
+	Close() error
+}
+
+// AudioSinkFunc adapts a function to the AudioSink interface. Close does
+// nothing.
+type AudioSinkFunc func(packet *AudioPacket) error
+
+// WritePacket implements AudioSink.
+func (f AudioSinkFunc) WritePacket(packet *AudioPacket) error {
+	return f(packet)
+}
+
+// Close implements AudioSink.
+func (f AudioSinkFunc) Close() error {
+	return nil
+}
+
+// AudioRecorder is an AudioHandlerProxy that copies the PCM data for each
+// audio stream out of CEF and passes it to an AudioSink. Pass it to
+// NewAudioHandler() and return the result from ClientProxy.GetAudioHandler.
+type AudioRecorder struct {
+	DefaultAudioHandler
+	open    func(streamID int32, format AudioFormat) (AudioSink, error)
+	lock    sync.Mutex
+	streams map[int32]*audioStream
+}
+
+type audioStream struct {
+	format AudioFormat
+	sink   AudioSink
+}
+
+// NewAudioRecorder creates a new AudioRecorder. open will be called each time
+// a stream starts to obtain the AudioSink for it. If open returns an error,
+// the stream is ignored until it is started again. The sink is closed when
+// the stream stops.
+func NewAudioRecorder(open func(streamID int32, format AudioFormat) (AudioSink, error)) *AudioRecorder {
+	return &AudioRecorder{
+		open:    open,
+		streams: make(map[int32]*audioStream),
+	}
+}
+
+// OnAudioStreamStarted implements AudioHandlerOnAudioStreamStartedProxy.
+func (r *AudioRecorder) OnAudioStreamStarted(self *AudioHandler, browser *Browser, audio_stream_id, channels int32, channel_layout ChannelLayout, sample_rate, frames_per_buffer int32) {
+	defer ReleaseAll(browser)
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.closeStream(audio_stream_id)
+	format := AudioFormat{
+		Channels:        int(channels),
+		ChannelLayout:   channel_layout,
+		SampleRate:      int(sample_rate),
+		FramesPerBuffer: int(frames_per_buffer),
+	}
+	sink, err := r.open(audio_stream_id, format)
+	if err != nil {
+		jot.Error(errs.NewfWithCause(err, "unable to open sink for audio stream %d", audio_stream_id))
+		return
+	}
+	r.streams[audio_stream_id] = &audioStream{
+		format: format,
+		sink:   sink,
+	}
+}
+
+// OnAudioStreamPacket implements AudioHandlerOnAudioStreamPacketProxy.
+func (r *AudioRecorder) OnAudioStreamPacket(self *AudioHandler, browser *Browser, audio_stream_id int32, data **float32, frames int32, pts int64) {
+	defer ReleaseAll(browser)
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	stream, exists := r.streams[audio_stream_id]
+	if !exists || data == nil || frames <= 0 {
+		return
+	}
+	packet := &AudioPacket{
+		StreamID: audio_stream_id,
+		Format:   stream.format,
+		PTS:      pts,
+		Samples:  make([][]float32, stream.format.Channels),
+	}
+	planes := (*[1 << 16]*float32)(unsafe.Pointer(data))[:stream.format.Channels:stream.format.Channels]
+	for i, plane := range planes {
+		packet.Samples[i] = make([]float32, frames)
+		copy(packet.Samples[i], (*[1 << 28]float32)(unsafe.Pointer(plane))[:frames:frames])
+	}
+	if err := stream.sink.WritePacket(packet); err != nil {
+		jot.Error(errs.NewfWithCause(err, "unable to write packet for audio stream %d", audio_stream_id))
+		r.closeStream(audio_stream_id)
+	}
+}
+
+// OnAudioStreamStopped implements AudioHandlerOnAudioStreamStoppedProxy.
+func (r *AudioRecorder) OnAudioStreamStopped(self *AudioHandler, browser *Browser, audio_stream_id int32) {
+	defer ReleaseAll(browser)
+	r.lock.Lock()
+	r.closeStream(audio_stream_id)
+	r.lock.Unlock()
+}
+
+// Close closes the sinks of any streams that have not yet stopped.
+func (r *AudioRecorder) Close() {
+	r.lock.Lock()
+	for id := range r.streams {
+		r.closeStream(id)
+	}
+	r.lock.Unlock()
+}
+
+// closeStream must be called with the lock held.
+func (r *AudioRecorder) closeStream(id int32) {
+	if stream, exists := r.streams[id]; exists {
+		delete(r.streams, id)
+		if err := stream.sink.Close(); err != nil {
+			jot.Error(errs.NewfWithCause(err, "unable to close sink for audio stream %d", id))
+		}
+	}
+}
+
+// NewPCMSink creates a new AudioSink that writes the samples to w as
+// interleaved, signed 16-bit little-endian values. w is not closed when the
+// stream stops.
+func NewPCMSink(w io.Writer) AudioSink {
+	return AudioSinkFunc(func(packet *AudioPacket) error {
+		return writePCM(w, packet)
+	})
+}
+
+func writePCM(w io.Writer, packet *AudioPacket) error {
+	if err := binary.Write(w, binary.LittleEndian, packet.Int16()); err != nil {
+		return errs.Wrap(err)
+	}
+	return nil
+}
+
+const wavHeaderSize = 44
+
+type wavSink struct {
+	w        io.WriteSeeker
+	closer   io.Closer
+	format   AudioFormat
+	started  bool
+	dataSize uint32
+}
+
+// NewWAVSink creates a new AudioSink that writes the samples to w as a 16-bit
+// PCM WAV file. The sizes in the WAV header are filled in when the stream
+// stops. w is not closed when the stream stops.
+func NewWAVSink(w io.WriteSeeker) AudioSink {
+	return &wavSink{w: w}
+}
+
+// CreateWAVFile creates a new AudioSink that writes the samples to the file
+// at path as a 16-bit PCM WAV file. The file is closed when the stream stops.
+func CreateWAVFile(path string) (AudioSink, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &wavSink{w: f, closer: f}, nil
+}
+
+func (s *wavSink) WritePacket(packet *AudioPacket) error {
+	if !s.started {
+		s.started = true
+		s.format = packet.Format
+		if err := s.writeHeader(); err != nil {
+			return err
+		}
+	}
+	if packet.Format.Channels != s.format.Channels || packet.Format.SampleRate != s.format.SampleRate {
+		return errs.New("audio format changed mid-stream")
+	}
+	if err := writePCM(s.w, packet); err != nil {
+		return err
+	}
+	s.dataSize += uint32(packet.Frames() * s.format.Channels * 2)
+	return nil
+}
+
+func (s *wavSink) Close() error {
+	var err error
+	if s.started {
+		err = s.finishHeader()
+	}
+	if s.closer != nil {
+		if cerr := s.closer.Close(); cerr != nil && err == nil {
+			err = errs.Wrap(cerr)
+		}
+	}
+	return err
+}
+
+func (s *wavSink) writeHeader() error {
+	channels := uint16(s.format.Channels)
+	rate := uint32(s.format.SampleRate)
+	header := make([]byte, wavHeaderSize)
+	copy(header[0:], "RIFF")
+	binary.LittleEndian.PutUint32(header[4:], wavHeaderSize-8)
+	copy(header[8:], "WAVE")
+	copy(header[12:], "fmt ")
+	binary.LittleEndian.PutUint32(header[16:], 16)
+	binary.LittleEndian.PutUint16(header[20:], 1) // PCM
+	binary.LittleEndian.PutUint16(header[22:], channels)
+	binary.LittleEndian.PutUint32(header[24:], rate)
+	binary.LittleEndian.PutUint32(header[28:], rate*uint32(channels)*2)
+	binary.LittleEndian.PutUint16(header[32:], channels*2)
+	binary.LittleEndian.PutUint16(header[34:], 16)
+	copy(header[36:], "data")
+	if _, err := s.w.Write(header); err != nil {
+		return errs.Wrap(err)
+	}
+	return nil
+}
+
+func (s *wavSink) finishHeader() error {
+	var buffer [4]byte
+	binary.LittleEndian.PutUint32(buffer[:], wavHeaderSize-8+s.dataSize)
+	if err := s.writeAt(buffer[:], 4); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint32(buffer[:], s.dataSize)
+	if err := s.writeAt(buffer[:], 40); err != nil {
+		return err
+	}
+	if _, err := s.w.Seek(0, io.SeekEnd); err != nil {
+		return errs.Wrap(err)
+	}
+	return nil
+}
+
+func (s *wavSink) writeAt(data []byte, offset int64) error {
+	if _, err := s.w.Seek(offset, io.SeekStart); err != nil {
+		return errs.Wrap(err)
+	}
+	if _, err := s.w.Write(data); err != nil {
+		return errs.Wrap(err)
+	}
+	return nil
+}
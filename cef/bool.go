@@ -0,0 +1,11 @@
+package cef
+
+import "C"
+
+// cefBool converts a Go bool into the integer form CEF uses for booleans.
+func cefBool(value bool) C.int {
+	if value {
+		return 1
+	}
+	return 0
+}
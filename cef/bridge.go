@@ -0,0 +1,295 @@
+package cef
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/richardwilkes/toolbox/errs"
+)
+
+const (
+	bridgeInvokeMessage = "gocef.bridge.invoke"
+	bridgeResultMessage = "gocef.bridge.result"
+	bridgeScript        = `(function(invoke) {
+	return function goInvoke(name, args) {
+		return new Promise(function(resolve, reject) {
+			invoke(String(name), JSON.stringify(args === undefined ? null : args), function(result) {
+				resolve(JSON.parse(result));
+			}, function(message) {
+				reject(new Error(message));
+			});
+		});
+	};
+})`
+)
+
+// BridgeHandler handles a named request received through a Bridge. args
+// holds the JSON-encoded arguments supplied by the caller. The returned
+// result will be encoded as JSON and sent back to the caller. Handlers are
+// run on their own goroutine, so they are free to block.
+type BridgeHandler func(browser *Browser, args json.RawMessage) (result interface{}, err error)
+
+// BridgeResult holds the outcome of a request made through a Bridge.
+type BridgeResult struct {
+	Value json.RawMessage
+	Err   error
+}
+
+// Bridge provides request/response messaging between Go code in the browser
+// and render processes, as well as between JavaScript and Go code in the
+// browser process, on top of process messages. Each process should create its
+// own Bridge and pass its process messages to HandleProcessMessage(). In the
+// render process, calling OnContextCreated() makes the JavaScript function
+// window.goInvoke(name, args) available, which returns a promise for the
+// result of the handler registered under name with the browser process'
+// Bridge.
+type Bridge struct {
+	lock     sync.Mutex
+	handlers map[string]BridgeHandler
+	pending  map[int32]*bridgeCall
+	nextID   int32
+}
+
+type bridgeCall struct {
+	context *V8context
+	done    func(result json.RawMessage, err error)
+}
+
+// NewBridge creates a new Bridge.
+func NewBridge() *Bridge {
+	return &Bridge{
+		handlers: make(map[string]BridgeHandler),
+		pending:  make(map[int32]*bridgeCall),
+	}
+}
+
+// Handle registers the handler for requests with the specified name. Passing
+// in nil removes any existing handler for the name.
+func (b *Bridge) Handle(name string, handler BridgeHandler) {
+	b.lock.Lock()
+	if handler == nil {
+		delete(b.handlers, name)
+	} else {
+		b.handlers[name] = handler
+	}
+	b.lock.Unlock()
+}
+
+// Invoke sends a request to the handler registered under name with the Bridge
+// in the target process. args will be encoded as JSON. callback will be called
+// with the JSON-encoded result once a response is received. This must be
+// called on the UI thread in the browser process or on the render thread in
+// the render process, which is also where callback will be called.
+func (b *Bridge) Invoke(browser *Browser, target ProcessID, name string, args interface{}, callback func(result json.RawMessage, err error)) error {
+	data, err := json.Marshal(args)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	return b.send(browser, target, name, data, &bridgeCall{done: callback})
+}
+
+// InvokeChan is the same as Invoke, except the result is delivered through
+// the returned channel.
+func (b *Bridge) InvokeChan(browser *Browser, target ProcessID, name string, args interface{}) <-chan BridgeResult {
+	ch := make(chan BridgeResult, 1)
+	if err := b.Invoke(browser, target, name, args, func(result json.RawMessage, err error) {
+		ch <- BridgeResult{Value: result, Err: err}
+	}); err != nil {
+		ch <- BridgeResult{Err: err}
+	}
+	return ch
+}
+
+func (b *Bridge) send(browser *Browser, target ProcessID, name string, args []byte, call *bridgeCall) error {
+	b.lock.Lock()
+	b.nextID++
+	id := b.nextID
+	b.pending[id] = call
+	b.lock.Unlock()
+	message := ProcessMessageCreate(bridgeInvokeMessage)
+	list := message.GetArgumentList()
+	list.SetInt(0, id)
+	list.SetString(1, name)
+	list.SetString(2, string(args))
+	ReleaseAll(list)
+	if !browser.SendProcessMessage(target, message) {
+		b.lock.Lock()
+		delete(b.pending, id)
+		b.lock.Unlock()
+		return errs.Newf("unable to send request for %q", name)
+	}
+	return nil
+}
+
+// HandleProcessMessage processes the messages used by the bridge. Call it
+// from ClientProxy.OnProcessMessageReceived in the browser process and from
+// RenderProcessHandlerProxy.OnProcessMessageReceived in the render process.
+// Returns true if the message was handled.
+func (b *Bridge) HandleProcessMessage(browser *Browser, source ProcessID, message *ProcessMessage) bool {
+	switch message.GetName() {
+	case bridgeInvokeMessage:
+		list := message.GetArgumentList()
+		defer ReleaseAll(list)
+		b.serve(browser, source, list.GetInt(0), list.GetString(1), json.RawMessage(list.GetString(2)))
+	case bridgeResultMessage:
+		list := message.GetArgumentList()
+		defer ReleaseAll(list)
+		b.complete(list.GetInt(0), list.GetBool(1), list.GetString(2))
+	default:
+		return false
+	}
+	return true
+}
+
+func (b *Bridge) serve(browser *Browser, source ProcessID, id int32, name string, args json.RawMessage) {
+	b.lock.Lock()
+	handler := b.handlers[name]
+	b.lock.Unlock()
+	thread := TIDUI
+	if source == PidBrowser {
+		thread = TIDRenderer
+	}
+	// The browser is needed after this call returns.
+	browser.Base().AddRef()
+	go func() {
+		result, err := runBridgeHandler(handler, name, browser, args)
+		RunOn(thread, func() {
+			message := ProcessMessageCreate(bridgeResultMessage)
+			list := message.GetArgumentList()
+			list.SetInt(0, id)
+			list.SetBool(1, err == nil)
+			if err != nil {
+				list.SetString(2, bridgeErrorText(err))
+			} else {
+				list.SetString(2, string(result))
+			}
+			ReleaseAll(list)
+			browser.SendProcessMessage(source, message)
+			ReleaseAll(browser)
+		})
+	}()
+}
+
+func runBridgeHandler(handler BridgeHandler, name string, browser *Browser, args json.RawMessage) (result []byte, err error) {
+	if handler == nil {
+		return nil, errs.Newf("no handler registered for %q", name)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = errs.Newf("recovered from panic in handler for %q: %v", name, r)
+		}
+	}()
+	var value interface{}
+	if value, err = handler(browser, args); err != nil {
+		return nil, err
+	}
+	if result, err = json.Marshal(value); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return result, nil
+}
+
+func bridgeErrorText(err error) string {
+	if e, ok := err.(*errs.Error); ok {
+		return e.Message()
+	}
+	return err.Error()
+}
+
+func (b *Bridge) complete(id int32, ok bool, payload string) {
+	b.lock.Lock()
+	call := b.pending[id]
+	delete(b.pending, id)
+	b.lock.Unlock()
+	if call == nil || call.done == nil {
+		return
+	}
+	if ok {
+		call.done(json.RawMessage(payload), nil)
+	} else {
+		call.done(nil, errs.New(payload))
+	}
+}
+
+// OnContextCreated installs window.goInvoke(name, args) into the context. Call
+// it from RenderProcessHandlerProxy.OnContextCreated.
+func (b *Bridge) OnContextCreated(context *V8context) error {
+	factory, err := v8Eval(context, bridgeScript)
+	if err != nil {
+		return err
+	}
+	invoke := V8valueCreateFunction("invoke", NewV8handler(V8handlerFunc(b.invokeFromJS)))
+	fn, err := v8Call(factory, context, nil, []*V8value{invoke})
+	ReleaseAll(factory)
+	if err != nil {
+		return err
+	}
+	global := context.GetGlobal()
+	defer ReleaseAll(global)
+	if !global.SetValueBykey("goInvoke", fn, V8PropertyAttributeReadonly|V8PropertyAttributeDontdelete) {
+		return errs.New("unable to install goInvoke function")
+	}
+	return nil
+}
+
+// OnContextReleased discards any outstanding JavaScript requests made from the
+// context. Call it from RenderProcessHandlerProxy.OnContextReleased.
+func (b *Bridge) OnContextReleased(context *V8context) {
+	b.lock.Lock()
+	for id, call := range b.pending {
+		if call.context == nil {
+			continue
+		}
+		context.Base().AddRef()
+		if call.context.IsSame(context) {
+			delete(b.pending, id)
+		}
+	}
+	b.lock.Unlock()
+}
+
+func (b *Bridge) invokeFromJS(self *V8handler, name string, object *V8value, arguments []*V8value) (*V8value, error) {
+	if len(arguments) != 4 || !arguments[2].IsFunction() || !arguments[3].IsFunction() {
+		releaseV8Args(object, arguments)
+		return nil, errs.New("invalid arguments")
+	}
+	defer ReleaseAll(object, arguments[0], arguments[1])
+	// The context and the promise's functions are needed once the response
+	// arrives, or until the call is discarded along with the context.
+	context := V8contextGetCurrentContext()
+	contextRef := Adopt(context)
+	resolveRef := Adopt(arguments[2])
+	rejectRef := Adopt(arguments[3])
+	release := func() {
+		contextRef.Release()
+		resolveRef.Release()
+		rejectRef.Release()
+	}
+	call := &bridgeCall{
+		context: context,
+		done: func(result json.RawMessage, err error) {
+			defer release()
+			if !context.IsValid() || !context.Enter() {
+				return
+			}
+			defer context.Exit()
+			fn := resolveRef
+			arg := string(result)
+			if err != nil {
+				fn = rejectRef
+				arg = bridgeErrorText(err)
+			}
+			value, _ := v8Call(fn.Object().(*V8value), context, nil, []*V8value{V8valueCreateString(arg)})
+			ReleaseAll(value)
+		},
+	}
+	requestName := arguments[0].GetStringValue()
+	browser := context.GetBrowser()
+	err := b.send(browser, PidBrowser, requestName, []byte(arguments[1].GetStringValue()), call)
+	ReleaseAll(browser)
+	if err != nil {
+		release()
+		return nil, err
+	}
+	return nil, nil
+}
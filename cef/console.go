@@ -0,0 +1,250 @@
+package cef
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/richardwilkes/toolbox/errs"
+	"github.com/richardwilkes/toolbox/log/jot"
+)
+
+// ConsoleLevel is the level of a console message.
+type ConsoleLevel int
+
+// Possible values for ConsoleLevel.
+const (
+	ConsoleDebug ConsoleLevel = iota
+	ConsoleInfo
+	ConsoleWarn
+	ConsoleError
+)
+
+var consoleLevelNames = []string{"debug", "info", "warn", "error"}
+
+// consoleLevelFromSeverity maps the severity CEF reports for a console
+// message to a ConsoleLevel. Severities that don't describe a message's
+// importance, such as LogseverityDefault and LogseverityDisable, are treated
+// as informational.
+func consoleLevelFromSeverity(severity LogSeverity) ConsoleLevel {
+	switch severity {
+	case LogseverityVerbose:
+		return ConsoleDebug
+	case LogseverityWarning:
+		return ConsoleWarn
+	case LogseverityError, LogseverityFatal:
+		return ConsoleError
+	case LogseverityDefault, LogseverityInfo, LogseverityDisable:
+		return ConsoleInfo
+	}
+	// Unknown severities are also treated as informational, rather than
+	// risking a message being mistaken for an error.
+	return ConsoleInfo
+}
+
+func (l ConsoleLevel) String() string {
+	if l >= ConsoleDebug && int(l) < len(consoleLevelNames) {
+		return consoleLevelNames[l]
+	}
+	return fmt.Sprintf("level(%d)", int(l))
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (l ConsoleLevel) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (l *ConsoleLevel) UnmarshalText(text []byte) error {
+	for i, name := range consoleLevelNames {
+		if name == string(text) {
+			*l = ConsoleLevel(i)
+			return nil
+		}
+	}
+	return errs.Newf("invalid console level: %s", text)
+}
+
+// ConsoleRecord holds a message that was logged to a browser's JavaScript
+// console.
+type ConsoleRecord struct {
+	Time      time.Time    `json:"time"`
+	BrowserID int32        `json:"browser"`
+	URL       string       `json:"url,omitempty"`
+	Level     ConsoleLevel `json:"level"`
+	Message   string       `json:"message"`
+	Source    string       `json:"source,omitempty"`
+	Line      int          `json:"line,omitempty"`
+}
+
+func (r *ConsoleRecord) String() string {
+	if r.Source == "" {
+		return r.Message
+	}
+	return fmt.Sprintf("%s (%s:%d)", r.Message, r.Source, r.Line)
+}
+
+// ConsoleSink receives the records captured by a ConsoleCapture.
+type ConsoleSink func(record *ConsoleRecord)
+
+// LogConsoleSink returns a ConsoleSink that writes records to logger. If
+// logger is nil, the standard logger is used.
+func LogConsoleSink(logger *log.Logger) ConsoleSink {
+	printf := log.Printf
+	if logger != nil {
+		printf = logger.Printf
+	}
+	return func(record *ConsoleRecord) {
+		printf("[console %s] %v", strings.ToUpper(record.Level.String()), record)
+	}
+}
+
+// JotConsoleSink returns a ConsoleSink that writes records to jot at the
+// matching level.
+func JotConsoleSink() ConsoleSink {
+	return func(record *ConsoleRecord) {
+		switch record.Level {
+		case ConsoleError:
+			jot.Errorf("[console] %v", record)
+		case ConsoleWarn:
+			jot.Warnf("[console] %v", record)
+		case ConsoleInfo:
+			jot.Infof("[console] %v", record)
+		default:
+			jot.Debugf("[console] %v", record)
+		}
+	}
+}
+
+// JSONConsoleSink returns a ConsoleSink that writes each record to w as a
+// line of JSON.
+func JSONConsoleSink(w io.Writer) ConsoleSink {
+	var lock sync.Mutex
+	encoder := json.NewEncoder(w)
+	return func(record *ConsoleRecord) {
+		lock.Lock()
+		defer lock.Unlock()
+		if err := encoder.Encode(record); err != nil {
+			jot.Error(errs.Wrap(err))
+		}
+	}
+}
+
+// ConsoleCapture is a DisplayHandlerProxy that captures the messages logged
+// to the JavaScript console of its browsers, forwarding them to its sinks and
+// retaining them so that they can be checked later. Pass it to
+// NewDisplayHandler() and return the result from
+// ClientProxy.GetDisplayHandler. To handle other display callbacks as well,
+// embed it in another proxy.
+type ConsoleCapture struct {
+	DefaultDisplayHandler
+	lock     sync.Mutex
+	sinks    []ConsoleSink
+	urls     map[int32]string
+	records  []*ConsoleRecord
+	suppress bool
+}
+
+// NewConsoleCapture creates a new ConsoleCapture that forwards records to
+// the specified sinks.
+func NewConsoleCapture(sinks ...ConsoleSink) *ConsoleCapture {
+	return &ConsoleCapture{
+		sinks: sinks,
+		urls:  make(map[int32]string),
+	}
+}
+
+// SetSuppressOutput controls whether the messages are also written to the
+// browser's own console output. Defaults to false.
+func (c *ConsoleCapture) SetSuppressOutput(suppress bool) {
+	c.lock.Lock()
+	c.suppress = suppress
+	c.lock.Unlock()
+}
+
+// OnAddressChange implements DisplayHandlerOnAddressChangeProxy.
+func (c *ConsoleCapture) OnAddressChange(self *DisplayHandler, browser *Browser, frame *Frame, url string) {
+	defer ReleaseAll(browser, frame)
+	if frame.IsMain() {
+		c.lock.Lock()
+		c.urls[browser.GetIdentifier()] = url
+		c.lock.Unlock()
+	}
+}
+
+// OnConsoleMessage implements DisplayHandlerOnConsoleMessageProxy.
+func (c *ConsoleCapture) OnConsoleMessage(self *DisplayHandler, browser *Browser, level LogSeverity, message, source string, line int32) bool {
+	defer ReleaseAll(browser)
+	id := browser.GetIdentifier()
+	c.lock.Lock()
+	record := &ConsoleRecord{
+		Time:      time.Now(),
+		BrowserID: id,
+		URL:       c.urls[id],
+		Level:     consoleLevelFromSeverity(level),
+		Message:   message,
+		Source:    source,
+		Line:      int(line),
+	}
+	c.records = append(c.records, record)
+	sinks := c.sinks
+	suppress := c.suppress
+	c.lock.Unlock()
+	for _, sink := range sinks {
+		sink(record)
+	}
+	return suppress
+}
+
+// Records returns the records captured since creation or the last call to
+// Reset().
+func (c *ConsoleCapture) Records() []ConsoleRecord {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	records := make([]ConsoleRecord, len(c.records))
+	for i, record := range c.records {
+		records[i] = *record
+	}
+	return records
+}
+
+// RecordsAtOrAbove returns the captured records with a level at or above the
+// specified level.
+func (c *ConsoleCapture) RecordsAtOrAbove(level ConsoleLevel) []ConsoleRecord {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	var records []ConsoleRecord
+	for _, record := range c.records {
+		if record.Level >= level {
+			records = append(records, *record)
+		}
+	}
+	return records
+}
+
+// Check returns an error describing the captured records with a level at or
+// above the specified level, or nil if there are none. For example,
+// Check(ConsoleError) fails if any error was logged to the console.
+func (c *ConsoleCapture) Check(level ConsoleLevel) error {
+	records := c.RecordsAtOrAbove(level)
+	if len(records) == 0 {
+		return nil
+	}
+	var buffer strings.Builder
+	fmt.Fprintf(&buffer, "%d console message(s) at level %s or above:", len(records), level)
+	for i := range records {
+		fmt.Fprintf(&buffer, "\n[%s] %v", records[i].Level, &records[i])
+	}
+	return errs.New(buffer.String())
+}
+
+// Reset discards the captured records.
+func (c *ConsoleCapture) Reset() {
+	c.lock.Lock()
+	c.records = nil
+	c.lock.Unlock()
+}
@@ -0,0 +1,504 @@
+package cef
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/richardwilkes/toolbox/errs"
+	"github.com/richardwilkes/toolbox/log/jot"
+)
+
+// DefaultCookieJarTimeout is the default amount of time a CookieJar will wait
+// for the CookieManager when used as an http.CookieJar.
+const DefaultCookieJarTimeout = 5 * time.Second
+
+// CookieFormat identifies the format used by CookieJar.Export() and
+// CookieJar.Import().
+type CookieFormat int
+
+// Possible values for CookieFormat.
+const (
+	// CookieFormatNetscape is the Netscape cookies.txt format, as used by curl
+	// and wget.
+	CookieFormatNetscape CookieFormat = iota
+	// CookieFormatJSON is a JSON array of cookie objects.
+	CookieFormatJSON
+)
+
+const netscapeHTTPOnlyPrefix = "#HttpOnly_"
+
+// ToHTTP creates an http.Cookie from the cookie.
+func (d *Cookie) ToHTTP() *http.Cookie {
+	c := &http.Cookie{
+		Name:     d.Name,
+		Value:    d.Value,
+		Domain:   d.Domain,
+		Path:     d.Path,
+		Secure:   d.Secure,
+		HttpOnly: d.Httponly,
+	}
+	if d.HasExpires {
+		c.Expires = d.Expires.ToGo()
+	}
+	return c
+}
+
+// NewCookieFromHTTP creates a new Cookie from an http.Cookie. u is the URL the
+// cookie was received from and is used to determine the default path.
+func NewCookieFromHTTP(c *http.Cookie, u *url.URL) *Cookie {
+	cookie := &Cookie{
+		Name:     c.Name,
+		Value:    c.Value,
+		Path:     c.Path,
+		Secure:   c.Secure,
+		Httponly: c.HttpOnly,
+	}
+	if c.Domain != "" {
+		cookie.Domain = "." + strings.TrimPrefix(c.Domain, ".")
+	}
+	if cookie.Path == "" || !strings.HasPrefix(cookie.Path, "/") {
+		cookie.Path = defaultCookiePath(u)
+	}
+	switch {
+	case c.MaxAge > 0:
+		cookie.HasExpires = true
+		cookie.Expires = *NewTimeFromGo(time.Now().Add(time.Duration(c.MaxAge) * time.Second))
+	case !c.Expires.IsZero():
+		cookie.HasExpires = true
+		cookie.Expires = *NewTimeFromGo(c.Expires)
+	}
+	return cookie
+}
+
+// defaultCookiePath returns the default path for a cookie received from u, as
+// described in RFC 6265, section 5.1.4.
+func defaultCookiePath(u *url.URL) string {
+	if u == nil || !strings.HasPrefix(u.Path, "/") {
+		return "/"
+	}
+	i := strings.LastIndex(u.Path, "/")
+	if i == 0 {
+		return "/"
+	}
+	return u.Path[:i]
+}
+
+// cookieURL returns a URL that the cookie can be set for.
+func cookieURL(cookie *Cookie) string {
+	scheme := "http"
+	if cookie.Secure {
+		scheme = "https"
+	}
+	p := cookie.Path
+	if p == "" {
+		p = "/"
+	}
+	return (&url.URL{Scheme: scheme, Host: strings.TrimPrefix(cookie.Domain, "."), Path: p}).String()
+}
+
+// CookieJar implements http.CookieJar on top of a CookieManager, allowing a
+// Go http.Client to share cookies with the browsers using the same
+// CookieManager. Since CEF accesses cookies on its IO thread, the methods of
+// CookieJar block until CEF has responded and must not be called on the IO
+// thread.
+type CookieJar struct {
+	manager *CookieManager
+	lock    sync.RWMutex
+	timeout time.Duration
+}
+
+// NewCookieJar creates a new CookieJar for the manager. If manager is nil,
+// the global CookieManager is used.
+func NewCookieJar(manager *CookieManager) *CookieJar {
+	if manager == nil {
+		manager = CookieManagerGetGlobalManager(nil)
+	}
+	return &CookieJar{
+		manager: manager,
+		timeout: DefaultCookieJarTimeout,
+	}
+}
+
+// Manager returns the underlying CookieManager.
+func (j *CookieJar) Manager() *CookieManager {
+	return j.manager
+}
+
+// SetTimeout sets the amount of time SetCookies() and Cookies() will wait for
+// the CookieManager to respond. Defaults to DefaultCookieJarTimeout.
+func (j *CookieJar) SetTimeout(timeout time.Duration) {
+	j.lock.Lock()
+	j.timeout = timeout
+	j.lock.Unlock()
+}
+
+func (j *CookieJar) newContext() (context.Context, context.CancelFunc) {
+	j.lock.RLock()
+	timeout := j.timeout
+	j.lock.RUnlock()
+	return context.WithTimeout(context.Background(), timeout)
+}
+
+// SetCookies implements http.CookieJar. Cookies with a negative MaxAge are
+// deleted.
+func (j *CookieJar) SetCookies(u *url.URL, cookies []*http.Cookie) {
+	ctx, cancel := j.newContext()
+	defer cancel()
+	list := make([]*Cookie, 0, len(cookies))
+	for _, c := range cookies {
+		if c.MaxAge < 0 {
+			if err := j.Delete(ctx, u.String(), c.Name); err != nil {
+				jot.Error(err)
+			}
+			continue
+		}
+		list = append(list, NewCookieFromHTTP(c, u))
+	}
+	if err := j.set(ctx, u.String(), list); err != nil {
+		jot.Error(err)
+	}
+}
+
+// Cookies implements http.CookieJar. HTTP-only cookies are included.
+func (j *CookieJar) Cookies(u *url.URL) []*http.Cookie {
+	ctx, cancel := j.newContext()
+	defer cancel()
+	list, err := j.visit(ctx, func(visitor *CookieVisitor) bool {
+		return j.manager.VisitUrlCookies(u.String(), true, visitor)
+	})
+	if err != nil {
+		jot.Error(err)
+		return nil
+	}
+	cookies := make([]*http.Cookie, len(list))
+	for i := range list {
+		cookies[i] = &http.Cookie{Name: list[i].Name, Value: list[i].Value}
+	}
+	return cookies
+}
+
+// GetAll returns all of the cookies held by the CookieManager.
+func (j *CookieJar) GetAll(ctx context.Context) ([]Cookie, error) {
+	return j.visit(ctx, j.manager.VisitAllCookies)
+}
+
+// Set sets the cookies, waiting for the CookieManager to finish. If rawURL is
+// empty, a URL is derived from each cookie's domain, path and secure flag.
+func (j *CookieJar) Set(ctx context.Context, rawURL string, cookies ...*Cookie) error {
+	return j.set(ctx, rawURL, cookies)
+}
+
+// Delete deletes the cookies matching rawURL and name, waiting for the
+// CookieManager to finish. See CookieManager.DeleteCookies() for details on
+// how the arguments are interpreted.
+func (j *CookieJar) Delete(ctx context.Context, rawURL, name string) error {
+	if err := checkNotOnIOThread(); err != nil {
+		return err
+	}
+	done := make(chan struct{})
+	if !j.manager.DeleteCookies(rawURL, name, NewDeleteCookiesCallback(&cookieDeleter{done: done})) {
+		return errs.Newf("unable to delete cookies for %s", rawURL)
+	}
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return errs.Wrap(ctx.Err())
+	}
+}
+
+// Export writes all of the cookies held by the CookieManager to w in the
+// specified format.
+func (j *CookieJar) Export(ctx context.Context, w io.Writer, format CookieFormat) error {
+	cookies, err := j.GetAll(ctx)
+	if err != nil {
+		return err
+	}
+	switch format {
+	case CookieFormatNetscape:
+		return writeNetscapeCookies(w, cookies)
+	case CookieFormatJSON:
+		list := make([]*jsonCookie, len(cookies))
+		for i := range cookies {
+			list[i] = newJSONCookie(&cookies[i])
+		}
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		if err = encoder.Encode(list); err != nil {
+			return errs.Wrap(err)
+		}
+		return nil
+	default:
+		return errs.Newf("unknown cookie format: %d", format)
+	}
+}
+
+// Import reads cookies in the specified format from r and sets them in the
+// CookieManager.
+func (j *CookieJar) Import(ctx context.Context, r io.Reader, format CookieFormat) error {
+	var cookies []*Cookie
+	switch format {
+	case CookieFormatNetscape:
+		var err error
+		if cookies, err = readNetscapeCookies(r); err != nil {
+			return err
+		}
+	case CookieFormatJSON:
+		var list []*jsonCookie
+		if err := json.NewDecoder(r).Decode(&list); err != nil {
+			return errs.Wrap(err)
+		}
+		cookies = make([]*Cookie, len(list))
+		for i, one := range list {
+			cookies[i] = one.toCookie()
+		}
+	default:
+		return errs.Newf("unknown cookie format: %d", format)
+	}
+	return j.set(ctx, "", cookies)
+}
+
+func (j *CookieJar) set(ctx context.Context, rawURL string, cookies []*Cookie) error {
+	if len(cookies) == 0 {
+		return nil
+	}
+	if err := checkNotOnIOThread(); err != nil {
+		return err
+	}
+	results := make(chan bool, len(cookies))
+	pending := 0
+	failed := 0
+	for _, cookie := range cookies {
+		target := rawURL
+		if target == "" {
+			target = cookieURL(cookie)
+		}
+		if j.manager.SetCookie(target, cookie, NewSetCookieCallback(&cookieSetter{results: results})) {
+			pending++
+		} else {
+			failed++
+		}
+	}
+	for ; pending > 0; pending-- {
+		select {
+		case ok := <-results:
+			if !ok {
+				failed++
+			}
+		case <-ctx.Done():
+			return errs.Wrap(ctx.Err())
+		}
+	}
+	if failed > 0 {
+		return errs.Newf("unable to set %d of %d cookies", failed, len(cookies))
+	}
+	return nil
+}
+
+func (j *CookieJar) visit(ctx context.Context, start func(visitor *CookieVisitor) bool) ([]Cookie, error) {
+	if err := checkNotOnIOThread(); err != nil {
+		return nil, err
+	}
+	collector := &cookieCollector{done: make(chan struct{})}
+	if !start(NewCookieVisitor(collector)) {
+		return nil, errs.New("unable to access cookies")
+	}
+	select {
+	case <-collector.done:
+		collector.lock.Lock()
+		defer collector.lock.Unlock()
+		return collector.cookies, nil
+	case <-ctx.Done():
+		return nil, errs.Wrap(ctx.Err())
+	}
+}
+
+func checkNotOnIOThread() error {
+	if CurrentlyOn(TIDIO) {
+		return errs.New("cookies cannot be accessed synchronously from the IO thread")
+	}
+	return nil
+}
+
+type cookieCollector struct {
+	DefaultCookieVisitor
+	lock    sync.Mutex
+	cookies []Cookie
+	done    chan struct{}
+	once    sync.Once
+}
+
+func (c *cookieCollector) Visit(self *CookieVisitor, cookie *Cookie, count, total int32, deleteCookie *bool) bool {
+	c.lock.Lock()
+	c.cookies = append(c.cookies, *cookie)
+	c.lock.Unlock()
+	if count >= total-1 {
+		c.finish()
+	}
+	return true
+}
+
+// refReleased is called once CEF is done with the visitor, which is the only
+// notification received when there are no cookies to visit.
+func (c *cookieCollector) refReleased() {
+	c.finish()
+}
+
+func (c *cookieCollector) finish() {
+	c.once.Do(func() { close(c.done) })
+}
+
+type cookieSetter struct {
+	DefaultSetCookieCallback
+	results chan<- bool
+}
+
+func (c *cookieSetter) OnComplete(self *SetCookieCallback, success bool) {
+	c.results <- success
+}
+
+type cookieDeleter struct {
+	DefaultDeleteCookiesCallback
+	done chan struct{}
+}
+
+func (c *cookieDeleter) OnComplete(self *DeleteCookiesCallback, num_deleted int32) {
+	close(c.done)
+}
+
+type jsonCookie struct {
+	Name     string     `json:"name"`
+	Value    string     `json:"value"`
+	Domain   string     `json:"domain"`
+	Path     string     `json:"path"`
+	Secure   bool       `json:"secure,omitempty"`
+	HTTPOnly bool       `json:"http_only,omitempty"`
+	Expires  *time.Time `json:"expires,omitempty"`
+	Creation *time.Time `json:"creation,omitempty"`
+}
+
+func newJSONCookie(cookie *Cookie) *jsonCookie {
+	c := &jsonCookie{
+		Name:     cookie.Name,
+		Value:    cookie.Value,
+		Domain:   cookie.Domain,
+		Path:     cookie.Path,
+		Secure:   cookie.Secure,
+		HTTPOnly: cookie.Httponly,
+	}
+	if cookie.HasExpires {
+		t := cookie.Expires.ToGo()
+		c.Expires = &t
+	}
+	if cookie.Creation.Year != 0 {
+		t := cookie.Creation.ToGo()
+		c.Creation = &t
+	}
+	return c
+}
+
+func (c *jsonCookie) toCookie() *Cookie {
+	cookie := &Cookie{
+		Name:     c.Name,
+		Value:    c.Value,
+		Domain:   c.Domain,
+		Path:     c.Path,
+		Secure:   c.Secure,
+		Httponly: c.HTTPOnly,
+	}
+	if c.Expires != nil {
+		cookie.HasExpires = true
+		cookie.Expires = *NewTimeFromGo(*c.Expires)
+	}
+	if c.Creation != nil {
+		cookie.Creation = *NewTimeFromGo(*c.Creation)
+	}
+	return cookie
+}
+
+func writeNetscapeCookies(w io.Writer, cookies []Cookie) error {
+	buffer := bufio.NewWriter(w)
+	fmt.Fprintln(buffer, "# Netscape HTTP Cookie File")
+	for i := range cookies {
+		cookie := &cookies[i]
+		domain := cookie.Domain
+		if cookie.Httponly {
+			domain = netscapeHTTPOnlyPrefix + domain
+		}
+		var expires int64
+		if cookie.HasExpires {
+			expires = cookie.Expires.ToGo().Unix()
+		}
+		p := cookie.Path
+		if p == "" {
+			p = "/"
+		}
+		fmt.Fprintf(buffer, "%s\t%s\t%s\t%s\t%d\t%s\t%s\n", domain, netscapeBool(strings.HasPrefix(cookie.Domain, ".")), p, netscapeBool(cookie.Secure), expires, cookie.Name, cookie.Value)
+	}
+	if err := buffer.Flush(); err != nil {
+		return errs.Wrap(err)
+	}
+	return nil
+}
+
+func netscapeBool(value bool) string {
+	if value {
+		return "TRUE"
+	}
+	return "FALSE"
+}
+
+func readNetscapeCookies(r io.Reader) ([]*Cookie, error) {
+	var cookies []*Cookie
+	scanner := bufio.NewScanner(r)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimRight(scanner.Text(), "\r")
+		httpOnly := strings.HasPrefix(line, netscapeHTTPOnlyPrefix)
+		if httpOnly {
+			line = line[len(netscapeHTTPOnlyPrefix):]
+		}
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) != 7 {
+			return nil, errs.Newf("invalid cookie on line %d", lineNum)
+		}
+		expires, err := strconv.ParseInt(fields[4], 10, 64)
+		if err != nil {
+			return nil, errs.NewfWithCause(err, "invalid cookie expiration on line %d", lineNum)
+		}
+		cookie := &Cookie{
+			Name:     fields[5],
+			Value:    fields[6],
+			Domain:   fields[0],
+			Path:     path.Clean("/" + fields[2]),
+			Secure:   strings.EqualFold(fields[3], "TRUE"),
+			Httponly: httpOnly,
+		}
+		if strings.EqualFold(fields[1], "TRUE") && !strings.HasPrefix(cookie.Domain, ".") {
+			cookie.Domain = "." + cookie.Domain
+		}
+		if expires != 0 {
+			cookie.HasExpires = true
+			cookie.Expires = *NewTimeFromGo(time.Unix(expires, 0))
+		}
+		cookies = append(cookies, cookie)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return cookies, nil
+}
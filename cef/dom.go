@@ -0,0 +1,128 @@
+package cef
+
+import "strings"
+
+// DOMTreeNode holds a snapshot of a DOM node and its descendants. Unlike a
+// Domnode, it remains valid after the Domvisitor callback that produced it
+// returns.
+type DOMTreeNode struct {
+	Type DOMNodeType
+	// Tag is the element's tag name. Empty for non-element nodes.
+	Tag string
+	// Attributes holds the element's attributes. Nil for non-element nodes.
+	Attributes map[string]string
+	// Text is the value of text, comment and CDATA nodes.
+	Text     string
+	Children []*DOMTreeNode
+}
+
+// VisitDOMTree calls fn with a snapshot of the frame's document. This must be
+// called from the render process and fn will be called on the render process
+// main thread.
+func VisitDOMTree(frame *Frame, fn func(root *DOMTreeNode)) {
+	frame.VisitDom(NewDomvisitor(&domTreeVisitor{fn: fn}))
+}
+
+type domTreeVisitor struct {
+	DefaultDomvisitor
+	fn func(root *DOMTreeNode)
+}
+
+func (v *domTreeVisitor) Visit(self *Domvisitor, document *Domdocument) {
+	defer ReleaseAll(document)
+	v.fn(document.ToTree())
+}
+
+// ToTree returns a snapshot of the document.
+func (d *Domdocument) ToTree() *DOMTreeNode {
+	root := d.GetDocument()
+	defer ReleaseAll(root)
+	return root.ToTree()
+}
+
+// ToTree returns a snapshot of the node and its descendants.
+func (d *Domnode) ToTree() *DOMTreeNode {
+	if d == nil {
+		return nil
+	}
+	node := &DOMTreeNode{Type: d.GetType()}
+	switch node.Type {
+	case DOMNodeTypeElement:
+		node.Tag = strings.ToLower(d.GetElementTagName())
+		node.Attributes = d.attributes()
+	case DOMNodeTypeText, DOMNodeTypeCdataSection, DOMNodeTypeComment:
+		node.Text = d.GetValue()
+	}
+	if d.HasChildren() {
+		for child := d.GetFirstChild(); child != nil; {
+			node.Children = append(node.Children, child.ToTree())
+			next := child.GetNextSibling()
+			ReleaseAll(child)
+			child = next
+		}
+	}
+	return node
+}
+
+func (d *Domnode) attributes() map[string]string {
+	if d.HasElementAttributes() {
+		return d.GetElementAttributes()
+	}
+	return make(map[string]string)
+}
+
+// Walk calls fn for the node and each of its descendants in document order.
+// Returning false from fn skips the descendants of the node passed to it.
+func (n *DOMTreeNode) Walk(fn func(node *DOMTreeNode) bool) {
+	if fn(n) {
+		for _, child := range n.Children {
+			child.Walk(fn)
+		}
+	}
+}
+
+// Find returns the node and any of its descendants for which match returns
+// true, in document order.
+func (n *DOMTreeNode) Find(match func(node *DOMTreeNode) bool) []*DOMTreeNode {
+	var found []*DOMTreeNode
+	n.Walk(func(node *DOMTreeNode) bool {
+		if match(node) {
+			found = append(found, node)
+		}
+		return true
+	})
+	return found
+}
+
+// ElementsByTag returns the elements with the specified tag name within the
+// subtree rooted at this node.
+func (n *DOMTreeNode) ElementsByTag(tag string) []*DOMTreeNode {
+	tag = strings.ToLower(tag)
+	return n.Find(func(node *DOMTreeNode) bool { return node.Type == DOMNodeTypeElement && node.Tag == tag })
+}
+
+// ElementByID returns the first element with the specified id attribute
+// within the subtree rooted at this node, or nil.
+func (n *DOMTreeNode) ElementByID(id string) *DOMTreeNode {
+	var found *DOMTreeNode
+	n.Walk(func(node *DOMTreeNode) bool {
+		if found == nil && node.Type == DOMNodeTypeElement && node.Attributes["id"] == id {
+			found = node
+		}
+		return found == nil
+	})
+	return found
+}
+
+// InnerText returns the concatenation of the text within the subtree rooted
+// at this node, excluding comments.
+func (n *DOMTreeNode) InnerText() string {
+	var buffer strings.Builder
+	n.Walk(func(node *DOMTreeNode) bool {
+		if node.Type == DOMNodeTypeText || node.Type == DOMNodeTypeCdataSection {
+			buffer.WriteString(node.Text)
+		}
+		return true
+	})
+	return buffer.String()
+}
@@ -0,0 +1,413 @@
+// Package download provides a manager for the file downloads made by CEF
+// browsers.
+package download
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/richardwilkes/cef/cef"
+	"github.com/richardwilkes/toolbox/errs"
+)
+
+// State is the state of a download.
+type State int
+
+// Possible values for State.
+const (
+	InProgress State = iota
+	Paused
+	Complete
+	Canceled
+)
+
+var stateNames = []string{"in_progress", "paused", "complete", "canceled"}
+
+func (s State) String() string {
+	if s >= InProgress && int(s) < len(stateNames) {
+		return stateNames[s]
+	}
+	return fmt.Sprintf("state(%d)", int(s))
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s State) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *State) UnmarshalText(text []byte) error {
+	for i, name := range stateNames {
+		if name == string(text) {
+			*s = State(i)
+			return nil
+		}
+	}
+	return errs.Newf("invalid download state: %s", text)
+}
+
+// Done returns true if the download has finished, either by completing or
+// being canceled.
+func (s State) Done() bool {
+	return s == Complete || s == Canceled
+}
+
+// Info holds a snapshot of a download's details.
+type Info struct {
+	ID            uint32 `json:"id"`
+	URL           string `json:"url"`
+	OriginalURL   string `json:"original_url,omitempty"`
+	SuggestedName string `json:"suggested_name,omitempty"`
+	MimeType      string `json:"mime_type,omitempty"`
+	Path          string `json:"path,omitempty"`
+	State         State  `json:"state"`
+	ReceivedBytes int64  `json:"received_bytes"`
+	// TotalBytes is -1 if the size is not known.
+	TotalBytes int64 `json:"total_bytes"`
+	// PercentComplete is -1 if the size is not known.
+	PercentComplete int `json:"percent_complete"`
+	// Speed is the current download speed, in bytes per second.
+	Speed     int64     `json:"-"`
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time,omitempty"`
+	// Error describes why the download was canceled before it started, if
+	// the DirectoryPolicy or the creation of the directory failed. It is
+	// empty for downloads canceled by the user or by CEF.
+	Error string `json:"error,omitempty"`
+}
+
+// DirectoryPolicy returns the directory a download should be saved into. An
+// empty directory uses CEF's default location. Returning an error cancels the
+// download.
+type DirectoryPolicy func(info Info) (dir string, err error)
+
+// ErrUnknownID is returned when no active download has the requested ID.
+var ErrUnknownID = errs.New("unknown download ID")
+
+// Manager is a DownloadHandlerProxy that tracks every download by ID. Pass it
+// to cef.NewDownloadHandler() and return the result from
+// ClientProxy.GetDownloadHandler.
+type Manager struct {
+	cef.DefaultDownloadHandler
+	policy      DirectoryPolicy
+	lock        sync.Mutex
+	active      map[uint32]*entry
+	rejected    map[uint32]bool
+	history     []Info
+	subscribers map[<-chan Info]chan Info
+}
+
+type entry struct {
+	info     Info
+	callback *cef.DownloadItemCallback
+}
+
+// NewManager creates a new Manager. policy may be nil, in which case
+// downloads are saved into CEF's default location.
+func NewManager(policy DirectoryPolicy) *Manager {
+	return &Manager{
+		policy:      policy,
+		active:      make(map[uint32]*entry),
+		rejected:    make(map[uint32]bool),
+		subscribers: make(map[<-chan Info]chan Info),
+	}
+}
+
+// Subscribe returns a channel that receives an Info each time a download
+// starts, makes progress or finishes. If the channel's buffer is full when an
+// event occurs, the event is dropped for that subscriber rather than blocking
+// CEF.
+func (m *Manager) Subscribe(buffer int) <-chan Info {
+	ch := make(chan Info, buffer)
+	m.lock.Lock()
+	m.subscribers[ch] = ch
+	m.lock.Unlock()
+	return ch
+}
+
+// Unsubscribe stops sending events to a channel returned by Subscribe() and
+// closes it.
+func (m *Manager) Unsubscribe(ch <-chan Info) {
+	m.lock.Lock()
+	if actual, exists := m.subscribers[ch]; exists {
+		delete(m.subscribers, ch)
+		close(actual)
+	}
+	m.lock.Unlock()
+}
+
+// Get returns the details of an active download, or of the most recent
+// download in the history, with the ID.
+func (m *Manager) Get(id uint32) (Info, bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if e, exists := m.active[id]; exists {
+		return e.info, true
+	}
+	for i := len(m.history) - 1; i >= 0; i-- {
+		if m.history[i].ID == id {
+			return m.history[i], true
+		}
+	}
+	return Info{}, false
+}
+
+// Active returns the downloads that have not yet finished.
+func (m *Manager) Active() []Info {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	list := make([]Info, 0, len(m.active))
+	for _, e := range m.active {
+		list = append(list, e.info)
+	}
+	return list
+}
+
+// Pause pauses the download with the ID.
+func (m *Manager) Pause(id uint32) error {
+	return m.control(id, Paused, func(callback *cef.DownloadItemCallback) { callback.Pause() })
+}
+
+// Resume resumes the paused download with the ID.
+func (m *Manager) Resume(id uint32) error {
+	return m.control(id, InProgress, func(callback *cef.DownloadItemCallback) { callback.Resume() })
+}
+
+// Cancel cancels the download with the ID.
+func (m *Manager) Cancel(id uint32) error {
+	return m.control(id, -1, func(callback *cef.DownloadItemCallback) { callback.Cancel() })
+}
+
+func (m *Manager) control(id uint32, state State, action func(callback *cef.DownloadItemCallback)) error {
+	m.lock.Lock()
+	e, exists := m.active[id]
+	if !exists || e.callback == nil {
+		m.lock.Unlock()
+		return ErrUnknownID
+	}
+	callback := e.callback
+	callback.Base().AddRef()
+	if state >= 0 {
+		e.info.State = state
+		m.publish(e.info)
+	}
+	m.lock.Unlock()
+	action(callback)
+	callback.Base().Release()
+	return nil
+}
+
+// OnBeforeDownload implements cef.DownloadHandlerOnBeforeDownloadProxy.
+func (m *Manager) OnBeforeDownload(self *cef.DownloadHandler, browser *cef.Browser, item *cef.DownloadItem, suggestedName string, callback *cef.BeforeDownloadCallback) {
+	defer cef.ReleaseAll(browser, item, callback)
+	m.lock.Lock()
+	e := m.update(item)
+	e.info.SuggestedName = suggestedName
+	info := e.info
+	m.lock.Unlock()
+	var dir string
+	if m.policy != nil {
+		var err error
+		if dir, err = m.policy(info); err != nil {
+			m.reject(e, err)
+			return
+		}
+	}
+	var path string
+	if dir != "" {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			m.reject(e, errs.Wrap(err))
+			return
+		}
+		m.lock.Lock()
+		path = m.uniquePath(dir, suggestedName)
+		e.info.Path = path
+		m.lock.Unlock()
+	}
+	callback.Cont(path, false)
+}
+
+// OnDownloadUpdated implements cef.DownloadHandlerOnDownloadUpdatedProxy.
+func (m *Manager) OnDownloadUpdated(self *cef.DownloadHandler, browser *cef.Browser, item *cef.DownloadItem, callback *cef.DownloadItemCallback) {
+	defer cef.ReleaseAll(browser, item)
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if id := item.GetId(); m.rejected[id] {
+		// CEF still reports on a download rejected in OnBeforeDownload()
+		// until it has noticed the cancellation.
+		if item.IsComplete() || item.IsCanceled() {
+			delete(m.rejected, id)
+		}
+		callback.Base().Release()
+		return
+	}
+	e := m.update(item)
+	// Keep the reference CEF added to the callback for us in place of the
+	// one from the previous update.
+	if e.callback != nil {
+		e.callback.Base().Release()
+	}
+	e.callback = callback
+	if e.info.State.Done() {
+		m.finish(e)
+	} else {
+		m.publish(e.info)
+	}
+}
+
+// update must be called with the lock held.
+func (m *Manager) update(item *cef.DownloadItem) *entry {
+	id := item.GetId()
+	e, exists := m.active[id]
+	if !exists {
+		e = &entry{info: Info{ID: id}}
+		m.active[id] = e
+	}
+	info := &e.info
+	info.URL = item.GetUrl()
+	info.OriginalURL = item.GetOriginalUrl()
+	info.MimeType = item.GetMimeType()
+	if p := item.GetFullPath(); p != "" {
+		info.Path = p
+	}
+	if name := item.GetSuggestedFileName(); name != "" {
+		info.SuggestedName = name
+	}
+	info.ReceivedBytes = item.GetReceivedBytes()
+	info.TotalBytes = item.GetTotalBytes()
+	if info.TotalBytes <= 0 {
+		info.TotalBytes = -1
+	}
+	info.PercentComplete = int(item.GetPercentComplete())
+	info.Speed = item.GetCurrentSpeed()
+	info.StartTime = itemTime(item.GetStartTime())
+	info.EndTime = itemTime(item.GetEndTime())
+	switch {
+	case item.IsComplete():
+		info.State = Complete
+	case item.IsCanceled():
+		info.State = Canceled
+	case info.State != Paused:
+		info.State = InProgress
+	}
+	return e
+}
+
+func itemTime(t cef.Time) time.Time {
+	if t.Year == 0 {
+		return time.Time{}
+	}
+	return t.ToGo()
+}
+
+// reject cancels a download from within OnBeforeDownload(), recording the
+// reason.
+func (m *Manager) reject(e *entry, err error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	// Not calling the callback cancels the download.
+	e.info.State = Canceled
+	e.info.Error = err.Error()
+	m.rejected[e.info.ID] = true
+	m.finish(e)
+}
+
+// finish must be called with the lock held.
+func (m *Manager) finish(e *entry) {
+	delete(m.active, e.info.ID)
+	if e.callback != nil {
+		e.callback.Base().Release()
+		e.callback = nil
+	}
+	if e.info.EndTime.IsZero() {
+		e.info.EndTime = time.Now().UTC()
+	}
+	e.info.Speed = 0
+	m.history = append(m.history, e.info)
+	m.publish(e.info)
+}
+
+// publish must be called with the lock held.
+func (m *Manager) publish(info Info) {
+	for _, ch := range m.subscribers {
+		select {
+		case ch <- info:
+		default:
+		}
+	}
+}
+
+// uniquePath must be called with the lock held.
+func (m *Manager) uniquePath(dir, name string) string {
+	name = strings.Map(func(r rune) rune {
+		if r == '/' || r == '\\' || r < ' ' {
+			return '_'
+		}
+		return r
+	}, name)
+	if name == "" || name == "." || name == ".." {
+		name = "download"
+	}
+	ext := filepath.Ext(name)
+	base := strings.TrimSuffix(name, ext)
+	path := filepath.Join(dir, name)
+	for i := 1; m.pathInUse(path); i++ {
+		path = filepath.Join(dir, fmt.Sprintf("%s (%d)%s", base, i, ext))
+	}
+	return path
+}
+
+func (m *Manager) pathInUse(path string) bool {
+	if _, err := os.Stat(path); err == nil {
+		return true
+	}
+	for _, e := range m.active {
+		if e.info.Path == path {
+			return true
+		}
+	}
+	return false
+}
+
+// History returns the downloads that have finished, oldest first.
+func (m *Manager) History() []Info {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return append([]Info(nil), m.history...)
+}
+
+// ClearHistory discards the history.
+func (m *Manager) ClearHistory() {
+	m.lock.Lock()
+	m.history = nil
+	m.lock.Unlock()
+}
+
+// SaveHistory writes the history to w as JSON.
+func (m *Manager) SaveHistory(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(m.History()); err != nil {
+		return errs.Wrap(err)
+	}
+	return nil
+}
+
+// LoadHistory reads JSON written by SaveHistory() from r and places it ahead
+// of the existing history.
+func (m *Manager) LoadHistory(r io.Reader) error {
+	var history []Info
+	if err := json.NewDecoder(r).Decode(&history); err != nil {
+		return errs.Wrap(err)
+	}
+	m.lock.Lock()
+	m.history = append(history, m.history...)
+	m.lock.Unlock()
+	return nil
+}
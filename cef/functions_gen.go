@@ -43,7 +43,7 @@ import (
 //
 // This function may be called on any thread. Returns false (0) if
 // |source_origin| is invalid or the whitelist cannot be accessed.
-func AddCrossOriginWhitelistEntry(source_origin, target_protocol, target_domain string, allow_target_subdomains int32) int32 {
+func AddCrossOriginWhitelistEntry(source_origin, target_protocol, target_domain string, allow_target_subdomains bool) bool {
 	source_origin_ := C.cef_string_userfree_alloc()
 	setCEFStr(source_origin, source_origin_)
 	defer func() {
@@ -59,12 +59,13 @@ func AddCrossOriginWhitelistEntry(source_origin, target_protocol, target_domain
 	defer func() {
 		C.cef_string_userfree_free(target_domain_)
 	}()
-	return int32(C.cef_add_cross_origin_whitelist_entry((*C.cef_string_t)(source_origin_), (*C.cef_string_t)(target_protocol_), (*C.cef_string_t)(target_domain_), C.int(allow_target_subdomains)))
+	return C.cef_add_cross_origin_whitelist_entry((*C.cef_string_t)(source_origin_), (*C.cef_string_t)(target_protocol_), (*C.cef_string_t)(target_domain_), cefBool(allow_target_subdomains)) != 0
 }
 
 // BinaryValueCreate (cef_binary_value_create from include/capi/cef_values_capi.h)
 // Creates a new object that is not owned by any other object. The specified
 // |data| will be copied.
+// The caller owns a reference to the returned value; see Adopt().
 func BinaryValueCreate(data unsafe.Pointer, data_size uint64) *BinaryValue {
 	return (*BinaryValue)(C.cef_binary_value_create(data, C.size_t(data_size)))
 }
@@ -75,19 +76,20 @@ func BinaryValueCreate(data unsafe.Pointer, data_size uint64) *BinaryValue {
 // be created on the UI thread. If |request_context| is NULL the global request
 // context will be used. This function can be called on any browser process
 // thread and will not block.
-func BrowserHostCreateBrowser(windowInfo *WindowInfo, client *Client, url string, settings *BrowserSettings, request_context *RequestContext) int32 {
+func BrowserHostCreateBrowser(windowInfo *WindowInfo, client *Client, url string, settings *BrowserSettings, request_context *RequestContext) bool {
 	url_ := C.cef_string_userfree_alloc()
 	setCEFStr(url, url_)
 	defer func() {
 		C.cef_string_userfree_free(url_)
 	}()
-	return int32(C.cef_browser_host_create_browser(windowInfo.toNative(&C.cef_window_info_t{}), client.toNative(), (*C.cef_string_t)(url_), settings.toNative(&C.cef_browser_settings_t{}), request_context.toNative()))
+	return C.cef_browser_host_create_browser(windowInfo.toNative(&C.cef_window_info_t{}), client.toNative(), (*C.cef_string_t)(url_), settings.toNative(&C.cef_browser_settings_t{}), request_context.toNative()) != 0
 }
 
 // BrowserHostCreateBrowserSync (cef_browser_host_create_browser_sync from include/capi/cef_browser_capi.h)
 // Create a new browser window using the window parameters specified by
 // |windowInfo|. If |request_context| is NULL the global request context will be
 // used. This function can only be called on the browser process UI thread.
+// The caller owns a reference to the returned value; see Adopt().
 func BrowserHostCreateBrowserSync(windowInfo *WindowInfo, client *Client, url string, settings *BrowserSettings, request_context *RequestContext) *Browser {
 	url_ := C.cef_string_userfree_alloc()
 	setCEFStr(url, url_)
@@ -100,6 +102,7 @@ func BrowserHostCreateBrowserSync(windowInfo *WindowInfo, client *Client, url st
 // BrowserViewCreate (cef_browser_view_create from include/capi/views/cef_browser_view_capi.h)
 // Create a new BrowserView. The underlying cef_browser_t will not be created
 // until this view is added to the views hierarchy.
+// The caller owns a reference to the returned value; see Adopt().
 func BrowserViewCreate(client *Client, url string, settings *BrowserSettings, request_context *RequestContext, delegate *BrowserViewDelegate) *BrowserView {
 	url_ := C.cef_string_userfree_alloc()
 	setCEFStr(url, url_)
@@ -115,6 +118,7 @@ func BrowserViewCreate(client *Client, url string, settings *BrowserSettings, re
 
 // BrowserViewGetForBrowser (cef_browser_view_get_for_browser from include/capi/views/cef_browser_view_capi.h)
 // Returns the BrowserView associated with |browser|.
+// The caller owns a reference to the returned value; see Adopt().
 func BrowserViewGetForBrowser(browser *Browser) *BrowserView {
 	return (*BrowserView)(C.cef_browser_view_get_for_browser(browser.toNative()))
 }
@@ -122,8 +126,8 @@ func BrowserViewGetForBrowser(browser *Browser) *BrowserView {
 // ClearCrossOriginWhitelist (cef_clear_cross_origin_whitelist from include/capi/cef_origin_whitelist_capi.h)
 // Remove all entries from the cross-origin access whitelist. Returns false (0)
 // if the whitelist cannot be accessed.
-func ClearCrossOriginWhitelist() int32 {
-	return int32(C.cef_clear_cross_origin_whitelist())
+func ClearCrossOriginWhitelist() bool {
+	return C.cef_clear_cross_origin_whitelist() != 0
 }
 
 // ClearSchemeHandlerFactories (cef_clear_scheme_handler_factories from include/capi/cef_scheme_capi.h)
@@ -132,12 +136,13 @@ func ClearCrossOriginWhitelist() int32 {
 // thread in the browser process. Using this function is equivalent to calling c
 // ef_request_tContext::cef_request_context_get_global_context()->clear_scheme_h
 // andler_factories().
-func ClearSchemeHandlerFactories() int32 {
-	return int32(C.cef_clear_scheme_handler_factories())
+func ClearSchemeHandlerFactories() bool {
+	return C.cef_clear_scheme_handler_factories() != 0
 }
 
 // CommandLineCreate (cef_command_line_create from include/capi/cef_command_line_capi.h)
 // Create a new cef_command_line_t instance.
+// The caller owns a reference to the returned value; see Adopt().
 func CommandLineCreate() *CommandLine {
 	return (*CommandLine)(C.cef_command_line_create())
 }
@@ -145,6 +150,7 @@ func CommandLineCreate() *CommandLine {
 // CommandLineGetGlobal (cef_command_line_get_global from include/capi/cef_command_line_capi.h)
 // Returns the singleton global cef_command_line_t object. The returned object
 // will be read-only.
+// The caller owns a reference to the returned value; see Adopt().
 func CommandLineGetGlobal() *CommandLine {
 	return (*CommandLine)(C.cef_command_line_get_global())
 }
@@ -157,13 +163,14 @@ func CommandLineGetGlobal() *CommandLine {
 // to be transient and most Web browsers do not persist them. If |callback| is
 // non-NULL it will be executed asnychronously on the IO thread after the
 // manager's storage has been initialized.
-func CookieManagerCreateManager(path string, persist_session_cookies int32, callback *CompletionCallback) *CookieManager {
+// The caller owns a reference to the returned value; see Adopt().
+func CookieManagerCreateManager(path string, persist_session_cookies bool, callback *CompletionCallback) *CookieManager {
 	path_ := C.cef_string_userfree_alloc()
 	setCEFStr(path, path_)
 	defer func() {
 		C.cef_string_userfree_free(path_)
 	}()
-	return (*CookieManager)(C.cef_cookie_manager_create_manager((*C.cef_string_t)(path_), C.int(persist_session_cookies), callback.toNative()))
+	return (*CookieManager)(C.cef_cookie_manager_create_manager((*C.cef_string_t)(path_), cefBool(persist_session_cookies), callback.toNative()))
 }
 
 // CookieManagerGetBlockingManager (cef_cookie_manager_get_blocking_manager from include/capi/cef_cookie_capi.h)
@@ -173,6 +180,7 @@ func CookieManagerCreateManager(path string, persist_session_cookies int32, call
 // cef_cookie_manager_t functions. No cookies will be displayed in DevTools. If
 // you wish to only block cookies sent via the network use the
 // cef_request_tHandler CanGetCookies and CanSetCookie functions instead.
+// The caller owns a reference to the returned value; see Adopt().
 func CookieManagerGetBlockingManager() *CookieManager {
 	return (*CookieManager)(C.cef_cookie_manager_get_blocking_manager())
 }
@@ -184,6 +192,7 @@ func CookieManagerGetBlockingManager() *CookieManager {
 // manager's storage has been initialized. Using this function is equivalent to
 // calling cef_request_tContext::cef_request_context_get_global_context()->get_d
 // efault_cookie_manager().
+// The caller owns a reference to the returned value; see Adopt().
 func CookieManagerGetGlobalManager(callback *CompletionCallback) *CookieManager {
 	return (*CookieManager)(C.cef_cookie_manager_get_global_manager(callback.toNative()))
 }
@@ -191,6 +200,7 @@ func CookieManagerGetGlobalManager(callback *CompletionCallback) *CookieManager
 // CreateContextShared (cef_create_context_shared from include/capi/cef_request_context_capi.h)
 // Creates a new context object that shares storage with |other| and uses an
 // optional |handler|.
+// The caller owns a reference to the returned value; see Adopt().
 func CreateContextShared(other *RequestContext, handler *RequestContextHandler) *RequestContext {
 	return (*RequestContext)(C.cef_create_context_shared(other.toNative(), handler.toNative()))
 }
@@ -198,12 +208,13 @@ func CreateContextShared(other *RequestContext, handler *RequestContextHandler)
 // CurrentlyOn (cef_currently_on from include/capi/cef_task_capi.h)
 // Returns true (1) if called on the specified thread. Equivalent to using
 // cef_task_tRunner::GetForThread(threadId)->belongs_to_current_thread().
-func CurrentlyOn(threadId ThreadID) int32 {
-	return int32(C.cef_currently_on(C.cef_thread_id_t(threadId)))
+func CurrentlyOn(threadId ThreadID) bool {
+	return C.cef_currently_on(C.cef_thread_id_t(threadId)) != 0
 }
 
 // DictionaryValueCreate (cef_dictionary_value_create from include/capi/cef_values_capi.h)
 // Creates a new object that is not owned by any other object.
+// The caller owns a reference to the returned value; see Adopt().
 func DictionaryValueCreate() *DictionaryValue {
 	return (*DictionaryValue)(C.cef_dictionary_value_create())
 }
@@ -227,19 +238,22 @@ func DisplayGetCount() uint64 {
 // Returns the Display that most closely intersects |bounds|.  Set
 // |input_pixel_coords| to true (1) if |bounds| is in pixel coordinates instead
 // of density independent pixels (DIP).
-func DisplayGetMatchingBounds(bounds *Rect, input_pixel_coords int32) *Display {
-	return (*Display)(C.cef_display_get_matching_bounds(bounds.toNative(&C.cef_rect_t{}), C.int(input_pixel_coords)))
+// The caller owns a reference to the returned value; see Adopt().
+func DisplayGetMatchingBounds(bounds *Rect, input_pixel_coords bool) *Display {
+	return (*Display)(C.cef_display_get_matching_bounds(bounds.toNative(&C.cef_rect_t{}), cefBool(input_pixel_coords)))
 }
 
 // DisplayGetNearestPoint (cef_display_get_nearest_point from include/capi/views/cef_display_capi.h)
 // Returns the Display nearest |point|. Set |input_pixel_coords| to true (1) if
 // |point| is in pixel coordinates instead of density independent pixels (DIP).
-func DisplayGetNearestPoint(point *Point, input_pixel_coords int32) *Display {
-	return (*Display)(C.cef_display_get_nearest_point(point.toNative(&C.cef_point_t{}), C.int(input_pixel_coords)))
+// The caller owns a reference to the returned value; see Adopt().
+func DisplayGetNearestPoint(point *Point, input_pixel_coords bool) *Display {
+	return (*Display)(C.cef_display_get_nearest_point(point.toNative(&C.cef_point_t{}), cefBool(input_pixel_coords)))
 }
 
 // DisplayGetPrimary (cef_display_get_primary from include/capi/views/cef_display_capi.h)
 // Returns the primary Display.
+// The caller owns a reference to the returned value; see Adopt().
 func DisplayGetPrimary() *Display {
 	return (*Display)(C.cef_display_get_primary())
 }
@@ -264,6 +278,7 @@ func DoMessageLoopWork() {
 
 // DragDataCreate (cef_drag_data_create from include/capi/cef_drag_data_capi.h)
 // Create a new cef_drag_data_t object.
+// The caller owns a reference to the returned value; see Adopt().
 func DragDataCreate() *DragData {
 	return (*DragData)(C.cef_drag_data_create())
 }
@@ -294,6 +309,7 @@ func ExecuteProcess(args *MainArgs, application *App, windows_sandbox_info unsaf
 // ImageCreate (cef_image_create from include/capi/cef_image_capi.h)
 // Create a new cef_image_t. It will initially be NULL. Use the Add*() functions
 // to add representations at different scale factors.
+// The caller owns a reference to the returned value; see Adopt().
 func ImageCreate() *Image {
 	return (*Image)(C.cef_image_create())
 }
@@ -304,21 +320,21 @@ func ImageCreate() *Image {
 // value of true (1) indicates that it succeeded and false (0) indicates that it
 // failed. The |windows_sandbox_info| parameter is only used on Windows and may
 // be NULL (see cef_sandbox_win.h for details).
-func Initialize(args *MainArgs, settings *Settings, application *App, windows_sandbox_info unsafe.Pointer) int32 {
-	return int32(C.cef_initialize(args.toNative(&C.cef_main_args_t{}), settings.toNative(&C.cef_settings_t{}), application.toNative(), windows_sandbox_info))
+func Initialize(args *MainArgs, settings *Settings, application *App, windows_sandbox_info unsafe.Pointer) bool {
+	return C.cef_initialize(args.toNative(&C.cef_main_args_t{}), settings.toNative(&C.cef_settings_t{}), application.toNative(), windows_sandbox_info) != 0
 }
 
 // IsCertStatusError (cef_is_cert_status_error from include/capi/cef_ssl_info_capi.h)
 // Returns true (1) if the certificate status has any error, major or minor.
-func IsCertStatusError(status CertStatus) int32 {
-	return int32(C.cef_is_cert_status_error(C.cef_cert_status_t(status)))
+func IsCertStatusError(status CertStatus) bool {
+	return C.cef_is_cert_status_error(C.cef_cert_status_t(status)) != 0
 }
 
 // IsCertStatusMinorError (cef_is_cert_status_minor_error from include/capi/cef_ssl_info_capi.h)
 // Returns true (1) if the certificate status represents only minor errors (e.g.
 // failure to verify certificate revocation).
-func IsCertStatusMinorError(status CertStatus) int32 {
-	return int32(C.cef_is_cert_status_minor_error(C.cef_cert_status_t(status)))
+func IsCertStatusMinorError(status CertStatus) bool {
+	return C.cef_is_cert_status_minor_error(C.cef_cert_status_t(status)) != 0
 }
 
 // IsWebPluginUnstable (cef_is_web_plugin_unstable from include/capi/cef_web_plugin_capi.h)
@@ -341,7 +357,8 @@ func IsWebPluginUnstable(path string, callback *WebPluginUnstableCallback) {
 // minimum size of 70x33 DIP. If |with_frame| is false (0) the button will only
 // have a visible frame on hover/press, left alignment, less padding and no
 // default minimum size.
-func LabelButtonCreate(delegate *ButtonDelegate, text string, with_frame int32) *LabelButton {
+// The caller owns a reference to the returned value; see Adopt().
+func LabelButtonCreate(delegate *ButtonDelegate, text string, with_frame bool) *LabelButton {
 	var delegate_ *C.cef_button_delegate_t
 	if delegate != nil {
 		delegate_ = delegate.toNative()
@@ -351,11 +368,12 @@ func LabelButtonCreate(delegate *ButtonDelegate, text string, with_frame int32)
 	defer func() {
 		C.cef_string_userfree_free(text_)
 	}()
-	return (*LabelButton)(C.cef_label_button_create(delegate_, (*C.cef_string_t)(text_), C.int(with_frame)))
+	return (*LabelButton)(C.cef_label_button_create(delegate_, (*C.cef_string_t)(text_), cefBool(with_frame)))
 }
 
 // ListValueCreate (cef_list_value_create from include/capi/cef_values_capi.h)
 // Creates a new object that is not owned by any other object.
+// The caller owns a reference to the returned value; see Adopt().
 func ListValueCreate() *ListValue {
 	return (*ListValue)(C.cef_list_value_create())
 }
@@ -368,7 +386,8 @@ func ListValueCreate() *ListValue {
 // default minimum size of 70x33 DIP. If |with_frame| is false (0) the button
 // will only have a visible frame on hover/press, left alignment, less padding
 // and no default minimum size.
-func MenuButtonCreate(delegate *MenuButtonDelegate, text string, with_frame int32) *MenuButton {
+// The caller owns a reference to the returned value; see Adopt().
+func MenuButtonCreate(delegate *MenuButtonDelegate, text string, with_frame bool) *MenuButton {
 	var delegate_ *C.cef_menu_button_delegate_t
 	if delegate != nil {
 		delegate_ = delegate.toNative()
@@ -378,11 +397,12 @@ func MenuButtonCreate(delegate *MenuButtonDelegate, text string, with_frame int3
 	defer func() {
 		C.cef_string_userfree_free(text_)
 	}()
-	return (*MenuButton)(C.cef_menu_button_create(delegate_, (*C.cef_string_t)(text_), C.int(with_frame)))
+	return (*MenuButton)(C.cef_menu_button_create(delegate_, (*C.cef_string_t)(text_), cefBool(with_frame)))
 }
 
 // MenuModelCreate (cef_menu_model_create from include/capi/cef_menu_model_capi.h)
 // Create a new MenuModel with the specified |delegate|.
+// The caller owns a reference to the returned value; see Adopt().
 func MenuModelCreate(delegate *MenuModelDelegate) *MenuModel {
 	var delegate_ *C.cef_menu_model_delegate_t
 	if delegate != nil {
@@ -393,6 +413,7 @@ func MenuModelCreate(delegate *MenuModelDelegate) *MenuModel {
 
 // PanelCreate (cef_panel_create from include/capi/views/cef_panel_capi.h)
 // Create a new Panel.
+// The caller owns a reference to the returned value; see Adopt().
 func PanelCreate(delegate *PanelDelegate) *Panel {
 	var delegate_ *C.cef_panel_delegate_t
 	if delegate != nil {
@@ -403,12 +424,14 @@ func PanelCreate(delegate *PanelDelegate) *Panel {
 
 // PostDataCreate (cef_post_data_create from include/capi/cef_request_capi.h)
 // Create a new cef_post_data_t object.
+// The caller owns a reference to the returned value; see Adopt().
 func PostDataCreate() *PostData {
 	return (*PostData)(C.cef_post_data_create())
 }
 
 // PostDataElementCreate (cef_post_data_element_create from include/capi/cef_request_capi.h)
 // Create a new cef_post_data_element_t object.
+// The caller owns a reference to the returned value; see Adopt().
 func PostDataElementCreate() *PostDataElement {
 	return (*PostDataElement)(C.cef_post_data_element_create())
 }
@@ -417,25 +440,27 @@ func PostDataElementCreate() *PostDataElement {
 // Post a task for delayed execution on the specified thread. Equivalent to
 // using cef_task_tRunner::GetForThread(threadId)->PostDelayedTask(task,
 // delay_ms).
-func PostDelayedTask(threadId ThreadID, task *Task, delay_ms int64) int32 {
-	return int32(C.cef_post_delayed_task(C.cef_thread_id_t(threadId), task.toNative(), C.int64(delay_ms)))
+func PostDelayedTask(threadId ThreadID, task *Task, delay_ms int64) bool {
+	return C.cef_post_delayed_task(C.cef_thread_id_t(threadId), task.toNative(), C.int64(delay_ms)) != 0
 }
 
 // PostTask (cef_post_task from include/capi/cef_task_capi.h)
 // Post a task for execution on the specified thread. Equivalent to using
 // cef_task_tRunner::GetForThread(threadId)->PostTask(task).
-func PostTask(threadId ThreadID, task *Task) int32 {
-	return int32(C.cef_post_task(C.cef_thread_id_t(threadId), task.toNative()))
+func PostTask(threadId ThreadID, task *Task) bool {
+	return C.cef_post_task(C.cef_thread_id_t(threadId), task.toNative()) != 0
 }
 
 // PrintSettingsCreate (cef_print_settings_create from include/capi/cef_print_settings_capi.h)
 // Create a new cef_print_settings_t object.
+// The caller owns a reference to the returned value; see Adopt().
 func PrintSettingsCreate() *PrintSettings {
 	return (*PrintSettings)(C.cef_print_settings_create())
 }
 
 // ProcessMessageCreate (cef_process_message_create from include/capi/cef_process_message_capi.h)
 // Create a new cef_process_message_t object with the specified name.
+// The caller owns a reference to the returned value; see Adopt().
 func ProcessMessageCreate(name string) *ProcessMessage {
 	name_ := C.cef_string_userfree_alloc()
 	setCEFStr(name, name_)
@@ -516,7 +541,7 @@ func RefreshWebPlugins() {
 //   // Call another function.
 //   example.test.increment();
 // </pre>
-func RegisterExtension(extension_name, javascript_code string, handler *V8handler) int32 {
+func RegisterExtension(extension_name, javascript_code string, handler *V8handler) bool {
 	extension_name_ := C.cef_string_userfree_alloc()
 	setCEFStr(extension_name, extension_name_)
 	defer func() {
@@ -527,7 +552,7 @@ func RegisterExtension(extension_name, javascript_code string, handler *V8handle
 	defer func() {
 		C.cef_string_userfree_free(javascript_code_)
 	}()
-	return int32(C.cef_register_extension((*C.cef_string_t)(extension_name_), (*C.cef_string_t)(javascript_code_), handler.toNative()))
+	return C.cef_register_extension((*C.cef_string_t)(extension_name_), (*C.cef_string_t)(javascript_code_), handler.toNative()) != 0
 }
 
 // RegisterSchemeHandlerFactory (cef_register_scheme_handler_factory from include/capi/cef_scheme_capi.h)
@@ -544,7 +569,7 @@ func RegisterExtension(extension_name, javascript_code string, handler *V8handle
 // browser process. Using this function is equivalent to calling cef_request_tCo
 // ntext::cef_request_context_get_global_context()->register_scheme_handler_fact
 // ory().
-func RegisterSchemeHandlerFactory(scheme_name, domain_name string, factory *SchemeHandlerFactory) int32 {
+func RegisterSchemeHandlerFactory(scheme_name, domain_name string, factory *SchemeHandlerFactory) bool {
 	scheme_name_ := C.cef_string_userfree_alloc()
 	setCEFStr(scheme_name, scheme_name_)
 	defer func() {
@@ -555,7 +580,7 @@ func RegisterSchemeHandlerFactory(scheme_name, domain_name string, factory *Sche
 	defer func() {
 		C.cef_string_userfree_free(domain_name_)
 	}()
-	return int32(C.cef_register_scheme_handler_factory((*C.cef_string_t)(scheme_name_), (*C.cef_string_t)(domain_name_), factory.toNative()))
+	return C.cef_register_scheme_handler_factory((*C.cef_string_t)(scheme_name_), (*C.cef_string_t)(domain_name_), factory.toNative()) != 0
 }
 
 // RegisterWebPluginCrash (cef_register_web_plugin_crash from include/capi/cef_web_plugin_capi.h)
@@ -623,7 +648,7 @@ func RegisterWidevineCdm(path string, callback *RegisterCdmCallback) {
 // RemoveCrossOriginWhitelistEntry (cef_remove_cross_origin_whitelist_entry from include/capi/cef_origin_whitelist_capi.h)
 // Remove an entry from the cross-origin access whitelist. Returns false (0) if
 // |source_origin| is invalid or the whitelist cannot be accessed.
-func RemoveCrossOriginWhitelistEntry(source_origin, target_protocol, target_domain string, allow_target_subdomains int32) int32 {
+func RemoveCrossOriginWhitelistEntry(source_origin, target_protocol, target_domain string, allow_target_subdomains bool) bool {
 	source_origin_ := C.cef_string_userfree_alloc()
 	setCEFStr(source_origin, source_origin_)
 	defer func() {
@@ -639,36 +664,41 @@ func RemoveCrossOriginWhitelistEntry(source_origin, target_protocol, target_doma
 	defer func() {
 		C.cef_string_userfree_free(target_domain_)
 	}()
-	return int32(C.cef_remove_cross_origin_whitelist_entry((*C.cef_string_t)(source_origin_), (*C.cef_string_t)(target_protocol_), (*C.cef_string_t)(target_domain_), C.int(allow_target_subdomains)))
+	return C.cef_remove_cross_origin_whitelist_entry((*C.cef_string_t)(source_origin_), (*C.cef_string_t)(target_protocol_), (*C.cef_string_t)(target_domain_), cefBool(allow_target_subdomains)) != 0
 }
 
 // RequestContextCreateContext (cef_request_context_create_context from include/capi/cef_request_context_capi.h)
 // Creates a new context object with the specified |settings| and optional
 // |handler|.
+// The caller owns a reference to the returned value; see Adopt().
 func RequestContextCreateContext(settings *RequestContextSettings, handler *RequestContextHandler) *RequestContext {
 	return (*RequestContext)(C.cef_request_context_create_context(settings.toNative(&C.cef_request_context_settings_t{}), handler.toNative()))
 }
 
 // RequestContextGetGlobalContext (cef_request_context_get_global_context from include/capi/cef_request_context_capi.h)
 // Returns the global context object.
+// The caller owns a reference to the returned value; see Adopt().
 func RequestContextGetGlobalContext() *RequestContext {
 	return (*RequestContext)(C.cef_request_context_get_global_context())
 }
 
 // RequestCreate (cef_request_create from include/capi/cef_request_capi.h)
 // Create a new cef_request_t object.
+// The caller owns a reference to the returned value; see Adopt().
 func RequestCreate() *Request {
 	return (*Request)(C.cef_request_create())
 }
 
 // ResourceBundleGetGlobal (cef_resource_bundle_get_global from include/capi/cef_resource_bundle_capi.h)
 // Returns the global resource bundle instance.
+// The caller owns a reference to the returned value; see Adopt().
 func ResourceBundleGetGlobal() *ResourceBundle {
 	return (*ResourceBundle)(C.cef_resource_bundle_get_global())
 }
 
 // ResponseCreate (cef_response_create from include/capi/cef_response_capi.h)
 // Create a new cef_response_t object.
+// The caller owns a reference to the returned value; see Adopt().
 func ResponseCreate() *Response {
 	return (*Response)(C.cef_response_create())
 }
@@ -686,6 +716,7 @@ func RunMessageLoop() {
 
 // ScrollViewCreate (cef_scroll_view_create from include/capi/views/cef_scroll_view_capi.h)
 // Create a new ScrollView.
+// The caller owns a reference to the returned value; see Adopt().
 func ScrollViewCreate(delegate *ViewDelegate) *ScrollView {
 	var delegate_ *C.cef_view_delegate_t
 	if delegate != nil {
@@ -719,8 +750,8 @@ func ServerCreate(address string, port uint16, backlog int32, handler *ServerHan
 // SetOsmodalLoop (cef_set_osmodal_loop from include/capi/cef_app_capi.h)
 // Set to true (1) before calling Windows APIs like TrackPopupMenu that enter a
 // modal message loop. Set to false (0) after exiting the modal message loop.
-func SetOsmodalLoop(osModalLoop int32) {
-	C.cef_set_osmodal_loop(C.int(osModalLoop))
+func SetOsmodalLoop(osModalLoop bool) {
+	C.cef_set_osmodal_loop(cefBool(osModalLoop))
 }
 
 // Shutdown (cef_shutdown from include/capi/cef_app_capi.h)
@@ -732,12 +763,14 @@ func Shutdown() {
 
 // StreamReaderCreateForData (cef_stream_reader_create_for_data from include/capi/cef_stream_capi.h)
 // Create a new cef_stream_reader_t object from data.
+// The caller owns a reference to the returned value; see Adopt().
 func StreamReaderCreateForData(data unsafe.Pointer, size uint64) *StreamReader {
 	return (*StreamReader)(C.cef_stream_reader_create_for_data(data, C.size_t(size)))
 }
 
 // StreamReaderCreateForFile (cef_stream_reader_create_for_file from include/capi/cef_stream_capi.h)
 // Create a new cef_stream_reader_t object from a file.
+// The caller owns a reference to the returned value; see Adopt().
 func StreamReaderCreateForFile(fileName string) *StreamReader {
 	fileName_ := C.cef_string_userfree_alloc()
 	setCEFStr(fileName, fileName_)
@@ -749,12 +782,14 @@ func StreamReaderCreateForFile(fileName string) *StreamReader {
 
 // StreamReaderCreateForHandler (cef_stream_reader_create_for_handler from include/capi/cef_stream_capi.h)
 // Create a new cef_stream_reader_t object from a custom handler.
+// The caller owns a reference to the returned value; see Adopt().
 func StreamReaderCreateForHandler(handler *ReadHandler) *StreamReader {
 	return (*StreamReader)(C.cef_stream_reader_create_for_handler(handler.toNative()))
 }
 
 // StreamWriterCreateForFile (cef_stream_writer_create_for_file from include/capi/cef_stream_capi.h)
 // Create a new cef_stream_writer_t object for a file.
+// The caller owns a reference to the returned value; see Adopt().
 func StreamWriterCreateForFile(fileName string) *StreamWriter {
 	fileName_ := C.cef_string_userfree_alloc()
 	setCEFStr(fileName, fileName_)
@@ -766,253 +801,30 @@ func StreamWriterCreateForFile(fileName string) *StreamWriter {
 
 // StreamWriterCreateForHandler (cef_stream_writer_create_for_handler from include/capi/cef_stream_capi.h)
 // Create a new cef_stream_writer_t object for a custom handler.
+// The caller owns a reference to the returned value; see Adopt().
 func StreamWriterCreateForHandler(handler *WriteHandler) *StreamWriter {
 	return (*StreamWriter)(C.cef_stream_writer_create_for_handler(handler.toNative()))
 }
 
-// StringListAlloc (cef_string_list_alloc from include/internal/cef_string_list.h)
-// Allocate a new string map.
-func StringListAlloc() StringList {
-	return StringList(C.cef_string_list_alloc())
-}
-
-// StringListAppend (cef_string_list_append from include/internal/cef_string_list.h)
-// Append a new value at the end of the string list.
-func StringListAppend(list StringList, value string) {
-	value_ := C.cef_string_userfree_alloc()
-	setCEFStr(value, value_)
-	defer func() {
-		C.cef_string_userfree_free(value_)
-	}()
-	C.cef_string_list_append(C.cef_string_list_t(list), (*C.cef_string_t)(value_))
-}
-
-// StringListClear (cef_string_list_clear from include/internal/cef_string_list.h)
-// Clear the string list.
-func StringListClear(list StringList) {
-	C.cef_string_list_clear(C.cef_string_list_t(list))
-}
-
-// StringListCopy (cef_string_list_copy from include/internal/cef_string_list.h)
-// Creates a copy of an existing string list.
-func StringListCopy(list StringList) StringList {
-	return StringList(C.cef_string_list_copy(C.cef_string_list_t(list)))
-}
-
-// StringListFree (cef_string_list_free from include/internal/cef_string_list.h)
-// Free the string list.
-func StringListFree(list StringList) {
-	C.cef_string_list_free(C.cef_string_list_t(list))
-}
-
-// StringListSize (cef_string_list_size from include/internal/cef_string_list.h)
-// Return the number of elements in the string list.
-func StringListSize(list StringList) uint64 {
-	return uint64(C.cef_string_list_size(C.cef_string_list_t(list)))
-}
-
-// StringListValue (cef_string_list_value from include/internal/cef_string_list.h)
-// Retrieve the value at the specified zero-based string list index. Returns
-// true (1) if the value was successfully retrieved.
-func StringListValue(list StringList, index uint64, value *string) int32 {
-	value_ := C.cef_string_userfree_alloc()
-	setCEFStr(*value, value_)
-	defer func() {
-		*value = cefstrToString(value_)
-		C.cef_string_userfree_free(value_)
-	}()
-	return int32(C.cef_string_list_value(C.cef_string_list_t(list), C.size_t(index), (*C.cef_string_t)(value_)))
-}
-
-// StringMapAlloc (cef_string_map_alloc from include/internal/cef_string_map.h)
-// Allocate a new string map.
-func StringMapAlloc() StringMap {
-	return StringMap(C.cef_string_map_alloc())
-}
-
-// StringMapAppend (cef_string_map_append from include/internal/cef_string_map.h)
-// Append a new key/value pair at the end of the string map.
-func StringMapAppend(map_r StringMap, key, value string) int32 {
-	key_ := C.cef_string_userfree_alloc()
-	setCEFStr(key, key_)
-	defer func() {
-		C.cef_string_userfree_free(key_)
-	}()
-	value_ := C.cef_string_userfree_alloc()
-	setCEFStr(value, value_)
-	defer func() {
-		C.cef_string_userfree_free(value_)
-	}()
-	return int32(C.cef_string_map_append(C.cef_string_map_t(map_r), (*C.cef_string_t)(key_), (*C.cef_string_t)(value_)))
-}
-
-// StringMapClear (cef_string_map_clear from include/internal/cef_string_map.h)
-// Clear the string map.
-func StringMapClear(map_r StringMap) {
-	C.cef_string_map_clear(C.cef_string_map_t(map_r))
-}
-
-// StringMapFind (cef_string_map_find from include/internal/cef_string_map.h)
-// Return the value assigned to the specified key.
-func StringMapFind(map_r StringMap, key string, value *string) int32 {
-	key_ := C.cef_string_userfree_alloc()
-	setCEFStr(key, key_)
-	defer func() {
-		C.cef_string_userfree_free(key_)
-	}()
-	value_ := C.cef_string_userfree_alloc()
-	setCEFStr(*value, value_)
-	defer func() {
-		*value = cefstrToString(value_)
-		C.cef_string_userfree_free(value_)
-	}()
-	return int32(C.cef_string_map_find(C.cef_string_map_t(map_r), (*C.cef_string_t)(key_), (*C.cef_string_t)(value_)))
-}
-
-// StringMapFree (cef_string_map_free from include/internal/cef_string_map.h)
-// Free the string map.
-func StringMapFree(map_r StringMap) {
-	C.cef_string_map_free(C.cef_string_map_t(map_r))
-}
-
-// StringMapKey (cef_string_map_key from include/internal/cef_string_map.h)
-// Return the key at the specified zero-based string map index.
-func StringMapKey(map_r StringMap, index uint64, key *string) int32 {
-	key_ := C.cef_string_userfree_alloc()
-	setCEFStr(*key, key_)
-	defer func() {
-		*key = cefstrToString(key_)
-		C.cef_string_userfree_free(key_)
-	}()
-	return int32(C.cef_string_map_key(C.cef_string_map_t(map_r), C.size_t(index), (*C.cef_string_t)(key_)))
-}
-
-// StringMapSize (cef_string_map_size from include/internal/cef_string_map.h)
-// Return the number of elements in the string map.
-func StringMapSize(map_r StringMap) uint64 {
-	return uint64(C.cef_string_map_size(C.cef_string_map_t(map_r)))
-}
-
-// StringMapValue (cef_string_map_value from include/internal/cef_string_map.h)
-// Return the value at the specified zero-based string map index.
-func StringMapValue(map_r StringMap, index uint64, value *string) int32 {
-	value_ := C.cef_string_userfree_alloc()
-	setCEFStr(*value, value_)
-	defer func() {
-		*value = cefstrToString(value_)
-		C.cef_string_userfree_free(value_)
-	}()
-	return int32(C.cef_string_map_value(C.cef_string_map_t(map_r), C.size_t(index), (*C.cef_string_t)(value_)))
-}
-
-// StringMultimapAlloc (cef_string_multimap_alloc from include/internal/cef_string_multimap.h)
-// Allocate a new string multimap.
-func StringMultimapAlloc() StringMultimap {
-	return StringMultimap(C.cef_string_multimap_alloc())
-}
-
-// StringMultimapAppend (cef_string_multimap_append from include/internal/cef_string_multimap.h)
-// Append a new key/value pair at the end of the string multimap.
-func StringMultimapAppend(map_r StringMultimap, key, value string) int32 {
-	key_ := C.cef_string_userfree_alloc()
-	setCEFStr(key, key_)
-	defer func() {
-		C.cef_string_userfree_free(key_)
-	}()
-	value_ := C.cef_string_userfree_alloc()
-	setCEFStr(value, value_)
-	defer func() {
-		C.cef_string_userfree_free(value_)
-	}()
-	return int32(C.cef_string_multimap_append(C.cef_string_multimap_t(map_r), (*C.cef_string_t)(key_), (*C.cef_string_t)(value_)))
-}
-
-// StringMultimapClear (cef_string_multimap_clear from include/internal/cef_string_multimap.h)
-// Clear the string multimap.
-func StringMultimapClear(map_r StringMultimap) {
-	C.cef_string_multimap_clear(C.cef_string_multimap_t(map_r))
-}
-
-// StringMultimapEnumerate (cef_string_multimap_enumerate from include/internal/cef_string_multimap.h)
-// Return the value_index-th value with the specified key.
-func StringMultimapEnumerate(map_r StringMultimap, key string, value_index uint64, value *string) int32 {
-	key_ := C.cef_string_userfree_alloc()
-	setCEFStr(key, key_)
-	defer func() {
-		C.cef_string_userfree_free(key_)
-	}()
-	value_ := C.cef_string_userfree_alloc()
-	setCEFStr(*value, value_)
-	defer func() {
-		*value = cefstrToString(value_)
-		C.cef_string_userfree_free(value_)
-	}()
-	return int32(C.cef_string_multimap_enumerate(C.cef_string_multimap_t(map_r), (*C.cef_string_t)(key_), C.size_t(value_index), (*C.cef_string_t)(value_)))
-}
-
-// StringMultimapFindCount (cef_string_multimap_find_count from include/internal/cef_string_multimap.h)
-// Return the number of values with the specified key.
-func StringMultimapFindCount(map_r StringMultimap, key string) uint64 {
-	key_ := C.cef_string_userfree_alloc()
-	setCEFStr(key, key_)
-	defer func() {
-		C.cef_string_userfree_free(key_)
-	}()
-	return uint64(C.cef_string_multimap_find_count(C.cef_string_multimap_t(map_r), (*C.cef_string_t)(key_)))
-}
-
-// StringMultimapFree (cef_string_multimap_free from include/internal/cef_string_multimap.h)
-// Free the string multimap.
-func StringMultimapFree(map_r StringMultimap) {
-	C.cef_string_multimap_free(C.cef_string_multimap_t(map_r))
-}
-
-// StringMultimapKey (cef_string_multimap_key from include/internal/cef_string_multimap.h)
-// Return the key at the specified zero-based string multimap index.
-func StringMultimapKey(map_r StringMultimap, index uint64, key *string) int32 {
-	key_ := C.cef_string_userfree_alloc()
-	setCEFStr(*key, key_)
-	defer func() {
-		*key = cefstrToString(key_)
-		C.cef_string_userfree_free(key_)
-	}()
-	return int32(C.cef_string_multimap_key(C.cef_string_multimap_t(map_r), C.size_t(index), (*C.cef_string_t)(key_)))
-}
-
-// StringMultimapSize (cef_string_multimap_size from include/internal/cef_string_multimap.h)
-// Return the number of elements in the string multimap.
-func StringMultimapSize(map_r StringMultimap) uint64 {
-	return uint64(C.cef_string_multimap_size(C.cef_string_multimap_t(map_r)))
-}
-
-// StringMultimapValue (cef_string_multimap_value from include/internal/cef_string_multimap.h)
-// Return the value at the specified zero-based string multimap index.
-func StringMultimapValue(map_r StringMultimap, index uint64, value *string) int32 {
-	value_ := C.cef_string_userfree_alloc()
-	setCEFStr(*value, value_)
-	defer func() {
-		*value = cefstrToString(value_)
-		C.cef_string_userfree_free(value_)
-	}()
-	return int32(C.cef_string_multimap_value(C.cef_string_multimap_t(map_r), C.size_t(index), (*C.cef_string_t)(value_)))
-}
-
 // TaskRunnerGetForCurrentThread (cef_task_runner_get_for_current_thread from include/capi/cef_task_capi.h)
 // Returns the task runner for the current thread. Only CEF threads will have
 // task runners. An NULL reference will be returned if this function is called
 // on an invalid thread.
+// The caller owns a reference to the returned value; see Adopt().
 func TaskRunnerGetForCurrentThread() *TaskRunner {
 	return (*TaskRunner)(C.cef_task_runner_get_for_current_thread())
 }
 
 // TaskRunnerGetForThread (cef_task_runner_get_for_thread from include/capi/cef_task_capi.h)
 // Returns the task runner for the specified CEF thread.
+// The caller owns a reference to the returned value; see Adopt().
 func TaskRunnerGetForThread(threadId ThreadID) *TaskRunner {
 	return (*TaskRunner)(C.cef_task_runner_get_for_thread(C.cef_thread_id_t(threadId)))
 }
 
 // TextfieldCreate (cef_textfield_create from include/capi/views/cef_textfield_capi.h)
 // Create a new Textfield.
+// The caller owns a reference to the returned value; see Adopt().
 func TextfieldCreate(delegate *TextfieldDelegate) *Textfield {
 	var delegate_ *C.cef_textfield_delegate_t
 	if delegate != nil {
@@ -1024,25 +836,25 @@ func TextfieldCreate(delegate *TextfieldDelegate) *Textfield {
 // TimeDelta (cef_time_delta from include/internal/cef_time.h)
 // Retrieve the delta in milliseconds between two time values.
 //
-func TimeDelta(cef_time1, cef_time2 *Time, delta *int64) int32 {
-	return int32(C.cef_time_delta(cef_time1.toNative(&C.cef_time_t{}), cef_time2.toNative(&C.cef_time_t{}), (*C.longlong)(delta)))
+func TimeDelta(cef_time1, cef_time2 *Time, delta *int64) bool {
+	return C.cef_time_delta(cef_time1.toNative(&C.cef_time_t{}), cef_time2.toNative(&C.cef_time_t{}), (*C.longlong)(delta)) != 0
 }
 
 // TimeFromDoublet (cef_time_from_doublet from include/internal/cef_time.h)
-func TimeFromDoublet(time float64, cef_time *Time) int32 {
-	return int32(C.cef_time_from_doublet(C.double(time), cef_time.toNative(&C.cef_time_t{})))
+func TimeFromDoublet(time float64, cef_time *Time) bool {
+	return C.cef_time_from_doublet(C.double(time), cef_time.toNative(&C.cef_time_t{})) != 0
 }
 
 // TimeFromTimet (cef_time_from_timet from include/internal/cef_time.h)
-func TimeFromTimet(time int64, cef_time *Time) int32 {
-	return int32(C.cef_time_from_timet(C.time_t(time), cef_time.toNative(&C.cef_time_t{})))
+func TimeFromTimet(time int64, cef_time *Time) bool {
+	return C.cef_time_from_timet(C.time_t(time), cef_time.toNative(&C.cef_time_t{})) != 0
 }
 
 // TimeNow (cef_time_now from include/internal/cef_time.h)
 // Retrieve the current system time.
 //
-func TimeNow(cef_time *Time) int32 {
-	return int32(C.cef_time_now(cef_time.toNative(&C.cef_time_t{})))
+func TimeNow(cef_time *Time) bool {
+	return C.cef_time_now(cef_time.toNative(&C.cef_time_t{})) != 0
 }
 
 // TimeToDoublet (cef_time_to_doublet from include/internal/cef_time.h)
@@ -1050,15 +862,15 @@ func TimeNow(cef_time *Time) int32 {
 // epoch (Jan 1, 1970). Webkit uses this format to represent time. A value of 0
 // means "not initialized". Returns true (1) on success and false (0) on
 // failure.
-func TimeToDoublet(cef_time *Time, time *float64) int32 {
-	return int32(C.cef_time_to_doublet(cef_time.toNative(&C.cef_time_t{}), (*C.double)(time)))
+func TimeToDoublet(cef_time *Time, time *float64) bool {
+	return C.cef_time_to_doublet(cef_time.toNative(&C.cef_time_t{}), (*C.double)(time)) != 0
 }
 
 // TimeToTimet (cef_time_to_timet from include/internal/cef_time.h)
 // Converts cef_time_t to/from time_t. Returns true (1) on success and false (0)
 // on failure.
-func TimeToTimet(cef_time *Time, time *int64) int32 {
-	return int32(C.cef_time_to_timet(cef_time.toNative(&C.cef_time_t{}), (*C.time_t)(time)))
+func TimeToTimet(cef_time *Time, time *int64) bool {
+	return C.cef_time_to_timet(cef_time.toNative(&C.cef_time_t{}), (*C.time_t)(time)) != 0
 }
 
 // UnregisterInternalWebPlugin (cef_unregister_internal_web_plugin from include/capi/cef_web_plugin_capi.h)
@@ -1088,31 +900,35 @@ func UnregisterInternalWebPlugin(path string) {
 // NULL the global request context will be used. In the render process
 // |request_context| must be NULL and the context associated with the current
 // renderer process' browser will be used.
+// The caller owns a reference to the returned value; see Adopt().
 func UrlrequestCreate(request *Request, client *UrlrequestClient, request_context *RequestContext) *Urlrequest {
 	return (*Urlrequest)(C.cef_urlrequest_create(request.toNative(), client.toNative(), request_context.toNative()))
 }
 
 // V8contextGetCurrentContext (cef_v8context_get_current_context from include/capi/cef_v8_capi.h)
 // Returns the current (top) context object in the V8 context stack.
+// The caller owns a reference to the returned value; see Adopt().
 func V8contextGetCurrentContext() *V8context {
 	return (*V8context)(C.cef_v8context_get_current_context())
 }
 
 // V8contextGetEnteredContext (cef_v8context_get_entered_context from include/capi/cef_v8_capi.h)
 // Returns the entered (bottom) context object in the V8 context stack.
+// The caller owns a reference to the returned value; see Adopt().
 func V8contextGetEnteredContext() *V8context {
 	return (*V8context)(C.cef_v8context_get_entered_context())
 }
 
 // V8contextInContext (cef_v8context_in_context from include/capi/cef_v8_capi.h)
 // Returns true (1) if V8 is currently inside a context.
-func V8contextInContext() int32 {
-	return int32(C.cef_v8context_in_context())
+func V8contextInContext() bool {
+	return C.cef_v8context_in_context() != 0
 }
 
 // V8stackTraceGetCurrent (cef_v8stack_trace_get_current from include/capi/cef_v8_capi.h)
 // Returns the stack trace for the currently active context. |frame_limit| is
 // the maximum number of frames that will be captured.
+// The caller owns a reference to the returned value; see Adopt().
 func V8stackTraceGetCurrent(frame_limit int32) *V8stackTrace {
 	return (*V8stackTrace)(C.cef_v8stack_trace_get_current(C.int(frame_limit)))
 }
@@ -1124,6 +940,7 @@ func V8stackTraceGetCurrent(frame_limit int32) *V8stackTrace {
 // cef_render_process_handler_t, cef_v8handler_t or cef_v8accessor_t callback,
 // or in combination with calling enter() and exit() on a stored cef_v8context_t
 // reference.
+// The caller owns a reference to the returned value; see Adopt().
 func V8valueCreateArray(length int32) *V8value {
 	return (*V8value)(C.cef_v8value_create_array(C.int(length)))
 }
@@ -1137,14 +954,16 @@ func V8valueCreateArray(length int32) *V8value {
 // cef_render_process_handler_t, cef_v8handler_t or cef_v8accessor_t callback,
 // or in combination with calling enter() and exit() on a stored cef_v8context_t
 // reference.
+// The caller owns a reference to the returned value; see Adopt().
 func V8valueCreateArrayBuffer(buffer unsafe.Pointer, length uint64, release_callback *V8arrayBufferReleaseCallback) *V8value {
 	return (*V8value)(C.cef_v8value_create_array_buffer(buffer, C.size_t(length), release_callback.toNative()))
 }
 
 // V8valueCreateBool (cef_v8value_create_bool from include/capi/cef_v8_capi.h)
 // Create a new cef_v8value_t object of type bool.
-func V8valueCreateBool(value int32) *V8value {
-	return (*V8value)(C.cef_v8value_create_bool(C.int(value)))
+// The caller owns a reference to the returned value; see Adopt().
+func V8valueCreateBool(value bool) *V8value {
+	return (*V8value)(C.cef_v8value_create_bool(cefBool(value)))
 }
 
 // V8valueCreateDate (cef_v8value_create_date from include/capi/cef_v8_capi.h)
@@ -1152,12 +971,14 @@ func V8valueCreateBool(value int32) *V8value {
 // called from within the scope of a cef_render_process_handler_t,
 // cef_v8handler_t or cef_v8accessor_t callback, or in combination with calling
 // enter() and exit() on a stored cef_v8context_t reference.
+// The caller owns a reference to the returned value; see Adopt().
 func V8valueCreateDate(date *Time) *V8value {
 	return (*V8value)(C.cef_v8value_create_date(date.toNative(&C.cef_time_t{})))
 }
 
 // V8valueCreateDouble (cef_v8value_create_double from include/capi/cef_v8_capi.h)
 // Create a new cef_v8value_t object of type double.
+// The caller owns a reference to the returned value; see Adopt().
 func V8valueCreateDouble(value float64) *V8value {
 	return (*V8value)(C.cef_v8value_create_double(C.double(value)))
 }
@@ -1167,6 +988,7 @@ func V8valueCreateDouble(value float64) *V8value {
 // be called from within the scope of a cef_render_process_handler_t,
 // cef_v8handler_t or cef_v8accessor_t callback, or in combination with calling
 // enter() and exit() on a stored cef_v8context_t reference.
+// The caller owns a reference to the returned value; see Adopt().
 func V8valueCreateFunction(name string, handler *V8handler) *V8value {
 	name_ := C.cef_string_userfree_alloc()
 	setCEFStr(name, name_)
@@ -1178,12 +1000,14 @@ func V8valueCreateFunction(name string, handler *V8handler) *V8value {
 
 // V8valueCreateInt (cef_v8value_create_int from include/capi/cef_v8_capi.h)
 // Create a new cef_v8value_t object of type int.
+// The caller owns a reference to the returned value; see Adopt().
 func V8valueCreateInt(value int32) *V8value {
 	return (*V8value)(C.cef_v8value_create_int(C.int32(value)))
 }
 
 // V8valueCreateNull (cef_v8value_create_null from include/capi/cef_v8_capi.h)
 // Create a new cef_v8value_t object of type null.
+// The caller owns a reference to the returned value; see Adopt().
 func V8valueCreateNull() *V8value {
 	return (*V8value)(C.cef_v8value_create_null())
 }
@@ -1194,12 +1018,14 @@ func V8valueCreateNull() *V8value {
 // of a cef_render_process_handler_t, cef_v8handler_t or cef_v8accessor_t
 // callback, or in combination with calling enter() and exit() on a stored
 // cef_v8context_t reference.
+// The caller owns a reference to the returned value; see Adopt().
 func V8valueCreateObject(accessor *V8accessor, interceptor *V8interceptor) *V8value {
 	return (*V8value)(C.cef_v8value_create_object(accessor.toNative(), interceptor.toNative()))
 }
 
 // V8valueCreateString (cef_v8value_create_string from include/capi/cef_v8_capi.h)
 // Create a new cef_v8value_t object of type string.
+// The caller owns a reference to the returned value; see Adopt().
 func V8valueCreateString(value string) *V8value {
 	value_ := C.cef_string_userfree_alloc()
 	setCEFStr(value, value_)
@@ -1211,18 +1037,21 @@ func V8valueCreateString(value string) *V8value {
 
 // V8valueCreateUint (cef_v8value_create_uint from include/capi/cef_v8_capi.h)
 // Create a new cef_v8value_t object of type unsigned int.
+// The caller owns a reference to the returned value; see Adopt().
 func V8valueCreateUint(value uint32) *V8value {
 	return (*V8value)(C.cef_v8value_create_uint(C.uint32(value)))
 }
 
 // V8valueCreateUndefined (cef_v8value_create_undefined from include/capi/cef_v8_capi.h)
 // Create a new cef_v8value_t object of type undefined.
+// The caller owns a reference to the returned value; see Adopt().
 func V8valueCreateUndefined() *V8value {
 	return (*V8value)(C.cef_v8value_create_undefined())
 }
 
 // ValueCreate (cef_value_create from include/capi/cef_values_capi.h)
 // Creates a new object.
+// The caller owns a reference to the returned value; see Adopt().
 func ValueCreate() *Value {
 	return (*Value)(C.cef_value_create())
 }
@@ -1240,12 +1069,14 @@ func VisitWebPluginInfo(visitor *WebPluginInfoVisitor) {
 // been released; otherwise, the state remains signaled until reset() is called
 // manually. If |initially_signaled| is true (1) then the event will start in
 // the signaled state.
-func WaitableEventCreate(automatic_reset, initially_signaled int32) *WaitableEvent {
-	return (*WaitableEvent)(C.cef_waitable_event_create(C.int(automatic_reset), C.int(initially_signaled)))
+// The caller owns a reference to the returned value; see Adopt().
+func WaitableEventCreate(automatic_reset, initially_signaled bool) *WaitableEvent {
+	return (*WaitableEvent)(C.cef_waitable_event_create(cefBool(automatic_reset), cefBool(initially_signaled)))
 }
 
 // WindowCreateTopLevel (cef_window_create_top_level from include/capi/views/cef_window_capi.h)
 // Create a new Window.
+// The caller owns a reference to the returned value; see Adopt().
 func WindowCreateTopLevel(delegate *WindowDelegate) *Window {
 	var delegate_ *C.cef_window_delegate_t
 	if delegate != nil {
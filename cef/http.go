@@ -0,0 +1,257 @@
+package cef
+
+import (
+	// #include "capi_gen.h"
+	// static void gocef_post_data_elements(cef_post_data_t *self, size_t *count, cef_post_data_element_t **elements) { self->get_elements(self, count, elements); }
+	"C"
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"unsafe"
+
+	"github.com/richardwilkes/toolbox/errs"
+)
+
+// Header returns the request's headers. The Referer value is not included;
+// use GetReferrerUrl() for that.
+func (d *Request) Header() http.Header {
+	return headerFromMap(d.GetHeaderMap())
+}
+
+// SetHeader replaces the request's headers. Any Referer value will be
+// ignored; use SetReferrer() for that.
+func (d *Request) SetHeader(header http.Header) {
+	d.SetHeaderMap(header)
+}
+
+// ToHTTP creates an http.Request from the request, including its headers and
+// post data.
+func (d *Request) ToHTTP() (*http.Request, error) {
+	var body io.Reader
+	if postData := d.GetPostData(); postData != nil {
+		var err error
+		body, err = postData.Reader()
+		ReleaseAll(postData)
+		if err != nil {
+			return nil, err
+		}
+	}
+	req, err := http.NewRequest(d.GetMethod(), d.GetUrl(), body)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	req.Header = d.Header()
+	if referrer := d.GetReferrerUrl(); referrer != "" {
+		req.Header.Set("Referer", referrer)
+	}
+	req.Host = req.URL.Host
+	return req, nil
+}
+
+// SetFromHTTP sets the request's URL, method, headers, referrer and post data
+// from an http.Request. The http.Request's body will be fully consumed.
+func (d *Request) SetFromHTTP(req *http.Request) error {
+	var postData *PostData
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		if postData, err = NewPostDataFromReader(req.Body); err != nil {
+			return err
+		}
+	}
+	header := cloneHeader(req.Header)
+	referrer := header.Get("Referer")
+	header.Del("Referer")
+	d.Set(req.URL.String(), req.Method, postData, header)
+	if referrer != "" {
+		d.SetReferrer(referrer, ReferrerPolicyDefault)
+	}
+	return nil
+}
+
+// Header returns the response's headers.
+func (d *Response) Header() http.Header {
+	return headerFromMap(d.GetHeaderMap())
+}
+
+// SetHeader replaces the response's headers.
+func (d *Response) SetHeader(header http.Header) {
+	d.SetHeaderMap(header)
+}
+
+// ToHTTP creates an http.Response from the response's status and headers.
+// Since CEF responses do not carry their content, the body will be empty.
+func (d *Response) ToHTTP() *http.Response {
+	status := int(d.GetStatus())
+	text := d.GetStatusText()
+	if text == "" {
+		text = http.StatusText(status)
+	}
+	resp := &http.Response{
+		Status:        strconv.Itoa(status) + " " + text,
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        d.Header(),
+		Body:          http.NoBody,
+		ContentLength: -1,
+	}
+	if mimeType := d.GetMimeType(); mimeType != "" && resp.Header.Get("Content-Type") == "" {
+		resp.Header.Set("Content-Type", mimeType)
+	}
+	if length, err := strconv.ParseInt(resp.Header.Get("Content-Length"), 10, 64); err == nil {
+		resp.ContentLength = length
+	}
+	return resp
+}
+
+// SetFromHTTP sets the response's status, status text, mime type and headers
+// from an http.Response. The http.Response's body is not used.
+func (d *Response) SetFromHTTP(resp *http.Response) {
+	d.SetStatus(int32(resp.StatusCode))
+	text := strings.TrimSpace(strings.TrimPrefix(resp.Status, strconv.Itoa(resp.StatusCode)))
+	if text == "" {
+		text = http.StatusText(resp.StatusCode)
+	}
+	d.SetStatusText(text)
+	if contentType := resp.Header.Get("Content-Type"); contentType != "" {
+		if i := strings.Index(contentType, ";"); i != -1 {
+			contentType = contentType[:i]
+		}
+		d.SetMimeType(strings.TrimSpace(contentType))
+	}
+	d.SetHeader(resp.Header)
+}
+
+// NewPostDataFromBytes creates a new PostData containing a copy of the data.
+func NewPostDataFromBytes(data []byte) *PostData {
+	postData := PostDataCreate()
+	if len(data) != 0 {
+		element := PostDataElementCreate()
+		element.SetBytes(data)
+		postData.AddElement(element)
+	}
+	return postData
+}
+
+// NewPostDataFromReader creates a new PostData containing the remaining
+// contents of the reader.
+func NewPostDataFromReader(r io.Reader) (*PostData, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return NewPostDataFromBytes(data), nil
+}
+
+// NewPostDataFromValues creates a new PostData containing the URL-encoded
+// form values.
+func NewPostDataFromValues(values url.Values) *PostData {
+	return NewPostDataFromBytes([]byte(values.Encode()))
+}
+
+// Elements returns the post data elements. The caller owns a reference to
+// each of the returned elements; see Adopt().
+func (d *PostData) Elements() []*PostDataElement {
+	count := C.size_t(d.GetElementCount())
+	if count == 0 {
+		return nil
+	}
+	array := (**C.cef_post_data_element_t)(C.calloc(count, C.size_t(unsafe.Sizeof(uintptr(0)))))
+	defer C.free(unsafe.Pointer(array))
+	C.gocef_post_data_elements(d.toNative(), &count, array)
+	p := (*[1<<30 - 1]*C.cef_post_data_element_t)(unsafe.Pointer(array))
+	elements := make([]*PostDataElement, int(count))
+	for i := range elements {
+		elements[i] = (*PostDataElement)(p[i])
+	}
+	return elements
+}
+
+// Reader returns a reader for the contents of the post data. The contents of
+// file elements are read from disk at the time of this call.
+func (d *PostData) Reader() (io.Reader, error) {
+	elements := d.Elements()
+	defer func() {
+		for _, element := range elements {
+			ReleaseAll(element)
+		}
+	}()
+	var readers []io.Reader
+	for _, element := range elements {
+		switch element.GetType() {
+		case PdeTypeBytes:
+			readers = append(readers, bytes.NewReader(element.Bytes()))
+		case PdeTypeFile:
+			data, err := ioutil.ReadFile(element.GetFile())
+			if err != nil {
+				return nil, errs.NewWithCause(element.GetFile(), err)
+			}
+			readers = append(readers, bytes.NewReader(data))
+		}
+	}
+	return io.MultiReader(readers...), nil
+}
+
+// Bytes returns the contents of the post data.
+func (d *PostData) Bytes() ([]byte, error) {
+	r, err := d.Reader()
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return data, nil
+}
+
+// Values parses the contents of the post data as URL-encoded form values.
+func (d *PostData) Values() (url.Values, error) {
+	data, err := d.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	values, err := url.ParseQuery(string(data))
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return values, nil
+}
+
+// Bytes returns a copy of the bytes held by the element. Returns nil if the
+// element does not hold bytes.
+func (d *PostDataElement) Bytes() []byte {
+	size := d.GetBytesCount()
+	if size == 0 {
+		return nil
+	}
+	data := make([]byte, size)
+	return data[:d.GetBytes(size, unsafe.Pointer(&data[0]))]
+}
+
+// SetBytes sets the element to hold a copy of the data.
+func (d *PostDataElement) SetBytes(data []byte) {
+	if len(data) == 0 {
+		d.SetToEmpty()
+	} else {
+		d.SetToBytes(uint64(len(data)), unsafe.Pointer(&data[0]))
+	}
+}
+
+// headerFromMap converts a map of header values into an http.Header,
+// canonicalizing the keys so that lookups work regardless of the case CEF
+// reported them in.
+func headerFromMap(m map[string][]string) http.Header {
+	header := make(http.Header, len(m))
+	for k, values := range m {
+		for _, v := range values {
+			header.Add(k, v)
+		}
+	}
+	return header
+}
@@ -0,0 +1,206 @@
+package cef
+
+import (
+	"bytes"
+	"context"
+	"mime"
+	"net/http"
+	"strconv"
+	"sync"
+	"unsafe"
+
+	"github.com/richardwilkes/toolbox/errs"
+	"github.com/richardwilkes/toolbox/log/jot"
+	"github.com/richardwilkes/toolbox/xio"
+)
+
+// NewResourceHandlerFromHTTP creates a new ResourceHandler that responds to
+// the request by running the specified http.Handler. The handler is run on
+// its own goroutine, so it is free to block. Its response is buffered in full
+// before being passed back to CEF, so the handler is not suited to streaming
+// or very large responses. Redirect responses with a Location header cause CEF
+// to follow the redirect.
+func NewResourceHandlerFromHTTP(handler http.Handler) *ResourceHandler {
+	return NewResourceHandler(&httpResourceHandler{handler: handler})
+}
+
+// NewSchemeHandlerFactoryFromHTTP creates a new SchemeHandlerFactory that
+// responds to every request by running the specified http.Handler.
+func NewSchemeHandlerFactoryFromHTTP(handler http.Handler) *SchemeHandlerFactory {
+	return NewSchemeHandlerFactory(&httpSchemeHandlerFactory{handler: handler})
+}
+
+type httpSchemeHandlerFactory struct {
+	DefaultSchemeHandlerFactory
+	handler http.Handler
+}
+
+func (f *httpSchemeHandlerFactory) Create(self *SchemeHandlerFactory, browser *Browser, frame *Frame, schemeName string, request *Request) *ResourceHandler {
+	defer ReleaseAll(browser, frame, request)
+	return NewResourceHandlerFromHTTP(f.handler)
+}
+
+type httpResourceHandler struct {
+	DefaultResourceHandler
+	handler  http.Handler
+	head     bool
+	lock     sync.Mutex
+	cancel   context.CancelFunc
+	canceled bool
+	recorder *httpResponseRecorder
+	body     []byte
+}
+
+func (h *httpResourceHandler) ProcessRequest(self *ResourceHandler, request *Request, callback *Callback) bool {
+	defer ReleaseAll(request)
+	req, err := request.ToHTTP()
+	if err != nil {
+		jot.Error(errs.NewWithCause("unable to convert request", err))
+		ReleaseAll(callback)
+		return false
+	}
+	ctx, cancel := context.WithCancel(req.Context())
+	req = req.WithContext(ctx)
+	h.head = req.Method == http.MethodHead
+	h.lock.Lock()
+	h.cancel = cancel
+	h.lock.Unlock()
+	go h.serve(req, callback)
+	return true
+}
+
+func (h *httpResourceHandler) serve(req *http.Request, callback *Callback) {
+	rec := newHTTPResponseRecorder()
+	defer func() {
+		if r := recover(); r != nil {
+			jot.Error(errs.Newf("recovered from panic in http handler for %s: %v", req.URL, r))
+			rec = newHTTPResponseRecorder()
+			rec.WriteHeader(http.StatusInternalServerError)
+		}
+		if req.Body != nil {
+			xio.CloseIgnoringErrors(req.Body)
+		}
+		rec.finish()
+		h.lock.Lock()
+		defer h.lock.Unlock()
+		h.recorder = rec
+		h.body = rec.body.Bytes()
+		if !h.canceled {
+			callback.Cont()
+		}
+		ReleaseAll(callback)
+	}()
+	h.handler.ServeHTTP(rec, req)
+}
+
+func (h *httpResourceHandler) GetResponseHeaders(self *ResourceHandler, response *Response, response_length *int64, redirectUrl *string) {
+	defer ReleaseAll(response)
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	rec := h.recorder
+	if rec == nil {
+		response.SetStatus(http.StatusInternalServerError)
+		*response_length = 0
+		return
+	}
+	status := rec.status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	response.SetStatus(int32(status))
+	response.SetStatusText(http.StatusText(status))
+	if mediaType, _, err := mime.ParseMediaType(rec.header.Get("Content-Type")); err == nil {
+		response.SetMimeType(mediaType)
+	}
+	header := rec.header
+	if header.Get("Content-Length") == "" {
+		header = cloneHeader(header)
+		header.Set("Content-Length", strconv.Itoa(len(h.body)))
+	}
+	response.SetHeader(header)
+	if location := header.Get("Location"); location != "" && status >= 300 && status < 400 && status != http.StatusNotModified {
+		*redirectUrl = location
+	}
+	if h.head {
+		// The Content-Length header still reports the length of the body
+		// that a GET would have produced, as net/http does.
+		h.body = nil
+	}
+	*response_length = int64(len(h.body))
+}
+
+func (h *httpResourceHandler) ReadResponse(self *ResourceHandler, data_out unsafe.Pointer, bytes_to_read int32, bytes_read *int32, callback *Callback) bool {
+	defer ReleaseAll(callback)
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	n := copy((*[1<<30 - 1]byte)(data_out)[:bytes_to_read:bytes_to_read], h.body)
+	*bytes_read = int32(n)
+	h.body = h.body[n:]
+	return n != 0
+}
+
+func (h *httpResourceHandler) CanGetCookie(self *ResourceHandler, cookie *Cookie) bool {
+	return true
+}
+
+func (h *httpResourceHandler) CanSetCookie(self *ResourceHandler, cookie *Cookie) bool {
+	return true
+}
+
+func (h *httpResourceHandler) Cancel(self *ResourceHandler) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	h.canceled = true
+	if h.cancel != nil {
+		h.cancel()
+	}
+}
+
+type httpResponseRecorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func newHTTPResponseRecorder() *httpResponseRecorder {
+	return &httpResponseRecorder{header: make(http.Header)}
+}
+
+func (r *httpResponseRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *httpResponseRecorder) Write(data []byte) (int, error) {
+	if r.status == 0 {
+		r.WriteHeader(http.StatusOK)
+	}
+	return r.body.Write(data)
+}
+
+func (r *httpResponseRecorder) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+}
+
+// finish fills in the Content-Type header, if the handler did not set one, by
+// sniffing the body, as net/http does.
+func (r *httpResponseRecorder) finish() {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	if r.status == http.StatusNoContent || r.status == http.StatusNotModified || (r.status >= 100 && r.status < 200) {
+		return
+	}
+	if _, exists := r.header["Content-Type"]; !exists {
+		r.header.Set("Content-Type", http.DetectContentType(r.body.Bytes()))
+	}
+}
+
+func cloneHeader(header http.Header) http.Header {
+	other := make(http.Header, len(header))
+	for k, v := range header {
+		other[k] = append([]string(nil), v...)
+	}
+	return other
+}
@@ -0,0 +1,208 @@
+package cef
+
+import (
+	"image"
+	"image/draw"
+	"sync"
+	"unsafe"
+)
+
+// OffscreenRenderer is a RenderHandlerProxy for windowless browsers that
+// composites the painted view and any popup, such as an open <select>
+// dropdown, into an *image.RGBA. Pass it to NewRenderHandler() and return the
+// result from ClientProxy.GetRenderHandler. Only the dirty portions of each
+// paint are copied into the persistent backbuffers.
+type OffscreenRenderer struct {
+	DefaultRenderHandler
+	lock      sync.Mutex
+	width     int
+	height    int
+	scale     float32
+	view      *image.RGBA
+	popup     *image.RGBA
+	popupRect Rect
+	popupShow bool
+	frames    chan image.Image
+}
+
+// NewOffscreenRenderer creates a new OffscreenRenderer with a view of the
+// specified size in logical pixels. scale is the device scale factor; values
+// less than or equal to zero are treated as 1.
+func NewOffscreenRenderer(width, height int, scale float32) *OffscreenRenderer {
+	if scale <= 0 {
+		scale = 1
+	}
+	return &OffscreenRenderer{
+		width:  width,
+		height: height,
+		scale:  scale,
+	}
+}
+
+// Resize changes the size of the view in logical pixels. Call
+// BrowserHost.WasResized() afterwards so that the browser repaints at the new
+// size.
+func (r *OffscreenRenderer) Resize(width, height int) {
+	r.lock.Lock()
+	r.width = width
+	r.height = height
+	r.lock.Unlock()
+}
+
+// Frames returns a channel that receives a new composited frame after each
+// paint. The channel holds only the most recent frame; if it has not been
+// received by the time the next frame is ready, it is replaced. Frames are
+// only produced once this has been called.
+func (r *OffscreenRenderer) Frames() <-chan image.Image {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.frames == nil {
+		r.frames = make(chan image.Image, 1)
+	}
+	return r.frames
+}
+
+// Snapshot returns a copy of the current composited view, or nil if nothing
+// has been painted yet.
+func (r *OffscreenRenderer) Snapshot() *image.RGBA {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.composite()
+}
+
+// GetViewRect implements RenderHandlerGetViewRectProxy.
+func (r *OffscreenRenderer) GetViewRect(self *RenderHandler, browser *Browser, rect *Rect) {
+	defer ReleaseAll(browser)
+	r.lock.Lock()
+	*rect = r.viewRect()
+	r.lock.Unlock()
+}
+
+// GetScreenInfo implements RenderHandlerGetScreenInfoProxy.
+func (r *OffscreenRenderer) GetScreenInfo(self *RenderHandler, browser *Browser, screenInfo *ScreenInfo) bool {
+	defer ReleaseAll(browser)
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	screenInfo.DeviceScaleFactor = r.scale
+	screenInfo.Depth = 32
+	screenInfo.DepthPerComponent = 8
+	screenInfo.Rect = r.viewRect()
+	screenInfo.AvailableRect = screenInfo.Rect
+	return true
+}
+
+func (r *OffscreenRenderer) viewRect() Rect {
+	width := r.width
+	if width < 1 {
+		width = 1
+	}
+	height := r.height
+	if height < 1 {
+		height = 1
+	}
+	return Rect{Width: int32(width), Height: int32(height)}
+}
+
+// OnPopupShow implements RenderHandlerOnPopupShowProxy.
+func (r *OffscreenRenderer) OnPopupShow(self *RenderHandler, browser *Browser, show bool) {
+	defer ReleaseAll(browser)
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.popupShow = show
+	if !show {
+		r.popup = nil
+		r.popupRect = Rect{}
+		r.publish()
+	}
+}
+
+// OnPopupSize implements RenderHandlerOnPopupSizeProxy.
+func (r *OffscreenRenderer) OnPopupSize(self *RenderHandler, browser *Browser, rect *Rect) {
+	defer ReleaseAll(browser)
+	r.lock.Lock()
+	r.popupRect = *rect
+	r.lock.Unlock()
+}
+
+// OnPaint implements RenderHandlerOnPaintProxy.
+func (r *OffscreenRenderer) OnPaint(self *RenderHandler, browser *Browser, type_r PaintElementType, dirtyRects []Rect, buffer unsafe.Pointer, width, height int32) {
+	defer ReleaseAll(browser)
+	if width <= 0 || height <= 0 {
+		return
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	var target **image.RGBA
+	switch type_r {
+	case PetView:
+		target = &r.view
+	case PetPopup:
+		if !r.popupShow {
+			return
+		}
+		target = &r.popup
+	default:
+		return
+	}
+	bounds := image.Rect(0, 0, int(width), int(height))
+	if *target == nil || (*target).Rect != bounds {
+		// The size changed, so the whole buffer needs to be copied.
+		*target = image.NewRGBA(bounds)
+		dirtyRects = []Rect{{Width: width, Height: height}}
+	}
+	size := int(width) * int(height) * 4
+	src := (*[1<<30 - 1]byte)(buffer)[:size:size]
+	for _, dirty := range dirtyRects {
+		copyBGRA(*target, src, int(width), image.Rect(int(dirty.X), int(dirty.Y), int(dirty.X+dirty.Width), int(dirty.Y+dirty.Height)).Intersect(bounds))
+	}
+	r.publish()
+}
+
+// copyBGRA copies the area of the BGRA src buffer into dst, which must be the
+// same size, converting it to RGBA.
+func copyBGRA(dst *image.RGBA, src []byte, width int, area image.Rectangle) {
+	for y := area.Min.Y; y < area.Max.Y; y++ {
+		s := src[(y*width+area.Min.X)*4 : (y*width+area.Max.X)*4]
+		d := dst.Pix[dst.PixOffset(area.Min.X, y):]
+		for i := 0; i < len(s); i += 4 {
+			d[i] = s[i+2]
+			d[i+1] = s[i+1]
+			d[i+2] = s[i]
+			d[i+3] = s[i+3]
+		}
+	}
+}
+
+// composite must be called with the lock held.
+func (r *OffscreenRenderer) composite() *image.RGBA {
+	if r.view == nil {
+		return nil
+	}
+	frame := image.NewRGBA(r.view.Rect)
+	copy(frame.Pix, r.view.Pix)
+	if r.popupShow && r.popup != nil {
+		// The popup rect is in logical pixels, while the buffers are in
+		// device pixels.
+		at := image.Pt(int(float32(r.popupRect.X)*r.scale), int(float32(r.popupRect.Y)*r.scale))
+		draw.Draw(frame, r.popup.Rect.Add(at), r.popup, image.Point{}, draw.Src)
+	}
+	return frame
+}
+
+// publish must be called with the lock held.
+func (r *OffscreenRenderer) publish() {
+	if r.frames == nil {
+		return
+	}
+	frame := r.composite()
+	if frame == nil {
+		return
+	}
+	// Only this function sends on the channel and it is always called with
+	// the lock held, so after discarding a stale frame there is room.
+	select {
+	case <-r.frames:
+	default:
+	}
+	r.frames <- frame
+}
@@ -0,0 +1,117 @@
+package cef
+
+import (
+	"reflect"
+	"runtime"
+	"sync/atomic"
+)
+
+// RefCounted is implemented by every CEF object type.
+//
+// CEF objects are reference counted, following the conventions of CEF's C
+// API. Methods and functions that return a CEF object add a reference on
+// behalf of the caller, which the caller then owns and must eventually release
+// via Base().Release(); the generated code notes each of these. Likewise, each
+// object passed as an argument to a proxy, other than self, arrives with a
+// reference added on the proxy's behalf, which the proxy owns. In the other
+// direction, passing an object as an argument to a CEF method or function
+// hands one of the caller's references over to CEF. The hand-written helpers
+// in this package, such as the proxies they install and the conversions they
+// provide, follow these conventions themselves. Beyond that, none of this is
+// managed for you: release references directly, several at a time with
+// ReleaseAll(), or, to opt in to having the Go garbage collector release them,
+// wrap the object with Adopt() or Retain().
+type RefCounted interface {
+	Base() *BaseRefCounted
+}
+
+// Ref is an owned reference to a CEF object. If the Ref becomes unreachable
+// without Release() having been called, the reference is released by a
+// finalizer on the CEF thread the Ref was created on.
+type Ref struct {
+	obj      RefCounted
+	thread   ThreadID
+	onThread bool
+	released int32
+}
+
+// refThreads are the CEF threads whose objects must be released on the same
+// thread they were obtained on.
+var refThreads = []ThreadID{TIDUI, TIDIO, TIDRenderer}
+
+// Adopt takes over the reference that the caller owns to obj, such as the one
+// added to an object returned by a CEF method or passed as an argument to a
+// proxy, and returns a Ref managing it. Returns nil if obj is nil.
+func Adopt(obj RefCounted) *Ref {
+	if obj == nil || reflect.ValueOf(obj).IsNil() {
+		return nil
+	}
+	r := &Ref{obj: obj}
+	for _, thread := range refThreads {
+		if CurrentlyOn(thread) {
+			r.thread = thread
+			r.onThread = true
+			break
+		}
+	}
+	runtime.SetFinalizer(r, (*Ref).finalize)
+	return r
+}
+
+// Retain adds a reference to obj and returns a Ref managing it. Use it when the
+// caller's own reference is to be kept elsewhere, such as before handing it
+// over to CEF by passing obj to a CEF method. Returns nil if obj is nil.
+func Retain(obj RefCounted) *Ref {
+	if obj == nil || reflect.ValueOf(obj).IsNil() {
+		return nil
+	}
+	obj.Base().AddRef()
+	return Adopt(obj)
+}
+
+// ReleaseAll releases the caller's reference to each of the objects that is
+// not nil. It is convenient for releasing the arguments passed to a proxy,
+// e.g. defer ReleaseAll(browser, frame).
+func ReleaseAll(objs ...RefCounted) {
+	for _, obj := range objs {
+		if obj != nil && !reflect.ValueOf(obj).IsNil() {
+			obj.Base().Release()
+		}
+	}
+}
+
+// Object returns the object. The result must be type asserted back to the
+// original type, e.g. ref.Object().(*Frame). The object must not be used once
+// Release() has been called.
+func (r *Ref) Object() RefCounted {
+	return r.obj
+}
+
+// Retain adds another reference to the object and returns a new Ref managing
+// it.
+func (r *Ref) Retain() *Ref {
+	return Retain(r.obj)
+}
+
+// Release releases the reference immediately, on the calling thread. Calling
+// it more than once has no effect.
+func (r *Ref) Release() {
+	if atomic.CompareAndSwapInt32(&r.released, 0, 1) {
+		runtime.SetFinalizer(r, nil)
+		r.obj.Base().Release()
+	}
+}
+
+func (r *Ref) finalize() {
+	if !atomic.CompareAndSwapInt32(&r.released, 0, 1) {
+		return
+	}
+	base := r.obj.Base()
+	if r.onThread {
+		// If the task can't be posted, CEF has shut down and the reference
+		// no longer matters.
+		RunOn(r.thread, func() { base.Release() })
+	} else {
+		base.Release()
+	}
+}
@@ -0,0 +1,88 @@
+package cef
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/richardwilkes/toolbox/errs"
+	"github.com/richardwilkes/toolbox/log/jot"
+)
+
+var (
+	// ErrProxyNotFound is the cause of a ProxyError when no proxy is
+	// registered for the object receiving the callback. This usually happens
+	// when CEF calls into an object that is being torn down.
+	ErrProxyNotFound = errors.New("proxy not found")
+	// ErrProxyWrongType is the cause of a ProxyError when the proxy
+	// registered for the object receiving the callback is not of the expected
+	// type.
+	ErrProxyWrongType = errors.New("proxy has the wrong type")
+)
+
+var (
+	proxyErrorLock    sync.Mutex
+	proxyErrorHandler = defaultProxyErrorHandler
+)
+
+// ProxyError is reported when a callback could not be delivered to its proxy,
+// or when the proxy panicked while handling it.
+type ProxyError struct {
+	// Type is the name of the type the callback belongs to, e.g.
+	// "LoadHandler".
+	Type string
+	// Callback is the name of the callback, e.g. "OnLoadEnd".
+	Callback string
+	// Err is ErrProxyNotFound, ErrProxyWrongType or, if the proxy panicked,
+	// an error describing the panic.
+	Err error
+	// Recovered holds the value passed to panic() when the proxy panicked.
+	Recovered interface{}
+}
+
+func (e *ProxyError) Error() string {
+	return fmt.Sprintf("%s.%s: %v", e.Type, e.Callback, e.Err)
+}
+
+// Unwrap returns the underlying cause.
+func (e *ProxyError) Unwrap() error {
+	return e.Err
+}
+
+// SetProxyErrorHandler sets the function that will be called when a callback
+// cannot be delivered to its proxy or its proxy panics. In either case, a
+// zero value is returned to CEF, which generally results in CEF's default
+// behavior. Passing in nil restores the default handler, which logs the
+// error. The handler may be called from any thread.
+func SetProxyErrorHandler(handler func(err *ProxyError)) {
+	if handler == nil {
+		handler = defaultProxyErrorHandler
+	}
+	proxyErrorLock.Lock()
+	proxyErrorHandler = handler
+	proxyErrorLock.Unlock()
+}
+
+func defaultProxyErrorHandler(err *ProxyError) {
+	jot.Error(err)
+}
+
+func reportProxyError(typeName, callback string, cause error, recovered interface{}) {
+	proxyErrorLock.Lock()
+	handler := proxyErrorHandler
+	proxyErrorLock.Unlock()
+	handler(&ProxyError{
+		Type:      typeName,
+		Callback:  callback,
+		Err:       cause,
+		Recovered: recovered,
+	})
+}
+
+// recoverProxyPanic must be deferred directly by the cgo trampolines so that
+// a panic within a proxy does not unwind into CEF.
+func recoverProxyPanic(typeName, callback string) {
+	if r := recover(); r != nil {
+		reportProxyError(typeName, callback, errs.Newf("recovered from panic: %v", r), r)
+	}
+}
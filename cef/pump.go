@@ -0,0 +1,151 @@
+package cef
+
+import (
+	"runtime"
+	"sync"
+	"time"
+)
+
+// MaxMessagePumpDelay is the longest a MessagePump will wait between calls
+// to DoMessageLoopWork(), regardless of the delay CEF requests. This matches
+// the behavior of CEF's own external pump implementation and ensures that
+// work CEF did not explicitly schedule still gets done.
+const MaxMessagePumpDelay = time.Second / 30
+
+// Pump is implemented by schedulers that tell an external event loop when to
+// call into CEF.
+type Pump interface {
+	// Wakeups returns a channel that receives a value each time Work()
+	// should be called. Multiple requests made before the value is received
+	// are coalesced into one.
+	Wakeups() <-chan struct{}
+	// Work performs any pending CEF work. It must be called on the thread
+	// that initialized CEF.
+	Work()
+}
+
+// MessagePump is a BrowserProcessHandlerProxy that schedules CEF's work when
+// CEF is initialized with Settings.ExternalMessagePump set to true, allowing
+// CEF to share the main thread with another event loop. Pass it to
+// NewBrowserProcessHandler() and return the result from
+// AppProxy.GetBrowserProcessHandler. To handle other browser process
+// callbacks as well, embed it in another proxy.
+type MessagePump struct {
+	DefaultBrowserProcessHandler
+	wakeups   chan struct{}
+	lock      sync.Mutex
+	timer     *time.Timer
+	due       time.Time
+	working   bool
+	reentered bool
+}
+
+// NewMessagePump creates a new MessagePump.
+func NewMessagePump() *MessagePump {
+	return &MessagePump{wakeups: make(chan struct{}, 1)}
+}
+
+// Wakeups implements Pump.
+func (p *MessagePump) Wakeups() <-chan struct{} {
+	return p.wakeups
+}
+
+// Work implements Pump. Calls made while a previous call is still running,
+// which can happen if DoMessageLoopWork() runs a nested event loop, are
+// deferred until that call returns.
+func (p *MessagePump) Work() {
+	p.lock.Lock()
+	if p.working {
+		p.reentered = true
+		p.lock.Unlock()
+		return
+	}
+	p.working = true
+	p.stopTimer()
+	p.lock.Unlock()
+	DoMessageLoopWork()
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.working = false
+	if p.reentered {
+		p.reentered = false
+		p.schedule(0)
+	} else if p.timer == nil {
+		p.schedule(MaxMessagePumpDelay)
+	}
+}
+
+// OnScheduleMessagePumpWork implements
+// BrowserProcessHandlerOnScheduleMessagePumpWorkProxy. It may be called on
+// any thread.
+func (p *MessagePump) OnScheduleMessagePumpWork(self *BrowserProcessHandler, delay_ms int64) {
+	p.lock.Lock()
+	p.schedule(time.Duration(delay_ms) * time.Millisecond)
+	p.lock.Unlock()
+}
+
+// schedule must be called with the lock held.
+func (p *MessagePump) schedule(delay time.Duration) {
+	if delay <= 0 {
+		p.stopTimer()
+		p.wake()
+		return
+	}
+	if delay > MaxMessagePumpDelay {
+		delay = MaxMessagePumpDelay
+	}
+	due := time.Now().Add(delay)
+	if p.timer != nil {
+		if !p.due.After(due) {
+			// Already scheduled to wake up at or before the requested time.
+			return
+		}
+		p.stopTimer()
+	}
+	p.due = due
+	var timer *time.Timer
+	timer = time.AfterFunc(delay, func() {
+		p.lock.Lock()
+		if p.timer == timer {
+			p.timer = nil
+			p.wake()
+		}
+		p.lock.Unlock()
+	})
+	p.timer = timer
+}
+
+// stopTimer must be called with the lock held.
+func (p *MessagePump) stopTimer() {
+	if p.timer != nil {
+		p.timer.Stop()
+		p.timer = nil
+	}
+}
+
+func (p *MessagePump) wake() {
+	select {
+	case p.wakeups <- struct{}{}:
+	default:
+	}
+}
+
+// RunPump locks the calling goroutine to its OS thread and calls p.Work()
+// each time p signals a wakeup, returning once quit is closed. It is meant to
+// be called on the thread that initialized CEF, for example as
+// RunOptions.Loop, in place of RunMessageLoop(). Event loops that already own
+// the main thread should instead select on p.Wakeups() and call p.Work()
+// themselves.
+func RunPump(p Pump, quit <-chan struct{}) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	wakeups := p.Wakeups()
+	for {
+		select {
+		case <-quit:
+			return
+		case <-wakeups:
+			p.Work()
+		}
+	}
+}
@@ -3,24 +3,155 @@ package cef
 import (
 	// #include "refcnt.h"
 	"C"
+	"fmt"
+	"runtime"
+	"sort"
+	"strings"
 	"sync"
+
+	"github.com/richardwilkes/toolbox/errs"
 )
 
 var (
-	refLock sync.Mutex
-	refMap  = make(map[C.uint32_t]interface{})
+	refLock     sync.Mutex
+	refMap      = make(map[C.uint32_t]interface{})
+	refTracking bool
+	refAllocs   = make(map[C.uint32_t]*refAlloc)
 )
 
+type refAlloc struct {
+	typeName string
+	stack    string
+}
+
+// LiveObject describes a Go-allocated CEF object that has not yet been
+// released by CEF.
+type LiveObject struct {
+	// ID is the object's unique identifier.
+	ID uint32
+	// Type is the name of the CEF type, e.g. "LoadHandler". Only available
+	// when ref tracking was enabled at the time the object was created.
+	Type string
+	// Proxy is the Go type of the proxy the object was created with.
+	Proxy string
+	// Stack is the stack trace of the call that created the object. Only
+	// available when ref tracking was enabled at the time the object was
+	// created.
+	Stack string
+}
+
+// SetRefTracking controls whether the type and allocation stack trace of
+// each Go-allocated CEF object are recorded for use by LiveObjects() and
+// CheckLeaks(). Recording a stack trace for every object is expensive, so
+// this is intended for debugging and defaults to false. Objects created while
+// tracking is disabled are still reported, but without those details.
+func SetRefTracking(enabled bool) {
+	refLock.Lock()
+	refTracking = enabled
+	refLock.Unlock()
+}
+
 func newRefCntObj(size uint, proxy interface{}) *BaseRefCounted {
 	cobj := C.gocef_refcnt_alloc(C.size_t(size))
 	id := C.gocef_refcnt_id(cobj)
 	obj := (*BaseRefCounted)(cobj)
 	refLock.Lock()
 	refMap[id] = proxy
+	if refTracking {
+		refAllocs[id] = newRefAlloc()
+	}
 	refLock.Unlock()
 	return obj
 }
 
+func newRefAlloc() *refAlloc {
+	// Skip runtime.Callers, newRefAlloc and newRefCntObj, leaving the
+	// New<Type>() function that created the object at the top.
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs)])
+	alloc := &refAlloc{}
+	var buffer strings.Builder
+	for {
+		frame, more := frames.Next()
+		if alloc.typeName == "" {
+			name := frame.Function
+			if i := strings.LastIndex(name, "."); i != -1 {
+				name = name[i+1:]
+			}
+			alloc.typeName = strings.TrimPrefix(name, "New")
+		}
+		fmt.Fprintf(&buffer, "%s\n\t%s:%d\n", frame.Function, frame.File, frame.Line)
+		if !more {
+			break
+		}
+	}
+	alloc.stack = buffer.String()
+	return alloc
+}
+
+// LiveObjects returns the Go-allocated CEF objects that have not yet been
+// released by CEF, grouped by type. If the type is not known because ref
+// tracking was disabled when the object was created, the proxy's type is used
+// instead.
+func LiveObjects() map[string][]LiveObject {
+	refLock.Lock()
+	defer refLock.Unlock()
+	objs := make(map[string][]LiveObject)
+	for id, proxy := range refMap {
+		obj := LiveObject{
+			ID:    uint32(id),
+			Proxy: fmt.Sprintf("%T", proxy),
+		}
+		if alloc, exists := refAllocs[id]; exists {
+			obj.Type = alloc.typeName
+			obj.Stack = alloc.stack
+		}
+		key := obj.Type
+		if key == "" {
+			key = obj.Proxy
+		}
+		objs[key] = append(objs[key], obj)
+	}
+	for _, list := range objs {
+		sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+	}
+	return objs
+}
+
+// CheckLeaks returns an error describing the Go-allocated CEF objects that
+// have not been released, or nil if there are none. Call it after Shutdown()
+// to find objects that leaked. For each type, the allocation stack trace of
+// the oldest surviving object is included when available.
+func CheckLeaks() error {
+	objs := LiveObjects()
+	if len(objs) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(objs))
+	total := 0
+	for key, list := range objs {
+		keys = append(keys, key)
+		total += len(list)
+	}
+	sort.Strings(keys)
+	var buffer strings.Builder
+	fmt.Fprintf(&buffer, "%d CEF object(s) were not released:", total)
+	for _, key := range keys {
+		list := objs[key]
+		fmt.Fprintf(&buffer, "\n%d x %s (proxy %s)", len(list), key, list[0].Proxy)
+		if list[0].Stack != "" {
+			fmt.Fprintf(&buffer, ", first allocated at:\n%s", strings.TrimRight(list[0].Stack, "\n"))
+		}
+	}
+	return errs.New(buffer.String())
+}
+
+// refReleaser may be implemented by a proxy that needs to know when the last
+// reference to its object has been released.
+type refReleaser interface {
+	refReleased()
+}
+
 func lookupProxy(base *BaseRefCounted) (proxy interface{}, exists bool) {
 	id := C.gocef_refcnt_id(base.toNative())
 	refLock.Lock()
@@ -32,6 +163,11 @@ func lookupProxy(base *BaseRefCounted) (proxy interface{}, exists bool) {
 //export freeObjByID
 func freeObjByID(cid C.uint32_t) {
 	refLock.Lock()
+	proxy := refMap[cid]
 	delete(refMap, cid)
+	delete(refAllocs, cid)
 	refLock.Unlock()
+	if releaser, ok := proxy.(refReleaser); ok {
+		releaser.refReleased()
+	}
 }
@@ -0,0 +1,362 @@
+package cef
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"sync"
+	"unsafe"
+
+	"github.com/richardwilkes/toolbox/errs"
+	"github.com/richardwilkes/toolbox/xio"
+)
+
+// ResponseTransform transforms the content read from r and writes the result
+// to w.
+type ResponseTransform func(r io.Reader, w io.Writer) error
+
+// NewStreamingResponseFilter creates a new ResponseFilter that passes the
+// response content through the transform as it arrives. The transform runs on
+// its own goroutine, in lockstep with CEF: each chunk of content is handed to
+// it and the filter waits until it has consumed the chunk and is waiting for
+// more before passing its output along, so the transform may process
+// arbitrarily large responses.
+//
+// CEF only tells a filter that the response is complete if the filter's
+// previous output filled CEF's buffer, so the transform should write its
+// output as soon as it can rather than holding it until it reaches the end of
+// its input. Output that is held back is passed along only if CEF does make
+// that final call. The transforms provided here only hold back data while more
+// input is already waiting to be read, so a match that spans two of CEF's
+// chunks is not found. If the transform returns without consuming all of its
+// input, the remaining input is discarded. If it returns an error, the
+// response fails.
+func NewStreamingResponseFilter(transform ResponseTransform) *ResponseFilter {
+	return NewResponseFilter(newStreamingResponseFilter(transform))
+}
+
+type streamingResponseFilter struct {
+	DefaultResponseFilter
+	transform ResponseTransform
+	lock      sync.Mutex
+	cond      *sync.Cond
+	in        bytes.Buffer
+	out       bytes.Buffer
+	started   bool
+	waiting   bool
+	inClosed  bool
+	done      bool
+	err       error
+}
+
+func newStreamingResponseFilter(transform ResponseTransform) *streamingResponseFilter {
+	f := &streamingResponseFilter{transform: transform}
+	f.cond = sync.NewCond(&f.lock)
+	return f
+}
+
+func (f *streamingResponseFilter) InitFilter(self *ResponseFilter) bool {
+	return true
+}
+
+func (f *streamingResponseFilter) Filter(self *ResponseFilter, data_in unsafe.Pointer, data_in_size uint64, data_in_read *uint64, data_out unsafe.Pointer, data_out_size uint64, data_out_written *uint64) ResponseFilterStatus {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if !f.started {
+		f.started = true
+		go f.run()
+	}
+	// The input is always consumed in full, as CEF requires whenever no
+	// output is written.
+	*data_in_read = data_in_size
+	*data_out_written = 0
+	switch {
+	case data_in_size == 0:
+		// CEF only calls with no input once the response is complete and
+		// the previous call filled the output buffer.
+		f.closeInput()
+	case !f.done:
+		f.in.Write((*[1<<30 - 1]byte)(data_in)[:data_in_size:data_in_size])
+		f.cond.Broadcast()
+	}
+	for !f.done && (f.inClosed || f.in.Len() != 0 || !f.waiting) {
+		f.cond.Wait()
+	}
+	if f.out.Len() != 0 && data_out_size != 0 {
+		n, _ := f.out.Read((*[1<<30 - 1]byte)(data_out)[:data_out_size:data_out_size])
+		*data_out_written = uint64(n)
+	}
+	switch {
+	case f.out.Len() != 0:
+		// The output buffer is full, so CEF will call again for the rest.
+		return ResponseFilterNeedMoreData
+	case f.err != nil:
+		return ResponseFilterError
+	default:
+		return ResponseFilterDone
+	}
+}
+
+func (f *streamingResponseFilter) refReleased() {
+	// Let the transform see the end of its input, so that its goroutine
+	// exits even if CEF never made a final call.
+	f.lock.Lock()
+	f.closeInput()
+	f.lock.Unlock()
+}
+
+// closeInput must be called with the lock held.
+func (f *streamingResponseFilter) closeInput() {
+	if !f.inClosed {
+		f.inClosed = true
+		f.cond.Broadcast()
+	}
+}
+
+func (f *streamingResponseFilter) run() {
+	err := f.runTransform()
+	f.lock.Lock()
+	f.done = true
+	f.err = err
+	f.in.Reset()
+	f.cond.Broadcast()
+	f.lock.Unlock()
+}
+
+func (f *streamingResponseFilter) runTransform() (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errs.Newf("recovered from panic in response transform: %v", r)
+		}
+	}()
+	return f.transform(&streamingFilterReader{f: f}, &streamingFilterWriter{f: f})
+}
+
+type streamingFilterReader struct {
+	f *streamingResponseFilter
+}
+
+func (r *streamingFilterReader) Read(p []byte) (int, error) {
+	f := r.f
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	for f.in.Len() == 0 && !f.inClosed {
+		// Let Filter() know the transform has caught up with its input.
+		f.waiting = true
+		f.cond.Broadcast()
+		f.cond.Wait()
+	}
+	f.waiting = false
+	if f.in.Len() == 0 {
+		return 0, io.EOF
+	}
+	n, _ := f.in.Read(p)
+	return n, nil
+}
+
+func (r *streamingFilterReader) inputPending() bool {
+	f := r.f
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.in.Len() != 0 || f.inClosed
+}
+
+type streamingFilterWriter struct {
+	f *streamingResponseFilter
+}
+
+func (w *streamingFilterWriter) Write(p []byte) (int, error) {
+	f := w.f
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.out.Write(p)
+}
+
+// ReplaceTransform returns a ResponseTransform that replaces all occurrences
+// of old with replacement.
+func ReplaceTransform(old, replacement string) ResponseTransform {
+	with := []byte(replacement)
+	return func(r io.Reader, w io.Writer) error {
+		return streamReplace(r, w, old, false, false, func(match []byte) []byte {
+			return with
+		})
+	}
+}
+
+// InjectBeforeHeadTransform returns a ResponseTransform that inserts html
+// immediately before the first </head> tag. The content is passed through
+// unchanged if it has no </head> tag.
+func InjectBeforeHeadTransform(html string) ResponseTransform {
+	return func(r io.Reader, w io.Writer) error {
+		return streamReplace(r, w, "</head>", true, true, func(match []byte) []byte {
+			return append([]byte(html), match...)
+		})
+	}
+}
+
+// GzipAwareTransform returns a ResponseTransform that runs transform on the
+// decompressed content when the content is gzip-compressed, then compresses
+// the result again. Other content is passed to transform as-is.
+func GzipAwareTransform(transform ResponseTransform) ResponseTransform {
+	return func(r io.Reader, w io.Writer) error {
+		br := bufio.NewReader(r)
+		if magic, err := br.Peek(2); err != nil || magic[0] != 0x1f || magic[1] != 0x8b {
+			return transform(&sniffedReader{Reader: br, r: r}, w)
+		}
+		zr, err := gzip.NewReader(br)
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		defer xio.CloseIgnoringErrors(zr)
+		// Stop at the end of the compressed data rather than waiting for the
+		// end of the input, which a filter may never see.
+		zr.Multistream(false)
+		zw := gzip.NewWriter(w)
+		if err = transform(zr, zw); err != nil {
+			return err
+		}
+		if err = zw.Close(); err != nil {
+			return errs.Wrap(err)
+		}
+		return nil
+	}
+}
+
+// pendingInputReader is implemented by readers that can tell whether a Read()
+// would return without having to wait for more input.
+type pendingInputReader interface {
+	inputPending() bool
+}
+
+// hasPendingInput returns true if a Read() from r is known to return without
+// waiting for more input, or if r can't tell.
+func hasPendingInput(r io.Reader) bool {
+	if pr, ok := r.(pendingInputReader); ok {
+		return pr.inputPending()
+	}
+	return true
+}
+
+// sniffedReader reads from a bufio.Reader that was used to sniff the start of
+// r, while still reporting whether r has input pending.
+type sniffedReader struct {
+	*bufio.Reader
+	r io.Reader
+}
+
+func (sr *sniffedReader) inputPending() bool {
+	return sr.Buffered() != 0 || hasPendingInput(sr.r)
+}
+
+// streamReplace copies r to w, replacing matches of target with the result of
+// calling replace. If fold is true, target must be lowercase ASCII and is
+// matched without regard to case. A trailing partial match is held back
+// between reads so that matches spanning reads are still found, but only while
+// r has more input pending, since a streaming filter may never see the end of
+// its input. All other data is written as soon as it is read. If once is true,
+// only the first match is replaced.
+func streamReplace(r io.Reader, w io.Writer, target string, fold, once bool, replace func(match []byte) []byte) error {
+	if target == "" {
+		if _, err := io.Copy(w, r); err != nil {
+			return errs.Wrap(err)
+		}
+		return nil
+	}
+	find := func(data []byte) int {
+		if fold {
+			return indexFoldASCII(data, target)
+		}
+		return bytes.Index(data, []byte(target))
+	}
+	size := len(target)
+	buffer := make([]byte, 32*1024)
+	var pending []byte
+	for {
+		n, rerr := r.Read(buffer)
+		pending = append(pending, buffer[:n]...)
+		for {
+			i := find(pending)
+			if i < 0 {
+				break
+			}
+			if _, err := w.Write(pending[:i]); err != nil {
+				return errs.Wrap(err)
+			}
+			if _, err := w.Write(replace(pending[i : i+size])); err != nil {
+				return errs.Wrap(err)
+			}
+			pending = pending[i+size:]
+			if once {
+				if _, err := w.Write(pending); err != nil {
+					return errs.Wrap(err)
+				}
+				switch rerr {
+				case nil:
+					if _, err := io.Copy(w, r); err != nil {
+						return errs.Wrap(err)
+					}
+				case io.EOF:
+				default:
+					return errs.Wrap(rerr)
+				}
+				return nil
+			}
+		}
+		if rerr != nil {
+			if _, err := w.Write(pending); err != nil {
+				return errs.Wrap(err)
+			}
+			if rerr == io.EOF {
+				return nil
+			}
+			return errs.Wrap(rerr)
+		}
+		keep := 0
+		if hasPendingInput(r) {
+			keep = partialMatchLen(pending, target, fold)
+		}
+		if len(pending) > keep {
+			if _, err := w.Write(pending[:len(pending)-keep]); err != nil {
+				return errs.Wrap(err)
+			}
+			pending = append(pending[:0], pending[len(pending)-keep:]...)
+		}
+	}
+}
+
+// partialMatchLen returns the length of the longest suffix of data that is a
+// proper prefix of target.
+func partialMatchLen(data []byte, target string, fold bool) int {
+	for n := len(target) - 1; n > 0; n-- {
+		if n > len(data) {
+			continue
+		}
+		suffix := data[len(data)-n:]
+		if (fold && indexFoldASCII(suffix, target[:n]) == 0) || (!fold && string(suffix) == target[:n]) {
+			return n
+		}
+	}
+	return 0
+}
+
+// indexFoldASCII returns the index of the first case-insensitive match of the
+// lowercase ASCII target within data, or -1 if there is none.
+func indexFoldASCII(data []byte, target string) int {
+	for i := 0; i+len(target) <= len(data); i++ {
+		j := 0
+		for ; j < len(target); j++ {
+			c := data[i+j]
+			if c >= 'A' && c <= 'Z' {
+				c += 'a' - 'A'
+			}
+			if c != target[j] {
+				break
+			}
+		}
+		if j == len(target) {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,172 @@
+package cef
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"unsafe"
+)
+
+// runFilter drives f the way CEF does: each chunk of input is offered until
+// it has been fully read, then, if the last call filled the output buffer and
+// asked for more, further calls are made with no input until it doesn't.
+func runFilter(t *testing.T, f *streamingResponseFilter, chunks [][]byte, outSize int) ([]byte, ResponseFilterStatus) {
+	t.Helper()
+	defer f.refReleased()
+	var result []byte
+	out := make([]byte, outSize)
+	status := ResponseFilterNeedMoreData
+	var written uint64
+	for _, chunk := range chunks {
+		for len(chunk) != 0 {
+			var read uint64
+			status = f.Filter(nil, unsafe.Pointer(&chunk[0]), uint64(len(chunk)), &read, unsafe.Pointer(&out[0]), uint64(outSize), &written)
+			if status == ResponseFilterError {
+				return result, status
+			}
+			if written == 0 && read != uint64(len(chunk)) {
+				t.Fatalf("read %d of %d input bytes without writing any output", read, len(chunk))
+			}
+			result = append(result, out[:written]...)
+			chunk = chunk[read:]
+		}
+	}
+	for status == ResponseFilterNeedMoreData && written == uint64(outSize) {
+		var read uint64
+		status = f.Filter(nil, nil, 0, &read, unsafe.Pointer(&out[0]), uint64(outSize), &written)
+		if status == ResponseFilterError {
+			return result, status
+		}
+		result = append(result, out[:written]...)
+	}
+	return result, status
+}
+
+func splitChunks(data []byte, size int) [][]byte {
+	var chunks [][]byte
+	for len(data) > size {
+		chunks = append(chunks, data[:size])
+		data = data[size:]
+	}
+	return append(chunks, data)
+}
+
+func TestStreamingResponseFilterPassThrough(t *testing.T) {
+	input := bytes.Repeat([]byte("0123456789abcdef"), 10000)
+	for _, outSize := range []int{7, 4096, 1 << 20} {
+		f := newStreamingResponseFilter(func(r io.Reader, w io.Writer) error {
+			_, err := io.Copy(w, r)
+			return err
+		})
+		result, status := runFilter(t, f, splitChunks(input, 32*1024), outSize)
+		if status == ResponseFilterError {
+			t.Fatalf("output size %d: unexpected error status", outSize)
+		}
+		if !bytes.Equal(result, input) {
+			t.Fatalf("output size %d: got %d bytes, expected %d", outSize, len(result), len(input))
+		}
+	}
+}
+
+func TestStreamingResponseFilterReplace(t *testing.T) {
+	input := strings.Repeat("the quick brown fox jumps over the lazy dog. ", 200) + "the end"
+	for _, chunkSize := range []int{1, 2, 5, 100, len(input)} {
+		// Matches spanning CEF's chunks are not found, since the filter can't
+		// tell whether another chunk is coming.
+		chunks := splitChunks([]byte(input), chunkSize)
+		var expected string
+		for _, chunk := range chunks {
+			expected += strings.Replace(string(chunk), "fox", "cat", -1)
+		}
+		f := newStreamingResponseFilter(ReplaceTransform("fox", "cat"))
+		result, _ := runFilter(t, f, chunks, 64)
+		if string(result) != expected {
+			t.Fatalf("chunk size %d: unexpected output %q", chunkSize, result)
+		}
+	}
+}
+
+func TestStreamingResponseFilterPartialMatchAtEnd(t *testing.T) {
+	for _, one := range []struct {
+		transform ResponseTransform
+		input     string
+		expected  string
+	}{
+		{ReplaceTransform("fox", "cat"), "hello fo", "hello fo"},
+		{ReplaceTransform("fox", "cat"), "a fox, a fo", "a cat, a fo"},
+		{InjectBeforeHeadTransform("<script></script>"), "<html></html><", "<html></html><"},
+		{InjectBeforeHeadTransform("<script></script>"), "<html><head></head></htm", "<html><head><script></script></head></htm"},
+	} {
+		f := newStreamingResponseFilter(one.transform)
+		result, _ := runFilter(t, f, [][]byte{[]byte(one.input)}, 1024)
+		if string(result) != one.expected {
+			t.Fatalf("%q: unexpected output %q", one.input, result)
+		}
+	}
+}
+
+func TestReplaceTransformAcrossReads(t *testing.T) {
+	input := "the quick brown fox jumps over the lazy fox"
+	var buffer bytes.Buffer
+	if err := ReplaceTransform("fox", "cat")(iotest.OneByteReader(strings.NewReader(input)), &buffer); err != nil {
+		t.Fatal(err)
+	}
+	if expected := strings.Replace(input, "fox", "cat", -1); buffer.String() != expected {
+		t.Fatalf("unexpected output %q", buffer.String())
+	}
+}
+
+func TestStreamingResponseFilterInjectBeforeHead(t *testing.T) {
+	input := "<html><HEAD><title>x</title></HEAD><body></head></body></html>"
+	expected := "<html><HEAD><title>x</title><script></script></HEAD><body></head></body></html>"
+	// Each chunking keeps the first </HEAD> within a single chunk.
+	for _, chunkSize := range []int{40, len(input)} {
+		f := newStreamingResponseFilter(InjectBeforeHeadTransform("<script></script>"))
+		result, _ := runFilter(t, f, splitChunks([]byte(input), chunkSize), 1024)
+		if string(result) != expected {
+			t.Fatalf("chunk size %d: unexpected output %q", chunkSize, result)
+		}
+	}
+}
+
+func TestStreamingResponseFilterGzip(t *testing.T) {
+	input := "<html><head></head><body>" + strings.Repeat("content ", 5000) + "</body></html>"
+	var compressed bytes.Buffer
+	zw := gzip.NewWriter(&compressed)
+	if _, err := zw.Write([]byte(input)); err != nil {
+		t.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	f := newStreamingResponseFilter(GzipAwareTransform(ReplaceTransform("content", "filtered")))
+	result, status := runFilter(t, f, splitChunks(compressed.Bytes(), 100), 256)
+	if status == ResponseFilterError {
+		t.Fatal("unexpected error status")
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(result))
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadAll(zr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := strings.Replace(input, "content", "filtered", -1); string(data) != expected {
+		t.Fatalf("got %d bytes, expected %d", len(data), len(expected))
+	}
+}
+
+func TestStreamingResponseFilterError(t *testing.T) {
+	f := newStreamingResponseFilter(func(r io.Reader, w io.Writer) error {
+		return errors.New("failed")
+	})
+	if _, status := runFilter(t, f, [][]byte{[]byte("data")}, 64); status != ResponseFilterError {
+		t.Fatalf("unexpected status %d", status)
+	}
+}
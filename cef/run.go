@@ -0,0 +1,120 @@
+package cef
+
+import (
+	"os"
+	"runtime"
+	"strings"
+
+	"github.com/richardwilkes/toolbox/atexit"
+	"github.com/richardwilkes/toolbox/errs"
+)
+
+// Process types, as returned by ProcessType().
+const (
+	ProcessTypeBrowser  = ""
+	ProcessTypeRenderer = "renderer"
+	ProcessTypeGPU      = "gpu-process"
+	ProcessTypeUtility  = "utility"
+	ProcessTypeZygote   = "zygote"
+)
+
+const processTypeArgPrefix = "--type="
+
+// RunOptions holds the options for Run().
+type RunOptions struct {
+	// App is passed to CEF in every process. May be nil.
+	App *App
+	// Settings are used to initialize CEF in the browser process. If nil,
+	// NewSettings() is used.
+	Settings *Settings
+	// CachePath overrides Settings.CachePath, if not empty.
+	CachePath string
+	// LogFile overrides Settings.LogFile, if not empty.
+	LogFile string
+	// Locale overrides Settings.Locale, if not empty.
+	Locale string
+	// Windowless enables windowless (off-screen) rendering.
+	Windowless bool
+	// Main is called in the browser process once CEF has been initialized
+	// and before the message loop is run. This is typically where the first
+	// browser is created. If it returns an error, CEF is shut down without
+	// running the message loop and Run() returns the error.
+	Main func() error
+	// Loop, if not nil, is called in place of RunMessageLoop() and must not
+	// return until the application is ready to shut down. Use it to drive CEF
+	// with DoMessageLoopWork() from another event loop, e.g. via RunPump().
+	Loop func()
+}
+
+// ProcessType returns the type of the current process, as determined from
+// the command line. Secondary processes launched by CEF are passed a --type
+// argument, while the browser process is not, so ProcessTypeBrowser is
+// returned for it.
+func ProcessType() string {
+	for _, arg := range os.Args[1:] {
+		if strings.HasPrefix(arg, processTypeArgPrefix) {
+			return arg[len(processTypeArgPrefix):]
+		}
+	}
+	return ProcessTypeBrowser
+}
+
+// IsBrowserProcess returns true if the current process is the main browser
+// process, rather than one of the secondary processes launched by CEF.
+func IsBrowserProcess() bool {
+	return ProcessType() == ProcessTypeBrowser
+}
+
+// Run performs the full CEF lifecycle: it executes the secondary process
+// logic, initializes CEF, calls opts.Main, runs the message loop and finally
+// shuts CEF down. When called in a secondary process, the process exits once
+// CEF is done with it, so code following the call to Run() only ever executes
+// in the browser process.
+//
+// Run must be called on the main thread, which generally means calling it
+// from main() and calling runtime.LockOSThread() from an init() function.
+func Run(opts *RunOptions) error {
+	runtime.LockOSThread()
+	if opts == nil {
+		opts = &RunOptions{}
+	}
+	args := NewMainArgs()
+	if code := ExecuteProcess(args, opts.App, nil); code >= 0 {
+		atexit.Exit(int(code))
+	}
+	if !IsBrowserProcess() {
+		return errs.Newf("CEF did not handle the %s process", ProcessType())
+	}
+	settings := opts.Settings
+	if settings == nil {
+		settings = NewSettings()
+	}
+	if opts.CachePath != "" {
+		settings.CachePath = opts.CachePath
+	}
+	if opts.LogFile != "" {
+		settings.LogFile = opts.LogFile
+	}
+	if opts.Locale != "" {
+		settings.Locale = opts.Locale
+	}
+	if opts.Windowless {
+		settings.WindowlessRenderingEnabled = true
+	}
+	InstantiateApplication()
+	if !Initialize(args, settings, opts.App, nil) {
+		return errs.New("unable to initialize CEF")
+	}
+	defer Shutdown()
+	if opts.Main != nil {
+		if err := opts.Main(); err != nil {
+			return err
+		}
+	}
+	if opts.Loop != nil {
+		opts.Loop()
+	} else {
+		RunMessageLoop()
+	}
+	return nil
+}
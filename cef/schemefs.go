@@ -0,0 +1,230 @@
+package cef
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"unsafe"
+
+	"github.com/richardwilkes/toolbox/xio"
+)
+
+// maxEmptyReads is the number of consecutive reads returning neither data nor
+// an error that are tolerated before a response is ended.
+const maxEmptyReads = 100
+
+// NewFileSystemSchemeHandlerFactory creates a new SchemeHandlerFactory that
+// serves the files found in fs, using the path portion of each request's URL
+// to locate them. Directory requests are served from their index.html file.
+// Use http.FS() to serve the contents of an fs.FS, such as an embed.FS.
+func NewFileSystemSchemeHandlerFactory(fs http.FileSystem) *SchemeHandlerFactory {
+	return NewSchemeHandlerFactory(&fsSchemeHandlerFactory{fs: fs})
+}
+
+type fsSchemeHandlerFactory struct {
+	DefaultSchemeHandlerFactory
+	fs http.FileSystem
+}
+
+func (f *fsSchemeHandlerFactory) Create(self *SchemeHandlerFactory, browser *Browser, frame *Frame, schemeName string, request *Request) *ResourceHandler {
+	defer ReleaseAll(browser, frame, request)
+	return NewResourceHandler(&fsResourceHandler{fs: f.fs})
+}
+
+type fsResourceHandler struct {
+	DefaultResourceHandler
+	fs        http.FileSystem
+	file      http.File
+	status    int32
+	mimeType  string
+	header    http.Header
+	remaining int64
+	buffer    []byte
+}
+
+func (h *fsResourceHandler) ProcessRequest(self *ResourceHandler, request *Request, callback *Callback) bool {
+	defer ReleaseAll(request, callback)
+	h.header = make(http.Header)
+	h.status = h.open(request)
+	callback.Cont()
+	return true
+}
+
+func (h *fsResourceHandler) open(request *Request) int32 {
+	u, err := url.Parse(request.GetUrl())
+	if err != nil {
+		return http.StatusBadRequest
+	}
+	name := path.Clean("/" + u.Path)
+	if h.file, err = h.fs.Open(name); err != nil {
+		if os.IsPermission(err) {
+			return http.StatusForbidden
+		}
+		return http.StatusNotFound
+	}
+	fi, err := h.file.Stat()
+	if err == nil && fi.IsDir() {
+		h.close()
+		name = path.Join(name, "index.html")
+		if h.file, err = h.fs.Open(name); err != nil {
+			return http.StatusNotFound
+		}
+		fi, err = h.file.Stat()
+	}
+	if err != nil {
+		h.close()
+		return http.StatusInternalServerError
+	}
+	h.mimeType = mime.TypeByExtension(path.Ext(name))
+	if h.mimeType == "" {
+		var sniff [512]byte
+		n, _ := io.ReadFull(h.file, sniff[:])
+		h.mimeType = http.DetectContentType(sniff[:n])
+		if _, err = h.file.Seek(0, io.SeekStart); err != nil {
+			h.close()
+			return http.StatusInternalServerError
+		}
+	}
+	size := fi.Size()
+	h.header.Set("Accept-Ranges", "bytes")
+	h.remaining = size
+	if spec := request.Header().Get("Range"); spec != "" {
+		start, length, ok := parseByteRange(spec, size)
+		if !ok {
+			h.close()
+			h.remaining = 0
+			h.header.Set("Content-Range", fmt.Sprintf("bytes */%d", size))
+			return http.StatusRequestedRangeNotSatisfiable
+		}
+		if start != 0 || length != size {
+			if _, err = h.file.Seek(start, io.SeekStart); err != nil {
+				h.close()
+				return http.StatusInternalServerError
+			}
+			h.remaining = length
+			h.header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, start+length-1, size))
+			return http.StatusPartialContent
+		}
+	}
+	return http.StatusOK
+}
+
+func (h *fsResourceHandler) GetResponseHeaders(self *ResourceHandler, response *Response, response_length *int64, redirectUrl *string) {
+	defer ReleaseAll(response)
+	response.SetStatus(h.status)
+	response.SetStatusText(http.StatusText(int(h.status)))
+	if h.mimeType != "" {
+		response.SetMimeType(h.mimeType)
+	}
+	if len(h.header) != 0 {
+		response.SetHeader(h.header)
+	}
+	if h.file == nil {
+		*response_length = 0
+	} else {
+		*response_length = h.remaining
+	}
+}
+
+func (h *fsResourceHandler) ReadResponse(self *ResourceHandler, data_out unsafe.Pointer, bytes_to_read int32, bytes_read *int32, callback *Callback) bool {
+	defer ReleaseAll(callback)
+	*bytes_read = 0
+	if h.file == nil || h.remaining <= 0 {
+		h.close()
+		return false
+	}
+	size := int64(bytes_to_read)
+	if size > h.remaining {
+		size = h.remaining
+	}
+	if int64(cap(h.buffer)) < size {
+		h.buffer = make([]byte, size)
+	}
+	// A reader may return no data and no error; retry a bounded number of
+	// times, as bufio does, rather than ending the response early.
+	for i := 0; i < maxEmptyReads; i++ {
+		n, err := h.file.Read(h.buffer[:size])
+		if n > 0 {
+			copy((*[1<<30 - 1]byte)(data_out)[:n:n], h.buffer[:n])
+			*bytes_read = int32(n)
+			h.remaining -= int64(n)
+			return true
+		}
+		if err != nil {
+			break
+		}
+	}
+	h.close()
+	return false
+}
+
+func (h *fsResourceHandler) CanGetCookie(self *ResourceHandler, cookie *Cookie) bool {
+	return false
+}
+
+func (h *fsResourceHandler) CanSetCookie(self *ResourceHandler, cookie *Cookie) bool {
+	return false
+}
+
+func (h *fsResourceHandler) Cancel(self *ResourceHandler) {
+	h.close()
+}
+
+func (h *fsResourceHandler) close() {
+	if h.file != nil {
+		xio.CloseIgnoringErrors(h.file)
+		h.file = nil
+	}
+}
+
+// parseByteRange parses an HTTP Range header value containing a single byte
+// range, returning the start offset and length it covers within a resource
+// of the given size. Unknown range units and multiple ranges are not supported
+// and result in the full resource being returned.
+func parseByteRange(spec string, size int64) (start, length int64, ok bool) {
+	const prefix = "bytes="
+	if !strings.HasPrefix(spec, prefix) {
+		return 0, size, true
+	}
+	spec = strings.TrimSpace(spec[len(prefix):])
+	if strings.Contains(spec, ",") {
+		return 0, size, true
+	}
+	i := strings.Index(spec, "-")
+	if i == -1 {
+		return 0, 0, false
+	}
+	first := strings.TrimSpace(spec[:i])
+	last := strings.TrimSpace(spec[i+1:])
+	var err error
+	if first == "" {
+		// Suffix range, e.g. "bytes=-500" for the final 500 bytes.
+		var n int64
+		if n, err = strconv.ParseInt(last, 10, 64); err != nil || n <= 0 {
+			return 0, 0, false
+		}
+		if n > size {
+			n = size
+		}
+		return size - n, n, true
+	}
+	if start, err = strconv.ParseInt(first, 10, 64); err != nil || start < 0 || start >= size {
+		return 0, 0, false
+	}
+	end := size - 1
+	if last != "" {
+		if end, err = strconv.ParseInt(last, 10, 64); err != nil || end < start {
+			return 0, 0, false
+		}
+		if end >= size {
+			end = size - 1
+		}
+	}
+	return start, end - start + 1, true
+}
@@ -4,6 +4,9 @@ import (
 	// #include <stdlib.h>
 	// #include <string.h>
 	// #include "include/capi/cef_base_capi.h"
+	// #include "include/internal/cef_string_list.h"
+	// #include "include/internal/cef_string_map.h"
+	// #include "include/internal/cef_string_multimap.h"
 	"C"
 	"unsafe"
 )
@@ -44,3 +47,95 @@ func cefuserfreestrToString(cefstr C.cef_string_userfree_t) string {
 	C.cef_string_userfree_free(cefstr)
 	return str
 }
+
+func newCEFStringList(list []string) C.cef_string_list_t {
+	native := C.cef_string_list_alloc()
+	for _, one := range list {
+		str := C.cef_string_userfree_alloc()
+		setCEFStr(one, str)
+		C.cef_string_list_append(native, (*C.cef_string_t)(str))
+		C.cef_string_userfree_free(str)
+	}
+	return native
+}
+
+func cefStringListToGo(native C.cef_string_list_t) []string {
+	if native == nil {
+		return nil
+	}
+	count := C.cef_string_list_size(native)
+	list := make([]string, 0, int(count))
+	var str C.cef_string_t
+	for i := C.size_t(0); i < count; i++ {
+		if C.cef_string_list_value(native, i, &str) != 0 {
+			list = append(list, cefstrToString(&str))
+		}
+	}
+	C.cef_string_clear(&str)
+	return list
+}
+
+func newCEFStringMap(m map[string]string) C.cef_string_map_t {
+	native := C.cef_string_map_alloc()
+	for k, v := range m {
+		key := C.cef_string_userfree_alloc()
+		setCEFStr(k, key)
+		value := C.cef_string_userfree_alloc()
+		setCEFStr(v, value)
+		C.cef_string_map_append(native, (*C.cef_string_t)(key), (*C.cef_string_t)(value))
+		C.cef_string_userfree_free(key)
+		C.cef_string_userfree_free(value)
+	}
+	return native
+}
+
+func cefStringMapToGo(native C.cef_string_map_t) map[string]string {
+	if native == nil {
+		return nil
+	}
+	count := C.cef_string_map_size(native)
+	m := make(map[string]string, int(count))
+	var key, value C.cef_string_t
+	for i := C.size_t(0); i < count; i++ {
+		if C.cef_string_map_key(native, i, &key) != 0 && C.cef_string_map_value(native, i, &value) != 0 {
+			m[cefstrToString(&key)] = cefstrToString(&value)
+		}
+	}
+	C.cef_string_clear(&key)
+	C.cef_string_clear(&value)
+	return m
+}
+
+func newCEFStringMultimap(m map[string][]string) C.cef_string_multimap_t {
+	native := C.cef_string_multimap_alloc()
+	for k, values := range m {
+		key := C.cef_string_userfree_alloc()
+		setCEFStr(k, key)
+		for _, v := range values {
+			value := C.cef_string_userfree_alloc()
+			setCEFStr(v, value)
+			C.cef_string_multimap_append(native, (*C.cef_string_t)(key), (*C.cef_string_t)(value))
+			C.cef_string_userfree_free(value)
+		}
+		C.cef_string_userfree_free(key)
+	}
+	return native
+}
+
+func cefStringMultimapToGo(native C.cef_string_multimap_t) map[string][]string {
+	if native == nil {
+		return nil
+	}
+	count := C.cef_string_multimap_size(native)
+	m := make(map[string][]string)
+	var key, value C.cef_string_t
+	for i := C.size_t(0); i < count; i++ {
+		if C.cef_string_multimap_key(native, i, &key) != 0 && C.cef_string_multimap_value(native, i, &value) != 0 {
+			k := cefstrToString(&key)
+			m[k] = append(m[k], cefstrToString(&value))
+		}
+	}
+	C.cef_string_clear(&key)
+	C.cef_string_clear(&value)
+	return m
+}
@@ -0,0 +1,71 @@
+package cef
+
+import (
+	"context"
+	"time"
+
+	"github.com/richardwilkes/toolbox/errs"
+)
+
+type funcTask struct {
+	DefaultTask
+	fn func()
+}
+
+func (t *funcTask) Execute(self *Task) {
+	t.fn()
+}
+
+// RunOn runs fn asynchronously on the specified CEF thread. It may be called
+// from any goroutine. Returns false if the task could not be posted, which
+// generally means CEF has not been initialized or is shutting down.
+func RunOn(thread ThreadID, fn func()) bool {
+	return PostTask(thread, NewTask(&funcTask{fn: fn}))
+}
+
+// RunOnAfter runs fn asynchronously on the specified CEF thread once the
+// delay has passed. It may be called from any goroutine. Returns false if
+// the task could not be posted.
+func RunOnAfter(thread ThreadID, delay time.Duration, fn func()) bool {
+	return PostDelayedTask(thread, NewTask(&funcTask{fn: fn}), int64(delay/time.Millisecond))
+}
+
+// RunOnSync runs fn on the specified CEF thread and waits for it to finish,
+// returning its result. If already on that thread, fn is called directly.
+// Since the wait blocks, RunOnSync must not be called from a CEF thread that
+// fn's own work depends upon. If ctx is done before fn has run, such as when
+// CEF shuts down without running it, RunOnSync stops waiting and returns the
+// context's error. If fn panics, the panic is reported through the proxy error
+// handler and returned as an error.
+func RunOnSync(ctx context.Context, thread ThreadID, fn func() interface{}) (interface{}, error) {
+	if CurrentlyOn(thread) {
+		return callSync(fn)
+	}
+	type outcome struct {
+		result interface{}
+		err    error
+	}
+	done := make(chan outcome, 1)
+	if !RunOn(thread, func() {
+		result, err := callSync(fn)
+		done <- outcome{result: result, err: err}
+	}) {
+		return nil, errs.Newf("unable to post task to thread %d", thread)
+	}
+	select {
+	case o := <-done:
+		return o.result, o.err
+	case <-ctx.Done():
+		return nil, errs.Wrap(ctx.Err())
+	}
+}
+
+func callSync(fn func() interface{}) (result interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errs.Newf("recovered from panic: %v", r)
+			reportProxyError("Task", "Execute", err, r)
+		}
+	}()
+	return fn(), nil
+}
@@ -0,0 +1,13 @@
+// +build debug
+
+package cef
+
+import "fmt"
+
+// AssertOn panics if not currently on the specified CEF thread. It does
+// nothing unless built with the 'debug' tag.
+func AssertOn(thread ThreadID) {
+	if !CurrentlyOn(thread) {
+		panic(fmt.Sprintf("must be called on CEF thread %d", thread))
+	}
+}
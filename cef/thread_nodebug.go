@@ -0,0 +1,8 @@
+// +build !debug
+
+package cef
+
+// AssertOn panics if not currently on the specified CEF thread. It does
+// nothing unless built with the 'debug' tag.
+func AssertOn(thread ThreadID) {
+}
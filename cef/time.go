@@ -0,0 +1,23 @@
+package cef
+
+import "time"
+
+// NewTimeFromGo creates a new Time from a time.Time.
+func NewTimeFromGo(t time.Time) *Time {
+	t = t.UTC()
+	return &Time{
+		Year:        int32(t.Year()),
+		Month:       int32(t.Month()),
+		DayOfWeek:   int32(t.Weekday()),
+		DayOfMonth:  int32(t.Day()),
+		Hour:        int32(t.Hour()),
+		Minute:      int32(t.Minute()),
+		Second:      int32(t.Second()),
+		Millisecond: int32(t.Nanosecond() / int(time.Millisecond)),
+	}
+}
+
+// ToGo returns the time as a time.Time in UTC.
+func (d *Time) ToGo() time.Time {
+	return time.Date(int(d.Year), time.Month(d.Month), int(d.DayOfMonth), int(d.Hour), int(d.Minute), int(d.Second), int(d.Millisecond)*int(time.Millisecond), time.UTC)
+}
@@ -4,12 +4,8 @@ package cef
 
 import (
 	// #include "include/internal/cef_string.h"
-	// #include "include/internal/cef_string_list.h"
-	// #include "include/internal/cef_string_map.h"
-	// #include "include/internal/cef_string_multimap.h"
 	// #include "include/internal/cef_types.h"
 	"C"
-	"unsafe"
 )
 
 // Char (cef_char_t from include/internal/cef_string.h)
@@ -19,16 +15,3 @@ type Char uint16
 // 32-bit ARGB color value, not premultiplied. The color components are always
 // in a known order. Equivalent to the SkColor type.
 type Color uint32
-
-// StringList (cef_string_list_t from include/internal/cef_string_list.h)
-// CEF string maps are a set of key/value string pairs.
-type StringList unsafe.Pointer
-
-// StringMap (cef_string_map_t from include/internal/cef_string_map.h)
-// CEF string maps are a set of key/value string pairs.
-type StringMap unsafe.Pointer
-
-// StringMultimap (cef_string_multimap_t from include/internal/cef_string_multimap.h)
-// CEF string multimaps are a set of key/value string pairs.
-// More than one value can be assigned to a single key.
-type StringMultimap unsafe.Pointer
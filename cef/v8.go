@@ -0,0 +1,110 @@
+package cef
+
+import (
+	// #include "capi_gen.h"
+	// static int gocef_v8_eval(cef_v8context_t *self, cef_string_t *code, cef_v8value_t **retval, cef_v8exception_t **exception) { return self->eval(self, code, NULL, 0, retval, exception); }
+	// static cef_v8value_t *gocef_v8_call(cef_v8value_t *self, cef_v8context_t *context, cef_v8value_t *object, size_t count, cef_v8value_t **args) { return self->execute_function_with_context(self, context, object, count, args); }
+	"C"
+	"unsafe"
+
+	"github.com/richardwilkes/toolbox/errs"
+)
+
+// v8Args converts a C array of V8 values into a Go slice.
+func v8Args(count C.size_t, values **C.cef_v8value_t) []*V8value {
+	args := make([]*V8value, int(count))
+	if count > 0 {
+		p := (*[1<<30 - 1]*C.cef_v8value_t)(unsafe.Pointer(values))
+		for i := range args {
+			args[i] = (*V8value)(p[i])
+		}
+	}
+	return args
+}
+
+// releaseV8Args releases the references to the receiver and arguments passed
+// to a V8 callback.
+func releaseV8Args(object *V8value, arguments []*V8value) {
+	ReleaseAll(object)
+	for _, arg := range arguments {
+		ReleaseAll(arg)
+	}
+}
+
+// v8Result stores the outcome of a Go V8 callback into the C out-parameters.
+// A non-nil error becomes the exception. If the value is nil, the retval is
+// left untouched and false (0) is returned unless an error was set.
+func v8Result(value *V8value, err error, retval **C.cef_v8value_t, exception *C.cef_string_t) C.int {
+	if err != nil {
+		setCEFStr(err.Error(), exception)
+		return 1
+	}
+	if value == nil {
+		return 0
+	}
+	*retval = value.toNative()
+	return 1
+}
+
+// v8Assigned converts the outcome of a Go V8 assignment callback into the C
+// return value, storing any error into the exception out-parameter.
+func v8Assigned(handled bool, err error, exception *C.cef_string_t) C.int {
+	if err != nil {
+		setCEFStr(err.Error(), exception)
+		return 1
+	}
+	if handled {
+		return 1
+	}
+	return 0
+}
+
+// v8Eval evaluates the JavaScript code within the context and returns the
+// result.
+func v8Eval(context *V8context, code string) (*V8value, error) {
+	code_ := C.cef_string_userfree_alloc()
+	setCEFStr(code, code_)
+	defer C.cef_string_userfree_free(code_)
+	var retval *C.cef_v8value_t
+	var exception *C.cef_v8exception_t
+	if C.gocef_v8_eval(context.toNative(), (*C.cef_string_t)(code_), &retval, &exception) == 0 || retval == nil {
+		if exception != nil {
+			defer ReleaseAll((*V8exception)(exception))
+			return nil, errs.New((*V8exception)(exception).GetMessage())
+		}
+		return nil, errs.New("unable to evaluate script")
+	}
+	return (*V8value)(retval), nil
+}
+
+// v8Call calls the function within the context. If object is nil, the
+// context's global object will be used as the receiver. A JavaScript
+// exception thrown by the function is returned as an error. The caller keeps
+// its references to fn, context and object, while those to args are handed
+// over.
+func v8Call(fn *V8value, context *V8context, object *V8value, args []*V8value) (*V8value, error) {
+	context.Base().AddRef()
+	if object != nil {
+		object.Base().AddRef()
+	}
+	var argv **C.cef_v8value_t
+	if len(args) != 0 {
+		native := make([]*C.cef_v8value_t, len(args))
+		for i, arg := range args {
+			native[i] = arg.toNative()
+		}
+		argv = &native[0]
+	}
+	result := C.gocef_v8_call(fn.toNative(), context.toNative(), object.toNative(), C.size_t(len(args)), argv)
+	if fn.HasException() {
+		exception := fn.GetException()
+		msg := exception.GetMessage()
+		ReleaseAll(exception, (*V8value)(result))
+		fn.ClearException()
+		return nil, errs.New(msg)
+	}
+	if result == nil {
+		return nil, errs.New("unable to call function")
+	}
+	return (*V8value)(result), nil
+}
@@ -0,0 +1,512 @@
+package cef
+
+import (
+	// #include "capi_gen.h"
+	"C"
+	"encoding/json"
+	"math"
+	"reflect"
+	"strings"
+	"time"
+	"unsafe"
+
+	"github.com/richardwilkes/toolbox/errs"
+)
+
+const v8ArrayBufferToStringScript = `(function(buffer) {
+	var bytes = new Uint8Array(buffer), s = '';
+	for (var i = 0; i < bytes.length; i += 8192) {
+		s += String.fromCharCode.apply(null, bytes.subarray(i, i + 8192));
+	}
+	return s;
+})`
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// V8Func is a JavaScript function that has been converted into Go by
+// (*V8value).ToGo(). The arguments are converted with V8ValueFromGo() and the
+// result with (*V8value).ToGo(). A JavaScript exception thrown by the
+// function is returned as an error. It must be called on the render thread
+// while the context the function came from is still valid.
+type V8Func func(args ...interface{}) (interface{}, error)
+
+// V8ValueFromGo creates a new V8value holding a copy of v. Maps and structs
+// become objects, slices and arrays become arrays, []byte becomes an
+// ArrayBuffer, time.Time becomes a Date and funcs become functions. Cycles
+// formed through pointers, maps and slices are preserved. Values that cannot
+// be represented, such as channels, become undefined. This must be called on
+// the render thread from within a V8 callback or while a context is entered.
+//
+// Go funcs may take any parameters that the JavaScript arguments can be
+// converted into and may return at most one value plus an optional error,
+// which will be thrown as a JavaScript exception. *V8value parameters are
+// only valid for the duration of the call.
+func V8ValueFromGo(v interface{}) *V8value {
+	e := &v8Encoder{seen: make(map[v8EncoderRef]*V8value)}
+	return e.encode(reflect.ValueOf(v))
+}
+
+type v8EncoderRef struct {
+	kind   reflect.Kind
+	ptr    uintptr
+	length int
+}
+
+type v8Encoder struct {
+	seen map[v8EncoderRef]*V8value
+}
+
+func (e *v8Encoder) encode(rv reflect.Value) *V8value {
+	if !rv.IsValid() {
+		return V8valueCreateNull()
+	}
+	if rv.CanInterface() {
+		switch x := rv.Interface().(type) {
+		case *V8value:
+			if x == nil {
+				return V8valueCreateNull()
+			}
+			x.Base().AddRef()
+			return x
+		case time.Time:
+			return V8valueCreateDate(NewTimeFromGo(x))
+		case json.Number:
+			if i, err := x.Int64(); err == nil {
+				return v8Int64(i)
+			}
+			f, err := x.Float64()
+			if err != nil {
+				return V8valueCreateUndefined()
+			}
+			return V8valueCreateDouble(f)
+		case json.RawMessage:
+			var value interface{}
+			if err := json.Unmarshal(x, &value); err != nil {
+				return V8valueCreateUndefined()
+			}
+			return e.encode(reflect.ValueOf(value))
+		}
+	}
+	switch rv.Kind() {
+	case reflect.Bool:
+		return V8valueCreateBool(rv.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v8Int64(rv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if u := rv.Uint(); u <= math.MaxUint32 {
+			return V8valueCreateUint(uint32(u))
+		}
+		return V8valueCreateDouble(float64(rv.Uint()))
+	case reflect.Float32, reflect.Float64:
+		return V8valueCreateDouble(rv.Float())
+	case reflect.String:
+		return V8valueCreateString(rv.String())
+	case reflect.Interface:
+		if rv.IsNil() {
+			return V8valueCreateNull()
+		}
+		return e.encode(rv.Elem())
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return V8valueCreateNull()
+		}
+		ref := v8EncoderRef{kind: reflect.Ptr, ptr: rv.Pointer()}
+		if value, exists := e.seen[ref]; exists {
+			value.Base().AddRef()
+			return value
+		}
+		if rv.Elem().Kind() == reflect.Struct {
+			obj := V8valueCreateObject(nil, nil)
+			e.seen[ref] = obj
+			e.encodeStruct(obj, rv.Elem())
+			return obj
+		}
+		return e.encode(rv.Elem())
+	case reflect.Struct:
+		obj := V8valueCreateObject(nil, nil)
+		e.encodeStruct(obj, rv)
+		return obj
+	case reflect.Map:
+		if rv.IsNil() {
+			return V8valueCreateNull()
+		}
+		ref := v8EncoderRef{kind: reflect.Map, ptr: rv.Pointer()}
+		if value, exists := e.seen[ref]; exists {
+			value.Base().AddRef()
+			return value
+		}
+		obj := V8valueCreateObject(nil, nil)
+		e.seen[ref] = obj
+		for _, key := range rv.MapKeys() {
+			var name string
+			if key.Kind() == reflect.String {
+				name = key.String()
+			} else if data, err := json.Marshal(key.Interface()); err == nil {
+				name = strings.Trim(string(data), `"`)
+			} else {
+				continue
+			}
+			obj.SetValueBykey(name, e.encode(rv.MapIndex(key)), V8PropertyAttributeNone)
+		}
+		return obj
+	case reflect.Slice:
+		if rv.IsNil() {
+			return V8valueCreateNull()
+		}
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return newV8ArrayBuffer(rv.Bytes())
+		}
+		ref := v8EncoderRef{kind: reflect.Slice, ptr: rv.Pointer(), length: rv.Len()}
+		if value, exists := e.seen[ref]; exists {
+			value.Base().AddRef()
+			return value
+		}
+		array := V8valueCreateArray(int32(rv.Len()))
+		e.seen[ref] = array
+		e.encodeElements(array, rv)
+		return array
+	case reflect.Array:
+		array := V8valueCreateArray(int32(rv.Len()))
+		e.encodeElements(array, rv)
+		return array
+	case reflect.Func:
+		if rv.IsNil() {
+			return V8valueCreateNull()
+		}
+		return newV8Function(rv)
+	default:
+		return V8valueCreateUndefined()
+	}
+}
+
+func (e *v8Encoder) encodeElements(array *V8value, rv reflect.Value) {
+	for i := 0; i < rv.Len(); i++ {
+		array.SetValueByindex(int32(i), e.encode(rv.Index(i)))
+	}
+}
+
+// encodeStruct sets the exported fields of the struct onto the object,
+// following the naming rules used by encoding/json for the `json` field tag.
+func (e *v8Encoder) encodeStruct(obj *V8value, rv reflect.Value) {
+	t := rv.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := field.Name
+		omitEmpty := false
+		if parts := strings.Split(tag, ","); parts[0] != "" || len(parts) > 1 {
+			if parts[0] != "" {
+				name = parts[0]
+			}
+			for _, opt := range parts[1:] {
+				if opt == "omitempty" {
+					omitEmpty = true
+				}
+			}
+		}
+		fv := rv.Field(i)
+		if field.Anonymous && tag == "" {
+			if fv.Kind() == reflect.Ptr {
+				if fv.IsNil() {
+					continue
+				}
+				fv = fv.Elem()
+			}
+			if fv.Kind() == reflect.Struct {
+				e.encodeStruct(obj, fv)
+				continue
+			}
+		}
+		if field.PkgPath != "" {
+			continue
+		}
+		if omitEmpty && isEmptyValue(fv) {
+			continue
+		}
+		obj.SetValueBykey(name, e.encode(fv), V8PropertyAttributeNone)
+	}
+}
+
+func isEmptyValue(rv reflect.Value) bool {
+	switch rv.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return rv.Len() == 0
+	case reflect.Bool:
+		return !rv.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return rv.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return rv.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return rv.IsNil()
+	default:
+		return false
+	}
+}
+
+func v8Int64(v int64) *V8value {
+	if v >= math.MinInt32 && v <= math.MaxInt32 {
+		return V8valueCreateInt(int32(v))
+	}
+	return V8valueCreateDouble(float64(v))
+}
+
+type v8BufferReleaser struct {
+	DefaultV8arrayBufferReleaseCallback
+}
+
+func (r *v8BufferReleaser) ReleaseBuffer(self *V8arrayBufferReleaseCallback, buffer unsafe.Pointer) {
+	C.free(buffer)
+}
+
+// newV8ArrayBuffer creates a new ArrayBuffer holding a copy of the data.
+func newV8ArrayBuffer(data []byte) *V8value {
+	size := len(data)
+	buffer := C.malloc(C.size_t(size + 1))
+	copy((*[1<<30 - 1]byte)(buffer)[:size:size], data)
+	return V8valueCreateArrayBuffer(buffer, uint64(size), NewV8arrayBufferReleaseCallback(&v8BufferReleaser{}))
+}
+
+// newV8Function creates a new JavaScript function that calls the Go func.
+func newV8Function(fn reflect.Value) *V8value {
+	t := fn.Type()
+	return V8valueCreateFunction("", NewV8handler(V8handlerFunc(func(self *V8handler, name string, object *V8value, arguments []*V8value) (*V8value, error) {
+		defer releaseV8Args(object, arguments)
+		count := t.NumIn()
+		var in []reflect.Value
+		for i, arg := range arguments {
+			var pt reflect.Type
+			if t.IsVariadic() && i >= count-1 {
+				pt = t.In(count - 1).Elem()
+			} else if i < count {
+				pt = t.In(i)
+			} else {
+				break
+			}
+			v, err := v8ArgToGo(arg, pt)
+			if err != nil {
+				return nil, errs.NewfWithCause(err, "unable to convert argument %d", i)
+			}
+			in = append(in, v)
+		}
+		fixed := count
+		if t.IsVariadic() {
+			fixed--
+		}
+		for i := len(in); i < fixed; i++ {
+			in = append(in, reflect.Zero(t.In(i)))
+		}
+		out := fn.Call(in)
+		if n := len(out); n != 0 && t.Out(n-1) == errorType {
+			if err, ok := out[n-1].Interface().(error); ok && err != nil {
+				return nil, err
+			}
+			out = out[:n-1]
+		}
+		if len(out) == 0 {
+			return nil, nil
+		}
+		return V8ValueFromGo(out[0].Interface()), nil
+	})))
+}
+
+// v8ArgToGo converts a JavaScript argument into a Go value of the specified
+// type.
+func v8ArgToGo(arg *V8value, t reflect.Type) (reflect.Value, error) {
+	if t == reflect.TypeOf(arg) {
+		return reflect.ValueOf(arg), nil
+	}
+	v, err := arg.ToGo()
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	if v == nil {
+		return reflect.Zero(t), nil
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Type().AssignableTo(t) {
+		return rv, nil
+	}
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		if rv.Type().ConvertibleTo(t) && rv.Kind() != reflect.String && rv.Kind() != reflect.Bool {
+			return rv.Convert(t), nil
+		}
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return reflect.Value{}, errs.Wrap(err)
+	}
+	target := reflect.New(t)
+	if err = json.Unmarshal(data, target.Interface()); err != nil {
+		return reflect.Value{}, errs.Wrap(err)
+	}
+	return target.Elem(), nil
+}
+
+// ToGo returns a copy of the value as a Go value. undefined and null become
+// nil, numbers become int or float64, Dates become time.Time, ArrayBuffers
+// become []byte, arrays become []interface{}, functions become V8Func and
+// other objects become map[string]interface{}. Cycles within the object graph
+// are preserved, so the result may refer to itself. This must be called on
+// the render thread from within a V8 callback or while a context is entered.
+func (d *V8value) ToGo() (interface{}, error) {
+	dec := &v8Decoder{context: V8contextGetCurrentContext()}
+	defer dec.release()
+	return dec.decode(d)
+}
+
+type v8Decoded struct {
+	value   *V8value
+	goValue interface{}
+}
+
+type v8Decoder struct {
+	context     *V8context
+	seen        []v8Decoded
+	bufferToStr *V8value
+	owned       []*V8value
+}
+
+// release releases the references obtained while decoding. The values are
+// kept until then, since dec.seen refers to them.
+func (dec *v8Decoder) release() {
+	ReleaseAll(dec.context, dec.bufferToStr)
+	for _, value := range dec.owned {
+		ReleaseAll(value)
+	}
+}
+
+// child takes ownership of a value obtained while decoding.
+func (dec *v8Decoder) child(value *V8value) *V8value {
+	if value != nil {
+		dec.owned = append(dec.owned, value)
+	}
+	return value
+}
+
+func (dec *v8Decoder) decode(d *V8value) (interface{}, error) {
+	switch {
+	case d == nil || !d.IsValid():
+		return nil, errs.New("invalid value")
+	case d.IsUndefined(), d.IsNull():
+		return nil, nil
+	case d.IsBool():
+		return d.GetBoolValue(), nil
+	case d.IsInt():
+		return int(d.GetIntValue()), nil
+	case d.IsUint():
+		if u := d.GetUintValue(); u <= math.MaxInt32 {
+			return int(u), nil
+		}
+		return float64(d.GetUintValue()), nil
+	case d.IsDouble():
+		return d.GetDoubleValue(), nil
+	case d.IsString():
+		return d.GetStringValue(), nil
+	case d.IsDate():
+		t := d.GetDateValue()
+		return t.ToGo(), nil
+	}
+	for _, one := range dec.seen {
+		d.Base().AddRef()
+		if one.value.IsSame(d) {
+			return one.goValue, nil
+		}
+	}
+	switch {
+	case d.IsArrayBuffer():
+		return dec.decodeArrayBuffer(d)
+	case d.IsFunction():
+		return dec.decodeFunction(d), nil
+	case d.IsArray():
+		s := make([]interface{}, d.GetArrayLength())
+		dec.seen = append(dec.seen, v8Decoded{value: d, goValue: s})
+		for i := range s {
+			v, err := dec.decode(dec.child(d.GetValueByindex(int32(i))))
+			if err != nil {
+				return nil, err
+			}
+			s[i] = v
+		}
+		return s, nil
+	case d.IsObject():
+		keys, _ := d.GetKeys()
+		m := make(map[string]interface{}, len(keys))
+		dec.seen = append(dec.seen, v8Decoded{value: d, goValue: m})
+		for _, key := range keys {
+			v, err := dec.decode(dec.child(d.GetValueBykey(key)))
+			if err != nil {
+				return nil, errs.NewWithCause(key, err)
+			}
+			m[key] = v
+		}
+		return m, nil
+	default:
+		return nil, errs.New("unsupported value type")
+	}
+}
+
+// decodeArrayBuffer copies the contents of an ArrayBuffer. Since CEF does not
+// provide direct access to the underlying buffer, the contents are extracted
+// as a string of byte-valued characters by a JavaScript helper.
+func (dec *v8Decoder) decodeArrayBuffer(d *V8value) (interface{}, error) {
+	if dec.context == nil {
+		return nil, errs.New("no current context")
+	}
+	if dec.bufferToStr == nil {
+		fn, err := v8Eval(dec.context, v8ArrayBufferToStringScript)
+		if err != nil {
+			return nil, err
+		}
+		dec.bufferToStr = fn
+	}
+	d.Base().AddRef()
+	str, err := v8Call(dec.bufferToStr, dec.context, nil, []*V8value{d})
+	if err != nil {
+		return nil, err
+	}
+	s := str.GetStringValue()
+	ReleaseAll(str)
+	data := make([]byte, 0, len(s))
+	for _, r := range s {
+		data = append(data, byte(r))
+	}
+	dec.seen = append(dec.seen, v8Decoded{value: d, goValue: data})
+	return data, nil
+}
+
+func (dec *v8Decoder) decodeFunction(d *V8value) V8Func {
+	// The V8Func outlives the decoding, so it keeps references of its own.
+	contextRef := Retain(dec.context)
+	fnRef := Retain(d)
+	var fn V8Func = func(args ...interface{}) (interface{}, error) {
+		if contextRef == nil || !contextRef.Object().(*V8context).IsValid() {
+			return nil, errs.New("context is no longer valid")
+		}
+		context := contextRef.Object().(*V8context)
+		if !context.Enter() {
+			return nil, errs.New("unable to enter context")
+		}
+		defer context.Exit()
+		values := make([]*V8value, len(args))
+		for i, arg := range args {
+			values[i] = V8ValueFromGo(arg)
+		}
+		result, err := v8Call(fnRef.Object().(*V8value), context, nil, values)
+		if err != nil {
+			return nil, err
+		}
+		defer ReleaseAll(result)
+		return result.ToGo()
+	}
+	dec.seen = append(dec.seen, v8Decoded{value: d, goValue: fn})
+	return fn
+}
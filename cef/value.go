@@ -0,0 +1,391 @@
+package cef
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"reflect"
+	"unsafe"
+
+	"github.com/richardwilkes/toolbox/errs"
+)
+
+// ValueFromGo creates a new Value holding a copy of v. See SetFromGo() for
+// the conversions that are applied.
+func ValueFromGo(v interface{}) (*Value, error) {
+	value := ValueCreate()
+	if err := value.SetFromGo(v); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// SetFromGo sets the value to hold a copy of v. Integers that fit within an
+// int32 are stored as ints, while all other numbers are stored as doubles.
+// Byte slices are stored as binary values, except that empty ones become null
+// since CEF can't hold an empty binary value. Maps with string keys are stored
+// as dictionaries and other slices and arrays as lists. Any other type, such as
+// a struct, is converted using its JSON encoding.
+func (d *Value) SetFromGo(v interface{}) error {
+	switch x := v.(type) {
+	case nil:
+		d.SetNull()
+	case bool:
+		d.SetBool(x)
+	case int:
+		d.setInt64(int64(x))
+	case int8:
+		d.SetInt(int32(x))
+	case int16:
+		d.SetInt(int32(x))
+	case int32:
+		d.SetInt(x)
+	case int64:
+		d.setInt64(x)
+	case uint:
+		d.setUint64(uint64(x))
+	case uint8:
+		d.SetInt(int32(x))
+	case uint16:
+		d.SetInt(int32(x))
+	case uint32:
+		d.setUint64(uint64(x))
+	case uint64:
+		d.setUint64(x)
+	case float32:
+		d.SetDouble(float64(x))
+	case float64:
+		d.SetDouble(x)
+	case string:
+		d.SetString(x)
+	case []byte:
+		d.setBytes(x)
+	case json.Number:
+		return d.setNumber(x)
+	case json.RawMessage:
+		return d.UnmarshalJSON(x)
+	case *Value:
+		return d.SetFromGo(x.ToGo())
+	case *BinaryValue:
+		d.SetBinary(x)
+	case *DictionaryValue:
+		d.SetDictionary(x)
+	case *ListValue:
+		d.SetList(x)
+	case map[string]interface{}:
+		dict := DictionaryValueCreate()
+		for k, one := range x {
+			if err := dict.setFromGo(k, one); err != nil {
+				return err
+			}
+		}
+		d.SetDictionary(dict)
+	case []interface{}:
+		list := ListValueCreate()
+		for i, one := range x {
+			if err := list.setFromGo(i, one); err != nil {
+				return err
+			}
+		}
+		d.SetList(list)
+	case json.Marshaler:
+		data, err := x.MarshalJSON()
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		return d.UnmarshalJSON(data)
+	default:
+		return d.setFromReflection(reflect.ValueOf(v))
+	}
+	return nil
+}
+
+func (d *Value) setFromReflection(rv reflect.Value) error {
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if rv.IsNil() {
+			d.SetNull()
+			return nil
+		}
+		return d.SetFromGo(rv.Elem().Interface())
+	case reflect.Bool:
+		d.SetBool(rv.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		d.setInt64(rv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		d.setUint64(rv.Uint())
+	case reflect.Float32, reflect.Float64:
+		d.SetDouble(rv.Float())
+	case reflect.String:
+		d.SetString(rv.String())
+	case reflect.Map:
+		if rv.IsNil() {
+			d.SetNull()
+			return nil
+		}
+		if rv.Type().Key().Kind() != reflect.String {
+			return d.setFromJSON(rv.Interface())
+		}
+		dict := DictionaryValueCreate()
+		for _, key := range rv.MapKeys() {
+			if err := dict.setFromGo(key.String(), rv.MapIndex(key).Interface()); err != nil {
+				return err
+			}
+		}
+		d.SetDictionary(dict)
+	case reflect.Slice, reflect.Array:
+		if rv.Kind() == reflect.Slice {
+			if rv.IsNil() {
+				d.SetNull()
+				return nil
+			}
+			if rv.Type().Elem().Kind() == reflect.Uint8 {
+				d.setBytes(rv.Bytes())
+				return nil
+			}
+		}
+		list := ListValueCreate()
+		for i := 0; i < rv.Len(); i++ {
+			if err := list.setFromGo(i, rv.Index(i).Interface()); err != nil {
+				return err
+			}
+		}
+		d.SetList(list)
+	case reflect.Struct:
+		return d.setFromJSON(rv.Interface())
+	default:
+		return errs.Newf("unable to convert %s to a Value", rv.Type())
+	}
+	return nil
+}
+
+func (d *Value) setFromJSON(v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	return d.UnmarshalJSON(data)
+}
+
+func (d *Value) setInt64(v int64) {
+	if v >= math.MinInt32 && v <= math.MaxInt32 {
+		d.SetInt(int32(v))
+	} else {
+		d.SetDouble(float64(v))
+	}
+}
+
+func (d *Value) setUint64(v uint64) {
+	if v <= math.MaxInt32 {
+		d.SetInt(int32(v))
+	} else {
+		d.SetDouble(float64(v))
+	}
+}
+
+func (d *Value) setBytes(data []byte) {
+	if len(data) == 0 {
+		d.SetNull()
+	} else {
+		d.SetBinary(NewBinaryValueFromBytes(data))
+	}
+}
+
+func (d *Value) setNumber(v json.Number) error {
+	if i, err := v.Int64(); err == nil {
+		d.setInt64(i)
+		return nil
+	}
+	f, err := v.Float64()
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	d.SetDouble(f)
+	return nil
+}
+
+// ToGo returns a copy of the value as a Go value. Null values are returned as
+// nil, bools as bool, ints as int, doubles as float64, strings as string,
+// binary values as []byte, dictionaries as map[string]interface{} and lists
+// as []interface{}.
+func (d *Value) ToGo() interface{} {
+	switch d.GetType() {
+	case VtypeBool:
+		return d.GetBool()
+	case VtypeInt:
+		return int(d.GetInt())
+	case VtypeDouble:
+		return d.GetDouble()
+	case VtypeString:
+		return d.GetString()
+	case VtypeBinary:
+		binary := d.GetBinary()
+		defer ReleaseAll(binary)
+		return binary.Bytes()
+	case VtypeDictionary:
+		dict := d.GetDictionary()
+		defer ReleaseAll(dict)
+		return dict.ToGo()
+	case VtypeList:
+		list := d.GetList()
+		defer ReleaseAll(list)
+		return list.ToGo()
+	default:
+		return nil
+	}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d *Value) MarshalJSON() ([]byte, error) {
+	return marshalValueJSON(d.ToGo())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Since the Value must already
+// exist, use ValueCreate() to obtain one before decoding into it.
+func (d *Value) UnmarshalJSON(data []byte) error {
+	var v interface{}
+	if err := unmarshalValueJSON(data, &v); err != nil {
+		return err
+	}
+	return d.SetFromGo(v)
+}
+
+// ToGo returns a copy of the dictionary's contents. See (*Value).ToGo() for
+// the conversions that are applied.
+func (d *DictionaryValue) ToGo() map[string]interface{} {
+	keys, _ := d.GetKeys()
+	m := make(map[string]interface{}, len(keys))
+	for _, key := range keys {
+		value := d.GetValue(key)
+		m[key] = value.ToGo()
+		ReleaseAll(value)
+	}
+	return m
+}
+
+// SetFromGo replaces the dictionary's contents with a copy of m. See
+// (*Value).SetFromGo() for the conversions that are applied.
+func (d *DictionaryValue) SetFromGo(m map[string]interface{}) error {
+	d.Clear()
+	for k, v := range m {
+		if err := d.setFromGo(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *DictionaryValue) setFromGo(key string, v interface{}) error {
+	value, err := ValueFromGo(v)
+	if err != nil {
+		return err
+	}
+	d.SetValue(key, value)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d *DictionaryValue) MarshalJSON() ([]byte, error) {
+	return marshalValueJSON(d.ToGo())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The dictionary's contents are
+// replaced by the decoded JSON object.
+func (d *DictionaryValue) UnmarshalJSON(data []byte) error {
+	var m map[string]interface{}
+	if err := unmarshalValueJSON(data, &m); err != nil {
+		return err
+	}
+	return d.SetFromGo(m)
+}
+
+// ToGo returns a copy of the list's contents. See (*Value).ToGo() for the
+// conversions that are applied.
+func (d *ListValue) ToGo() []interface{} {
+	s := make([]interface{}, d.GetSize())
+	for i := range s {
+		value := d.GetValue(uint64(i))
+		s[i] = value.ToGo()
+		ReleaseAll(value)
+	}
+	return s
+}
+
+// SetFromGo replaces the list's contents with a copy of s. See
+// (*Value).SetFromGo() for the conversions that are applied.
+func (d *ListValue) SetFromGo(s []interface{}) error {
+	d.Clear()
+	for i, v := range s {
+		if err := d.setFromGo(i, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *ListValue) setFromGo(index int, v interface{}) error {
+	value, err := ValueFromGo(v)
+	if err != nil {
+		return err
+	}
+	d.SetValue(uint64(index), value)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d *ListValue) MarshalJSON() ([]byte, error) {
+	return marshalValueJSON(d.ToGo())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The list's contents are replaced
+// by the decoded JSON array.
+func (d *ListValue) UnmarshalJSON(data []byte) error {
+	var s []interface{}
+	if err := unmarshalValueJSON(data, &s); err != nil {
+		return err
+	}
+	return d.SetFromGo(s)
+}
+
+// NewBinaryValueFromBytes creates a new BinaryValue containing a copy of the
+// data. Returns nil if data is empty, as CEF can't create an empty
+// BinaryValue.
+func NewBinaryValueFromBytes(data []byte) *BinaryValue {
+	if len(data) == 0 {
+		return nil
+	}
+	return BinaryValueCreate(unsafe.Pointer(&data[0]), uint64(len(data)))
+}
+
+// Bytes returns a copy of the data held by the binary value.
+func (d *BinaryValue) Bytes() []byte {
+	size := d.GetSize()
+	data := make([]byte, size)
+	if size != 0 {
+		data = data[:d.GetData(unsafe.Pointer(&data[0]), size, 0)]
+	}
+	return data
+}
+
+// MarshalJSON implements json.Marshaler. The data is encoded as a base64
+// string, the same as for a []byte.
+func (d *BinaryValue) MarshalJSON() ([]byte, error) {
+	return marshalValueJSON(d.Bytes())
+}
+
+func marshalValueJSON(v interface{}) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return data, nil
+}
+
+func unmarshalValueJSON(data []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(v); err != nil {
+		return errs.Wrap(err)
+	}
+	return nil
+}
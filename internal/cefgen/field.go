@@ -17,34 +17,156 @@ type field struct {
 }
 
 func newField(owner *structDef, name, typeInfo string, pos position) *field {
-	return &field{
+	f := &field{
 		Owner:    owner,
 		Var:      newCVar(name, typeInfo, pos),
 		Position: pos,
 	}
+	if f.Var.FunctionPtr {
+		markBools(name, f.Var.GoName, f.Var, f.Var.Params, pos)
+	} else {
+		markBoolField(f.Var, pos)
+	}
+	return f
 }
 
 func (f *field) ParameterList() string {
 	if f.Var.FunctionPtr {
-		params := f.Var.Params
+		params := f.goParams()
 		if len(params) > 0 && params[0].GoType == "*"+f.Owner.GoName {
 			params = params[1:]
 		}
-		return parameterList(params)
+		outs := f.outParams()
+		var filtered []*variable
+		for _, p := range params {
+			if !isVarIn(p, outs) {
+				filtered = append(filtered, p)
+			}
+		}
+		return parameterList(filtered)
 	}
 	return ""
 }
 
+// outParams returns the string collection parameters that CEF fills in for
+// the caller, which are returned as Go values rather than passed in.
+func (f *field) outParams() []*variable {
+	var outs []*variable
+	if f.Var.FunctionPtr && !f.Owner.isCallback() && (strings.HasPrefix(f.Var.Name, "get_") || strings.HasPrefix(f.Var.Name, "resolve_")) {
+		for _, p := range f.Var.Params {
+			if p.isStringCollection() {
+				outs = append(outs, p)
+			}
+		}
+	}
+	return outs
+}
+
+// ReturnType returns the Go return type for the field's accessor method.
+func (f *field) ReturnType() string {
+	outs := f.outParams()
+	if len(outs) == 0 {
+		return f.Var.GoType
+	}
+	types := make([]string, 0, len(outs)+1)
+	for _, p := range outs {
+		types = append(types, p.GoType)
+	}
+	if f.Var.GoType != "" {
+		types = append(types, f.Var.GoType)
+	}
+	if len(types) == 1 {
+		return types[0]
+	}
+	return "(" + strings.Join(types, ", ") + ")"
+}
+
+// TransfersOwnership returns true if the field's accessor method returns an
+// object with a reference owned by the caller.
+func (f *field) TransfersOwnership() bool {
+	return f.Var.FunctionPtr && f.Var.transfersOwnership()
+}
+
+// ProxyName returns the name of the optional interface a proxy implements to
+// handle the field's callback.
+func (f *field) ProxyName() string {
+	return f.Owner.GoName + f.Var.GoName + "Proxy"
+}
+
+// ProxyFlagName returns the name used for the flag indicating whether the
+// proxy handles the field's callback.
+func (f *field) ProxyFlagName() string {
+	return adjustedParamName(f.Var.NameNoMangle())
+}
+
+// ZeroValue returns the Go zero value for the field's type.
+func (f *field) ZeroValue() string {
+	switch {
+	case f.Var.GoType == "bool":
+		return "false"
+	case f.Var.GoType == "string":
+		return `""`
+	case f.Var.GoType == "unsafe.Pointer", strings.HasPrefix(f.Var.GoType, "*"), strings.HasPrefix(f.Var.GoType, "[]"), strings.HasPrefix(f.Var.GoType, "map["):
+		return "nil"
+	}
+	if sdef, exists := sdefsMap[f.Var.BaseType]; exists && !sdef.isClassEquivalent() && f.Var.Ptrs == "" {
+		return f.Var.GoType + "{}"
+	}
+	return "0"
+}
+
 func (f *field) ProxyParameterList() string {
 	if f.Var.FunctionPtr {
-		return parameterList(f.Var.Params)
+		return parameterList(f.goParams())
 	}
 	return ""
 }
 
+// goParams returns the parameters as seen from Go. For callbacks, a pointer to
+// an array of structures and the count that precedes it are combined into a
+// single slice parameter.
+func (f *field) goParams() []*variable {
+	if !f.Owner.isCallback() {
+		return f.Var.Params
+	}
+	params := make([]*variable, 0, len(f.Var.Params))
+	for i, p := range f.Var.Params {
+		switch {
+		case f.isStructArrayCount(i):
+		case f.isStructArray(i):
+			slice := *p
+			slice.GoType = "[]" + strings.TrimPrefix(p.GoType, "*")
+			params = append(params, &slice)
+		default:
+			params = append(params, p)
+		}
+	}
+	return params
+}
+
+// isStructArray returns true if the parameter at the index is a pointer to an
+// array of structures whose length is given by the preceding size_t
+// parameter, e.g. (size_t dirtyRectsCount, cef_rect_t const* dirtyRects).
+func (f *field) isStructArray(index int) bool {
+	if index < 1 || index >= len(f.Var.Params) {
+		return false
+	}
+	p := f.Var.Params[index]
+	count := f.Var.Params[index-1]
+	if p.Ptrs != "*" || count.Ptrs != "" || count.BaseType != "size_t" || count.Name != p.Name+"Count" {
+		return false
+	}
+	sdef, exists := sdefsMap[p.BaseType]
+	return exists && !sdef.isClassEquivalent()
+}
+
+func (f *field) isStructArrayCount(index int) bool {
+	return f.isStructArray(index + 1)
+}
+
 func (f *field) ParameterNames() string {
 	var buffer strings.Builder
-	for i, p := range f.Var.Params {
+	for i, p := range f.goParams() {
 		if i == 0 {
 			buffer.WriteString("d")
 		} else {
@@ -76,7 +198,8 @@ func (f *field) processedCParams(in []string) []param {
 }
 
 func (f *field) CallFunctionPointer() string {
-	prep, names := prepGoVarsForC(f.Var.Params)
+	outs := f.outParams()
+	prep, names := prepGoVarsForC(f.Var.Params, outs)
 	var buffer strings.Builder
 	fmt.Fprintf(&buffer, "C.%s(d.toNative()", f.TrampolineName())
 	emitParamsForCCall(&buffer, f.Var.Params[1:], names[1:], false)
@@ -84,7 +207,13 @@ func (f *field) CallFunctionPointer() string {
 	expression := buffer.String()
 	buffer.Reset()
 	buffer.WriteString(prep)
-	emitReturnForCCall(&buffer, expression, f.Var)
+	if len(outs) == 0 {
+		emitReturnForCCall(&buffer, expression, f.Var)
+	} else {
+		var call strings.Builder
+		emitReturnForCCall(&call, expression, f.Var)
+		emitOutsForCCall(&buffer, call.String(), outs)
+	}
 	return buffer.String()
 }
 
@@ -122,6 +251,12 @@ func (f *field) ToNative() string {
 			fmt.Fprintf(&buffer, `setCEFStr(d.%s, &native.%s)`, f.Var.GoName, f.Var.Name)
 		case cefStringPtrType:
 			fmt.Fprintf(&buffer, `setCEFStr(d.%s, native.%s)`, f.Var.GoName, f.Var.Name)
+		case "int":
+			if f.Var.isBool() {
+				fmt.Fprintf(&buffer, "native.%s = cefBool(d.%s)", f.Var.Name, f.Var.GoName)
+			} else {
+				fmt.Fprintf(&buffer, "native.%s = C.int(d.%s)", f.Var.Name, f.Var.GoName)
+			}
 		default:
 			fmt.Fprintf(&buffer, "native.%s = ", f.Var.Name)
 			if i := strings.Index(f.Var.CType, " "); i != -1 {
@@ -151,6 +286,8 @@ func (f *field) IntoGo() string {
 			fmt.Fprintf(&buffer, "cefstrToString(&n.%s)", f.Var.Name)
 		case cefStringPtrType:
 			fmt.Fprintf(&buffer, "cefstrToString(n.%s)", f.Var.Name)
+		case "int":
+			fmt.Fprintf(&buffer, "%s", f.Var.GoCast("n."+f.Var.Name))
 		default:
 			if f.Var.Name == baseFieldName {
 				fmt.Fprintf(&buffer, "(%s)(&n.base)", f.Var.GoType)
@@ -207,11 +344,16 @@ func (f *field) Trampoline() string {
 
 func (f *field) Callback() string {
 	var buffer strings.Builder
-	names := make([]string, len(f.Var.Params))
-	names[0] = "me__"
+	names := make([]string, 0, len(f.Var.Params))
 	for i, p := range f.Var.Params {
-		if i != 0 {
-			names[i] = p.transformCToGo(&buffer)
+		switch {
+		case i == 0:
+			names = append(names, "me__")
+		case f.isStructArrayCount(i):
+		case f.isStructArray(i):
+			names = append(names, p.transformCArrayToGo(&buffer, f.Var.Params[i-1]))
+		default:
+			names = append(names, p.transformCToGo(&buffer))
 		}
 	}
 	prefixLines := buffer.String()
@@ -274,6 +416,22 @@ func (f *field) CallbackReturnType() string {
 	return fmt.Sprintf("%sC.%s", rt.Ptrs, rt.Type)
 }
 
+// CallbackZeroValue returns the C zero value for the callback's return type,
+// or an empty string if the callback has no return value.
+func (f *field) CallbackZeroValue() string {
+	if f.Var.GoType == "" {
+		return ""
+	}
+	rt := f.processedCParams([]string{f.Var.CType})[0]
+	if rt.Ptrs != "" || rt.Type == "cef_string_userfree_t" {
+		return "nil"
+	}
+	if _, exists := sdefsMap[f.Var.BaseType]; exists {
+		return fmt.Sprintf("C.%s{}", rt.Type)
+	}
+	return "0"
+}
+
 func (f *field) ToBase() string {
 	var buffer strings.Builder
 	buffer.WriteString(".Base()")
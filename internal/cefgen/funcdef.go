@@ -14,6 +14,16 @@ import (
 var funcRegex = regexp.MustCompile(`.*>\s+\S+\s+(cef_\S+)\s+'([^(]+)`)
 var paramRegex = regexp.MustCompile(`.*-ParmVarDecl\s+[^>]+>\s+\S+\s+(\S+)\s+'([^']+)`)
 
+// excludedFunctionSources holds the headers whose functions are not exposed.
+// The string collection functions are used internally to marshal to and from
+// native Go slices and maps.
+var excludedFunctionSources = map[string]bool{
+	"include/internal/cef_string_types.h":    true,
+	"include/internal/cef_string_list.h":     true,
+	"include/internal/cef_string_map.h":      true,
+	"include/internal/cef_string_multimap.h": true,
+}
+
 type funcDef struct {
 	Name     string
 	GoName   string
@@ -22,6 +32,12 @@ type funcDef struct {
 	Position position
 }
 
+// TransfersOwnership returns true if the function returns an object with a
+// reference owned by the caller.
+func (f *funcDef) TransfersOwnership() bool {
+	return f.Return.transfersOwnership()
+}
+
 func (f *funcDef) ParameterList() string {
 	return parameterList(f.Params)
 }
@@ -42,7 +58,7 @@ func parameterList(params []*variable) string {
 }
 
 func (f *funcDef) Body() string {
-	prep, names := prepGoVarsForC(f.Params)
+	prep, names := prepGoVarsForC(f.Params, nil)
 	var buffer strings.Builder
 	fmt.Fprintf(&buffer, "C.%s(", f.Name)
 	emitParamsForCCall(&buffer, f.Params, names, true)
@@ -54,12 +70,21 @@ func (f *funcDef) Body() string {
 	return buffer.String()
 }
 
-func prepGoVarsForC(vars []*variable) (prep string, names []string) {
+func prepGoVarsForC(vars []*variable, outs []*variable) (prep string, names []string) {
 	var buffer strings.Builder
 	names = make([]string, len(vars))
 	for i, p := range vars {
 		names[i] = p.Name
 		switch {
+		case p.isStringCollection():
+			names[i] = p.Name + "_"
+			cname := strings.TrimSuffix(p.BaseType, "_t")
+			if isVarIn(p, outs) {
+				fmt.Fprintf(&buffer, "%s_ := C.%s_alloc()\n", p.Name, cname)
+			} else {
+				fmt.Fprintf(&buffer, "%s_ := newCEF%s(%s)\n", p.Name, stringCollections[p.BaseType].Helper, p.Name)
+			}
+			fmt.Fprintf(&buffer, "defer C.%s_free(%s_)\n", cname, p.Name)
 		case p.BaseType == cefStringType:
 			names[i] = p.Name + "_"
 			fmt.Fprintf(&buffer, "%s_ := C.cef_string_userfree_alloc()\n", p.Name)
@@ -92,6 +117,10 @@ for i, one := range %[1]s {
 }
 `, p.Name)
 			}
+		case p.isBool() && p.Ptrs == "*":
+			names[i] = p.Name + "_"
+			fmt.Fprintf(&buffer, "%[1]s_ := cefBool(*%[1]s)\n", p.Name)
+			fmt.Fprintf(&buffer, "defer func() {\n*%[1]s = %[1]s_ != 0\n}()\n", p.Name)
 		case p.Ptrs == "*":
 			if _, exists := edefsMap[p.BaseType]; exists {
 				names[i] = p.Name + "_"
@@ -136,6 +165,8 @@ return cefstrToString(&native__)`, expression)
 			fmt.Fprintf(buffer, "return cefstrToString(%s)", expression)
 		case "cef_string_userfree_t":
 			fmt.Fprintf(buffer, "return cefuserfreestrToString(%s)", expression)
+		case "int":
+			fmt.Fprintf(buffer, "return %s", retVar.GoCast(expression))
 		default:
 			buffer.WriteString("return ")
 			if strings.HasPrefix(retVar.GoType, "*") {
@@ -148,16 +179,49 @@ return cefstrToString(&native__)`, expression)
 	}
 }
 
+// emitOutsForCCall rewrites the return emitted for a C call so that the
+// string collection out-parameters are returned ahead of the C function's own
+// result.
+func emitOutsForCCall(buffer *strings.Builder, code string, outs []*variable) {
+	var values []string
+	for _, p := range outs {
+		values = append(values, fmt.Sprintf("cef%sToGo(%s_)", stringCollections[p.BaseType].Helper, p.Name))
+	}
+	if i := strings.LastIndex(code, "return "); i != -1 && !strings.Contains(code[i:], "\n") {
+		fmt.Fprintf(buffer, "%sresult__ := %s\n", code[:i], code[i+len("return "):])
+		values = append(values, "result__")
+	} else {
+		buffer.WriteString(code)
+		buffer.WriteString("\n")
+	}
+	fmt.Fprintf(buffer, "return %s", strings.Join(values, ", "))
+}
+
+func isVarIn(v *variable, list []*variable) bool {
+	for _, one := range list {
+		if one == v {
+			return true
+		}
+	}
+	return false
+}
+
 func emitParamsForCCall(buffer *strings.Builder, vars []*variable, names []string, omitFirstComma bool) {
 	for i, p := range vars {
 		if !omitFirstComma || i != 0 {
 			buffer.WriteString(", ")
 		}
 		switch {
-		case p.BaseType == voidType:
+		case p.BaseType == voidType, p.isStringCollection():
 			buffer.WriteString(names[i])
 		case p.BaseType == charType && p.Ptrs == "**":
 			fmt.Fprintf(buffer, "(**C.char)(%s)", names[i])
+		case p.isBool():
+			if p.Ptrs == "*" {
+				fmt.Fprintf(buffer, "&%s", names[i])
+			} else {
+				fmt.Fprintf(buffer, "cefBool(%s)", names[i])
+			}
 		default:
 			if p.Ptrs == "*" {
 				if _, exists := edefsMap[p.BaseType]; exists {
@@ -191,7 +255,7 @@ func emitParamsForCCall(buffer *strings.Builder, vars []*variable, names []strin
 }
 
 func processFunctionDecl(block []lineInfo) {
-	if block[0].Position.Src != "include/internal/cef_string_types.h" {
+	if !excludedFunctionSources[block[0].Position.Src] {
 		if result := funcRegex.FindAllStringSubmatch(block[0].Line, -1); len(result) > 0 {
 			name := result[0][1]
 			if _, exclude := fdefsMap[name]; !exclude {
@@ -206,6 +270,7 @@ func processFunctionDecl(block []lineInfo) {
 						fdef.Params = append(fdef.Params, newCVar(adjustedParamName(result[0][1]), result[0][2], b.Position))
 					}
 				}
+				markBools(name, fdef.GoName, fdef.Return, fdef.Params, fdef.Position)
 				fdefsMap[name] = fdef
 			}
 		}
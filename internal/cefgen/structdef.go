@@ -13,6 +13,36 @@ import (
 var (
 	defRegex   = regexp.MustCompile(` struct\s+_(cef_\S+)\s+definition$`)
 	fieldRegex = regexp.MustCompile(`-FieldDecl .*>\s+\S+\s+(\S+)\s+'([^']+)'`)
+	// callbackSuffixes holds the lowercased name suffixes that identify
+	// structures implemented on the client side.
+	callbackSuffixes = []string{"visitor", "callback", "handler", "delegate", "filter", "factory", "client"}
+	// cefImplementedCallbacks holds the types whose names identify them as
+	// callbacks, but which are implemented by CEF and called by the client,
+	// so must be generated as classes instead.
+	cefImplementedCallbacks = map[string]bool{
+		"AuthCallback":                    true,
+		"BeforeDownloadCallback":          true,
+		"Callback":                        true,
+		"DownloadItemCallback":            true,
+		"FileDialogCallback":              true,
+		"GetExtensionResourceCallback":    true,
+		"JsdialogCallback":                true,
+		"PrintDialogCallback":             true,
+		"PrintJobCallback":                true,
+		"RequestCallback":                 true,
+		"RunContextMenuCallback":          true,
+		"SelectClientCertificateCallback": true,
+	}
+	// manualStructs holds the types whose Go side is written by hand rather
+	// than generated, typically because their C signatures don't map cleanly
+	// onto the generated proxy conventions.
+	manualStructs = map[string]bool{
+		"MainArgs":      true,
+		"V8accessor":    true,
+		"V8handler":     true,
+		"V8interceptor": true,
+		"WindowInfo":    true,
+	}
 )
 
 type structDef struct {
@@ -63,6 +93,25 @@ func (s *structDef) isClassEquivalent() bool {
 	return false
 }
 
+// isCallback returns true if the structure is one that is implemented on the
+// client side. The suffix check is case-insensitive, since CEF's naming isn't
+// always consistent (e.g. cef_domvisitor_t).
+func (s *structDef) isCallback() bool {
+	if s.GoName == "App" || s.GoName == "Task" {
+		return true
+	}
+	if cefImplementedCallbacks[s.GoName] {
+		return false
+	}
+	name := strings.ToLower(s.GoName)
+	for _, suffix := range callbackSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *structDef) TrimmedName() string {
 	return strings.TrimSuffix(strings.TrimPrefix(s.Name, "cef_"), "_t")
 }
@@ -116,10 +165,10 @@ func dumpStructs() {
 	jot.FatalIfErr(err)
 
 	for _, sdef := range sdefs {
-		if sdef.GoName != "MainArgs" && sdef.GoName != "WindowInfo" {
+		if !manualStructs[sdef.GoName] {
 			var tmplFile string
 			if sdef.isClassEquivalent() {
-				if strings.HasSuffix(sdef.GoName, "Visitor") || strings.HasSuffix(sdef.GoName, "Callback") || strings.HasSuffix(sdef.GoName, "Handler") || strings.HasSuffix(sdef.GoName, "Delegate") || strings.HasSuffix(sdef.GoName, "Filter") || strings.HasSuffix(sdef.GoName, "Client") || sdef.GoName == "App" || sdef.GoName == "Task" {
+				if sdef.isCallback() {
 					genSourceFile(tmpl, callbackHeaderTmplFile, sdef.GoName+"_gen.h", sdef)
 					genSourceFile(tmpl, callbackCTmplFile, sdef.GoName+"_gen.c", sdef)
 					tmplFile = callbackTmplFile
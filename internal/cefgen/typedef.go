@@ -38,6 +38,9 @@ var excludeMap = map[string]bool{
 	"cef_string_utf8_t":           true,
 	"cef_string_utf16_t":          true,
 	"cef_string_wide_t":           true,
+	"cef_string_list_t":           true,
+	"cef_string_map_t":            true,
+	"cef_string_multimap_t":       true,
 }
 
 func processTypedefDecl(block []lineInfo) {
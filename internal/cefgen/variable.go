@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 	"io"
+	"regexp"
 	"strings"
 
 	"github.com/richardwilkes/toolbox/errs"
@@ -19,9 +20,104 @@ const (
 	stringPtrGoType  = "*string"
 )
 
+// stringCollections maps the CEF string collection types to the Go types and
+// helper name suffixes used to marshal them.
+var stringCollections = map[string]struct {
+	GoType string
+	Helper string
+}{
+	"cef_string_list_t":     {GoType: "[]string", Helper: "StringList"},
+	"cef_string_map_t":      {GoType: "map[string]string", Helper: "StringMap"},
+	"cef_string_multimap_t": {GoType: "map[string][]string", Helper: "StringMultimap"},
+}
+
 var (
-	cNamesToPrefixForAccess = []string{"range", "type"}
+	cNamesToPrefixForAccess = []string{"range", "select", "type"}
 	paramRenames            = []string{"chan", "defer", "error", "fallthrough", "func", "go", "import", "interface", "map", "package", "range", "select", "string", "type", "var"}
+	boolNameRegex           = regexp.MustCompile(`^(is|has|can)([_A-Z]|$)`)
+	boolGoNameRegex         = regexp.MustCompile(`^(Is|Has|Can)([A-Z]|$)`)
+	boolReturnRegex         = regexp.MustCompile(`(?i)\b(returns?|value of) (true|false) \([01]\)`)
+	boolFieldRegex          = regexp.MustCompile(`(?i)\btrue\b`)
+	// boolNames holds the parameter and field names that CEF uses for booleans
+	// but which can't be identified as such from their name prefix or comment.
+	boolNames = map[string]bool{
+		"added":                   true,
+		"allow_os_execution":      true,
+		"allow_target_subdomains": true,
+		"alt_pressed":             true,
+		"checked":                 true,
+		"clearSelection":          true,
+		"collate":                 true,
+		"ctrl_pressed":            true,
+		"deleteCookie":            true,
+		"disabled":                true,
+		"enabled":                 true,
+		"exclude_empty_children":  true,
+		"findNext":                true,
+		"focus":                   true,
+		"focusable":               true,
+		"forward":                 true,
+		"fullscreen":              true,
+		"heightSet":               true,
+		"hidden":                  true,
+		"include_incognito":       true,
+		"input_pixel_coords":      true,
+		"landscape":               true,
+		"landscape_needs_flip":    true,
+		"matchCase":               true,
+		"menuBarVisible":          true,
+		"mouseLeave":              true,
+		"mouseUp":                 true,
+		"mute":                    true,
+		"next":                    true,
+		"no_javascript_access":    true,
+		"ok":                      true,
+		"on_top":                  true,
+		"osModalLoop":             true,
+		"password_input":          true,
+		"persist_session_cookies": true,
+		"read_only":               true,
+		"rethrow":                 true,
+		"scrollbarsVisible":       true,
+		"selection_only":          true,
+		"setFocus":                true,
+		"shift_pressed":           true,
+		"show_dialog":             true,
+		"statusBarVisible":        true,
+		"success":                 true,
+		"suppress_message":        true,
+		"toolBarVisible":          true,
+		"unstable":                true,
+		"user_gesture":            true,
+		"visible":                 true,
+		"widthSet":                true,
+		"xSet":                    true,
+		"ySet":                    true,
+	}
+	// boolReturns holds the functions that return booleans but whose comments
+	// don't say so. The cef_time_t functions share a single comment in
+	// cef_time.h, which only ends up attached to the first of them.
+	boolReturns = map[string]bool{
+		"add_bitmap":                      true,
+		"add_jpeg":                        true,
+		"add_png":                         true,
+		"cef_browser_host_create_browser": true,
+		"cef_post_delayed_task":           true,
+		"cef_post_task":                   true,
+		"cef_register_extension":          true,
+		"cef_time_delta":                  true,
+		"cef_time_from_doublet":           true,
+		"cef_time_from_timet":             true,
+		"cef_time_now":                    true,
+		"get_bool":                        true,
+		"get_bool_value":                  true,
+		"get_file_names":                  true,
+		"get_keys":                        true,
+		"neuter_array_buffer":             true,
+		"post_delayed_task":               true,
+		"post_task":                       true,
+		"remove":                          true,
+	}
 )
 
 type variable struct {
@@ -111,6 +207,11 @@ func newCVar(name, typeInfo string, pos position) *variable {
 		}
 	case "char16":
 		v.GoType = v.Ptrs + "int16"
+	case "cef_string_list_t", "cef_string_map_t", "cef_string_multimap_t":
+		if v.Ptrs != "" {
+			jot.Fatal(1, errs.Newf("Unhandled string collection case: %s", v.Ptrs))
+		}
+		v.GoType = stringCollections[v.BaseType].GoType
 	case "int":
 		v.GoType = v.Ptrs + "int32"
 	case "int64_t", "time_t", "longlong":
@@ -179,6 +280,52 @@ func adjustedParamName(name string) string {
 	return name
 }
 
+// markBool switches an int variable, or a pointer to one, over to bool.
+func (v *variable) markBool() {
+	if v.BaseType == "int" && (v.Ptrs == "" || v.Ptrs == "*") {
+		v.GoType = v.Ptrs + "bool"
+	}
+}
+
+func (v *variable) isBool() bool {
+	return v.BaseType == "int" && strings.HasSuffix(v.GoType, "bool")
+}
+
+// markBools switches the return value and parameters of the function cName
+// over to bool where they follow CEF's boolean conventions: a name starting
+// with is, has or can, a comment describing the return value as true (1) or
+// false (0), or a comment describing a parameter as being true or false.
+func markBools(cName, goName string, result *variable, params []*variable, pos position) {
+	comment := flattenComment(pos.Comment())
+	if boolGoNameRegex.MatchString(goName) || boolReturnRegex.MatchString(comment) || boolReturns[cName] {
+		result.markBool()
+	}
+	for _, p := range params {
+		if boolNameRegex.MatchString(p.Name) || boolNames[p.Name] || (p.Name == "value" && strings.Contains(cName, "bool")) {
+			p.markBool()
+		} else if matched, _ := regexp.MatchString(`\|`+regexp.QuoteMeta(p.Name)+`\|\s+(is|was)\s+(true|false)`, comment); matched {
+			p.markBool()
+		}
+	}
+}
+
+// markBoolField switches a structure field over to bool where it follows
+// CEF's boolean conventions, which for fields is typically a comment such as
+// "Set to true (1) to ...".
+func markBoolField(v *variable, pos position) {
+	if boolNameRegex.MatchString(v.Name) || boolNames[v.Name] || boolFieldRegex.MatchString(flattenComment(pos.Comment())) {
+		v.markBool()
+	}
+}
+
+func flattenComment(comment string) string {
+	lines := strings.Split(comment, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(strings.TrimPrefix(line, "//"))
+	}
+	return strings.Join(lines, " ")
+}
+
 func (v *variable) paramGoType() string {
 	if !v.HadConst && v.GoType == "string" {
 		return stringPtrGoType
@@ -199,6 +346,9 @@ func (v *variable) transformCToGo(w io.Writer) string {
 			}
 		} else if v.Ptrs == "*" {
 			fmt.Fprintf(w, "%[1]s_ := %[1]s.toGo()\n", v.Name)
+			if !v.HadConst && sdef.Fields[0].Var.Name != baseFieldName {
+				fmt.Fprintf(w, "defer func() {\n%[1]s_.toNative(%[1]s)\n}()\n", v.Name)
+			}
 			return fmt.Sprintf("%s_", v.Name)
 		}
 	} else {
@@ -212,13 +362,20 @@ func (v *variable) transformCToGo(w io.Writer) string {
 				fmt.Fprintf(w, "%[1]s_ := %[2]s(*%[1]s)\n", v.Name, v.GoType[1:])
 				return fmt.Sprintf("&%s_", v.Name)
 			}
+		case v.isStringCollection():
+			fmt.Fprintf(w, "%[1]s_ := cef%[2]sToGo(%[1]s)\n", v.Name, stringCollections[v.BaseType].Helper)
+			return fmt.Sprintf("%s_", v.Name)
+		case v.isBool() && v.Ptrs == "*":
+			fmt.Fprintf(w, "%[1]s_ := *%[1]s != 0\n", v.Name)
+			fmt.Fprintf(w, "defer func() {\n*%[1]s = cefBool(%[1]s_)\n}()\n", v.Name)
+			return fmt.Sprintf("&%s_", v.Name)
 		case v.BaseType == cefStringType:
-			addressOf := ""
-			if !v.HadConst {
-				addressOf = "&"
-			}
 			fmt.Fprintf(w, "%[1]s_ := cefstrToString(%[1]s)\n", v.Name)
-			return fmt.Sprintf("%s%s_", addressOf, v.Name)
+			if v.HadConst {
+				return fmt.Sprintf("%s_", v.Name)
+			}
+			fmt.Fprintf(w, "defer func() {\nsetCEFStr(%[1]s_, %[1]s)\n}()\n", v.Name)
+			return fmt.Sprintf("&%s_", v.Name)
 		default:
 			return v.GoCast(v.Name)
 		}
@@ -227,6 +384,31 @@ func (v *variable) transformCToGo(w io.Writer) string {
 	return ""
 }
 
+// transformCArrayToGo emits the code to convert a C array of structures,
+// whose length is held by count, into a Go slice.
+func (v *variable) transformCArrayToGo(w io.Writer, count *variable) string {
+	fmt.Fprintf(w, "%[1]s_ := make([]%[2]s, %[3]s)\n", v.Name, strings.TrimPrefix(v.GoType, "*"), count.Name)
+	fmt.Fprintf(w, "if %s > 0 {\n", count.Name)
+	fmt.Fprintf(w, "%[1]s__p := (*[1<<30 - 1]C.%[2]s)(unsafe.Pointer(%[1]s))\n", v.Name, v.BaseType)
+	fmt.Fprintf(w, "for i := range %s_ {\n", v.Name)
+	fmt.Fprintf(w, "%[1]s__p[i].intoGo(&%[1]s_[i])\n", v.Name)
+	fmt.Fprint(w, "}\n}\n")
+	return fmt.Sprintf("%s_", v.Name)
+}
+
+// transfersOwnership returns true if the variable is a pointer to a
+// reference-counted structure. CEF adds a reference to any such structure it
+// returns, which the caller is then responsible for releasing.
+func (v *variable) transfersOwnership() bool {
+	sdef, exists := sdefsMap[v.BaseType]
+	return exists && v.Ptrs == "*" && sdef.isClassEquivalent()
+}
+
+func (v *variable) isStringCollection() bool {
+	_, exists := stringCollections[v.BaseType]
+	return exists
+}
+
 func (v *variable) CGoCast(expression string) string {
 	if sdef, exists := sdefsMap[v.BaseType]; exists {
 		if sdef.isClassEquivalent() {
@@ -234,6 +416,9 @@ func (v *variable) CGoCast(expression string) string {
 		}
 		return fmt.Sprintf("(%s).toNative(&C.%s{})", expression, v.BaseType)
 	}
+	if v.isBool() && v.Ptrs == "" {
+		return fmt.Sprintf("cefBool(%s)", expression)
+	}
 	if strings.HasPrefix(v.Ptrs, "*") {
 		return fmt.Sprintf("(%sC.%s)(%s)", v.Ptrs, v.BaseType, expression)
 	}
@@ -244,6 +429,9 @@ func (v *variable) GoCast(expression string) string {
 	if v.BaseType == voidType {
 		return expression
 	}
+	if v.GoType == "bool" {
+		return fmt.Sprintf("%s != 0", expression)
+	}
 	if strings.HasPrefix(v.GoType, "*") {
 		if v.GoType == "**float32" {
 			// Go doesn't like this cast: (**float32)(x) where x is a **C.float32, so work around it
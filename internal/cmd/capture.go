@@ -0,0 +1,300 @@
+// +build capture
+
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+	"unsafe"
+
+	"github.com/richardwilkes/cef/cef"
+	"github.com/richardwilkes/toolbox"
+	"github.com/richardwilkes/toolbox/atexit"
+	"github.com/richardwilkes/toolbox/cmdline"
+)
+
+const (
+	captureFormatPNG = "png"
+	captureFormatPDF = "pdf"
+)
+
+func init() {
+	// CEF must be initialized and run on the main thread.
+	runtime.LockOSThread()
+}
+
+type capture struct {
+	cef.DefaultClient
+	cef.DefaultLoadHandler
+	cef.DefaultLifeSpanHandler
+	cef.DefaultPDFPrintCallback
+	output      string
+	format      string
+	width       int
+	height      int
+	scale       float64
+	waitLoadEnd bool
+	delay       time.Duration
+	timeout     time.Duration
+	script      string
+	landscape   bool
+	renderer    *cef.OffscreenRenderer
+	browser     *cef.Browser
+	started     bool
+	finished    bool
+	err         error
+}
+
+// NewCapture returns the capture command.
+func NewCapture() cmdline.Cmd {
+	return &capture{
+		width:   1280,
+		height:  720,
+		scale:   1,
+		timeout: 30 * time.Second,
+	}
+}
+
+// ExecuteSubprocess runs the CEF secondary process logic and exits if the
+// command line indicates this is one of the subprocesses CEF launched while
+// capturing. Must be called before the command line is parsed.
+func ExecuteSubprocess() {
+	for _, arg := range os.Args[1:] {
+		if strings.HasPrefix(arg, "--type=") {
+			if code := cef.ExecuteProcess(cef.NewMainArgs(), nil, nil); code >= 0 {
+				atexit.Exit(int(code))
+			}
+			return
+		}
+	}
+}
+
+func (c *capture) Name() string {
+	return "capture"
+}
+
+func (c *capture) Usage() string {
+	return "Renders a URL or local HTML file offscreen and writes it out as a PNG or PDF."
+}
+
+func (c *capture) Run(cl *cmdline.CmdLine, args []string) error {
+	cl.UsageSuffix = "<url or file>"
+	cl.NewStringOption(&c.output).SetSingle('o').SetName("output").SetUsage("Set the output file (required)")
+	cl.NewStringOption(&c.format).SetSingle('f').SetName("format").SetUsage("Set the output format, either png or pdf. If not set, it is determined from the output file's extension")
+	cl.NewIntOption(&c.width).SetSingle('W').SetName("width").SetUsage("Set the width of the viewport")
+	cl.NewIntOption(&c.height).SetSingle('H').SetName("height").SetUsage("Set the height of the viewport")
+	cl.NewFloat64Option(&c.scale).SetSingle('s').SetName("scale").SetUsage("Set the device scale factor")
+	cl.NewBoolOption(&c.waitLoadEnd).SetSingle('l').SetName("load-end").SetUsage("Wait for the main frame to finish loading before capturing")
+	cl.NewDurationOption(&c.delay).SetSingle('d').SetName("delay").SetUsage("Set an additional delay before capturing")
+	cl.NewDurationOption(&c.timeout).SetSingle('t').SetName("timeout").SetUsage("Set the maximum amount of time to wait for the capture to complete")
+	cl.NewStringOption(&c.script).SetSingle('j').SetName("js").SetUsage("Set JavaScript to run in the main frame before the delay and capture")
+	cl.NewBoolOption(&c.landscape).SetName("landscape").SetUsage("Use landscape orientation for PDF output")
+	remaining := cl.Parse(args)
+	if len(remaining) != 1 {
+		cl.FatalMsg("Exactly one URL or file must be specified.")
+	}
+	if c.output == "" {
+		cl.FatalMsg("An output file must be specified.")
+	}
+	if c.format == "" {
+		c.format = strings.TrimPrefix(strings.ToLower(filepath.Ext(c.output)), ".")
+	}
+	if c.format != captureFormatPNG && c.format != captureFormatPDF {
+		cl.FatalMsg("The output format must be either png or pdf.")
+	}
+	if c.width < 1 || c.height < 1 {
+		cl.FatalMsg("The viewport width and height must be greater than zero.")
+	}
+	if c.scale <= 0 {
+		cl.FatalMsg("The device scale factor must be greater than zero.")
+	}
+	target, err := captureURL(remaining[0])
+	if err != nil {
+		return err
+	}
+	checkPlatform()
+	return c.capture(target)
+}
+
+func captureURL(target string) (string, error) {
+	if _, err := os.Stat(target); err != nil {
+		// Not a local file, so assume it is a URL.
+		return target, nil
+	}
+	p, err := filepath.Abs(target)
+	if err != nil {
+		return "", err
+	}
+	p = filepath.ToSlash(p)
+	if !strings.HasPrefix(p, "/") {
+		p = "/" + p
+	}
+	return "file://" + p, nil
+}
+
+func (c *capture) capture(target string) error {
+	exe, err := os.Executable()
+	if err != nil {
+		return err
+	}
+	cef.InstantiateApplication()
+	settings := cef.NewSettings()
+	settings.WindowlessRenderingEnabled = true
+	settings.BrowserSubprocessPath = exe
+	if runtime.GOOS == toolbox.MacOS {
+		settings.FrameworkDirPath = path.Join(installPrefix, "Release", "Chromium Embedded Framework.framework")
+	}
+	if !cef.Initialize(cef.NewMainArgs(), settings, nil, nil) {
+		return fmt.Errorf("Unable to initialize CEF")
+	}
+	defer cef.Shutdown()
+	c.renderer = cef.NewOffscreenRenderer(c.width, c.height, float32(c.scale))
+	windowInfo := &cef.WindowInfo{
+		Width:                      int32(c.width),
+		Height:                     int32(c.height),
+		WindowlessRenderingEnabled: true,
+	}
+	browserSettings := cef.NewBrowserSettings()
+	browserSettings.WindowlessFrameRate = 30
+	browserSettings.BackgroundColor = 0xFFFFFFFF
+	if !cef.BrowserHostCreateBrowser(windowInfo, cef.NewClient(c), target, browserSettings, nil) {
+		return fmt.Errorf("Unable to create browser for %s", target)
+	}
+	c.after(c.timeout, func() {
+		c.finish(fmt.Errorf("Timed out waiting for the capture of %s", target))
+	})
+	cef.RunMessageLoop()
+	return c.err
+}
+
+func (c *capture) GetLifeSpanHandler(self *cef.Client) *cef.LifeSpanHandler {
+	return cef.NewLifeSpanHandler(c)
+}
+
+func (c *capture) GetLoadHandler(self *cef.Client) *cef.LoadHandler {
+	return cef.NewLoadHandler(c)
+}
+
+func (c *capture) GetRenderHandler(self *cef.Client) *cef.RenderHandler {
+	return cef.NewRenderHandler(c.renderer)
+}
+
+func (c *capture) OnAfterCreated(self *cef.LifeSpanHandler, browser *cef.Browser) {
+	// Keeps the reference to the browser until it closes.
+	c.browser = browser
+	if !c.waitLoadEnd {
+		c.start()
+	}
+}
+
+func (c *capture) OnBeforeClose(self *cef.LifeSpanHandler, browser *cef.Browser) {
+	cef.ReleaseAll(browser, c.browser)
+	c.browser = nil
+	cef.QuitMessageLoop()
+}
+
+func (c *capture) OnLoadEnd(self *cef.LoadHandler, browser *cef.Browser, frame *cef.Frame, httpStatusCode int32) {
+	defer cef.ReleaseAll(browser, frame)
+	if frame.IsMain() {
+		c.start()
+	}
+}
+
+func (c *capture) OnLoadError(self *cef.LoadHandler, browser *cef.Browser, frame *cef.Frame, errorCode cef.Errorcode, errorText, failedURL string) {
+	defer cef.ReleaseAll(browser, frame)
+	if frame.IsMain() && errorCode != cef.ErrAborted {
+		c.finish(fmt.Errorf("Unable to load %s: %s", failedURL, errorText))
+	}
+}
+
+func (c *capture) start() {
+	if c.started {
+		return
+	}
+	c.started = true
+	if c.script != "" {
+		frame := c.browser.GetMainFrame()
+		frame.ExecuteJavaScript(c.script, "", 1)
+		cef.ReleaseAll(frame)
+	}
+	c.after(c.delay, func() {
+		if c.format == captureFormatPDF {
+			c.capturePDF()
+		} else {
+			c.capturePNG()
+		}
+	})
+}
+
+func (c *capture) capturePNG() {
+	if c.finished {
+		return
+	}
+	img := c.renderer.Snapshot()
+	if img == nil {
+		// Nothing has been painted yet, so try again shortly.
+		c.after(100*time.Millisecond, c.capturePNG)
+		return
+	}
+	size := img.Rect.Size()
+	image := cef.ImageCreate()
+	defer cef.ReleaseAll(image)
+	if !image.AddBitmap(float32(c.scale), int32(size.X), int32(size.Y), cef.ColorTypeRgba8888, cef.AlphaTypePremultiplied, unsafe.Pointer(&img.Pix[0]), uint64(len(img.Pix))) {
+		c.finish(fmt.Errorf("Unable to create image"))
+		return
+	}
+	var width, height int32
+	data := image.GetAsPng(float32(c.scale), false, &width, &height)
+	if data == nil {
+		c.finish(fmt.Errorf("Unable to encode image as PNG"))
+		return
+	}
+	defer cef.ReleaseAll(data)
+	c.finish(ioutil.WriteFile(c.output, data.Bytes(), 0644))
+}
+
+func (c *capture) capturePDF() {
+	if c.finished {
+		return
+	}
+	settings := cef.NewPDFPrintSettings()
+	settings.BackgroundsEnabled = true
+	settings.Landscape = c.landscape
+	host := c.browser.GetHost()
+	host.PrintToPdf(c.output, settings, cef.NewPDFPrintCallback(c))
+	cef.ReleaseAll(host)
+}
+
+func (c *capture) OnPdfPrintFinished(self *cef.PDFPrintCallback, path string, ok bool) {
+	if ok {
+		c.finish(nil)
+	} else {
+		c.finish(fmt.Errorf("Unable to write PDF to %s", path))
+	}
+}
+
+func (c *capture) finish(err error) {
+	if c.finished {
+		return
+	}
+	c.finished = true
+	c.err = err
+	if c.browser != nil {
+		host := c.browser.GetHost()
+		host.CloseBrowser(true)
+		cef.ReleaseAll(host)
+	} else {
+		cef.QuitMessageLoop()
+	}
+}
+
+// after runs fn on the UI thread once the delay has passed.
+func (c *capture) after(delay time.Duration, fn func()) {
+	cef.RunOnAfter(cef.TIDUI, delay, fn)
+}
@@ -0,0 +1,35 @@
+// +build !capture
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/richardwilkes/toolbox/cmdline"
+)
+
+type capture struct {
+}
+
+// NewCapture returns the capture command. Since capturing requires the CEF
+// libraries to be linked in, which in turn requires them to be installed
+// first, the command is only functional when built with the 'capture' tag.
+func NewCapture() cmdline.Cmd {
+	return &capture{}
+}
+
+// ExecuteSubprocess does nothing when not built with the 'capture' tag.
+func ExecuteSubprocess() {
+}
+
+func (c *capture) Name() string {
+	return "capture"
+}
+
+func (c *capture) Usage() string {
+	return "Renders a page offscreen and writes it out as a PNG or PDF (requires building with '-tags capture')."
+}
+
+func (c *capture) Run(cl *cmdline.CmdLine, args []string) error {
+	return fmt.Errorf("The capture command is not available. Run 'cef install', then rebuild with 'go install -tags capture'")
+}
@@ -12,6 +12,7 @@ import (
 const desiredCEFVersion = "73.1.12+gee4b49f+chromium-73.0.3683.75"
 
 func main() {
+	cmd.ExecuteSubprocess()
 	cmdline.CopyrightYears = "2018-2019"
 	cmdline.CopyrightHolder = "Richard A. Wilkes"
 	cmdline.AppIdentifier = "com.trollworks.cef"
@@ -19,6 +20,7 @@ func main() {
 	cl.Description = "Utilities for managing setup of the Chromium Embedded Framework."
 	cl.AddCommand(cmd.NewInstall(desiredCEFVersion))
 	cl.AddCommand(cmd.NewDist())
+	cl.AddCommand(cmd.NewCapture())
 	if err := cl.RunCommand(cl.Parse(os.Args[1:])); err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		atexit.Exit(1)